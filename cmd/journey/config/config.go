@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/joho/godotenv"
@@ -13,6 +14,22 @@ const PREFIX_ENVIRONMENT_VARIABLES = "JOURNEY_"
 const SPLIT_OPERATOR_ENVIRONMENT_VARIABLES = "="
 const DEFAULT_PATH_TO_ENVIRONMENT_VARIABLES_FILE = "../../../.env"
 
+// ENVIRONMENT_VARIABLES_FILE_VARIABLE names the variable giving the path of the .env file, as the -env-file
+// flag does.
+const ENVIRONMENT_VARIABLES_FILE_VARIABLE = "JOURNEY_ENV_FILE"
+
+// ENVIRONMENT_VARIABLES_FILE_NAME is the .env file searched upward from the working directory.
+const ENVIRONMENT_VARIABLES_FILE_NAME = ".env"
+
+// environmentVariablesFile is the path of the .env file given by the -env-file flag, see SetEnvironmentVariablesFile.
+var environmentVariablesFile string
+
+// SetEnvironmentVariablesFile sets the path of the .env file, as given on the command line. It takes
+// precedence over the JOURNEY_ENV_FILE variable.
+func SetEnvironmentVariablesFile(path string) {
+	environmentVariablesFile = path
+}
+
 func GetSpecificEnvironmentVariable(key string) (string, error) {
 
 	var stringEmpty = ""
@@ -27,16 +44,32 @@ func GetSpecificEnvironmentVariable(key string) (string, error) {
 	return variable, nil
 }
 
+// GetEnvironmentVariables merges the JOURNEY_ variables of the .env file and of the os, the os value taking
+// precedence on a key set in both. A .env file missing is only an error when given by the flag or
+// JOURNEY_ENV_FILE, or when the os has no variable either.
 func GetEnvironmentVariables() (map[string]string, error) {
 
-	var variables map[string]string
+	path := givenEnvironmentVariablesFile()
+	searched := path == ""
+	if searched {
+		path = findEnvironmentVariablesFile()
+	}
 
-	variables, err := getEnvironmentVariablesFromOS()
-	if err != nil {
-		variables, err = getEnvironmentVariablesFromEnvFile()
-		if err != nil {
-			return nil, errors.New("environment variables don't found in os and .env file")
-		}
+	variables, fileErr := getEnvironmentVariablesFromEnvFile(path)
+	if fileErr != nil && !searched {
+		return nil, fileErr
+	}
+
+	osVariables, osErr := getEnvironmentVariablesFromOS()
+	if fileErr != nil && osErr != nil {
+		return nil, errors.New("environment variables don't found in os and .env file")
+	}
+
+	if variables == nil {
+		variables = make(map[string]string, len(osVariables))
+	}
+	for key, value := range osVariables {
+		variables[key] = value
 	}
 
 	return variables, nil
@@ -46,7 +79,7 @@ func getSpecificEnvironmentVariableFromEnvFile(key string) (string, error) {
 
 	responseEmpty := ""
 
-	variables, err := getEnvironmentVariablesFromEnvFile()
+	variables, err := getEnvironmentVariablesFromEnvFile(findEnvironmentVariablesFile())
 	if err != nil {
 		return responseEmpty, err
 	}
@@ -70,32 +103,58 @@ func getSpecificEnvironmentVariableFromOs(key string) (string, error) {
 	return value, nil
 }
 
-func getEnvironmentVariablesFromEnvFile() (map[string]string, error) {
+// givenEnvironmentVariablesFile is the path of the .env file given by the -env-file flag, else by the
+// JOURNEY_ENV_FILE variable, empty when none is.
+func givenEnvironmentVariablesFile() string {
+	if environmentVariablesFile != "" {
+		return environmentVariablesFile
+	}
+	return os.Getenv(ENVIRONMENT_VARIABLES_FILE_VARIABLE)
+}
 
-	err := godotenv.Load(DEFAULT_PATH_TO_ENVIRONMENT_VARIABLES_FILE)
+// findEnvironmentVariablesFile searches a .env file upward from the working directory, falling back to the
+// default path when none is found.
+func findEnvironmentVariablesFile() string {
+	dir, err := os.Getwd()
 	if err != nil {
-		return nil, errors.New(err.Error())
+		return DEFAULT_PATH_TO_ENVIRONMENT_VARIABLES_FILE
 	}
 
-	dictionaryVariables := make(map[string]string)
-	variables := os.Environ()
-	variablesFiltered := filterApplicationEnvironmentVariables(variables)
+	for {
+		path := filepath.Join(dir, ENVIRONMENT_VARIABLES_FILE_NAME)
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			return path
+		}
 
-	if len(variablesFiltered) == 0 {
-		return nil, errors.New(fmt.Sprintf("environment variables don't found in .env file '%s'", DEFAULT_PATH_TO_ENVIRONMENT_VARIABLES_FILE))
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return DEFAULT_PATH_TO_ENVIRONMENT_VARIABLES_FILE
+		}
+		dir = parent
 	}
+}
 
-	for _, row := range variablesFiltered {
+// getEnvironmentVariablesFromEnvFile reads the JOURNEY_ variables of the .env file, without setting them in
+// the os environment.
+func getEnvironmentVariablesFromEnvFile(path string) (map[string]string, error) {
 
-		fieldsValue := strings.Split(row, SPLIT_OPERATOR_ENVIRONMENT_VARIABLES)
+	variables, err := godotenv.Read(path)
+	if err != nil {
+		return nil, errors.New(err.Error())
+	}
 
-		key := strings.Trim(fieldsValue[0], " ")
-		value := strings.Trim(fieldsValue[1], " ")
+	dictionaryVariables := make(map[string]string)
+	for key, value := range variables {
+		if strings.HasPrefix(key, PREFIX_ENVIRONMENT_VARIABLES) {
+			dictionaryVariables[strings.Trim(key, " ")] = strings.Trim(value, " ")
+		}
+	}
 
-		dictionaryVariables[key] = value
+	if len(dictionaryVariables) == 0 {
+		return nil, errors.New(fmt.Sprintf("environment variables don't found in .env file '%s'", path))
 	}
 
-	return dictionaryVariables, err
+	return dictionaryVariables, nil
 }
 
 func getEnvironmentVariablesFromOS() (map[string]string, error) {
@@ -109,16 +168,23 @@ func getEnvironmentVariablesFromOS() (map[string]string, error) {
 	}
 
 	for _, row := range variablesFiltered {
+		if key, value, ok := parseEnvironmentVariable(row); ok {
+			dictionaryVariables[key] = value
+		}
+	}
 
-		fieldsValue := strings.Split(row, SPLIT_OPERATOR_ENVIRONMENT_VARIABLES)
-
-		key := strings.Trim(fieldsValue[0], " ")
-		value := strings.Trim(fieldsValue[1], " ")
+	return dictionaryVariables, nil
+}
 
-		dictionaryVariables[key] = value
+// parseEnvironmentVariable splits the row on its first "=", the value keeping the next ones as a secret
+// may hold them. A row without "=" is not a variable.
+func parseEnvironmentVariable(row string) (key string, value string, ok bool) {
+	fieldsValue := strings.SplitN(row, SPLIT_OPERATOR_ENVIRONMENT_VARIABLES, 2)
+	if len(fieldsValue) != 2 {
+		return "", "", false
 	}
 
-	return dictionaryVariables, nil
+	return strings.Trim(fieldsValue[0], " "), strings.Trim(fieldsValue[1], " "), true
 }
 
 func filterApplicationEnvironmentVariables(variables []string) []string {
@@ -0,0 +1,151 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// writeEnvFile writes the .env content in a temp dir, answering its path.
+func writeEnvFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), ".env")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestGetEnvironmentVariablesFromTheGivenFile(t *testing.T) {
+	path := writeEnvFile(t, "JOURNEY_TEST_FROM_FILE=fixture\n")
+	t.Setenv(ENVIRONMENT_VARIABLES_FILE_VARIABLE, path)
+
+	variables, err := GetEnvironmentVariables()
+	if err != nil {
+		t.Fatalf("expected the variables loaded, got %v", err)
+	}
+	if variables["JOURNEY_TEST_FROM_FILE"] != "fixture" {
+		t.Fatalf("expected the variable of %s, got %q", path, variables["JOURNEY_TEST_FROM_FILE"])
+	}
+}
+
+func TestTheFlagOverridesTheEnvFileVariable(t *testing.T) {
+	fromVariable := writeEnvFile(t, "JOURNEY_TEST_FROM_VARIABLE=variable\n")
+	fromFlag := writeEnvFile(t, "JOURNEY_TEST_FROM_FLAG=flag\n")
+	t.Setenv(ENVIRONMENT_VARIABLES_FILE_VARIABLE, fromVariable)
+	SetEnvironmentVariablesFile(fromFlag)
+	t.Cleanup(func() { SetEnvironmentVariablesFile("") })
+
+	variables, err := GetEnvironmentVariables()
+	if err != nil {
+		t.Fatalf("expected the variables loaded, got %v", err)
+	}
+	if variables["JOURNEY_TEST_FROM_FLAG"] != "flag" {
+		t.Fatalf("expected the variable of the flag file, got %q", variables["JOURNEY_TEST_FROM_FLAG"])
+	}
+	if _, loaded := variables["JOURNEY_TEST_FROM_VARIABLE"]; loaded {
+		t.Fatal("expected the JOURNEY_ENV_FILE file overridden by the flag one")
+	}
+}
+
+func TestGetEnvironmentVariablesMergesTheOsAndTheFile(t *testing.T) {
+	path := writeEnvFile(t, "JOURNEY_TEST_APP_PORT=8080\nJOURNEY_TEST_DATABASE_HOST=localhost\nJOURNEY_TEST_DATABASE_NAME=journey\n")
+	t.Setenv(ENVIRONMENT_VARIABLES_FILE_VARIABLE, path)
+	t.Setenv("JOURNEY_TEST_DATABASE_HOST", "db.internal")
+
+	variables, err := GetEnvironmentVariables()
+	if err != nil {
+		t.Fatalf("expected the variables loaded, got %v", err)
+	}
+
+	expected := map[string]string{
+		"JOURNEY_TEST_APP_PORT":      "8080",
+		"JOURNEY_TEST_DATABASE_HOST": "db.internal",
+		"JOURNEY_TEST_DATABASE_NAME": "journey",
+	}
+	for key, value := range expected {
+		if variables[key] != value {
+			t.Fatalf("expected %s=%s, got %q", key, value, variables[key])
+		}
+	}
+}
+
+func TestGetEnvironmentVariablesWithoutTheFile(t *testing.T) {
+	t.Setenv("JOURNEY_TEST_APP_PORT", "8080")
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = os.Chdir(wd) })
+
+	variables, err := GetEnvironmentVariables()
+	if err != nil {
+		t.Fatalf("expected the os variables answered without a .env file, got %v", err)
+	}
+	if variables["JOURNEY_TEST_APP_PORT"] != "8080" {
+		t.Fatalf("expected the os variable, got %q", variables["JOURNEY_TEST_APP_PORT"])
+	}
+}
+
+func TestFindEnvironmentVariablesFileSearchesUpward(t *testing.T) {
+	root := t.TempDir()
+	nested := filepath.Join(root, "cmd", "journey")
+	if err := os.MkdirAll(nested, 0o700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(root, ".env"), nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(nested); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = os.Chdir(wd) })
+
+	found := findEnvironmentVariablesFile()
+
+	// the temp dir may be reached through a symlink, as /tmp on macOS.
+	expected, _ := filepath.EvalSymlinks(filepath.Join(root, ".env"))
+	if resolved, _ := filepath.EvalSymlinks(found); resolved != expected {
+		t.Fatalf("expected %s found, got %s", expected, found)
+	}
+}
+
+func TestParseEnvironmentVariable(t *testing.T) {
+	cases := map[string]struct {
+		key   string
+		value string
+		ok    bool
+	}{
+		"JOURNEY_DATABASE_PASSWORD=ab=cd==": {key: "JOURNEY_DATABASE_PASSWORD", value: "ab=cd==", ok: true},
+		"JOURNEY_DATABASE_PASSWORD=":        {key: "JOURNEY_DATABASE_PASSWORD", value: "", ok: true},
+		"JOURNEY_DATABASE_PASSWORD":         {ok: false},
+	}
+
+	for row, expected := range cases {
+		key, value, ok := parseEnvironmentVariable(row)
+		if key != expected.key || value != expected.value || ok != expected.ok {
+			t.Fatalf("expected %q parsed as (%q, %q, %v), got (%q, %q, %v)", row, expected.key, expected.value, expected.ok, key, value, ok)
+		}
+	}
+}
+
+func TestGetEnvironmentVariablesKeepsTheEqualSigns(t *testing.T) {
+	t.Setenv(ENVIRONMENT_VARIABLES_FILE_VARIABLE, writeEnvFile(t, "JOURNEY_TEST_FILE_SECRET=\"ab=cd\"\n"))
+	t.Setenv("JOURNEY_TEST_OS_SECRET", "ef=gh=")
+
+	variables, err := GetEnvironmentVariables()
+	if err != nil {
+		t.Fatalf("expected the variables loaded, got %v", err)
+	}
+	if variables["JOURNEY_TEST_FILE_SECRET"] != "ab=cd" || variables["JOURNEY_TEST_OS_SECRET"] != "ef=gh=" {
+		t.Fatalf("expected the secrets kept whole, got %q and %q", variables["JOURNEY_TEST_FILE_SECRET"], variables["JOURNEY_TEST_OS_SECRET"])
+	}
+}
@@ -0,0 +1,51 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// requiredVariables are the variables the app does not start without, with the check of their value. The
+// JOURNEY_DATABASE_PASSWORD is left out, a trusted connection has none.
+var requiredVariables = []struct {
+	key   string
+	check func(string) error
+}{
+	{"JOURNEY_APP_PORT", checkPort},
+	{"JOURNEY_DATABASE_HOST", nil},
+	{"JOURNEY_DATABASE_PORT", checkPort},
+	{"JOURNEY_DATABASE_NAME", nil},
+	{"JOURNEY_DATABASE_USER", nil},
+}
+
+// Validate checks the required variables are present and well-formed, answering every missing or invalid one
+// at once rather than the first, so a misconfigured deploy fails on startup with all it has to fix.
+func Validate(variables map[string]string) error {
+	var errs []error
+	for _, required := range requiredVariables {
+		value := variables[required.key]
+		if value == "" {
+			errs = append(errs, fmt.Errorf("%s is missing", required.key))
+			continue
+		}
+		if required.check != nil {
+			if err := required.check(value); err != nil {
+				errs = append(errs, fmt.Errorf("%s is invalid: %w", required.key, err))
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid environment variables:\n%w", errors.Join(errs...))
+	}
+	return nil
+}
+
+func checkPort(value string) error {
+	port, err := strconv.Atoi(value)
+	if err != nil || port < 1 || port > 65535 {
+		return fmt.Errorf("expected a port between 1 and 65535, got %q", value)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func newValidEnv(overrides map[string]string) map[string]string {
+	variables := map[string]string{
+		"JOURNEY_APP_PORT":          "8080",
+		"JOURNEY_DATABASE_HOST":     "localhost",
+		"JOURNEY_DATABASE_PORT":     "5432",
+		"JOURNEY_DATABASE_NAME":     "journey",
+		"JOURNEY_DATABASE_USER":     "postgres",
+		"JOURNEY_DATABASE_PASSWORD": "secret",
+	}
+	for key, value := range overrides {
+		if value == "" {
+			delete(variables, key)
+			continue
+		}
+		variables[key] = value
+	}
+	return variables
+}
+
+func TestValidate(t *testing.T) {
+	if err := Validate(newValidEnv(nil)); err != nil {
+		t.Fatalf("expected the env valid, got %v", err)
+	}
+}
+
+func TestValidateMissingVariable(t *testing.T) {
+	err := Validate(newValidEnv(map[string]string{"JOURNEY_APP_PORT": ""}))
+
+	if err == nil || !strings.Contains(err.Error(), "JOURNEY_APP_PORT is missing") {
+		t.Fatalf("expected the missing JOURNEY_APP_PORT reported, got %v", err)
+	}
+	if strings.Contains(err.Error(), "JOURNEY_DATABASE") {
+		t.Fatalf("expected only JOURNEY_APP_PORT reported, got %v", err)
+	}
+}
+
+func TestValidateReportsEveryInvalidVariable(t *testing.T) {
+	err := Validate(newValidEnv(map[string]string{
+		"JOURNEY_DATABASE_HOST": "",
+		"JOURNEY_DATABASE_USER": "",
+		"JOURNEY_DATABASE_PORT": "postgres",
+	}))
+
+	if err == nil {
+		t.Fatal("expected the env invalid")
+	}
+	for _, expected := range []string{"JOURNEY_DATABASE_HOST is missing", "JOURNEY_DATABASE_USER is missing", "JOURNEY_DATABASE_PORT is invalid"} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Fatalf("expected %q reported, got %v", expected, err)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// newPoolConfig parses the connection of the JOURNEY_DATABASE_* variables and tunes the pool with the
+// JOURNEY_DB_* ones: JOURNEY_DB_MAX_CONNS, JOURNEY_DB_MIN_CONNS, JOURNEY_DB_MAX_CONN_LIFETIME,
+// JOURNEY_DB_MAX_CONN_IDLE_TIME and JOURNEY_DB_CONNECT_TIMEOUT (durations as "30m"). A missing variable keeps
+// the pgxpool default, an invalid one fails the startup.
+//
+// pgxpool has no acquire timeout of its own, the acquisitions are bounded by the contexts of the requests.
+func newPoolConfig(envVariables map[string]string) (*pgxpool.Config, error) {
+	poolConfig, err := pgxpool.ParseConfig(fmt.Sprintf("user=%s password=%s host=%s port=%s dbname=%s",
+		envVariables["JOURNEY_DATABASE_USER"],
+		envVariables["JOURNEY_DATABASE_PASSWORD"],
+		envVariables["JOURNEY_DATABASE_HOST"],
+		envVariables["JOURNEY_DATABASE_PORT"],
+		envVariables["JOURNEY_DATABASE_NAME"],
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the database config: %w", err)
+	}
+
+	if err := parseConns(envVariables, "JOURNEY_DB_MAX_CONNS", &poolConfig.MaxConns); err != nil {
+		return nil, err
+	}
+	if err := parseConns(envVariables, "JOURNEY_DB_MIN_CONNS", &poolConfig.MinConns); err != nil {
+		return nil, err
+	}
+	if err := parseDuration(envVariables, "JOURNEY_DB_MAX_CONN_LIFETIME", &poolConfig.MaxConnLifetime); err != nil {
+		return nil, err
+	}
+	if err := parseDuration(envVariables, "JOURNEY_DB_MAX_CONN_IDLE_TIME", &poolConfig.MaxConnIdleTime); err != nil {
+		return nil, err
+	}
+	if err := parseDuration(envVariables, "JOURNEY_DB_CONNECT_TIMEOUT", &poolConfig.ConnConfig.ConnectTimeout); err != nil {
+		return nil, err
+	}
+
+	if poolConfig.MaxConns < 1 {
+		return nil, fmt.Errorf("JOURNEY_DB_MAX_CONNS must be at least 1, got %d", poolConfig.MaxConns)
+	}
+	if poolConfig.MaxConns < poolConfig.MinConns {
+		return nil, fmt.Errorf("JOURNEY_DB_MAX_CONNS (%d) must be at least JOURNEY_DB_MIN_CONNS (%d)",
+			poolConfig.MaxConns, poolConfig.MinConns)
+	}
+
+	return poolConfig, nil
+}
+
+// parseConns sets the connections count of the variable, when present.
+func parseConns(envVariables map[string]string, key string, conns *int32) error {
+	value := envVariables[key]
+	if value == "" {
+		return nil
+	}
+
+	parsed, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || parsed < 0 {
+		return fmt.Errorf("%s must be a non-negative integer, got %q", key, value)
+	}
+	*conns = int32(parsed)
+	return nil
+}
+
+// parseDuration sets the duration of the variable, when present.
+func parseDuration(envVariables map[string]string, key string, duration *time.Duration) error {
+	value := envVariables[key]
+	if value == "" {
+		return nil
+	}
+
+	parsed, err := time.ParseDuration(value)
+	if err != nil || parsed < 0 {
+		return fmt.Errorf("%s must be a non-negative duration as \"30m\", got %q", key, value)
+	}
+	*duration = parsed
+	return nil
+}
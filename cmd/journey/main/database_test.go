@@ -0,0 +1,94 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func newDatabaseEnv(overrides map[string]string) map[string]string {
+	envVariables := map[string]string{
+		"JOURNEY_DATABASE_USER":     "journey",
+		"JOURNEY_DATABASE_PASSWORD": "secret",
+		"JOURNEY_DATABASE_HOST":     "localhost",
+		"JOURNEY_DATABASE_PORT":     "5432",
+		"JOURNEY_DATABASE_NAME":     "journey",
+	}
+	for key, value := range overrides {
+		envVariables[key] = value
+	}
+	return envVariables
+}
+
+func TestNewPoolConfigKeepsTheDefaults(t *testing.T) {
+	poolConfig, err := newPoolConfig(newDatabaseEnv(nil))
+	if err != nil {
+		t.Fatalf("expected the config parsed, got %v", err)
+	}
+
+	if poolConfig.MaxConns < 1 || poolConfig.MinConns != 0 {
+		t.Fatalf("expected the pgxpool default conns, got max %d min %d", poolConfig.MaxConns, poolConfig.MinConns)
+	}
+	if poolConfig.MaxConnLifetime != time.Hour || poolConfig.MaxConnIdleTime != 30*time.Minute {
+		t.Fatalf("expected the pgxpool default lifetimes, got %s and %s", poolConfig.MaxConnLifetime, poolConfig.MaxConnIdleTime)
+	}
+	if poolConfig.ConnConfig.Host != "localhost" || poolConfig.ConnConfig.Database != "journey" {
+		t.Fatalf("expected the connection of the env, got %s/%s", poolConfig.ConnConfig.Host, poolConfig.ConnConfig.Database)
+	}
+}
+
+func TestNewPoolConfigReadsTheEnv(t *testing.T) {
+	poolConfig, err := newPoolConfig(newDatabaseEnv(map[string]string{
+		"JOURNEY_DB_MAX_CONNS":          "20",
+		"JOURNEY_DB_MIN_CONNS":          "4",
+		"JOURNEY_DB_MAX_CONN_LIFETIME":  "15m",
+		"JOURNEY_DB_MAX_CONN_IDLE_TIME": "90s",
+		"JOURNEY_DB_CONNECT_TIMEOUT":    "5s",
+	}))
+	if err != nil {
+		t.Fatalf("expected the config parsed, got %v", err)
+	}
+
+	if poolConfig.MaxConns != 20 || poolConfig.MinConns != 4 {
+		t.Fatalf("expected max 20 min 4 conns, got max %d min %d", poolConfig.MaxConns, poolConfig.MinConns)
+	}
+	if poolConfig.MaxConnLifetime != 15*time.Minute || poolConfig.MaxConnIdleTime != 90*time.Second {
+		t.Fatalf("expected 15m and 90s lifetimes, got %s and %s", poolConfig.MaxConnLifetime, poolConfig.MaxConnIdleTime)
+	}
+	if poolConfig.ConnConfig.ConnectTimeout != 5*time.Second {
+		t.Fatalf("expected a 5s connect timeout, got %s", poolConfig.ConnConfig.ConnectTimeout)
+	}
+}
+
+func TestNewPoolConfigRejectsInvalidValues(t *testing.T) {
+	cases := map[string]struct {
+		overrides map[string]string
+		expected  string
+	}{
+		"max below min": {
+			overrides: map[string]string{"JOURNEY_DB_MAX_CONNS": "2", "JOURNEY_DB_MIN_CONNS": "5"},
+			expected:  "JOURNEY_DB_MAX_CONNS (2) must be at least JOURNEY_DB_MIN_CONNS (5)",
+		},
+		"zero max": {
+			overrides: map[string]string{"JOURNEY_DB_MAX_CONNS": "0"},
+			expected:  "JOURNEY_DB_MAX_CONNS must be at least 1",
+		},
+		"malformed conns": {
+			overrides: map[string]string{"JOURNEY_DB_MIN_CONNS": "many"},
+			expected:  "JOURNEY_DB_MIN_CONNS must be a non-negative integer",
+		},
+		"malformed duration": {
+			overrides: map[string]string{"JOURNEY_DB_CONNECT_TIMEOUT": "5"},
+			expected:  "JOURNEY_DB_CONNECT_TIMEOUT must be a non-negative duration",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := newPoolConfig(newDatabaseEnv(tc.overrides))
+			if err == nil || !strings.Contains(err.Error(), tc.expected) {
+				t.Fatalf("expected %q, got %v", tc.expected, err)
+			}
+		})
+	}
+}
@@ -3,28 +3,43 @@ package main
 import (
 	"context"
 	"errors"
+	"flag"
 	"fmt"
 	"journey/cmd/journey/config"
 	"journey/internal/api"
-	"journey/internal/api/spec"
+	"journey/internal/cors"
+	"journey/internal/httplog"
+	"journey/internal/mailer/dispatcher"
 	"journey/internal/mailer/mailpit"
+	"journey/internal/metrics"
+	"journey/internal/pgstore"
+	"journey/internal/ratelimit"
+	"journey/internal/reminder"
+	"journey/internal/webhook"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
+	// Embeds the timezones the trips are grouped by, the image may lack them.
+	_ "time/tzdata"
 
 	"github.com/go-chi/chi/middleware"
 	"github.com/go-chi/chi/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
-	"github.com/phenpessoa/gutils/netutils/httputils"
 	"go.uber.org/zap"
-	"go.uber.org/zap/zapcore"
 )
 
+// version is the version the app is built as, set with -ldflags "-X main.version=...".
+var version = api.DEFAULT_VERSION
+
 func main() {
-	ctx := context.Background()
-	ctx, cancel := signal.NotifyContext(ctx, os.Interrupt, os.Kill, syscall.SIGTERM, syscall.SIGKILL)
+	envFile := flag.String("env-file", "", "path of the .env file, overriding JOURNEY_ENV_FILE")
+	flag.Parse()
+	config.SetEnvironmentVariablesFile(*envFile)
+
+	ctx, cancel := withShutdownSignals(context.Background())
 	defer cancel()
 
 	if err := run(ctx); err != nil {
@@ -34,11 +49,27 @@ func main() {
 	fmt.Println("goodbye :)")
 }
 
+// shutdownTimeout bounds how long the requests in flight, then the queued emails, are waited on exit.
+const shutdownTimeout = 30 * time.Second
+
+// withShutdownSignals is done on SIGINT or SIGTERM, as sent by a deploy stopping the container.
+func withShutdownSignals(ctx context.Context) (context.Context, context.CancelFunc) {
+	return signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+}
+
+// run serves the API until ctx is done, then drains the requests in flight, the queued emails and finally
+// closes the pool, in this order: a request still answered may enqueue an email or query the database.
 func run(ctx context.Context) error {
-	cfg := zap.NewDevelopmentConfig()
-	cfg.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	envVariables, err := config.GetEnvironmentVariables()
+	if err != nil {
+		return err
+	}
+
+	if err := config.Validate(envVariables); err != nil {
+		return err
+	}
 
-	logger, err := cfg.Build()
+	logger, err := newLogger(envVariables)
 	if err != nil {
 		return err
 	}
@@ -46,18 +77,12 @@ func run(ctx context.Context) error {
 	logger = logger.Named("journey_app")
 	defer func() { _ = logger.Sync() }()
 
-	envVariables, err := config.GetEnvironmentVariables()
+	poolConfig, err := newPoolConfig(envVariables)
 	if err != nil {
 		return err
 	}
 
-	pool, err := pgxpool.New(ctx, fmt.Sprintf("user=%s password=%s host=%s port=%s dbname=%s",
-		envVariables["JOURNEY_DATABASE_USER"],
-		envVariables["JOURNEY_DATABASE_PASSWORD"],
-		envVariables["JOURNEY_DATABASE_HOST"],
-		envVariables["JOURNEY_DATABASE_PORT"],
-		envVariables["JOURNEY_DATABASE_NAME"],
-	))
+	pool, err := pgxpool.NewWithConfig(ctx, poolConfig)
 	if err != nil {
 		return err
 	}
@@ -67,16 +92,50 @@ func run(ctx context.Context) error {
 		return err
 	}
 
+	limiter := ratelimit.NewFromEnvironment()
+	go limiter.Run(ctx, ratelimit.DEFAULT_CLEANUP_INTERVAL)
+
 	r := chi.NewMux()
-	r.Use(middleware.RequestID, middleware.Recoverer, httputils.ChiLogger(logger))
+	r.Use(
+		httplog.Middleware(logger),
+		metrics.Middleware,
+		middleware.Recoverer,
+		cors.Middleware(cors.ParseOrigins(envVariables["JOURNEY_CORS_ORIGINS"])),
+		limiter.Middleware,
+	)
+
+	emailDispatcher := dispatcher.New(logger, dispatcher.DefaultWorkers, dispatcher.DefaultQueueSize)
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+
+		if err := emailDispatcher.Shutdown(ctx); err != nil {
+			logger.Error("failed to drain pending emails", zap.Error(err))
+		}
+	}()
+
+	apiOptions := []api.Option{api.WithDispatcher(emailDispatcher), api.WithVersion(version)}
+	if notifier := webhook.NewFromEnvironment(); notifier.Enabled() {
+		apiOptions = append(apiOptions, api.WithWebhook(notifier))
+	}
+
+	mailer, err := mailpit.NewMailPit(pool, logger)
+	if err != nil {
+		return err
+	}
 
 	si := api.NewApi(
 		pool,
 		logger,
-		mailpit.NewMailPit(pool),
+		mailer,
+		apiOptions...,
 	)
 
-	r.Mount("/", spec.Handler(&si))
+	r.Handle("/metrics", metrics.Handler())
+	r.Mount("/", si.Handler())
+
+	reminders := reminder.New(pgstore.New(pool), mailer, logger)
+	go reminders.Run(ctx)
 
 	srv := &http.Server{
 		Addr:         fmt.Sprintf(":%s", envVariables["JOURNEY_APP_PORT"]),
@@ -86,32 +145,38 @@ func run(ctx context.Context) error {
 		WriteTimeout: 5 * time.Second,
 	}
 
-	defer func() {
-		const timeout = 30 * time.Second
-		ctx, cancel := context.WithTimeout(context.Background(), timeout)
-		defer cancel()
+	listener, err := net.Listen("tcp", srv.Addr)
+	if err != nil {
+		return err
+	}
 
-		if err := srv.Shutdown(ctx); err != nil {
-			logger.Error("failed to shutdown server", zap.Error(err))
-		}
-	}()
+	fmt.Println("server starting on:", srv.Addr)
+	return serve(ctx, srv, listener, logger)
+}
 
+// serve answers on the listener until ctx is done, then stops accepting connections and waits up to
+// shutdownTimeout for the requests in flight to complete.
+func serve(ctx context.Context, srv *http.Server, listener net.Listener, logger *zap.Logger) error {
 	errChan := make(chan error, 1)
-
 	go func() {
-		fmt.Println("server starting on:", srv.Addr)
-		if err := srv.ListenAndServe(); err != nil {
-			errChan <- err
-		}
+		errChan <- srv.Serve(listener)
 	}()
 
 	select {
-	case <-ctx.Done():
-		return nil
 	case err := <-errChan:
-		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		if !errors.Is(err, http.ErrServerClosed) {
 			return err
 		}
+		return nil
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		logger.Error("failed to shutdown server", zap.Error(err))
+		return err
 	}
 
 	return nil
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+func TestServeDrainsTheRequestsInFlightOnSIGTERM(t *testing.T) {
+	ctx, cancel := withShutdownSignals(context.Background())
+	defer cancel()
+
+	started := make(chan struct{})
+	var finished atomic.Bool
+	srv := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		time.Sleep(200 * time.Millisecond)
+		finished.Store(true)
+		_, _ = io.WriteString(w, "done")
+	})}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	served := make(chan error, 1)
+	go func() { served <- serve(ctx, srv, listener, zap.NewNop()) }()
+
+	type result struct {
+		body string
+		err  error
+	}
+	responded := make(chan result, 1)
+	go func() {
+		resp, err := http.Get("http://" + listener.Addr().String())
+		if err != nil {
+			responded <- result{err: err}
+			return
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		responded <- result{body: string(body), err: err}
+	}()
+
+	<-started
+	if err := syscall.Kill(os.Getpid(), syscall.SIGTERM); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case err := <-served:
+		if err != nil {
+			t.Fatalf("expected a clean shutdown, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the server to shutdown")
+	}
+
+	if !finished.Load() {
+		t.Fatal("expected the slow handler to finish before serve returned")
+	}
+	if res := <-responded; res.err != nil || res.body != "done" {
+		t.Fatalf("expected the request in flight answered, got %q, %v", res.body, res.err)
+	}
+
+	if _, err := http.Get("http://" + listener.Addr().String()); err == nil {
+		t.Fatal("expected the new connections refused after the shutdown")
+	}
+}
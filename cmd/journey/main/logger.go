@@ -0,0 +1,49 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+const (
+	// DEFAULT_LOG_LEVEL is the level of the logger when JOURNEY_LOG_LEVEL is missing.
+	DEFAULT_LOG_LEVEL = "info"
+	// DEFAULT_LOG_FORMAT is the format of the logger when JOURNEY_LOG_FORMAT is missing, as the log collectors
+	// of a deploy expect.
+	DEFAULT_LOG_FORMAT = "json"
+)
+
+// newLogger builds the app logger of JOURNEY_LOG_LEVEL (debug, info, warn, error...) and JOURNEY_LOG_FORMAT,
+// "json" or "text" for the human-readable lines of the local development. A missing variable keeps the
+// default, an invalid one fails the startup.
+func newLogger(envVariables map[string]string) (*zap.Logger, error) {
+	levelName := envVariables["JOURNEY_LOG_LEVEL"]
+	if levelName == "" {
+		levelName = DEFAULT_LOG_LEVEL
+	}
+	level, err := zapcore.ParseLevel(levelName)
+	if err != nil {
+		return nil, fmt.Errorf("JOURNEY_LOG_LEVEL must be one of debug, info, warn, error, dpanic, panic or fatal, got %q", levelName)
+	}
+
+	var cfg zap.Config
+	switch format := strings.ToLower(envVariables["JOURNEY_LOG_FORMAT"]); format {
+	case "", DEFAULT_LOG_FORMAT:
+		cfg = zap.NewProductionConfig()
+	case "text":
+		cfg = zap.NewDevelopmentConfig()
+		cfg.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	default:
+		return nil, fmt.Errorf("JOURNEY_LOG_FORMAT must be json or text, got %q", envVariables["JOURNEY_LOG_FORMAT"])
+	}
+	cfg.Level = zap.NewAtomicLevelAt(level)
+
+	logger, err := cfg.Build()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build the logger: %w", err)
+	}
+	return logger, nil
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"go.uber.org/zap/zapcore"
+)
+
+func TestNewLoggerDefaultsToInfo(t *testing.T) {
+	logger, err := newLogger(map[string]string{})
+	if err != nil {
+		t.Fatalf("expected the default logger built, got %v", err)
+	}
+
+	if logger.Core().Enabled(zapcore.DebugLevel) || !logger.Core().Enabled(zapcore.InfoLevel) {
+		t.Fatal("expected the logger at the info level")
+	}
+}
+
+func TestNewLoggerReadsTheEnv(t *testing.T) {
+	for _, format := range []string{"json", "text", "TEXT"} {
+		logger, err := newLogger(map[string]string{"JOURNEY_LOG_LEVEL": "warn", "JOURNEY_LOG_FORMAT": format})
+		if err != nil {
+			t.Fatalf("expected the %s logger built, got %v", format, err)
+		}
+
+		if logger.Core().Enabled(zapcore.InfoLevel) || !logger.Core().Enabled(zapcore.WarnLevel) {
+			t.Fatalf("expected the %s logger at the warn level", format)
+		}
+	}
+}
+
+func TestNewLoggerRejectsInvalidValues(t *testing.T) {
+	cases := map[string]map[string]string{
+		"JOURNEY_LOG_LEVEL":  {"JOURNEY_LOG_LEVEL": "verbose"},
+		"JOURNEY_LOG_FORMAT": {"JOURNEY_LOG_FORMAT": "xml"},
+	}
+
+	for key, envVariables := range cases {
+		_, err := newLogger(envVariables)
+		if err == nil || !strings.Contains(err.Error(), key) {
+			t.Fatalf("expected an error naming %s, got %v", key, err)
+		}
+	}
+}
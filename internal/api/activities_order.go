@@ -0,0 +1,73 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"journey/internal/api/spec"
+	"journey/internal/i18n"
+	"journey/internal/pgstore"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// Reorder the activities of a trip day.
+// (PUT /trips/{tripId}/activities/order)
+func (api *API) PutTripsTripIDActivitiesOrder(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID := pathUUID(r, "tripId")
+
+	trip, err := api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		return spec.PutTripsTripIDActivitiesOrderJSON404Response(api.notFound(r, i18n.TripNotFound))
+	}
+
+	if err := api.authorizeTripOwner(r, trip); err != nil {
+		if errors.Is(err, errMissingOwnerToken) {
+			return spec.PutTripsTripIDActivitiesOrderJSON401Response(api.unauthorized(r, i18n.MissingOwnerToken))
+		}
+		return spec.PutTripsTripIDActivitiesOrderJSON403Response(api.forbidden(r, refusedOwnerTokenKey(err)))
+	}
+
+	if isTripClosed(trip) {
+		return spec.PutTripsTripIDActivitiesOrderJSON409Response(api.conflict(r, i18n.TripClosed))
+	}
+
+	var body spec.PutTripsTripIDActivitiesOrderJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PutTripsTripIDActivitiesOrderJSON400Response(api.badRequest(r, i18n.InvalidRequest, err.Error()))
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PutTripsTripIDActivitiesOrderJSON400Response(api.invalidFieldsRequest(r, err))
+	}
+
+	// the ids were validated as uuids, they always parse.
+	activityIDs := make([]uuid.UUID, len(body.ActivityIds))
+	for index, activityID := range body.ActivityIds {
+		activityIDs[index] = uuid.MustParse(activityID)
+	}
+
+	// The day is the one of the trip timezone, the transaction picks its activities as the listing groups them.
+	err = api.store.ReorderActivities(r.Context(), api.pool, pgstore.ReorderActivitiesParams{
+		TripID: tripUUID,
+		Day:    pgtype.Date{Valid: true, Time: body.Day.Time},
+		Ids:    activityIDs,
+	})
+	if errors.Is(err, pgstore.ErrActivitiesNotOfDay) {
+		return spec.PutTripsTripIDActivitiesOrderJSON400Response(api.badRequest(r, i18n.ActivitiesNotOfDay, body.Day.Format(time.DateOnly)))
+	}
+	if err != nil {
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed on route: '%v: %v'", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripID),
+		)
+		return spec.PutTripsTripIDActivitiesOrderJSON500Response(api.internalServerError(r, i18n.UnableToUpdateActivity))
+	}
+
+	return spec.PutTripsTripIDActivitiesOrderJSON204Response(nil)
+}
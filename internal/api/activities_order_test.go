@@ -0,0 +1,107 @@
+package api
+
+import (
+	"journey/internal/api/spec"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// firstDayTitles answers the titles of the activities of the first trip day, as listed.
+func firstDayTitles(t *testing.T, api *API, tripID string) []string {
+	t.Helper()
+
+	w := serve(api, newRequest(t, http.MethodGet, "/trips/"+tripID+"/activities", nil))
+	assertStatus(t, w, http.StatusOK)
+	var response spec.GetTripActivitiesResponse
+	decodeResponse(t, w, &response)
+	if len(response.Activities) == 0 {
+		t.Fatal("expected the trip days listed, got none")
+	}
+
+	var titles []string
+	for _, activity := range response.Activities[0].Activities {
+		titles = append(titles, activity.Title)
+	}
+	return titles
+}
+
+func assertTitles(t *testing.T, titles []string, expected ...string) {
+	t.Helper()
+
+	if len(titles) != len(expected) {
+		t.Fatalf("expected the activities %v, got %v", expected, titles)
+	}
+	for index := range expected {
+		if titles[index] != expected[index] {
+			t.Fatalf("expected the activities %v, got %v", expected, titles)
+		}
+	}
+}
+
+func TestPutTripsTripIDActivitiesOrder(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	at := trip.StartsAt.Time.Add(time.Hour)
+	museum := store.addActivity(trip.ID, "Museum", at)
+	beach := store.addActivity(trip.ID, "Beach", at)
+	dinner := store.addActivity(trip.ID, "Dinner", at)
+	breakfast := store.addActivity(trip.ID, "Breakfast", at.Add(-30*time.Minute))
+	api := newTestAPI(store, &fakeMailer{})
+	target := "/trips/" + trip.ID.String() + "/activities/order"
+	day := at.Format(time.DateOnly)
+
+	r := newRequest(t, http.MethodPut, target, map[string]any{
+		"day":          day,
+		"activity_ids": []string{dinner.ID.String(), museum.ID.String(), beach.ID.String(), breakfast.ID.String()},
+	})
+	assertStatus(t, serve(api, withOwnerToken(r, TEST_OWNER_TOKEN)), http.StatusNoContent)
+
+	// the order only breaks the ties, the breakfast is still listed first.
+	assertTitles(t, firstDayTitles(t, api, trip.ID.String()), "Breakfast", "Dinner", "Museum", "Beach")
+}
+
+func TestPutTripsTripIDActivitiesOrderInvalid(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	at := trip.StartsAt.Time.Add(time.Hour)
+	museum := store.addActivity(trip.ID, "Museum", at)
+	beach := store.addActivity(trip.ID, "Beach", at)
+	nextDay := store.addActivity(trip.ID, "Hike", at.AddDate(0, 0, 1))
+	api := newTestAPI(store, &fakeMailer{})
+	target := "/trips/" + trip.ID.String() + "/activities/order"
+	day := at.Format(time.DateOnly)
+
+	cases := map[string]any{
+		"missing day":      map[string]any{"activity_ids": []string{beach.ID.String(), museum.ID.String()}},
+		"no ids":           map[string]any{"day": day, "activity_ids": []string{}},
+		"not an uuid":      map[string]any{"day": day, "activity_ids": []string{"not-an-uuid"}},
+		"duplicated":       map[string]any{"day": day, "activity_ids": []string{beach.ID.String(), beach.ID.String()}},
+		"missing activity": map[string]any{"day": day, "activity_ids": []string{beach.ID.String()}},
+		"of another day":   map[string]any{"day": day, "activity_ids": []string{beach.ID.String(), museum.ID.String(), nextDay.ID.String()}},
+		"unknown activity": map[string]any{"day": day, "activity_ids": []string{beach.ID.String(), uuid.NewString()}},
+	}
+
+	for name, body := range cases {
+		t.Run(name, func(t *testing.T) {
+			w := serve(api, withOwnerToken(newRequest(t, http.MethodPut, target, body), TEST_OWNER_TOKEN))
+
+			assertStatus(t, w, http.StatusBadRequest)
+		})
+	}
+	for _, activity := range []uuid.UUID{museum.ID, beach.ID, nextDay.ID} {
+		if order := store.activity(activity).SortOrder; order != 0 {
+			t.Fatalf("expected the order left untouched, got %d", order)
+		}
+	}
+
+	t.Run("missing trip", func(t *testing.T) {
+		r := newRequest(t, http.MethodPut, "/trips/"+uuid.NewString()+"/activities/order", map[string]any{
+			"day":          day,
+			"activity_ids": []string{beach.ID.String(), museum.ID.String()},
+		})
+		assertStatus(t, serve(api, withOwnerToken(r, TEST_OWNER_TOKEN)), http.StatusNotFound)
+	})
+}
@@ -0,0 +1,84 @@
+package api
+
+import (
+	"journey/internal/api/spec"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestGetTripsTripIDActivitiesSummary(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(4))
+	firstDay := trip.StartsAt.Time
+	store.addActivity(trip.ID, "Breakfast", firstDay.Add(-3*time.Hour))
+	store.addActivity(trip.ID, "Museum", firstDay.Add(time.Hour))
+	store.addActivity(trip.ID, "Dinner", firstDay.Add(8*time.Hour))
+	store.addActivity(trip.ID, "Beach", firstDay.AddDate(0, 0, 2))
+	// another trip's activities are never counted.
+	other := store.addTrip(newTestTrip(4))
+	store.addActivity(other.ID, "Museum", firstDay.AddDate(0, 0, 1))
+	api := newTestAPI(store, &fakeMailer{})
+
+	w := serve(api, newRequest(t, http.MethodGet, "/trips/"+trip.ID.String()+"/activities/summary", nil))
+
+	assertStatus(t, w, http.StatusOK)
+	var response spec.GetTripActivitiesSummaryResponse
+	decodeResponse(t, w, &response)
+	assertDayCounts(t, response.Days, time.UTC, []int{3, 0, 1, 0})
+	if calls := store.callsOf("GetTripWithActivities"); calls != 0 {
+		t.Fatalf("expected the activities counted by the store rather than loaded, got %d loads", calls)
+	}
+}
+
+func TestGetTripsTripIDActivitiesSummaryOnTheTripTimezone(t *testing.T) {
+	store := newFakeStore()
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tripInSaoPaulo := newTestTrip(2)
+	tripInSaoPaulo.Timezone = "America/Sao_Paulo"
+	trip := store.addTrip(tripInSaoPaulo)
+	// 01:00 UTC of the second day is still 22:00 of the first one in São Paulo.
+	secondDayUTC := dayIn(trip.StartsAt.Time, time.UTC).AddDate(0, 0, 1)
+	store.addActivity(trip.ID, "Late dinner", secondDayUTC.Add(time.Hour))
+	api := newTestAPI(store, &fakeMailer{})
+
+	w := serve(api, newRequest(t, http.MethodGet, "/trips/"+trip.ID.String()+"/activities/summary", nil))
+
+	assertStatus(t, w, http.StatusOK)
+	var response spec.GetTripActivitiesSummaryResponse
+	decodeResponse(t, w, &response)
+	assertDayCounts(t, response.Days, saoPaulo, []int{1, 0})
+}
+
+func TestGetTripsTripIDActivitiesSummaryNotFound(t *testing.T) {
+	api := newTestAPI(newFakeStore(), &fakeMailer{})
+
+	w := serve(api, newRequest(t, http.MethodGet, "/trips/"+uuid.NewString()+"/activities/summary", nil))
+
+	assertStatus(t, w, http.StatusNotFound)
+}
+
+// assertDayCounts checks days are the consecutive days of location with the counts, in order.
+func assertDayCounts(t *testing.T, days []spec.GetTripActivitiesSummaryResponseArray, location *time.Location, counts []int) {
+	t.Helper()
+
+	if len(days) != len(counts) {
+		t.Fatalf("expected %d days, got %+v", len(counts), days)
+	}
+	for index, day := range days {
+		if day.Count != counts[index] {
+			t.Errorf("expected %d activities on day %d, got %d", counts[index], index+1, day.Count)
+		}
+		if local := day.Date.In(location); local.Hour() != 0 || local.Minute() != 0 {
+			t.Errorf("expected day %d to start at midnight in %s, got %v", index+1, location, local)
+		}
+		if index > 0 && !day.Date.Equal(days[index-1].Date.In(location).AddDate(0, 0, 1)) {
+			t.Errorf("expected day %d to follow %v, got %v", index+1, days[index-1].Date, day.Date)
+		}
+	}
+}
@@ -0,0 +1,421 @@
+package api
+
+import (
+	"journey/internal/api/spec"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestGetActivity(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	other := store.addTrip(newTestTrip(3))
+	activity := store.addActivity(trip.ID, "Museum", trip.StartsAt.Time.Add(2*time.Hour))
+	api := newTestAPI(store, &fakeMailer{})
+
+	t.Run("found", func(t *testing.T) {
+		w := serve(api, newRequest(t, http.MethodGet, "/trips/"+trip.ID.String()+"/activities/"+activity.ID.String(), nil))
+
+		assertStatus(t, w, http.StatusOK)
+		var response spec.GetActivityResponse
+		decodeResponse(t, w, &response)
+		if response.Activity.ID != activity.ID.String() || response.Activity.Title != "Museum" {
+			t.Fatalf("expected activity %s, got %+v", activity.ID, response.Activity)
+		}
+		if calls := store.callsOf("TripExists"); calls != 0 {
+			t.Fatalf("expected the trip only checked on a miss, got %d checks", calls)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		w := serve(api, newRequest(t, http.MethodGet, "/trips/"+trip.ID.String()+"/activities/"+uuid.NewString(), nil))
+
+		assertStatus(t, w, http.StatusNotFound)
+		var response spec.NotFoundRequest
+		decodeResponse(t, w, &response)
+		if response.Code != string(ErrorCodeActivityNotFound) {
+			t.Fatalf("expected %s on the existing trip, got %s", ErrorCodeActivityNotFound, response.Code)
+		}
+	})
+
+	t.Run("missing trip", func(t *testing.T) {
+		w := serve(api, newRequest(t, http.MethodGet, "/trips/"+uuid.NewString()+"/activities/"+activity.ID.String(), nil))
+
+		assertStatus(t, w, http.StatusNotFound)
+		var response spec.NotFoundRequest
+		decodeResponse(t, w, &response)
+		if response.Code != string(ErrorCodeTripNotFound) {
+			t.Fatalf("expected %s, got %s", ErrorCodeTripNotFound, response.Code)
+		}
+	})
+
+	t.Run("wrong trip", func(t *testing.T) {
+		w := serve(api, newRequest(t, http.MethodGet, "/trips/"+other.ID.String()+"/activities/"+activity.ID.String(), nil))
+
+		assertStatus(t, w, http.StatusNotFound)
+	})
+}
+
+func TestPostTripsTripIDActivitiesDuration(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	api := newTestAPI(store, &fakeMailer{})
+	activitiesPath := "/trips/" + trip.ID.String() + "/activities"
+
+	t.Run("omitted", func(t *testing.T) {
+		occursAt := trip.StartsAt.Time.Add(time.Hour)
+		r := newRequest(t, http.MethodPost, activitiesPath, map[string]any{"title": "Lunch", "occurs_at": occursAt})
+		w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+		assertStatus(t, w, http.StatusCreated)
+		var created spec.CreateActivityResponse
+		decodeResponse(t, w, &created)
+
+		w = serve(api, newRequest(t, http.MethodGet, activitiesPath+"/"+created.ActivityID, nil))
+		var response spec.GetActivityResponse
+		decodeResponse(t, w, &response)
+		if response.Activity.DurationMinutes != 0 || !response.Activity.EndsAt.Equal(occursAt) {
+			t.Fatalf("expected a zero duration ending when it occurs, got %+v", response.Activity)
+		}
+	})
+
+	t.Run("within the trip", func(t *testing.T) {
+		occursAt := trip.StartsAt.Time.Add(time.Hour)
+		r := newRequest(t, http.MethodPost, activitiesPath, map[string]any{"title": "Museum", "occurs_at": occursAt, "duration_minutes": 120})
+		w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+		assertStatus(t, w, http.StatusCreated)
+		var created spec.CreateActivityResponse
+		decodeResponse(t, w, &created)
+
+		w = serve(api, newRequest(t, http.MethodGet, activitiesPath+"/"+created.ActivityID, nil))
+		var response spec.GetActivityResponse
+		decodeResponse(t, w, &response)
+		if response.Activity.DurationMinutes != 120 || !response.Activity.EndsAt.Equal(occursAt.Add(2*time.Hour)) {
+			t.Fatalf("expected a 2h activity, got %+v", response.Activity)
+		}
+	})
+
+	t.Run("ends past the trip", func(t *testing.T) {
+		occursAt := dayIn(trip.EndsAt.Time, time.UTC).Add(23 * time.Hour)
+		r := newRequest(t, http.MethodPost, activitiesPath, map[string]any{"title": "Late dinner", "occurs_at": occursAt, "duration_minutes": 90})
+		w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+		assertStatus(t, w, http.StatusBadRequest)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		occursAt := trip.StartsAt.Time.Add(time.Hour)
+		r := newRequest(t, http.MethodPost, activitiesPath, map[string]any{"title": "Museum", "occurs_at": occursAt, "duration_minutes": -30})
+		w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+		assertStatus(t, w, http.StatusBadRequest)
+	})
+
+	if store.callsOf("CreateActivity") != 2 {
+		t.Fatalf("expected only the 2 valid activities created, got %d", store.callsOf("CreateActivity"))
+	}
+}
+
+func TestPostTripsTripIDActivitiesAnswersTheActivity(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	api := newTestAPI(store, &fakeMailer{})
+	activitiesPath := "/trips/" + trip.ID.String() + "/activities"
+
+	r := newRequest(t, http.MethodPost, activitiesPath, map[string]any{
+		"title": "Museum", "occurs_at": trip.StartsAt.Time.Add(2 * time.Hour), "duration_minutes": 45,
+	})
+	w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+	assertStatus(t, w, http.StatusCreated)
+	var created spec.CreateActivityResponse
+	decodeResponse(t, w, &created)
+	if created.ActivityID == "" || created.Activity.ID != created.ActivityID {
+		t.Fatalf("expected the activity id on both fields, got %+v", created)
+	}
+
+	w = serve(api, newRequest(t, http.MethodGet, activitiesPath+"/"+created.ActivityID, nil))
+
+	var fetched spec.GetActivityResponse
+	decodeResponse(t, w, &fetched)
+	if !reflect.DeepEqual(created.Activity, fetched.Activity) {
+		t.Fatalf("expected the created activity as fetched %+v, got %+v", fetched.Activity, created.Activity)
+	}
+}
+
+func TestPostTripsTripIDActivitiesTripWindow(t *testing.T) {
+	store := newFakeStore()
+	// the trip ends at noon of its last day, the window still runs to the end of that day.
+	trip := store.addTrip(newTestTrip(3))
+	api := newTestAPI(store, &fakeMailer{})
+	lastDay := dayIn(trip.EndsAt.Time, time.UTC)
+
+	cases := map[string]struct {
+		occursAt time.Time
+		expected int
+	}{
+		"last day late evening":   {occursAt: lastDay.Add(23*time.Hour + 59*time.Minute), expected: http.StatusCreated},
+		"first day early morning": {occursAt: dayIn(trip.StartsAt.Time, time.UTC).Add(time.Hour), expected: http.StatusCreated},
+		"day after the trip":      {occursAt: lastDay.AddDate(0, 0, 1).Add(30 * time.Minute), expected: http.StatusBadRequest},
+		"day before the trip":     {occursAt: dayIn(trip.StartsAt.Time, time.UTC).Add(-time.Minute), expected: http.StatusBadRequest},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := newRequest(t, http.MethodPost, "/trips/"+trip.ID.String()+"/activities", map[string]any{"title": "Dinner", "occurs_at": tc.occursAt})
+			w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+			assertStatus(t, w, tc.expected)
+		})
+	}
+
+	w := serve(api, newRequest(t, http.MethodGet, "/trips/"+trip.ID.String()+"/activities", nil))
+
+	assertStatus(t, w, http.StatusOK)
+	var response spec.GetTripActivitiesResponse
+	decodeResponse(t, w, &response)
+	if len(response.Activities) != 3 {
+		t.Fatalf("expected the 3 trip days listed, got %d", len(response.Activities))
+	}
+	if len(response.Activities[2].Activities) != 1 {
+		t.Fatalf("expected the late-evening activity listed on the last day, got %+v", response.Activities)
+	}
+}
+
+func TestPostTripsTripIDActivitiesBatch(t *testing.T) {
+	t.Run("all valid", func(t *testing.T) {
+		store := newFakeStore()
+		trip := store.addTrip(newTestTrip(3))
+		api := newTestAPI(store, &fakeMailer{})
+		titles := []string{"Breakfast", "Museum", "Dinner"}
+
+		activities := make([]map[string]any, len(titles))
+		for index, title := range titles {
+			activities[index] = map[string]any{"title": title, "occurs_at": trip.StartsAt.Time.Add(time.Duration(index) * time.Hour)}
+		}
+		r := newRequest(t, http.MethodPost, "/trips/"+trip.ID.String()+"/activities/batch", map[string]any{"activities": activities})
+		w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+		assertStatus(t, w, http.StatusCreated)
+		var response spec.CreateActivitiesBatchResponse
+		decodeResponse(t, w, &response)
+		if len(response.ActivityIds) != len(titles) {
+			t.Fatalf("expected %d ids, got %v", len(titles), response.ActivityIds)
+		}
+		for index, activityID := range response.ActivityIds {
+			w := serve(api, newRequest(t, http.MethodGet, "/trips/"+trip.ID.String()+"/activities/"+activityID, nil))
+
+			var fetched spec.GetActivityResponse
+			decodeResponse(t, w, &fetched)
+			if fetched.Activity.Title != titles[index] {
+				t.Fatalf("expected the id %d of %q, got %q", index, titles[index], fetched.Activity.Title)
+			}
+		}
+		if store.callsOf("CreateActivities") != 1 {
+			t.Fatalf("expected the activities created in a single batch, got %d", store.callsOf("CreateActivities"))
+		}
+	})
+
+	t.Run("one out of the trip", func(t *testing.T) {
+		store := newFakeStore()
+		trip := store.addTrip(newTestTrip(3))
+		api := newTestAPI(store, &fakeMailer{})
+
+		r := newRequest(t, http.MethodPost, "/trips/"+trip.ID.String()+"/activities/batch", map[string]any{"activities": []map[string]any{
+			{"title": "Museum", "occurs_at": trip.StartsAt.Time.Add(time.Hour)},
+			{"title": "Flight back", "occurs_at": trip.EndsAt.Time.AddDate(0, 0, 2)},
+		}})
+		w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+		assertStatus(t, w, http.StatusBadRequest)
+		var response spec.BadRequest
+		decodeResponse(t, w, &response)
+		if response.Code != string(ErrorCodeActivityOutOfRange) || !strings.Contains(response.Message, " 1 ") {
+			t.Fatalf("expected the index 1 reported out of range, got %+v", response)
+		}
+		if store.callsOf("CreateActivities") != 0 || len(store.activities) != 0 {
+			t.Fatalf("expected none of the activities created, got %d", len(store.activities))
+		}
+	})
+}
+
+func TestGetTripsTripIDActivitiesDaySpans(t *testing.T) {
+	store := newFakeStore()
+	sameDay := store.addTrip(newTestTrip(1))
+	sameDayActivity := store.addActivity(sameDay.ID, "Museum", sameDay.StartsAt.Time.Add(2*time.Hour))
+
+	inverted := newTestTrip(1)
+	inverted.EndsAt.Time = inverted.StartsAt.Time.AddDate(0, 0, -3)
+	store.addTrip(inverted)
+
+	core, logs := observer.New(zapcore.WarnLevel)
+	api := newTestAPI(store, &fakeMailer{})
+	api.logger = zap.New(core)
+
+	t.Run("same day", func(t *testing.T) {
+		w := serve(api, newRequest(t, http.MethodGet, "/trips/"+sameDay.ID.String()+"/activities", nil))
+
+		assertStatus(t, w, http.StatusOK)
+		var response spec.GetTripActivitiesResponse
+		decodeResponse(t, w, &response)
+		if len(response.Activities) != 1 || len(response.Activities[0].Activities) != 1 || response.Activities[0].Activities[0].ID != sameDayActivity.ID.String() {
+			t.Fatalf("expected exactly one day holding the activity, got %+v", response.Activities)
+		}
+		if logs.Len() != 0 {
+			t.Fatalf("expected no warning on a consistent trip, got %d", logs.Len())
+		}
+	})
+
+	t.Run("inverted dates", func(t *testing.T) {
+		w := serve(api, newRequest(t, http.MethodGet, "/trips/"+inverted.ID.String()+"/activities", nil))
+
+		assertStatus(t, w, http.StatusOK)
+		var response spec.GetTripActivitiesResponse
+		decodeResponse(t, w, &response)
+		if len(response.Activities) != 1 {
+			t.Fatalf("expected the activities grouped in a single day, got %d", len(response.Activities))
+		}
+		if logs.Len() != 1 {
+			t.Fatalf("expected a warning on the inconsistent dates, got %d", logs.Len())
+		}
+	})
+}
+
+func TestGetTripsTripIDActivitiesFilters(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(5))
+	store.addActivity(trip.ID, "Beach", trip.StartsAt.Time.Add(time.Hour))
+	museum := store.addActivity(trip.ID, "Art Museum", trip.StartsAt.Time.AddDate(0, 0, 1))
+	store.addActivity(trip.ID, "Hike", trip.StartsAt.Time.AddDate(0, 0, 2))
+	api := newTestAPI(store, &fakeMailer{})
+	target := "/trips/" + trip.ID.String() + "/activities"
+	secondDay := trip.StartsAt.Time.AddDate(0, 0, 1).Format(time.DateOnly)
+
+	t.Run("single day", func(t *testing.T) {
+		w := serve(api, newRequest(t, http.MethodGet, target+"?from="+secondDay+"&to="+secondDay, nil))
+
+		assertStatus(t, w, http.StatusOK)
+		var response spec.GetTripActivitiesResponse
+		decodeResponse(t, w, &response)
+		if len(response.Activities) != 1 || response.Activities[0].Date.Format(time.DateOnly) != secondDay {
+			t.Fatalf("expected only the %s bucket, got %+v", secondDay, response.Activities)
+		}
+		if len(response.Activities[0].Activities) != 1 || response.Activities[0].Activities[0].ID != museum.ID.String() {
+			t.Fatalf("expected only the activity of that day, got %+v", response.Activities[0].Activities)
+		}
+	})
+
+	t.Run("without range", func(t *testing.T) {
+		w := serve(api, newRequest(t, http.MethodGet, target, nil))
+
+		assertStatus(t, w, http.StatusOK)
+		var response spec.GetTripActivitiesResponse
+		decodeResponse(t, w, &response)
+		if len(response.Activities) != 5 {
+			t.Fatalf("expected a bucket for each of the 5 days, got %d", len(response.Activities))
+		}
+		activities := 0
+		for _, day := range response.Activities {
+			activities += len(day.Activities)
+		}
+		if activities != 3 {
+			t.Fatalf("expected the 3 activities listed, got %d", activities)
+		}
+	})
+
+	t.Run("title search", func(t *testing.T) {
+		w := serve(api, newRequest(t, http.MethodGet, target+"?q=museum", nil))
+
+		assertStatus(t, w, http.StatusOK)
+		var response spec.GetTripActivitiesResponse
+		decodeResponse(t, w, &response)
+		if len(response.Activities) != 5 {
+			t.Fatalf("expected a bucket for each of the 5 days, got %d", len(response.Activities))
+		}
+		var found []string
+		for _, day := range response.Activities {
+			for _, activity := range day.Activities {
+				found = append(found, activity.Title)
+			}
+		}
+		if len(found) != 1 || found[0] != museum.Title {
+			t.Fatalf("expected only the museum, got %v", found)
+		}
+	})
+
+	t.Run("inverted range", func(t *testing.T) {
+		calls := store.callsOf("GetTripWithActivities")
+		firstDay := trip.StartsAt.Time.Format(time.DateOnly)
+
+		w := serve(api, newRequest(t, http.MethodGet, target+"?from="+secondDay+"&to="+firstDay, nil))
+
+		assertStatus(t, w, http.StatusBadRequest)
+		var response spec.BadRequest
+		decodeResponse(t, w, &response)
+		if response.Code != string(ErrorCodeInvalidRequest) {
+			t.Fatalf("expected the %s code, got %q", ErrorCodeInvalidRequest, response.Code)
+		}
+		if store.callsOf("GetTripWithActivities") != calls {
+			t.Fatal("expected the store not called on an inverted range")
+		}
+	})
+}
+
+func TestGetTripsTripIDActivitiesSearch(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	other := store.addTrip(newTestTrip(3))
+	dinner := store.addActivity(trip.ID, "Seafood dinner", trip.StartsAt.Time.AddDate(0, 0, 1).Add(20*time.Hour))
+	lunch := store.addActivity(trip.ID, "Seafood lunch", trip.StartsAt.Time.Add(12*time.Hour))
+	store.addActivity(trip.ID, "Museum", trip.StartsAt.Time.Add(2*time.Hour))
+	store.addActivity(other.ID, "Seafood festival", other.StartsAt.Time.Add(2*time.Hour))
+	api := newTestAPI(store, &fakeMailer{})
+
+	search := func(t *testing.T, tripID uuid.UUID, q string) *httptest.ResponseRecorder {
+		t.Helper()
+		return serve(api, newRequest(t, http.MethodGet, "/trips/"+tripID.String()+"/activities/search?q="+url.QueryEscape(q), nil))
+	}
+
+	t.Run("matching", func(t *testing.T) {
+		w := search(t, trip.ID, "SEAFOOD")
+
+		assertStatus(t, w, http.StatusOK)
+		var response spec.SearchActivitiesResponse
+		decodeResponse(t, w, &response)
+		if len(response.Activities) != 2 || response.Activities[0].ID != lunch.ID.String() || response.Activities[1].ID != dinner.ID.String() {
+			t.Fatalf("expected the lunch then the dinner, got %+v", response.Activities)
+		}
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		w := search(t, trip.ID, "karaoke")
+
+		assertStatus(t, w, http.StatusOK)
+		if body := strings.TrimSpace(w.Body.String()); body != `{"activities":[]}` {
+			t.Fatalf("expected an empty array, got %s", body)
+		}
+	})
+
+	t.Run("empty query", func(t *testing.T) {
+		w := search(t, trip.ID, "  ")
+
+		assertStatus(t, w, http.StatusBadRequest)
+	})
+
+	t.Run("trip not found", func(t *testing.T) {
+		w := search(t, uuid.New(), "seafood")
+
+		assertStatus(t, w, http.StatusNotFound)
+	})
+}
@@ -0,0 +1,115 @@
+package api
+
+import (
+	"journey/internal/api/spec"
+	"journey/internal/pgstore"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestDeleteTripsTripIDActivitiesActivityID(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	activity := store.addActivity(trip.ID, "Museum", trip.StartsAt.Time.Add(time.Hour))
+	kept := store.addActivity(trip.ID, "Beach", trip.StartsAt.Time.Add(2*time.Hour))
+	api := newTestAPI(store, &fakeMailer{})
+	target := "/trips/" + trip.ID.String() + "/activities/" + activity.ID.String()
+
+	r := newRequest(t, http.MethodDelete, target, nil)
+	assertStatus(t, serve(api, withOwnerToken(r, TEST_OWNER_TOKEN)), http.StatusNoContent)
+
+	assertStatus(t, serve(api, newRequest(t, http.MethodGet, target, nil)), http.StatusNotFound)
+	assertTitles(t, firstDayTitles(t, api, trip.ID.String()), kept.Title)
+
+	w := serve(api, newRequest(t, http.MethodGet, "/trips/"+trip.ID.String()+"/activities", nil))
+	assertStatus(t, w, http.StatusOK)
+	var listed spec.GetTripActivitiesResponse
+	decodeResponse(t, w, &listed)
+	if len(listed.Activities) != 3 {
+		t.Fatalf("expected the 3 trip days listed, got %d", len(listed.Activities))
+	}
+	for _, day := range listed.Activities {
+		for _, other := range day.Activities {
+			if other.ID == activity.ID.String() {
+				t.Fatalf("expected the deleted activity listed on no day, got it on %v", day.Date)
+			}
+		}
+	}
+
+	t.Run("is then missing", func(t *testing.T) {
+		r := newRequest(t, http.MethodDelete, target, nil)
+		assertStatus(t, serve(api, withOwnerToken(r, TEST_OWNER_TOKEN)), http.StatusNotFound)
+	})
+}
+
+func TestDeleteTripsTripIDActivitiesActivityIDNotFound(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	other := store.addTrip(newTestTrip(3))
+	activity := store.addActivity(other.ID, "Museum", other.StartsAt.Time.Add(time.Hour))
+	api := newTestAPI(store, &fakeMailer{})
+
+	cases := map[string]struct {
+		target string
+		code   ErrorCode
+	}{
+		"activity of another trip": {"/trips/" + trip.ID.String() + "/activities/" + activity.ID.String(), ErrorCodeActivityNotFound},
+		"missing activity":         {"/trips/" + trip.ID.String() + "/activities/" + uuid.NewString(), ErrorCodeActivityNotFound},
+		"missing trip":             {"/trips/" + uuid.NewString() + "/activities/" + activity.ID.String(), ErrorCodeTripNotFound},
+	}
+
+	for name, test := range cases {
+		t.Run(name, func(t *testing.T) {
+			w := serve(api, withOwnerToken(newRequest(t, http.MethodDelete, test.target, nil), TEST_OWNER_TOKEN))
+
+			assertStatus(t, w, http.StatusNotFound)
+			var response spec.NotFoundRequest
+			decodeResponse(t, w, &response)
+			if response.Code != string(test.code) {
+				t.Fatalf("expected %s, got %s", test.code, response.Code)
+			}
+		})
+	}
+	if store.activity(activity.ID).ID != activity.ID {
+		t.Fatal("expected the activity of the other trip kept")
+	}
+}
+
+func TestDeleteTripsTripIDActivitiesActivityIDRefused(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	closed := newTestTrip(3)
+	closed.Status = pgstore.TripStatusCancelled
+	store.addTrip(closed)
+	activity := store.addActivity(trip.ID, "Museum", trip.StartsAt.Time.Add(time.Hour))
+	closedActivity := store.addActivity(closed.ID, "Museum", closed.StartsAt.Time.Add(time.Hour))
+	api := newTestAPI(store, &fakeMailer{})
+
+	cases := []struct {
+		name   string
+		target string
+		token  string
+		status int
+	}{
+		{"without the owner token", "/trips/" + trip.ID.String() + "/activities/" + activity.ID.String(), "", http.StatusUnauthorized},
+		{"with a wrong token", "/trips/" + trip.ID.String() + "/activities/" + activity.ID.String(), "not-the-owner", http.StatusForbidden},
+		{"closed trip", "/trips/" + closed.ID.String() + "/activities/" + closedActivity.ID.String(), TEST_OWNER_TOKEN, http.StatusConflict},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			r := newRequest(t, http.MethodDelete, c.target, nil)
+			if c.token != "" {
+				r = withOwnerToken(r, c.token)
+			}
+
+			assertStatus(t, serve(api, r), c.status)
+		})
+	}
+	if calls := store.callsOf("DeleteActivity"); calls != 0 {
+		t.Fatalf("expected no activity deleted, got %d deletes", calls)
+	}
+}
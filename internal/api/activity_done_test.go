@@ -0,0 +1,140 @@
+package api
+
+import (
+	"journey/internal/api/spec"
+	"journey/internal/pgstore"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestPatchTripsTripIDActivitiesActivityIDDone(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	// the trip has not started yet, the activities yet to come are checked off as well.
+	activity := store.addActivity(trip.ID, "Museum", trip.StartsAt.Time.Add(2*time.Hour))
+	api := newTestAPI(store, &fakeMailer{})
+	target := "/trips/" + trip.ID.String() + "/activities/" + activity.ID.String() + "/done"
+
+	mark := func(t *testing.T, done bool) spec.GetTripActivitiesResponseInnerArray {
+		t.Helper()
+		r := newRequest(t, http.MethodPatch, target, map[string]bool{"is_done": done})
+		w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+		assertStatus(t, w, http.StatusOK)
+		var response spec.GetActivityResponse
+		decodeResponse(t, w, &response)
+		if response.Activity.ID != activity.ID.String() {
+			t.Fatalf("expected activity %s, got %+v", activity.ID, response.Activity)
+		}
+		return response.Activity
+	}
+	stored := func(t *testing.T) bool {
+		t.Helper()
+		w := serve(api, newRequest(t, http.MethodGet, "/trips/"+trip.ID.String()+"/activities/"+activity.ID.String(), nil))
+		assertStatus(t, w, http.StatusOK)
+		var response spec.GetActivityResponse
+		decodeResponse(t, w, &response)
+		return response.Activity.IsDone
+	}
+
+	t.Run("on", func(t *testing.T) {
+		for attempt := 0; attempt < 2; attempt++ {
+			if answered := mark(t, true); !answered.IsDone {
+				t.Fatalf("expected the activity answered as done on attempt %d", attempt)
+			}
+		}
+		if !stored(t) {
+			t.Fatal("expected the activity stored as done")
+		}
+	})
+
+	t.Run("off", func(t *testing.T) {
+		for attempt := 0; attempt < 2; attempt++ {
+			if answered := mark(t, false); answered.IsDone {
+				t.Fatalf("expected the activity answered as not done on attempt %d", attempt)
+			}
+		}
+		if stored(t) {
+			t.Fatal("expected the activity stored as not done")
+		}
+	})
+
+	t.Run("listed", func(t *testing.T) {
+		mark(t, true)
+
+		w := serve(api, newRequest(t, http.MethodGet, "/trips/"+trip.ID.String()+"/activities", nil))
+		assertStatus(t, w, http.StatusOK)
+		var response spec.GetTripActivitiesResponse
+		decodeResponse(t, w, &response)
+		if len(response.Activities) != 3 {
+			t.Fatalf("expected the 3 trip days listed, got %d", len(response.Activities))
+		}
+		for _, day := range response.Activities {
+			for _, listed := range day.Activities {
+				if listed.ID == activity.ID.String() && listed.IsDone {
+					return
+				}
+			}
+		}
+		t.Fatalf("expected the activity listed as done, got %+v", response.Activities)
+	})
+
+	t.Run("missing state", func(t *testing.T) {
+		r := newRequest(t, http.MethodPatch, target, map[string]any{})
+		assertStatus(t, serve(api, withOwnerToken(r, TEST_OWNER_TOKEN)), http.StatusBadRequest)
+	})
+
+	t.Run("without the owner token", func(t *testing.T) {
+		r := newRequest(t, http.MethodPatch, target, map[string]bool{"is_done": true})
+		assertStatus(t, serve(api, r), http.StatusUnauthorized)
+	})
+}
+
+func TestPatchTripsTripIDActivitiesActivityIDDoneNotFound(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	other := store.addTrip(newTestTrip(3))
+	activity := store.addActivity(other.ID, "Museum", other.StartsAt.Time.Add(2*time.Hour))
+	api := newTestAPI(store, &fakeMailer{})
+
+	cases := map[string]struct {
+		target string
+		code   ErrorCode
+	}{
+		"activity of another trip": {"/trips/" + trip.ID.String() + "/activities/" + activity.ID.String() + "/done", ErrorCodeActivityNotFound},
+		"missing activity":         {"/trips/" + trip.ID.String() + "/activities/" + uuid.NewString() + "/done", ErrorCodeActivityNotFound},
+		"missing trip":             {"/trips/" + uuid.NewString() + "/activities/" + activity.ID.String() + "/done", ErrorCodeTripNotFound},
+	}
+
+	for name, test := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := newRequest(t, http.MethodPatch, test.target, map[string]bool{"is_done": true})
+			w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+			assertStatus(t, w, http.StatusNotFound)
+			var response spec.NotFoundRequest
+			decodeResponse(t, w, &response)
+			if response.Code != string(test.code) {
+				t.Fatalf("expected %s, got %s", test.code, response.Code)
+			}
+		})
+	}
+	if store.activity(activity.ID).IsDone {
+		t.Fatal("expected the activity of the other trip left untouched")
+	}
+}
+
+func TestPatchTripsTripIDActivitiesActivityIDDoneClosedTrip(t *testing.T) {
+	store := newFakeStore()
+	closed := newTestTrip(3)
+	closed.Status = pgstore.TripStatusCancelled
+	trip := store.addTrip(closed)
+	activity := store.addActivity(trip.ID, "Museum", trip.StartsAt.Time.Add(2*time.Hour))
+	api := newTestAPI(store, &fakeMailer{})
+
+	r := newRequest(t, http.MethodPatch, "/trips/"+trip.ID.String()+"/activities/"+activity.ID.String()+"/done", map[string]bool{"is_done": true})
+	assertStatus(t, serve(api, withOwnerToken(r, TEST_OWNER_TOKEN)), http.StatusConflict)
+}
@@ -0,0 +1,128 @@
+package api
+
+import (
+	"journey/internal/api/spec"
+	"journey/internal/pgstore"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestPutTripsTripIDActivitiesActivityID(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	activity := store.addActivity(trip.ID, "Musuem", trip.StartsAt.Time.Add(time.Hour))
+	api := newTestAPI(store, &fakeMailer{})
+	target := "/trips/" + trip.ID.String() + "/activities/" + activity.ID.String()
+	occursAt := trip.StartsAt.Time.AddDate(0, 0, 1).Add(3 * time.Hour)
+
+	r := newRequest(t, http.MethodPut, target, map[string]any{
+		"title":            "Museum",
+		"occurs_at":        occursAt,
+		"duration_minutes": 90,
+		"notes":            "Closed on mondays",
+	})
+	w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+	assertStatus(t, w, http.StatusOK)
+	var response spec.GetActivityResponse
+	decodeResponse(t, w, &response)
+	answered := response.Activity
+	if answered.ID != activity.ID.String() || answered.Title != "Museum" || !answered.OccursAt.Equal(occursAt) {
+		t.Fatalf("expected the activity renamed and moved, got %+v", answered)
+	}
+	if answered.DurationMinutes != 90 || !answered.EndsAt.Equal(occursAt.Add(90*time.Minute)) {
+		t.Fatalf("expected the activity lasting 90 minutes, got %+v", answered)
+	}
+
+	stored := store.activity(activity.ID)
+	if stored.Title != "Museum" || !stored.OccursAt.Time.Equal(occursAt) || stored.Notes.String != "Closed on mondays" {
+		t.Fatalf("expected the activity stored updated, got %+v", stored)
+	}
+
+	t.Run("clears the notes left out", func(t *testing.T) {
+		r := newRequest(t, http.MethodPut, target, map[string]any{"title": "Museum", "occurs_at": occursAt})
+		assertStatus(t, serve(api, withOwnerToken(r, TEST_OWNER_TOKEN)), http.StatusOK)
+
+		if stored := store.activity(activity.ID); stored.Notes.Valid || stored.DurationMinutes != 0 {
+			t.Fatalf("expected the notes and duration cleared, got %+v", stored)
+		}
+	})
+}
+
+func TestPutTripsTripIDActivitiesActivityIDInvalid(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	at := trip.StartsAt.Time.Add(time.Hour)
+	activity := store.addActivity(trip.ID, "Museum", at)
+	api := newTestAPI(store, &fakeMailer{})
+	target := "/trips/" + trip.ID.String() + "/activities/" + activity.ID.String()
+
+	cases := map[string]any{
+		"missing title":         map[string]any{"occurs_at": at},
+		"blank title":           map[string]any{"title": "   ", "occurs_at": at},
+		"missing time":          map[string]any{"title": "Beach"},
+		"negative duration":     map[string]any{"title": "Beach", "occurs_at": at, "duration_minutes": -1},
+		"before the trip":       map[string]any{"title": "Beach", "occurs_at": trip.StartsAt.Time.AddDate(0, 0, -1)},
+		"after the trip":        map[string]any{"title": "Beach", "occurs_at": trip.EndsAt.Time.AddDate(0, 0, 1)},
+		"ending after the trip": map[string]any{"title": "Beach", "occurs_at": at, "duration_minutes": 7 * 24 * 60},
+		"not json":              "not json",
+	}
+
+	for name, body := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := newRequest(t, http.MethodPut, target, body)
+
+			assertStatus(t, serve(api, withOwnerToken(r, TEST_OWNER_TOKEN)), http.StatusBadRequest)
+		})
+	}
+	if stored := store.activity(activity.ID); stored.Title != "Museum" || !stored.OccursAt.Time.Equal(at) {
+		t.Fatalf("expected the activity left untouched, got %+v", stored)
+	}
+}
+
+func TestPutTripsTripIDActivitiesActivityIDRefused(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	closed := newTestTrip(3)
+	closed.Status = pgstore.TripStatusCompleted
+	store.addTrip(closed)
+	other := store.addTrip(newTestTrip(3))
+	activity := store.addActivity(trip.ID, "Museum", trip.StartsAt.Time.Add(time.Hour))
+	closedActivity := store.addActivity(closed.ID, "Museum", closed.StartsAt.Time.Add(time.Hour))
+	api := newTestAPI(store, &fakeMailer{})
+	body := map[string]any{"title": "Beach", "occurs_at": trip.StartsAt.Time.Add(2 * time.Hour)}
+
+	cases := []struct {
+		name   string
+		target string
+		token  string
+		status int
+	}{
+		{"missing trip", "/trips/" + uuid.NewString() + "/activities/" + activity.ID.String(), TEST_OWNER_TOKEN, http.StatusNotFound},
+		{"missing activity", "/trips/" + trip.ID.String() + "/activities/" + uuid.NewString(), TEST_OWNER_TOKEN, http.StatusNotFound},
+		{"activity of another trip", "/trips/" + other.ID.String() + "/activities/" + activity.ID.String(), TEST_OWNER_TOKEN, http.StatusNotFound},
+		{"without the owner token", "/trips/" + trip.ID.String() + "/activities/" + activity.ID.String(), "", http.StatusUnauthorized},
+		{"with a wrong token", "/trips/" + trip.ID.String() + "/activities/" + activity.ID.String(), "not-the-owner", http.StatusForbidden},
+		{"closed trip", "/trips/" + closed.ID.String() + "/activities/" + closedActivity.ID.String(), TEST_OWNER_TOKEN, http.StatusConflict},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			r := newRequest(t, http.MethodPut, c.target, body)
+			if c.token != "" {
+				r = withOwnerToken(r, c.token)
+			}
+
+			assertStatus(t, serve(api, r), c.status)
+		})
+	}
+	if stored := store.activity(activity.ID); stored.Title != "Museum" {
+		t.Fatalf("expected the activity left untouched, got %+v", stored)
+	}
+	if calls := store.callsOf("UpdateActivity"); calls != 2 {
+		t.Fatalf("expected the activity updated only on the trips found and open, got %d updates", calls)
+	}
+}
@@ -0,0 +1,86 @@
+package api
+
+import (
+	"errors"
+	"journey/internal/api/spec"
+	"journey/internal/i18n"
+	"journey/internal/pgstore"
+	"net/http"
+	"time"
+
+	"github.com/discord-gophers/goapi-gen/types"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// List the participants of all the trips.
+// (GET /admin/participants)
+func (api *API) GetAdminParticipants(w http.ResponseWriter, r *http.Request, params spec.GetAdminParticipantsParams) *spec.Response {
+	if err := api.authorizeAdmin(r); err != nil {
+		if errors.Is(err, errMissingAdminToken) {
+			return spec.GetAdminParticipantsJSON401Response(api.unauthorized(r, i18n.MissingAdminToken))
+		}
+		return spec.GetAdminParticipantsJSON403Response(api.forbidden(r, i18n.WrongAdminToken))
+	}
+
+	page, perPage := 1, defaultParticipantsPerPage
+	if params.Page != nil {
+		page = *params.Page
+	}
+	if params.PerPage != nil {
+		perPage = *params.PerPage
+	}
+	if page < 1 || perPage < 1 || perPage > maxParticipantsPerPage {
+		return spec.GetAdminParticipantsJSON400Response(api.badRequest(r, i18n.InvalidPagination, maxParticipantsPerPage))
+	}
+
+	now := api.clock.Now().UTC()
+	var isConfirmed pgtype.Bool
+	if params.Confirmed != nil {
+		isConfirmed = pgtype.Bool{Valid: true, Bool: *params.Confirmed}
+	}
+	var invitedBefore pgtype.Timestamp
+	if params.OlderThan != nil {
+		olderThan, err := time.ParseDuration(*params.OlderThan)
+		if err != nil || olderThan <= 0 {
+			return spec.GetAdminParticipantsJSON400Response(api.badRequest(r, i18n.InvalidOlderThan))
+		}
+		invitedBefore = pgtype.Timestamp{Valid: true, Time: now.Add(-olderThan)}
+	}
+
+	participants, err := api.store.GetAdminParticipants(r.Context(), pgstore.GetAdminParticipantsParams{
+		IsConfirmed:   isConfirmed,
+		InvitedBefore: invitedBefore,
+		PageLimit:     int32(perPage),
+		PageOffset:    int32((page - 1) * perPage),
+	})
+	if err != nil {
+		api.loggerFor(r.Context()).Error("failed to get the participants of all the trips", zap.Error(err))
+		return spec.GetAdminParticipantsJSON500Response(api.internalServerError(r, i18n.UnableToGetParticipants))
+	}
+
+	total, err := api.store.CountAdminParticipants(r.Context(), pgstore.CountAdminParticipantsParams{
+		IsConfirmed:   isConfirmed,
+		InvitedBefore: invitedBefore,
+	})
+	if err != nil {
+		api.loggerFor(r.Context()).Error("failed to count the participants of all the trips", zap.Error(err))
+		return spec.GetAdminParticipantsJSON500Response(api.internalServerError(r, i18n.UnableToGetParticipants))
+	}
+
+	participantsParsed := make([]spec.GetAdminParticipantsResponseArray, len(participants))
+	for index, participant := range participants {
+		participantsParsed[index] = spec.GetAdminParticipantsResponseArray{
+			ID:               participant.ID.String(),
+			TripID:           participant.TripID.String(),
+			TripDestination:  participant.TripDestination,
+			Email:            types.Email(participant.Email),
+			IsConfirmed:      participant.IsConfirmed,
+			InviteStatus:     participant.InviteStatus,
+			InvitedAt:        participant.InvitedAt.Time,
+			InviteAgeSeconds: int(max(now.Sub(participant.InvitedAt.Time), 0) / time.Second),
+		}
+	}
+
+	return spec.GetAdminParticipantsJSON200Response(spec.NewPaginated(participantsParsed, page, perPage, total))
+}
@@ -0,0 +1,210 @@
+package api
+
+import (
+	"context"
+	"journey/internal/api/spec"
+	"journey/internal/pgstore"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// adminParticipants lists the participants of all the trips at target, failing the test unless they are listed.
+func adminParticipants(t *testing.T, api *API, target string) spec.GetAdminParticipantsResponse {
+	t.Helper()
+
+	r := newRequest(t, http.MethodGet, target, nil)
+	r.Header.Set("Authorization", "Bearer "+testAdminToken)
+	w := serve(api, r)
+	assertStatus(t, w, http.StatusOK)
+	var response spec.GetAdminParticipantsResponse
+	decodeResponse(t, w, &response)
+	return response
+}
+
+func assertEmails(t *testing.T, participants []spec.GetAdminParticipantsResponseArray, expected ...string) {
+	t.Helper()
+
+	var emails []string
+	for _, participant := range participants {
+		emails = append(emails, string(participant.Email))
+	}
+	if len(emails) != len(expected) {
+		t.Fatalf("expected the participants %v, got %v", expected, emails)
+	}
+	for index := range expected {
+		if emails[index] != expected[index] {
+			t.Fatalf("expected the participants %v, got %v", expected, emails)
+		}
+	}
+}
+
+// newAdminParticipantsStore has a trip to Lisbon with participants invited from 5 days to an hour before
+// testNow, the ones of 5 and 2 days ago confirmed.
+func newAdminParticipantsStore(t *testing.T) *fakeStore {
+	t.Helper()
+
+	store := newFakeStore()
+	trip := newTestTrip(3)
+	trip.Destination = "Lisbon"
+	store.addTrip(trip)
+
+	invites := []struct {
+		email     string
+		invitedAt time.Time
+		confirmed bool
+	}{
+		{"hour@trip.com", testNow.Add(-time.Hour), false},
+		{"two-days@trip.com", testNow.AddDate(0, 0, -2), true},
+		{"three-days@trip.com", testNow.AddDate(0, 0, -3), false},
+		{"five-days@trip.com", testNow.AddDate(0, 0, -5), true},
+	}
+	for _, invite := range invites {
+		participant := store.addParticipant(trip.ID, invite.email)
+		store.invite(participant.ID, invite.invitedAt)
+		if invite.confirmed {
+			err := store.ConfirmParticipant(context.Background(), pgstore.ConfirmParticipantParams{IsConfirmed: true, ID: participant.ID})
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	return store
+}
+
+func TestGetAdminParticipants(t *testing.T) {
+	api := newTestAPI(newAdminParticipantsStore(t), &fakeMailer{}, WithAdminToken(testAdminToken))
+
+	response := adminParticipants(t, api, "/admin/participants")
+
+	assertEmails(t, response.Items, "five-days@trip.com", "three-days@trip.com", "two-days@trip.com", "hour@trip.com")
+	if response.Total != 4 || response.Page != 1 || response.PageSize != defaultParticipantsPerPage {
+		t.Fatalf("expected the 4 participants on the first page, got %+v", response)
+	}
+	oldest := response.Items[0]
+	if oldest.TripDestination != "Lisbon" || !oldest.IsConfirmed {
+		t.Fatalf("expected the participant confirmed on the trip to Lisbon, got %+v", oldest)
+	}
+	if age := 5 * 24 * 60 * 60; oldest.InviteAgeSeconds != age || !oldest.InvitedAt.Equal(testNow.AddDate(0, 0, -5)) {
+		t.Fatalf("expected the invite %ds old, got %ds at %v", age, oldest.InviteAgeSeconds, oldest.InvitedAt)
+	}
+
+	t.Run("paginated", func(t *testing.T) {
+		response := adminParticipants(t, api, "/admin/participants?page=2&perPage=3")
+
+		assertEmails(t, response.Items, "hour@trip.com")
+		if response.Total != 4 || response.TotalPages != 2 {
+			t.Fatalf("expected the 4 participants on 2 pages, got %d on %d", response.Total, response.TotalPages)
+		}
+	})
+}
+
+func TestGetAdminParticipantsOlderThan(t *testing.T) {
+	api := newTestAPI(newAdminParticipantsStore(t), &fakeMailer{}, WithAdminToken(testAdminToken))
+
+	cases := []struct {
+		olderThan string
+		expected  []string
+	}{
+		{"30m", []string{"five-days@trip.com", "three-days@trip.com", "two-days@trip.com", "hour@trip.com"}},
+		{"48h", []string{"five-days@trip.com", "three-days@trip.com"}},
+		{"72h", []string{"five-days@trip.com"}},
+		{"240h", nil},
+	}
+
+	for _, c := range cases {
+		t.Run(c.olderThan, func(t *testing.T) {
+			response := adminParticipants(t, api, "/admin/participants?olderThan="+c.olderThan)
+
+			assertEmails(t, response.Items, c.expected...)
+			if response.Total != len(c.expected) {
+				t.Fatalf("expected %d participants in total, got %d", len(c.expected), response.Total)
+			}
+		})
+	}
+}
+
+func TestGetAdminParticipantsConfirmedAndOlderThan(t *testing.T) {
+	api := newTestAPI(newAdminParticipantsStore(t), &fakeMailer{}, WithAdminToken(testAdminToken))
+
+	cases := []struct {
+		query    string
+		expected []string
+	}{
+		{"confirmed=true", []string{"five-days@trip.com", "two-days@trip.com"}},
+		{"confirmed=false", []string{"three-days@trip.com", "hour@trip.com"}},
+		{"confirmed=false&olderThan=24h", []string{"three-days@trip.com"}},
+		{"confirmed=true&olderThan=24h", []string{"five-days@trip.com", "two-days@trip.com"}},
+		{"confirmed=true&olderThan=96h", []string{"five-days@trip.com"}},
+		{"confirmed=false&olderThan=96h", nil},
+	}
+
+	for _, c := range cases {
+		t.Run(c.query, func(t *testing.T) {
+			response := adminParticipants(t, api, "/admin/participants?"+c.query)
+
+			assertEmails(t, response.Items, c.expected...)
+			if response.Total != len(c.expected) {
+				t.Fatalf("expected %d participants in total, got %d", len(c.expected), response.Total)
+			}
+		})
+	}
+}
+
+func TestGetAdminParticipantsInvalid(t *testing.T) {
+	store := newAdminParticipantsStore(t)
+	api := newTestAPI(store, &fakeMailer{}, WithAdminToken(testAdminToken))
+
+	queries := map[string]string{
+		"olderThan not a duration": "olderThan=3days",
+		"olderThan negative":       "olderThan=-1h",
+		"olderThan zero":           "olderThan=0s",
+		"confirmed not a boolean":  "confirmed=maybe",
+		"page zero":                "page=0",
+		"perPage over the max":     "perPage=201",
+	}
+
+	for name, query := range queries {
+		t.Run(name, func(t *testing.T) {
+			r := newRequest(t, http.MethodGet, "/admin/participants?"+query, nil)
+			r.Header.Set("Authorization", "Bearer "+testAdminToken)
+
+			assertStatus(t, serve(api, r), http.StatusBadRequest)
+		})
+	}
+	if calls := store.callsOf("GetAdminParticipants"); calls != 0 {
+		t.Fatalf("expected no participants read, got %d", calls)
+	}
+}
+
+func TestGetAdminParticipantsRequiresTheAdminToken(t *testing.T) {
+	store := newAdminParticipantsStore(t)
+
+	cases := []struct {
+		name       string
+		adminToken string
+		token      string
+		status     int
+	}{
+		{"missing token", testAdminToken, "", http.StatusUnauthorized},
+		{"wrong token", testAdminToken, "not-the-admin", http.StatusForbidden},
+		{"no admin token configured", "", "anything", http.StatusForbidden},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			api := newTestAPI(store, &fakeMailer{}, WithAdminToken(c.adminToken))
+
+			r := newRequest(t, http.MethodGet, "/admin/participants", nil)
+			if c.token != "" {
+				r.Header.Set("Authorization", "Bearer "+c.token)
+			}
+
+			assertStatus(t, serve(api, r), c.status)
+		})
+	}
+
+	if store.callsOf("GetAdminParticipants") != 0 {
+		t.Fatal("expected no participants read without the admin token")
+	}
+}
@@ -2,13 +2,27 @@ package api
 
 import (
 	"context"
+	"encoding/csv"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"journey/cmd/journey/config"
 	"journey/internal/api/spec"
+	"journey/internal/calendar"
+	"journey/internal/httplog"
+	"journey/internal/i18n"
+	"journey/internal/jwt"
+	"journey/internal/mailer/dispatcher"
 	"journey/internal/mailer/mailpit"
 	"journey/internal/pgstore"
+	"journey/internal/token"
+	"journey/internal/webhook"
+	"math"
+	"net"
 	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -18,747 +32,2315 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
-	"github.com/payfazz/baseurl"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 )
 
 type mailer interface {
-	SendConfirmTripEmailToTripOwner(uuid.UUID) error
+	SendConfirmTripEmailToTripOwner(uuid.UUID, i18n.Locale) error
+	SendConfirmTripEmailToTripOwnerOnce(context.Context, uuid.UUID, i18n.Locale) error
 	SendConfirmTripEmailToParticipants(mailpit.SendInviteToParticipants) error
+	SendTripCancellationToParticipants(mailpit.SendTripCancellation) error
+	SendOwnerMagicLink(mailpit.SendOwnerMagicLink) error
+	Ping(context.Context) error
+}
+
+type pinger interface {
+	Ping(context.Context) error
+}
+
+type emailDispatcher interface {
+	Enqueue(ctx context.Context, name string, send func() error, fields ...zap.Field) error
+}
+
+// webhookNotifier delivers the trip events to the integrations, as Slack or Zapier.
+type webhookNotifier interface {
+	NotifyTripConfirmed(context.Context, webhook.TripConfirmed) error
 }
 
 type store interface {
 	// Trips
-	CreateTrip(context.Context, *pgxpool.Pool, spec.CreateTripRequest) (uuid.UUID, error)
+	CreateTrip(context.Context, *pgxpool.Pool, spec.CreateTripRequest, string) (uuid.UUID, error)
+	CloneTrip(context.Context, *pgxpool.Pool, pgstore.CloneTripParams) (uuid.UUID, error)
 	GetTrip(context.Context, uuid.UUID) (pgstore.Trip, error)
+	TripExists(context.Context, uuid.UUID) (bool, error)
 	UpdateTrip(context.Context, pgstore.UpdateTripParams) error
-	UpdateTripConfirm(context.Context, pgstore.UpdateTripConfirmParams) error
+	UpdateTripConfirm(context.Context, pgstore.UpdateTripConfirmParams) (int64, error)
+	UpdateTripStatus(context.Context, pgstore.UpdateTripStatusParams) error
+	RescheduleTrip(context.Context, *pgxpool.Pool, pgstore.RescheduleTripParams) error
+	TransferTripOwner(context.Context, *pgxpool.Pool, pgstore.TransferTripOwnerParams) error
+	UpdateTripOwnerTokenHash(context.Context, pgstore.UpdateTripOwnerTokenHashParams) error
+	DeleteTrip(context.Context, *pgxpool.Pool, uuid.UUID) error
+	GetDeletedTrip(context.Context, uuid.UUID) (pgstore.Trip, error)
+	RestoreTrip(context.Context, *pgxpool.Pool, uuid.UUID, pgtype.Timestamp) error
+	GetTripsByEmail(context.Context, pgstore.GetTripsByEmailParams) ([]pgstore.GetTripsByEmailRow, error)
+	CountTripsByEmail(context.Context, string) (int64, error)
+	ListTripsByOwner(context.Context, pgstore.ListTripsByOwnerParams) ([]pgstore.ListTripsByOwnerRow, error)
+	CountTripsByOwner(context.Context, string) (int64, error)
+	// Idempotency keys
+	ClaimIdempotencyKey(context.Context, pgstore.ClaimIdempotencyKeyParams) (int64, error)
+	GetIdempotencyKey(context.Context, string) (pgstore.IdempotencyKey, error)
+	CompleteIdempotencyKey(context.Context, pgstore.CompleteIdempotencyKeyParams) error
+	ReleaseIdempotencyKey(context.Context, string) error
 	// Participants
 	ConfirmParticipant(context.Context, pgstore.ConfirmParticipantParams) error
+	ConfirmParticipantsBatch(context.Context, *pgxpool.Pool, pgstore.ConfirmTripParticipantsParams) ([]pgstore.Participant, error)
 	GetParticipant(context.Context, uuid.UUID) (pgstore.Participant, error)
+	GetParticipantForTrip(context.Context, pgstore.GetParticipantForTripParams) (pgstore.Participant, error)
 	GetParticipants(context.Context, uuid.UUID) ([]pgstore.Participant, error)
-	InviteParticipantsToTrip(context.Context, []pgstore.InviteParticipantsToTripParams) (int64, error)
+	GetParticipantsSummary(context.Context, uuid.UUID) (pgstore.GetParticipantsSummaryRow, error)
+	GetAdminParticipants(context.Context, pgstore.GetAdminParticipantsParams) ([]pgstore.GetAdminParticipantsRow, error)
+	CountAdminParticipants(context.Context, pgstore.CountAdminParticipantsParams) (int64, error)
+	CountPendingInvitesByEmail(context.Context, string) (int64, error)
+	GetTripWithParticipants(context.Context, uuid.UUID) (pgstore.Trip, []pgstore.Participant, error)
+	GetTripWithParticipantsPage(context.Context, pgstore.GetTripAndParticipantsPageParams) (pgstore.Trip, []pgstore.Participant, int64, error)
+	InviteParticipantsWithinCapacity(context.Context, *pgxpool.Pool, []pgstore.InviteParticipantsToTripParams) (int64, error)
+	UpdateParticipantEmail(context.Context, pgstore.UpdateParticipantEmailParams) error
+	DeleteParticipant(context.Context, pgstore.DeleteParticipantParams) (int64, error)
+	PromoteWaitlistedParticipant(context.Context, *pgxpool.Pool, pgstore.PromoteParticipantParams) (int64, error)
 	// Activities
 	CreateActivity(context.Context, pgstore.CreateActivityParams) (uuid.UUID, error)
+	CreateActivities(context.Context, *pgxpool.Pool, []pgstore.CreateActivityParams) ([]uuid.UUID, error)
 	GetTripActivities(context.Context, uuid.UUID) ([]pgstore.Activity, error)
+	GetTripWithActivities(context.Context, pgstore.GetTripAndActivitiesParams) (pgstore.Trip, []pgstore.Activity, error)
+	GetTripWithActivitiesPage(context.Context, pgstore.GetTripAndActivitiesPageParams) (pgstore.Trip, []pgstore.Activity, int64, error)
+	SearchActivities(context.Context, uuid.UUID, string) ([]pgstore.Activity, error)
+	GetTripWithActivityCounts(context.Context, uuid.UUID) (pgstore.Trip, []pgstore.ActivityDayCount, error)
+	GetActivity(context.Context, pgstore.GetActivityParams) (pgstore.Activity, error)
+	UpdateActivity(context.Context, pgstore.UpdateActivityParams) (pgstore.Activity, error)
+	UpdateActivityDone(context.Context, pgstore.UpdateActivityDoneParams) (pgstore.Activity, error)
+	DeleteActivity(context.Context, pgstore.DeleteActivityParams) (int64, error)
+	ReorderActivities(context.Context, *pgxpool.Pool, pgstore.ReorderActivitiesParams) error
 	// Links
 	CreateTripLink(context.Context, pgstore.CreateTripLinkParams) (uuid.UUID, error)
-	GetTripLinks(context.Context, uuid.UUID) ([]pgstore.Link, error)
+	GetTripWithLinks(context.Context, uuid.UUID) (pgstore.Trip, []pgstore.Link, error)
+	GetLink(context.Context, pgstore.GetLinkParams) (pgstore.Link, error)
+	// Diagnostics
+	GetSchemaVersion(context.Context) (int32, error)
+}
+
+const (
+	healthCheckTimeout      = 2 * time.Second
+	healthStatusOK          = "ok"
+	healthStatusUnavailable = "unavailable"
+
+	defaultTripsPerPage = 20
+	maxTripsPerPage     = 100
+
+	defaultParticipantsPerPage = 50
+	maxParticipantsPerPage     = 200
+
+	defaultTimelinePerPage = 50
+	maxTimelinePerPage     = 200
+
+	// maxActivitiesSearchLength bounds the text the activities are searched by.
+	maxActivitiesSearchLength = 100
+
+	// maxTripStartYearsAhead bounds how far in the future a trip may start.
+	maxTripStartYearsAhead = 5
+)
+
+const DEFAULT_MAX_TRIP_DAYS = 365
+
+var (
+	errTripNotFound                = errors.New("trip not found")
+	errTripAlreadyConfirmed        = errors.New("trip already confirmed")
+	errParticipantNotFound         = errors.New("participant not found")
+	errParticipantAlreadyConfirmed = errors.New("participant already confirmed")
+	errParticipantWaitlisted       = errors.New("participant waitlisted")
+)
+
+// Clock tells the now the time-based validations are made against.
+type Clock interface {
+	Now() time.Time
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
 }
 
 type API struct {
-	store     store
-	logger    *zap.Logger
-	validator *validator.Validate
-	pool      *pgxpool.Pool
-	mailer    mailer
+	store       store
+	logger      *zap.Logger
+	validator   *validator.Validate
+	pool        *pgxpool.Pool
+	mailer      mailer
+	clock       Clock
+	dispatcher  emailDispatcher
+	database    pinger
+	webhook     webhookNotifier
+	maxTripDays int
+	// idempotencyKeyTTL is how long an Idempotency-Key replays the trip it created.
+	idempotencyKeyTTL time.Duration
+	// storeRetries is how many times a store call failing transiently is attempted again.
+	storeRetries int
+	// mxResolver checks the domains of the invited emails receive mail, nil skipping the check.
+	mxResolver mxResolver
+	// maxBodyBytes is the largest request body read, a larger one answered a 413.
+	maxBodyBytes int64
+	// version is the version the app was built as, adminToken the token of the admin routes and startedAt
+	// when the API was built, reported on the diagnostics.
+	version    string
+	adminToken string
+	startedAt  time.Time
+	// geocoder resolves the coordinates of the destinations sent without them.
+	geocoder Geocoder
+	// syncOwnerConfirmation sends the confirmation email of the owner before answering the created trip, unless
+	// the request asks otherwise.
+	syncOwnerConfirmation bool
+	// syncOwnerConfirmationTimeout bounds the wait on that email, well under the write timeout of the server.
+	syncOwnerConfirmationTimeout time.Duration
+	// confirmTokens verifies the tokens of the confirmation links sent by email.
+	confirmTokens token.Signer
+	// ownerTokens issues and verifies the JWTs of the trip owners, the opaque owner tokens only when disabled.
+	ownerTokens jwt.Issuer
+}
+
+// Option customizes the API built by NewApi.
+type Option func(*API)
+
+// WithDispatcher overrides the dispatcher the emails are sent through.
+func WithDispatcher(dispatcher emailDispatcher) Option {
+	return func(api *API) {
+		api.dispatcher = dispatcher
+	}
+}
+
+// WithDatabasePinger overrides how the database reachability is checked, the pool by default.
+func WithDatabasePinger(database pinger) Option {
+	return func(api *API) {
+		api.database = database
+	}
+}
+
+// WithWebhook sets the notifier the trip events are delivered through, none by default.
+func WithWebhook(notifier webhookNotifier) Option {
+	return func(api *API) {
+		api.webhook = notifier
+	}
+}
+
+// WithMaxTripDays overrides how many days a trip may last, JOURNEY_MAX_TRIP_DAYS by default.
+func WithMaxTripDays(days int) Option {
+	return func(api *API) {
+		api.maxTripDays = days
+	}
+}
+
+// WithIdempotencyKeyTTL overrides how long the Idempotency-Keys last, JOURNEY_IDEMPOTENCY_KEY_TTL by default.
+func WithIdempotencyKeyTTL(ttl time.Duration) Option {
+	return func(api *API) {
+		api.idempotencyKeyTTL = ttl
+	}
+}
+
+// WithStoreRetries overrides how many times a store call failing transiently is attempted again,
+// JOURNEY_DB_RETRIES by default.
+func WithStoreRetries(retries int) Option {
+	return func(api *API) {
+		api.storeRetries = retries
+	}
 }
 
-func NewApi(pool *pgxpool.Pool, logger *zap.Logger, mailer mailer) API {
-	validator := validator.New(validator.WithRequiredStructEnabled())
-	return API{
+// WithClock overrides the clock used on time-based validations.
+func WithClock(clock Clock) Option {
+	return func(api *API) {
+		api.clock = clock
+	}
+}
+
+func NewApi(pool *pgxpool.Pool, logger *zap.Logger, mailer mailer, opts ...Option) API {
+	validator := newValidator()
+	api := API{
 		pgstore.New(pool),
 		logger,
 		validator,
 		pool,
 		mailer,
+		realClock{},
+		nil,
+		nil,
+		nil,
+		GetMaxTripDays(),
+		GetIdempotencyKeyTTL(),
+		GetStoreRetries(),
+		nil,
+		GetMaxBodyBytes(),
+		DEFAULT_VERSION,
+		GetAdminToken(),
+		time.Time{},
+		noopGeocoder{},
+		GetMailSyncOwnerConfirmation(),
+		SYNC_OWNER_CONFIRMATION_TIMEOUT,
+		token.NewFromEnvironment(),
+		jwt.NewFromEnvironment(),
 	}
-}
 
-// Create a new trip
-// (POST /trips)
-func (api *API) PostTrips(w http.ResponseWriter, r *http.Request) *spec.Response {
+	if pool != nil {
+		api.database = pool
+	}
 
-	var body spec.CreateTripRequest
-	err := json.NewDecoder(r.Body).Decode(&body)
-	if err != nil {
-		spec.PostTripsJSON400Response(spec.BadRequest{Message: "invalid request: " + err.Error()})
+	if GetMailCheckMX() {
+		api.mxResolver = net.DefaultResolver
 	}
 
-	if err := api.validator.Struct(body); err != nil {
-		return spec.PostTripsJSON400Response(spec.BadRequest{Message: "invalid input: " + err.Error()})
+	for _, opt := range opts {
+		opt(&api)
 	}
 
-	if body.StartsAt.UTC().Before(time.Now().UTC()) {
-		return spec.PutTripsTripIDJSON400Response(spec.BadRequest{Message: "the travel period is invalid, it is not possible to change the start date to before today/now"})
+	api.startedAt = api.clock.Now()
+
+	if api.dispatcher == nil {
+		api.dispatcher = dispatcher.New(logger, dispatcher.DefaultWorkers, dispatcher.DefaultQueueSize)
 	}
 
-	if body.EndsAt.UTC().Before(body.StartsAt.UTC()) {
-		return spec.PutTripsTripIDJSON400Response(spec.BadRequest{Message: "the travel period is invalid, end date must be equal to or greater than the start date"})
+	if api.storeRetries > 0 {
+		api.store = newRetryingStore(api.store, api.storeRetries, storeRetryBackoff)
 	}
 
-	tripID, err := api.store.CreateTrip(r.Context(), api.pool, body)
-	if err != nil {
-		api.logger.Error(
-			fmt.Sprintf("failed route: '%v: %v' when create a trip: ", r.URL.RawPath, r.URL.Path),
-			zap.Error(err),
-		)
+	return api
+}
 
-		return spec.PostTripsJSON500Response(spec.InternalServerErrorRequest{
-			Message: "unable to create trip, contact adm",
-		})
+// GetMaxTripDays reads JOURNEY_MAX_TRIP_DAYS, falling back to the default when missing or invalid.
+func GetMaxTripDays() int {
+	if value, err := config.GetSpecificEnvironmentVariable("JOURNEY_MAX_TRIP_DAYS"); err == nil {
+		if days, err := strconv.Atoi(value); err == nil && days > 0 {
+			return days
+		}
 	}
+	return DEFAULT_MAX_TRIP_DAYS
+}
 
-	go func() {
-		if err := api.mailer.SendConfirmTripEmailToTripOwner(tripID); err != nil {
-			api.logger.Error(
-				"failed to send email on PostTrips",
-				zap.Error(err),
-				zap.String("trip_id", tripID.String()),
-			)
+// Check the application readiness.
+// (GET /healthz)
+func (api *API) GetHealthz(w http.ResponseWriter, r *http.Request, params spec.GetHealthzParams) *spec.Response {
+	ctx, cancel := context.WithTimeout(r.Context(), healthCheckTimeout)
+	defer cancel()
+
+	healthy := true
+	response := spec.HealthResponse{Status: healthStatusOK, Database: healthStatusOK}
+
+	if api.database == nil {
+		healthy = false
+		response.Database = healthStatusUnavailable
+	} else if err := api.database.Ping(ctx); err != nil {
+		api.loggerFor(r.Context()).Warn("database unreachable on GetHealthz", zap.Error(err))
+		healthy = false
+		response.Database = healthStatusUnavailable
+	}
+
+	if params.Deep != nil && *params.Deep {
+		mailerStatus := healthStatusOK
+		if err := api.mailer.Ping(ctx); err != nil {
+			api.loggerFor(r.Context()).Warn("mailer unreachable on GetHealthz", zap.Error(err))
+			healthy = false
+			mailerStatus = healthStatusUnavailable
 		}
-	}()
+		response.Mailer = &mailerStatus
+	}
 
-	return spec.PostTripsJSON201Response(spec.CreateTripResponse{TripID: tripID.String()})
-}
+	if !healthy {
+		response.Status = healthStatusUnavailable
+		return spec.GetHealthzJSON503Response(response)
+	}
 
-// Wrapper to confirm a trip and send e-mail invitations.
-// (GET /trips/{tripId}/confirm)
-func (api *API) GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tripId string) *spec.Response {
+	return spec.GetHealthzJSON200Response(response)
+}
 
-	response, err := api.buildRedirectRequestUsingRequestsWithParametersInTheURL(r, r.RequestURI)
-	if err != nil {
-		api.logger.Error(
-			fmt.Sprintf("failed on route: '%v: %v'", r.URL.RawPath, r.URL.Path),
-			zap.Error(err),
-			zap.String("tripId", tripId),
-		)
+// List the trips an email owns or participates in.
+// (GET /trips)
+func (api *API) GetParticipantsByEmailTrips(w http.ResponseWriter, r *http.Request, params spec.GetParticipantsByEmailTripsParams) *spec.Response {
+	if (params.ParticipantEmail == nil) == (params.OwnerEmail == nil) {
+		return spec.GetParticipantsByEmailTripsJSON400Response(api.badRequest(r, i18n.InvalidTripsEmail))
+	}
 
-		return spec.GetTripsTripIDConfirmJSON500Response(spec.InternalServerErrorRequest{
-			Message: "unable to confirm trip by wrapper",
-		})
+	var email string
+	query := struct {
+		ParticipantEmail *string `json:"participantEmail" validate:"omitnil,email"`
+		OwnerEmail       *string `json:"ownerEmail" validate:"omitnil,email"`
+	}{}
+	if params.OwnerEmail != nil {
+		email = normalizeEmail(string(*params.OwnerEmail))
+		query.OwnerEmail = &email
+	} else {
+		email = normalizeEmail(string(*params.ParticipantEmail))
+		query.ParticipantEmail = &email
+	}
+	if err := api.validator.Struct(query); err != nil {
+		return spec.GetParticipantsByEmailTripsJSON400Response(api.invalidFieldsRequest(r, err))
 	}
 
-	if response.StatusCode == 400 {
-		var body400 spec.BadRequest
-		json.NewDecoder(response.Body).Decode(&body400)
-		return spec.GetTripsTripIDConfirmJSON400Response(body400)
+	page, perPage := 1, defaultTripsPerPage
+	if params.Page != nil {
+		page = *params.Page
+	}
+	if params.PerPage != nil {
+		perPage = *params.PerPage
+	}
+	if page < 1 || perPage < 1 || perPage > maxTripsPerPage {
+		return spec.GetParticipantsByEmailTripsJSON400Response(api.badRequest(r, i18n.InvalidPagination, maxTripsPerPage))
 	}
 
-	if response.StatusCode == 404 {
-		var body404 spec.NotFoundRequest
-		json.NewDecoder(response.Body).Decode(&body404)
-		return spec.GetTripsTripIDConfirmJSON404Response(body404)
+	listTrips := api.tripsOfEmail
+	if query.OwnerEmail != nil {
+		listTrips = api.tripsOwnedBy
+	}
+	trips, total, err := listTrips(r.Context(), email, page, perPage)
+	if err != nil {
+		api.loggerFor(r.Context()).Error("failed to get the trips of an email", zap.Error(err))
+		return spec.GetParticipantsByEmailTripsJSON500Response(api.internalServerError(r, i18n.UnableToGetTrips))
 	}
 
-	return spec.GetTripsTripIDConfirmJSON204Response(response.Body)
+	return spec.GetParticipantsByEmailTripsJSON200Response(spec.NewPaginated(trips, page, perPage, total))
 }
 
-// Confirm a trip and send e-mail invitations.
-// (PATCH /trips/{tripId}/confirm)
-func (api *API) PatchTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	tripUUID, friendlyErrorMessage, err := api.tryParseUUID("tripID", tripID)
+// tripsOfEmail is the page of the trips the email owns or participates in, along with their total.
+func (api *API) tripsOfEmail(ctx context.Context, email string, page, perPage int) ([]spec.GetParticipantTripsResponseArray, int64, error) {
+	trips, err := api.store.GetTripsByEmail(ctx, pgstore.GetTripsByEmailParams{
+		Email:      email,
+		PageLimit:  int32(perPage),
+		PageOffset: int32((page - 1) * perPage),
+	})
 	if err != nil {
-		return spec.PatchTripsTripIDConfirmJSON400Response(spec.BadRequest{
-			Message: friendlyErrorMessage,
-		})
+		return nil, 0, fmt.Errorf("unable to get the trips: %w", err)
 	}
 
-	trip, err := api.store.GetTrip(r.Context(), tripUUID)
+	total, err := api.store.CountTripsByEmail(ctx, email)
 	if err != nil {
-		return spec.PatchTripsTripIDConfirmJSON404Response(spec.NotFoundRequest{
-			Message: "trip not found",
-		})
+		return nil, 0, fmt.Errorf("unable to count the trips: %w", err)
+	}
+
+	tripsParsed := make([]spec.GetParticipantTripsResponseArray, len(trips))
+	for index, trip := range trips {
+		tripsParsed[index] = spec.GetParticipantTripsResponseArray{
+			ID:          trip.ID.String(),
+			Destination: trip.Destination,
+			StartsAt:    trip.StartsAt.Time,
+			EndsAt:      trip.EndsAt.Time,
+			IsOwner:     trip.IsOwner,
+			IsConfirmed: trip.IsConfirmed,
+		}
 	}
+	return tripsParsed, total, nil
+}
 
-	confirmTrip := pgstore.UpdateTripConfirmParams{
-		IsConfirmed: true,
-		ID:          tripUUID,
+// tripsOwnedBy is the page of the trips the email owns, along with their total.
+func (api *API) tripsOwnedBy(ctx context.Context, email string, page, perPage int) ([]spec.GetParticipantTripsResponseArray, int64, error) {
+	trips, err := api.store.ListTripsByOwner(ctx, pgstore.ListTripsByOwnerParams{
+		Email:      email,
+		PageLimit:  int32(perPage),
+		PageOffset: int32((page - 1) * perPage),
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("unable to get the owned trips: %w", err)
 	}
 
-	if err := api.store.UpdateTripConfirm(r.Context(), confirmTrip); err != nil {
+	total, err := api.store.CountTripsByOwner(ctx, email)
+	if err != nil {
+		return nil, 0, fmt.Errorf("unable to count the owned trips: %w", err)
+	}
+
+	tripsParsed := make([]spec.GetParticipantTripsResponseArray, len(trips))
+	for index, trip := range trips {
+		tripsParsed[index] = spec.GetParticipantTripsResponseArray{
+			ID:          trip.ID.String(),
+			Destination: trip.Destination,
+			StartsAt:    trip.StartsAt.Time,
+			EndsAt:      trip.EndsAt.Time,
+			IsOwner:     true,
+			IsConfirmed: trip.IsConfirmed,
+		}
+	}
+	return tripsParsed, total, nil
+}
 
-		api.logger.Error(
-			fmt.Sprintf("failed route: '%v: %v'", r.URL.RawPath, r.URL.Path),
-			zap.Error(err),
-			zap.String("tripID", tripID),
-		)
+// Create a new trip
+// (POST /trips)
+func (api *API) PostTrips(w http.ResponseWriter, r *http.Request, params spec.PostTripsParams) *spec.Response {
 
-		return spec.PatchTripsTripIDConfirmJSON500Response(spec.InternalServerErrorRequest{
-			Message: "unable to confirm trip and send notifications",
-		})
+	var body spec.CreateTripRequest
+	if err := decodeBody(r, &body); err != nil {
+		return spec.PostTripsJSON400Response(api.badRequest(r, i18n.InvalidRequest, err.Error()))
 	}
 
-	participants, err := api.store.GetParticipants(r.Context(), tripUUID)
-	if err != nil {
-		return spec.PatchTripsTripIDConfirmJSON500Response(spec.InternalServerErrorRequest{
-			Message: "unable to get participants to invite",
-		})
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostTripsJSON400Response(api.invalidFieldsRequest(r, err))
 	}
 
-	invites := make([]mailpit.InviteParticipantsToTrip, len(participants))
-	for index, participant := range participants {
-		invites[index] = mailpit.InviteParticipantsToTrip{
-			TripID: trip.ID,
-			Participant: mailpit.Participant{
-				ParticipantId: participant.ID,
-				Email:         participant.Email,
-			},
-		}
+	if badRequest := api.checkTripPeriod(r, body.StartsAt, body.EndsAt); badRequest != nil {
+		return spec.PostTripsJSON400Response(*badRequest)
 	}
 
-	dataToSendInvite := mailpit.SendInviteToParticipants{
-		Trip:    trip,
-		Invites: invites,
+	emailsToInvite := make([]string, len(body.EmailsToInvite))
+	for index, email := range body.EmailsToInvite {
+		emailsToInvite[index] = string(email)
+	}
+	if undeliverable := api.undeliverableEmails(r.Context(), emailsToInvite); len(undeliverable) > 0 {
+		return spec.PostTripsJSON400Response(api.badRequest(r, i18n.UndeliverableEmails, strings.Join(undeliverable, ", ")))
 	}
 
-	go func() {
-		if err := api.mailer.SendConfirmTripEmailToParticipants(dataToSendInvite); err != nil {
-			api.logger.Error(
-				"failed to send email on GetTripsTripIDConfirm",
-				zap.Error(err),
-				zap.String("tripID", tripID),
-			)
+	var idempotencyKey string
+	if params.IdempotencyKey != nil {
+		idempotencyKey = *params.IdempotencyKey
+		if idempotencyKey == "" || len(idempotencyKey) > maxIdempotencyKeyLength {
+			return spec.PostTripsJSON400Response(api.badRequest(r, i18n.InvalidIdempotencyKey, maxIdempotencyKeyLength))
 		}
-	}()
 
-	return spec.PatchTripsTripIDConfirmJSON204Response(nil)
-}
+		replay, err := api.claimIdempotencyKey(r.Context(), idempotencyKey, body)
+		if errors.Is(err, errIdempotencyKeyConflict) {
+			return spec.PostTripsJSON409Response(api.conflict(r, i18n.IdempotencyKeyConflict))
+		}
+		if errors.Is(err, errIdempotencyKeyInProgress) {
+			return spec.PostTripsJSON409Response(api.conflict(r, i18n.IdempotencyKeyInProgress))
+		}
+		if err != nil {
+			api.loggerFor(r.Context()).Error(
+				fmt.Sprintf("failed route: '%v: %v' when create a trip: ", r.URL.RawPath, r.URL.Path),
+				zap.Error(err),
+			)
 
-// Wrapper to confirms a participant on a trip.
-// (GET /participants/{participantId}/confirm)
-func (api *API) GetParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request, participantID string) *spec.Response {
+			return spec.PostTripsJSON500Response(api.internalServerError(r, i18n.UnableToCreateTrip))
+		}
+		if replay != nil {
+			w.Header().Set("Location", "/trips/"+replay.TripID)
+			return spec.PostTripsJSON201Response(*replay)
+		}
+	}
 
-	response, err := api.buildRedirectRequestUsingRequestsWithParametersInTheURL(r, r.RequestURI)
+	ownerToken, ownerTokenHash, err := generateOwnerToken()
 	if err != nil {
-		api.logger.Error(
-			fmt.Sprintf("failed on route: '%v: %v'", r.URL.RawPath, r.URL.Path),
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed route: '%v: %v' when create a trip: ", r.URL.RawPath, r.URL.Path),
 			zap.Error(err),
-			zap.String("tripId", participantID),
 		)
+		api.releaseIdempotencyKey(r.Context(), idempotencyKey)
 
-		return spec.GetParticipantsParticipantIDConfirmJSON500Response(spec.InternalServerErrorRequest{
-			Message: "unable to confirm participant by wrapper",
-		})
-	}
-
-	if response.StatusCode == 400 {
-		var body400 spec.BadRequest
-		json.NewDecoder(response.Body).Decode(&body400)
-		return spec.GetParticipantsParticipantIDConfirmJSON400Response(body400)
-	}
-
-	if response.StatusCode == 404 {
-		var body404 spec.NotFoundRequest
-		json.NewDecoder(response.Body).Decode(&body404)
-		return spec.GetParticipantsParticipantIDConfirmJSON404Response(body404)
+		return spec.PostTripsJSON500Response(api.internalServerError(r, i18n.UnableToCreateTrip))
 	}
 
-	return spec.GetParticipantsParticipantIDConfirmJSON204Response(response.Body)
-}
-
-// Confirms a participant on a trip.
-// (PATCH /participants/{participantId}/confirm)
-func (api *API) PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request, participantID string) *spec.Response {
-	participantUUID, friendlyMessageError, err := api.tryParseUUID("participantID", participantID)
-	if err != nil {
-		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.BadRequest{
-			Message: friendlyMessageError,
-		})
+	// Resolved after the Idempotency-Key is claimed, a replay answers the trip as it was first created.
+	if body.Latitude == nil {
+		if coordinates := api.geocode(r.Context(), body.Destination); coordinates != nil {
+			body.Latitude, body.Longitude = &coordinates.Latitude, &coordinates.Longitude
+		}
 	}
 
-	participant, err := api.store.GetParticipant(r.Context(), participantUUID)
+	tripID, err := api.store.CreateTrip(r.Context(), api.pool, body, ownerTokenHash)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.PatchParticipantsParticipantIDConfirmJSON404Response(spec.NotFoundRequest{
-				Message: "participant not found",
-			})
-		}
-
-		api.logger.Error(
-			fmt.Sprintf("failed on route: '%v: %v'", r.URL.RawPath, r.URL.Path),
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed route: '%v: %v' when create a trip: ", r.URL.RawPath, r.URL.Path),
 			zap.Error(err),
-			zap.String("participantID", participantID),
 		)
+		api.releaseIdempotencyKey(r.Context(), idempotencyKey)
 
-		return spec.PatchParticipantsParticipantIDConfirmJSON500Response(spec.InternalServerErrorRequest{
-			Message: "unable to retrieve trip's participants",
-		})
-	}
-
-	if participant.IsConfirmed {
-		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.BadRequest{
-			Message: "participant already confirmed",
-		})
+		if storeFailureStatus(err) == http.StatusConflict {
+			return spec.PostTripsJSON409Response(api.conflict(r, i18n.ParticipantAlreadyInvited))
+		}
+		return spec.PostTripsJSON500Response(api.internalServerError(r, i18n.UnableToCreateTrip))
 	}
 
-	confirmParticipant := pgstore.ConfirmParticipantParams{
-		IsConfirmed: true,
-		ID:          participantUUID,
-	}
+	ownerToken = api.issueOwnerToken(r.Context(), tripID, string(body.OwnerEmail), ownerToken)
+	api.completeIdempotencyKey(r.Context(), idempotencyKey, tripID)
 
-	if err := api.store.ConfirmParticipant(r.Context(), confirmParticipant); err != nil {
+	response := spec.CreateTripResponse{TripID: tripID.String(), OwnerToken: ownerToken}
 
-		api.logger.Error(
-			fmt.Sprintf("failed route: ''%v: %v'' when updating confirmation: ", r.URL.RawPath, r.URL.Path),
+	locale := i18n.FromRequest(r)
+	sendEmail := func() error { return api.mailer.SendConfirmTripEmailToTripOwner(tripID, locale) }
+	if api.sendsOwnerConfirmationSync(params) {
+		sendOnce := func(ctx context.Context) error {
+			return api.mailer.SendConfirmTripEmailToTripOwnerOnce(ctx, tripID, locale)
+		}
+		response.Warning = api.sendOwnerConfirmation(r, tripID, string(body.OwnerEmail), sendOnce)
+	} else if err := api.dispatcher.Enqueue(r.Context(), "PostTrips", sendEmail, zap.String("trip_id", tripID.String())); err != nil {
+		api.loggerFor(r.Context()).Error(
+			"failed to enqueue email on PostTrips",
 			zap.Error(err),
-			zap.String("participantID", participantID),
+			zap.String("trip_id", tripID.String()),
 		)
-
-		return spec.PatchParticipantsParticipantIDConfirmJSON500Response(spec.InternalServerErrorRequest{
-			Message: "unable to retrieve trip's participants",
-		})
 	}
 
-	return spec.PatchParticipantsParticipantIDConfirmJSON204Response(nil)
+	w.Header().Set("Location", "/trips/"+tripID.String())
+	return spec.PostTripsJSON201Response(response)
 }
 
-// Get a trip participants.
-// (GET /trips/{tripId}/participants)
-func (api *API) GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	tripUUID, friendlyErrorMessage, err := api.tryParseUUID("tripID", tripID)
-	if err != nil {
-		return spec.GetTripsTripIDParticipantsJSON400Response(spec.BadRequest{
-			Message: friendlyErrorMessage,
-		})
-	}
+// Clone a trip on new dates.
+// (POST /trips/{tripId}/clone)
+func (api *API) PostTripsTripIDClone(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID := pathUUID(r, "tripId")
 
-	if _, err := api.store.GetTrip(r.Context(), tripUUID); err != nil {
-		return spec.GetTripsTripIDParticipantsJSON404Response(spec.NotFoundRequest{
-			Message: "trip not found",
-		})
+	source, links, err := api.store.GetTripWithLinks(r.Context(), tripUUID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return spec.PostTripsTripIDCloneJSON404Response(api.notFound(r, i18n.TripNotFound))
 	}
-
-	participants, err := api.store.GetParticipants(r.Context(), tripUUID)
 	if err != nil {
-		api.logger.Error(
-			fmt.Sprintf("failed on route: '%v: %v'", r.URL.RawPath, r.URL.Path),
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed route: '%v: %v' when cloning a trip: ", r.URL.RawPath, r.URL.Path),
 			zap.Error(err),
-			zap.String("tripID", tripUUID.String()),
+			zap.String("tripID", tripID),
 		)
-		return spec.GetTripsTripIDParticipantsJSON500Response(spec.InternalServerErrorRequest{
-			Message: "unable to retrieve trip's participants",
-		})
+		return spec.PostTripsTripIDCloneJSON500Response(api.internalServerError(r, i18n.UnableToCreateTrip))
 	}
 
-	participantsParsed := make([]spec.GetTripParticipantsResponseArray, len(participants))
-	for index := 0; index < len(participants); index++ {
-		participant := participants[index]
-		participantsParsed[index] = spec.GetTripParticipantsResponseArray{
-			ID:          participant.ID.String(),
-			Email:       types.Email(participant.Email),
-			IsConfirmed: participant.IsConfirmed,
+	if err := api.authorizeTripOwner(r, source); err != nil {
+		if errors.Is(err, errMissingOwnerToken) {
+			return spec.PostTripsTripIDCloneJSON401Response(api.unauthorized(r, i18n.MissingOwnerToken))
 		}
+		return spec.PostTripsTripIDCloneJSON403Response(api.forbidden(r, refusedOwnerTokenKey(err)))
 	}
 
-	return spec.GetTripsTripIDParticipantsJSON200Response(spec.GetTripParticipantsResponse{
-		Participants: participantsParsed,
-	})
-}
-
-// Get a trip details.
-// (GET /trips/{tripId})
-func (api *API) GetTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	tripUUID, friendlyMessageError, err := api.tryParseUUID("tripID", tripID)
-	if err != nil {
-		return spec.GetTripsTripIDJSON400Response(spec.BadRequest{
-			Message: friendlyMessageError,
-		})
+	var body spec.PostTripsTripIDCloneJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostTripsTripIDCloneJSON400Response(api.badRequest(r, i18n.InvalidRequest, err.Error()))
 	}
 
-	tripDetail, err := api.store.GetTrip(r.Context(), tripUUID)
-	if err != nil {
-		return spec.GetTripsTripIDJSON404Response(spec.NotFoundRequest{
-			Message: "trip not found",
-		})
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostTripsTripIDCloneJSON400Response(api.invalidFieldsRequest(r, err))
 	}
 
-	// TODO: Verificar como garantir a geracao do spec da API garantindo a ordenacao mais amigavel das propriedades
-	return spec.GetTripsTripIDJSON200Response(spec.GetTripDetailsResponse{
-		Trip: spec.GetTripDetailsResponseTripObj{
-			ID:          tripDetail.ID.String(),
-			Destination: tripDetail.Destination,
-			StartsAt:    tripDetail.StartsAt.Time,
-			EndsAt:      tripDetail.EndsAt.Time,
-			IsConfirmed: tripDetail.IsConfirmed,
-		}},
-	)
-}
+	if badRequest := api.checkTripPeriod(r, body.StartsAt, body.EndsAt); badRequest != nil {
+		return spec.PostTripsTripIDCloneJSON400Response(*badRequest)
+	}
 
-// Update a trip.
-// (PUT /trips/{tripId})
-func (api *API) PutTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	tripUUID, friendlyMessageError, err := api.tryParseUUID("tripID", tripID)
+	activities, err := api.store.GetTripActivities(r.Context(), tripUUID)
 	if err != nil {
-		return spec.PutTripsTripIDJSON400Response(spec.BadRequest{
-			Message: friendlyMessageError,
-		})
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed route: '%v: %v' when reading the activities: ", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripID),
+		)
+		return spec.PostTripsTripIDCloneJSON500Response(api.internalServerError(r, i18n.UnableToCreateTrip))
 	}
 
-	var body spec.PutTripsTripIDJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		return spec.PutTripsTripIDJSON400Response(spec.BadRequest{Message: "json body request invalid. " + err.Error()})
+	// The activities keep their offset from the start of the trip, the ones a shorter clone leaves out fail it.
+	from, until := tripWindow(body.StartsAt, body.EndsAt, api.tripLocation(r.Context(), source))
+	var activitiesOutOfTrip []string
+	clonedActivities := make([]pgstore.CreateActivityParams, len(activities))
+	for index, activity := range activities {
+		activity.OccursAt.Time = body.StartsAt.Add(activity.OccursAt.Time.Sub(source.StartsAt.Time))
+		if activity.OccursAt.Time.Before(from) || activityEndsAt(activity).After(until) {
+			activitiesOutOfTrip = append(activitiesOutOfTrip, activity.ID.String())
+		}
+		clonedActivities[index] = pgstore.CreateActivityParams{
+			Title:           activity.Title,
+			OccursAt:        pgtype.Timestamp{Valid: true, Time: activity.OccursAt.Time},
+			DurationMinutes: activity.DurationMinutes,
+			Notes:           activity.Notes,
+		}
 	}
 
-	if err := api.validator.Struct(body); err != nil {
-		return spec.PostTripsJSON400Response(spec.BadRequest{Message: "json body request invalid. " + err.Error()})
+	if len(activitiesOutOfTrip) > 0 {
+		return spec.PostTripsTripIDCloneJSON400Response(api.badRequest(r, i18n.ActivitiesOutOfTripPeriod, strings.Join(activitiesOutOfTrip, ", ")))
 	}
 
-	tripActual, err := api.store.GetTrip(r.Context(), tripUUID)
-	if err != nil {
-		return spec.PutTripsTripIDJSON404Response(spec.NotFoundRequest{
-			Message: "trip not found",
-		})
+	clonedLinks := make([]pgstore.CreateTripLinkParams, len(links))
+	for index, link := range links {
+		clonedLinks[index] = pgstore.CreateTripLinkParams{Title: link.Title, Url: link.Url}
 	}
 
-	activitiesFromActualTrip, err := api.store.GetTripActivities(r.Context(), tripUUID)
+	ownerToken, ownerTokenHash, err := generateOwnerToken()
 	if err != nil {
-		return spec.PutTripsTripIDJSON400Response(spec.BadRequest{
-			Message: "unable to apply consistence, before update, " + err.Error(),
-		})
-	}
-
-	if body.StartsAt.UTC().Before(time.Now().UTC()) {
-		return spec.PutTripsTripIDJSON400Response(spec.BadRequest{Message: "the travel period is invalid, it is not possible to change the start date to before today/now"})
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed route: '%v: %v' when cloning a trip: ", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripID),
+		)
+		return spec.PostTripsTripIDCloneJSON500Response(api.internalServerError(r, i18n.UnableToCreateTrip))
+	}
+
+	cloneID, err := api.store.CloneTrip(r.Context(), api.pool, pgstore.CloneTripParams{
+		Trip: pgstore.InsertTripParams{
+			Destination:     source.Destination,
+			OwnerEmail:      source.OwnerEmail,
+			OwnerName:       source.OwnerName,
+			StartsAt:        pgtype.Timestamp{Valid: true, Time: body.StartsAt},
+			EndsAt:          pgtype.Timestamp{Valid: true, Time: body.EndsAt},
+			OwnerTokenHash:  ownerTokenHash,
+			Timezone:        source.Timezone,
+			Notes:           source.Notes,
+			Latitude:        source.Latitude,
+			Longitude:       source.Longitude,
+			MaxParticipants: source.MaxParticipants,
+		},
+		Activities: clonedActivities,
+		Links:      clonedLinks,
+	})
+	if err != nil {
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed route: '%v: %v' when cloning a trip: ", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripID),
+		)
+		return spec.PostTripsTripIDCloneJSON500Response(api.internalServerError(r, i18n.UnableToCreateTrip))
 	}
 
-	if body.EndsAt.UTC().Before(body.StartsAt.UTC()) {
-		return spec.PutTripsTripIDJSON400Response(spec.BadRequest{Message: "the travel period is invalid, end date must be equal to or greater than the start date"})
+	locale := i18n.FromRequest(r)
+	sendEmail := func() error { return api.mailer.SendConfirmTripEmailToTripOwner(cloneID, locale) }
+	if err := api.dispatcher.Enqueue(r.Context(), "PostTripsTripIDClone", sendEmail, zap.String("trip_id", cloneID.String())); err != nil {
+		api.loggerFor(r.Context()).Error(
+			"failed to enqueue email on PostTripsTripIDClone",
+			zap.Error(err),
+			zap.String("trip_id", cloneID.String()),
+		)
 	}
 
-	activitiesOutFromChangesInTrip := api.filterActivities(activitiesFromActualTrip, func(activity pgstore.Activity) bool {
-		return body.StartsAt.After(activity.OccursAt.Time) || body.EndsAt.Before(activity.OccursAt.Time)
+	w.Header().Set("Location", "/trips/"+cloneID.String())
+	return spec.PostTripsTripIDCloneJSON201Response(spec.CreateTripResponse{
+		TripID:     cloneID.String(),
+		OwnerToken: api.issueOwnerToken(r.Context(), cloneID, source.OwnerEmail, ownerToken),
 	})
+}
 
-	if len(activitiesOutFromChangesInTrip) > 0 {
-		activitiesId := make([]string, len(activitiesOutFromChangesInTrip))
-		for index := 0; index < len(activitiesOutFromChangesInTrip); index++ {
-			activitiesId[index] = activitiesOutFromChangesInTrip[index].ID.String()
-		}
-
-		return spec.PutTripsTripIDJSON400Response(spec.BadRequest{
-			Message: "changes invalid. There are activities occuring out of range the new period's trip. Activities out of range: " + strings.Join(activitiesId, ", "),
-		})
-	}
+// Wrapper to confirm a trip and send e-mail invitations.
+// (GET /trips/{tripId}/confirm)
+func (api *API) GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tripId string, params spec.GetTripsTripIDConfirmParams) *spec.Response {
+	tripUUID := pathUUID(r, "tripId")
 
-	var trip = pgstore.UpdateTripParams{
-		Destination: body.Destination,
-		EndsAt:      pgtype.Timestamp{Valid: true, Time: body.EndsAt},
-		StartsAt:    pgtype.Timestamp{Valid: true, Time: body.StartsAt},
-		IsConfirmed: tripActual.IsConfirmed,
-		ID:          tripActual.ID,
+	if err := api.checkConfirmToken(token.PurposeTrip, tripUUID, params.Token); err != nil {
+		if errors.Is(err, token.ErrMissing) {
+			return spec.GetTripsTripIDConfirmJSON401Response(api.unauthorized(r, i18n.MissingConfirmToken))
+		}
+		if errors.Is(err, token.ErrExpired) {
+			return spec.GetTripsTripIDConfirmJSON403Response(api.forbidden(r, i18n.ConfirmTokenExpired))
+		}
+		return spec.GetTripsTripIDConfirmJSON403Response(api.forbidden(r, i18n.InvalidConfirmToken))
 	}
 
-	if err := api.store.UpdateTrip(r.Context(), trip); err != nil {
+	if err := api.confirmTrip(r.Context(), tripUUID, i18n.FromRequest(r)); err != nil {
+		if errors.Is(err, errTripNotFound) {
+			return spec.GetTripsTripIDConfirmJSON404Response(api.notFound(r, i18n.TripNotFound))
+		}
+		if errors.Is(err, errTripClosed) {
+			return spec.GetTripsTripIDConfirmJSON409Response(api.conflict(r, i18n.TripClosed))
+		}
+		if errors.Is(err, errTripAlreadyConfirmed) {
+			return spec.GetTripsTripIDConfirmJSON409Response(api.conflict(r, i18n.TripAlreadyConfirmed))
+		}
 
-		api.logger.Error(
-			fmt.Sprintf("failed route: '%v: %v' when updating trip: ", r.URL.RawPath, r.URL.Path),
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed on route: '%v: %v'", r.URL.RawPath, r.URL.Path),
 			zap.Error(err),
-			zap.String("tripID", tripID),
+			zap.String("tripId", tripId),
 		)
 
-		return spec.PutTripsTripIDJSON500Response(spec.InternalServerErrorRequest{
-			Message: "unable to update trip",
-		})
+		return spec.GetTripsTripIDConfirmJSON500Response(api.internalServerError(r, i18n.UnableToConfirmTrip))
 	}
 
-	return spec.PutTripsTripIDJSON204Response(nil)
+	api.writeConfirmedPage(w, r, i18n.PageTripConfirmedTitle, i18n.PageTripConfirmedText)
+	return nil
 }
 
-// Get a trip activities.
-// (GET /trips/{tripId}/activities)
-func (api *API) GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	tripIdConverted, friendlyMessageError, err := api.tryParseUUID("tripID", tripID)
-	if err != nil {
-		return spec.GetTripsTripIDActivitiesJSON400Response(spec.BadRequest{
-			Message: friendlyMessageError,
-		})
+// Confirm a trip and send e-mail invitations.
+// (PATCH /trips/{tripId}/confirm)
+func (api *API) PatchTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tripID string, params spec.PatchTripsTripIDConfirmParams) *spec.Response {
+	tripUUID := pathUUID(r, "tripId")
+
+	if err := api.checkConfirmToken(token.PurposeTrip, tripUUID, params.Token); err != nil {
+		if errors.Is(err, token.ErrMissing) {
+			return spec.PatchTripsTripIDConfirmJSON401Response(api.unauthorized(r, i18n.MissingConfirmToken))
+		}
+		if errors.Is(err, token.ErrExpired) {
+			return spec.PatchTripsTripIDConfirmJSON403Response(api.forbidden(r, i18n.ConfirmTokenExpired))
+		}
+		return spec.PatchTripsTripIDConfirmJSON403Response(api.forbidden(r, i18n.InvalidConfirmToken))
+	}
+
+	if err := api.confirmTrip(r.Context(), tripUUID, i18n.FromRequest(r)); err != nil {
+		if errors.Is(err, errTripNotFound) {
+			return spec.PatchTripsTripIDConfirmJSON404Response(api.notFound(r, i18n.TripNotFound))
+		}
+		if errors.Is(err, errTripClosed) {
+			return spec.PatchTripsTripIDConfirmJSON409Response(api.conflict(r, i18n.TripClosed))
+		}
+		if errors.Is(err, errTripAlreadyConfirmed) {
+			return spec.PatchTripsTripIDConfirmJSON409Response(api.conflict(r, i18n.TripAlreadyConfirmed))
+		}
+
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed route: '%v: %v'", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripID),
+		)
+
+		return spec.PatchTripsTripIDConfirmJSON500Response(api.internalServerError(r, i18n.UnableToConfirmTrip))
+	}
+
+	return spec.PatchTripsTripIDConfirmJSON204Response(nil)
+}
+
+// Move a trip to another status.
+// (PATCH /trips/{tripId}/status)
+func (api *API) PatchTripsTripIDStatus(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID := pathUUID(r, "tripId")
+
+	trip, err := api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		return spec.PatchTripsTripIDStatusJSON404Response(api.notFound(r, i18n.TripNotFound))
+	}
+
+	if err := api.authorizeTripOwner(r, trip); err != nil {
+		if errors.Is(err, errMissingOwnerToken) {
+			return spec.PatchTripsTripIDStatusJSON401Response(api.unauthorized(r, i18n.MissingOwnerToken))
+		}
+		return spec.PatchTripsTripIDStatusJSON403Response(api.forbidden(r, refusedOwnerTokenKey(err)))
+	}
+
+	var body spec.PatchTripsTripIDStatusJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PatchTripsTripIDStatusJSON400Response(api.badRequest(r, i18n.InvalidRequest, err.Error()))
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PatchTripsTripIDStatusJSON400Response(api.invalidFieldsRequest(r, err))
+	}
+
+	if body.Status == trip.Status {
+		return spec.PatchTripsTripIDStatusJSON204Response(nil)
+	}
+
+	if !canMoveTripStatus(trip.Status, body.Status) {
+		return spec.PatchTripsTripIDStatusJSON409Response(api.conflict(r, i18n.InvalidTripStatusTransition, trip.Status, body.Status))
+	}
+
+	// confirming sends the invitations and cancelling tells the participants, as their own routes do.
+	switch body.Status {
+	case pgstore.TripStatusConfirmed:
+		err = api.confirmTrip(r.Context(), tripUUID, i18n.FromRequest(r))
+	case pgstore.TripStatusCancelled:
+		err = api.cancelTrip(r.Context(), trip, i18n.FromRequest(r))
+	default:
+		err = api.store.UpdateTripStatus(r.Context(), pgstore.UpdateTripStatusParams{Status: body.Status, ID: tripUUID})
+	}
+	if err != nil {
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed route: '%v: %v' when updating the trip status: ", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripID),
+			zap.String("status", body.Status),
+		)
+
+		return spec.PatchTripsTripIDStatusJSON500Response(api.internalServerError(r, i18n.UnableToUpdateTrip))
+	}
+
+	return spec.PatchTripsTripIDStatusJSON204Response(nil)
+}
+
+// Count the invitations of an email awaiting its confirmation.
+// (GET /participants/pending-count)
+func (api *API) GetParticipantsPendingCount(w http.ResponseWriter, r *http.Request, params spec.GetParticipantsPendingCountParams) *spec.Response {
+	query := struct {
+		Email string `json:"email" validate:"required,email"`
+	}{normalizeEmail(string(params.Email))}
+	if err := api.validator.Struct(query); err != nil {
+		return spec.GetParticipantsPendingCountJSON400Response(api.invalidFieldsRequest(r, err))
+	}
+
+	count, err := api.store.CountPendingInvitesByEmail(r.Context(), query.Email)
+	if err != nil {
+		api.loggerFor(r.Context()).Error("failed to count the pending invites of an email", zap.Error(err))
+		return spec.GetParticipantsPendingCountJSON500Response(api.internalServerError(r, i18n.UnableToGetParticipants))
+	}
+
+	return spec.GetParticipantsPendingCountJSON200Response(spec.GetParticipantsPendingCountResponse{Count: int(count)})
+}
+
+// Wrapper to confirms a participant on a trip.
+// (GET /participants/{participantId}/confirm)
+func (api *API) GetParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request, participantID string, params spec.GetParticipantsParticipantIDConfirmParams) *spec.Response {
+	participantUUID := pathUUID(r, "participantId")
+
+	if err := api.checkConfirmToken(token.PurposeParticipant, participantUUID, params.Token); err != nil {
+		if errors.Is(err, token.ErrMissing) {
+			return spec.GetParticipantsParticipantIDConfirmJSON401Response(api.unauthorized(r, i18n.MissingConfirmToken))
+		}
+		if errors.Is(err, token.ErrExpired) {
+			return spec.GetParticipantsParticipantIDConfirmJSON403Response(api.forbidden(r, i18n.ConfirmTokenExpired))
+		}
+		return spec.GetParticipantsParticipantIDConfirmJSON403Response(api.forbidden(r, i18n.InvalidConfirmToken))
+	}
+
+	if err := api.confirmParticipant(r.Context(), participantUUID); err != nil {
+		if errors.Is(err, errParticipantNotFound) {
+			return spec.GetParticipantsParticipantIDConfirmJSON404Response(api.notFound(r, i18n.ParticipantNotFound))
+		}
+
+		if errors.Is(err, errParticipantAlreadyConfirmed) {
+			return spec.GetParticipantsParticipantIDConfirmJSON400Response(api.badRequest(r, i18n.ParticipantAlreadyConfirmed))
+		}
+
+		if errors.Is(err, errParticipantWaitlisted) {
+			return spec.GetParticipantsParticipantIDConfirmJSON400Response(api.badRequest(r, i18n.ParticipantWaitlisted))
+		}
+
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed on route: '%v: %v'", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("participantID", participantID),
+		)
+
+		return spec.GetParticipantsParticipantIDConfirmJSON500Response(api.internalServerError(r, i18n.UnableToConfirmParticipant))
+	}
+
+	api.writeConfirmedPage(w, r, i18n.PageParticipantConfirmedTitle, i18n.PageParticipantConfirmedText)
+	return nil
+}
+
+// Confirms a participant on a trip.
+// (PATCH /participants/{participantId}/confirm)
+func (api *API) PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request, participantID string, params spec.PatchParticipantsParticipantIDConfirmParams) *spec.Response {
+	participantUUID := pathUUID(r, "participantId")
+
+	if err := api.checkConfirmToken(token.PurposeParticipant, participantUUID, params.Token); err != nil {
+		if errors.Is(err, token.ErrMissing) {
+			return spec.PatchParticipantsParticipantIDConfirmJSON401Response(api.unauthorized(r, i18n.MissingConfirmToken))
+		}
+		if errors.Is(err, token.ErrExpired) {
+			return spec.PatchParticipantsParticipantIDConfirmJSON403Response(api.forbidden(r, i18n.ConfirmTokenExpired))
+		}
+		return spec.PatchParticipantsParticipantIDConfirmJSON403Response(api.forbidden(r, i18n.InvalidConfirmToken))
+	}
+
+	if err := api.confirmParticipant(r.Context(), participantUUID); err != nil {
+		if errors.Is(err, errParticipantNotFound) {
+			return spec.PatchParticipantsParticipantIDConfirmJSON404Response(api.notFound(r, i18n.ParticipantNotFound))
+		}
+
+		if errors.Is(err, errParticipantAlreadyConfirmed) {
+			return spec.PatchParticipantsParticipantIDConfirmJSON400Response(api.badRequest(r, i18n.ParticipantAlreadyConfirmed))
+		}
+
+		if errors.Is(err, errParticipantWaitlisted) {
+			return spec.PatchParticipantsParticipantIDConfirmJSON400Response(api.badRequest(r, i18n.ParticipantWaitlisted))
+		}
+
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed on route: '%v: %v'", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("participantID", participantID),
+		)
+
+		return spec.PatchParticipantsParticipantIDConfirmJSON500Response(api.internalServerError(r, i18n.UnableToConfirmParticipant))
+	}
+
+	return spec.PatchParticipantsParticipantIDConfirmJSON204Response(nil)
+}
+
+// Resend a participant its invitation.
+// (POST /participants/{participantId}/resend-invite)
+func (api *API) PostParticipantsParticipantIDResendInvite(w http.ResponseWriter, r *http.Request, participantID string) *spec.Response {
+	participantUUID := pathUUID(r, "participantId")
+
+	participant, err := api.store.GetParticipant(r.Context(), participantUUID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return spec.PostParticipantsParticipantIDResendInviteJSON404Response(api.notFound(r, i18n.ParticipantNotFound))
+	}
+	if err != nil {
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed on route: '%v: %v'", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("participantID", participantID),
+		)
+		return spec.PostParticipantsParticipantIDResendInviteJSON500Response(api.internalServerError(r, i18n.UnableToResendInvite))
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), participant.TripID)
+	if err != nil {
+		return spec.PostParticipantsParticipantIDResendInviteJSON404Response(api.notFound(r, i18n.TripNotFound))
+	}
+
+	if err := api.authorizeTripOwner(r, trip); err != nil {
+		if errors.Is(err, errMissingOwnerToken) {
+			return spec.PostParticipantsParticipantIDResendInviteJSON401Response(api.unauthorized(r, i18n.MissingOwnerToken))
+		}
+		return spec.PostParticipantsParticipantIDResendInviteJSON403Response(api.forbidden(r, refusedOwnerTokenKey(err)))
+	}
+
+	if isTripClosed(trip) {
+		return spec.PostParticipantsParticipantIDResendInviteJSON409Response(api.conflict(r, i18n.TripClosed))
+	}
+
+	if participant.IsConfirmed {
+		return spec.PostParticipantsParticipantIDResendInviteJSON400Response(api.badRequest(r, i18n.ParticipantAlreadyConfirmed))
+	}
+
+	// The waitlisted participants were never sent the invite, they are sent it once promoted.
+	if participant.IsWaitlisted {
+		return spec.PostParticipantsParticipantIDResendInviteJSON400Response(api.badRequest(r, i18n.ParticipantWaitlisted))
+	}
+
+	dataToSendInvite := mailpit.SendInviteToParticipants{
+		Trip: trip,
+		Invites: []mailpit.InviteParticipantsToTrip{{
+			TripID: trip.ID,
+			Participant: mailpit.Participant{
+				ParticipantId: participant.ID,
+				Email:         participant.Email,
+				Name:          participant.Name.String,
+			},
+		}},
+		Locale: i18n.FromRequest(r),
+	}
+
+	// Resending is all the route does, unlike the other routes a send that can't be queued fails the request.
+	sendEmail := func() error { return api.mailer.SendConfirmTripEmailToParticipants(dataToSendInvite) }
+	if err := api.dispatcher.Enqueue(r.Context(), "PostParticipantsParticipantIDResendInvite", sendEmail, zap.String("participantID", participantID)); err != nil {
+		api.loggerFor(r.Context()).Error(
+			"failed to enqueue email on PostParticipantsParticipantIDResendInvite",
+			zap.Error(err),
+			zap.String("tripID", trip.ID.String()),
+			zap.String("participantID", participantID),
+		)
+		return spec.PostParticipantsParticipantIDResendInviteJSON500Response(api.internalServerError(r, i18n.UnableToResendInvite))
+	}
+
+	return spec.PostParticipantsParticipantIDResendInviteJSON204Response(nil)
+}
+
+// Get a trip participants.
+// (GET /trips/{tripId}/participants)
+func (api *API) GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDParticipantsParams) *spec.Response {
+	tripUUID := pathUUID(r, "tripId")
+
+	// Out of range, the page and its size are clamped rather than rejected.
+	page, perPage := 1, defaultParticipantsPerPage
+	if params.Page != nil {
+		page = max(*params.Page, 1)
+	}
+	if params.PerPage != nil {
+		perPage = min(max(*params.PerPage, 1), maxParticipantsPerPage)
+	}
+	// the offset must fit the query int, a page that far is past the last one anyway.
+	page = min(page, math.MaxInt32/perPage)
+
+	_, participants, total, err := api.store.GetTripWithParticipantsPage(r.Context(), pgstore.GetTripAndParticipantsPageParams{
+		ID:         tripUUID,
+		PageLimit:  int32(perPage),
+		PageOffset: int32((page - 1) * perPage),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return spec.GetTripsTripIDParticipantsJSON404Response(api.notFound(r, i18n.TripNotFound))
+	}
+	if err != nil {
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed on route: '%v: %v'", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripUUID.String()),
+		)
+		return spec.GetTripsTripIDParticipantsJSON500Response(api.internalServerError(r, i18n.UnableToGetParticipants))
+	}
+
+	return spec.GetTripsTripIDParticipantsJSON200Response(spec.NewPaginated(participantsResponse(participants), page, perPage, total))
+}
+
+// Get a trip participants as a CSV file.
+// (GET /trips/{tripId}/participants.csv)
+func (api *API) GetTripsTripIDParticipantsCSV(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID := pathUUID(r, "tripId")
+
+	_, participants, err := api.store.GetTripWithParticipants(r.Context(), tripUUID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return spec.GetTripsTripIDParticipantsCSVJSON404Response(api.notFound(r, i18n.TripNotFound))
+	}
+	if err != nil {
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed on route: '%v: %v'", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripUUID.String()),
+		)
+		return spec.GetTripsTripIDParticipantsCSVJSON500Response(api.internalServerError(r, i18n.UnableToGetParticipants))
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"trip-%s-participants.csv\"", tripUUID))
+	w.WriteHeader(http.StatusOK)
+
+	writer := csv.NewWriter(w)
+	writer.Write([]string{"id", "email", "is_confirmed"})
+	for _, participant := range participants {
+		writer.Write([]string{participant.ID.String(), participant.Email, strconv.FormatBool(participant.IsConfirmed)})
+	}
+	writer.Flush()
+
+	if err := writer.Error(); err != nil {
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed on route: '%v: %v' when writing the csv", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripUUID.String()),
+		)
+	}
+
+	return nil
+}
+
+// Get a trip participants RSVP summary.
+// (GET /trips/{tripId}/participants/summary)
+func (api *API) GetTripsTripIDParticipantsSummary(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID := pathUUID(r, "tripId")
+
+	trip, err := api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		return spec.GetTripsTripIDParticipantsSummaryJSON404Response(api.notFound(r, i18n.TripNotFound))
+	}
+
+	// The pending emails are personal data, only the owner is answered them.
+	if err := api.authorizeTripOwner(r, trip); err != nil {
+		if errors.Is(err, errMissingOwnerToken) {
+			return spec.GetTripsTripIDParticipantsSummaryJSON401Response(api.unauthorized(r, i18n.MissingOwnerToken))
+		}
+		return spec.GetTripsTripIDParticipantsSummaryJSON403Response(api.forbidden(r, refusedOwnerTokenKey(err)))
+	}
+
+	summary, err := api.store.GetParticipantsSummary(r.Context(), tripUUID)
+	if err != nil {
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed on route: '%v: %v'", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripUUID.String()),
+		)
+		return spec.GetTripsTripIDParticipantsSummaryJSON500Response(api.internalServerError(r, i18n.UnableToGetParticipants))
+	}
+
+	pendingEmails := make([]types.Email, len(summary.PendingEmails))
+	for index, email := range summary.PendingEmails {
+		pendingEmails[index] = types.Email(email)
+	}
+
+	return spec.GetTripsTripIDParticipantsSummaryJSON200Response(spec.GetTripParticipantsSummaryResponse{
+		Total:         int(summary.Total),
+		Confirmed:     int(summary.Confirmed),
+		Pending:       int(summary.Pending),
+		PendingEmails: pendingEmails,
+		Waitlisted:    int(summary.Waitlisted),
+	})
+}
+
+// Correct the email of a trip participant.
+// (PUT /trips/{tripId}/participants/{participantId})
+func (api *API) PutTripsTripIDParticipantsParticipantID(w http.ResponseWriter, r *http.Request, tripID string, participantID string) *spec.Response {
+	tripUUID := pathUUID(r, "tripId")
+	participantUUID := pathUUID(r, "participantId")
+
+	trip, err := api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		return spec.PutTripsTripIDParticipantsParticipantIDJSON404Response(api.notFound(r, i18n.TripNotFound))
+	}
+
+	if err := api.authorizeTripOwner(r, trip); err != nil {
+		if errors.Is(err, errMissingOwnerToken) {
+			return spec.PutTripsTripIDParticipantsParticipantIDJSON401Response(api.unauthorized(r, i18n.MissingOwnerToken))
+		}
+		return spec.PutTripsTripIDParticipantsParticipantIDJSON403Response(api.forbidden(r, refusedOwnerTokenKey(err)))
+	}
+
+	if isTripClosed(trip) {
+		return spec.PutTripsTripIDParticipantsParticipantIDJSON409Response(api.conflict(r, i18n.TripClosed))
+	}
+
+	var body spec.PutTripsTripIDParticipantsParticipantIDJSONRequestBody
+	if err := decodeBody(r, &body); err != nil {
+		return spec.PutTripsTripIDParticipantsParticipantIDJSON400Response(api.badRequest(r, i18n.InvalidRequest, err.Error()))
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PutTripsTripIDParticipantsParticipantIDJSON400Response(api.invalidFieldsRequest(r, err))
+	}
+
+	participant, err := api.store.GetParticipantForTrip(r.Context(), pgstore.GetParticipantForTripParams{
+		ID:     participantUUID,
+		TripID: tripUUID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return spec.PutTripsTripIDParticipantsParticipantIDJSON404Response(api.notFound(r, i18n.ParticipantNotFound))
+	}
+	if err != nil {
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed on route: '%v: %v'", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripID),
+			zap.String("participantID", participantID),
+		)
+		return spec.PutTripsTripIDParticipantsParticipantIDJSON500Response(api.internalServerError(r, i18n.UnableToUpdateParticipant))
+	}
+
+	// Once confirmed the participant answered on the email, it is no longer a typo to correct.
+	if participant.IsConfirmed {
+		return spec.PutTripsTripIDParticipantsParticipantIDJSON400Response(api.badRequest(r, i18n.ParticipantAlreadyConfirmed))
+	}
+
+	email := normalizeEmail(string(body.Email))
+	if email == normalizeEmail(trip.OwnerEmail) {
+		return spec.PutTripsTripIDParticipantsParticipantIDJSON400Response(api.badRequest(r, i18n.ParticipantIsTheOwner))
+	}
+
+	participants, err := api.store.GetParticipants(r.Context(), tripUUID)
+	if err != nil {
+		return spec.PutTripsTripIDParticipantsParticipantIDJSON500Response(api.internalServerError(r, i18n.UnableToCheckParticipants))
+	}
+
+	participantsAlreadyExists := api.filterParticipants(participants, func(other pgstore.Participant) bool {
+		return other.ID != participant.ID && normalizeEmail(other.Email) == email
+	})
+
+	if len(participantsAlreadyExists) > 0 {
+		return spec.PutTripsTripIDParticipantsParticipantIDJSON400Response(api.badRequest(r, i18n.ParticipantAlreadyInvited))
+	}
+
+	if err := api.store.UpdateParticipantEmail(r.Context(), pgstore.UpdateParticipantEmailParams{
+		Email: email,
+		ID:    participant.ID,
+	}); err != nil {
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed on route: '%v: %v'", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripUUID.String()),
+		)
+		if storeFailureStatus(err) == http.StatusConflict {
+			return spec.PutTripsTripIDParticipantsParticipantIDJSON409Response(api.conflict(r, i18n.ParticipantAlreadyInvited))
+		}
+		return spec.PutTripsTripIDParticipantsParticipantIDJSON500Response(api.internalServerError(r, i18n.UnableToUpdateParticipant))
+	}
+
+	// A waitlisted participant was sent no invite yet, it gets one to the corrected email once promoted.
+	if participant.IsWaitlisted {
+		return spec.PutTripsTripIDParticipantsParticipantIDJSON204Response(nil)
+	}
+
+	// The invite sent to the mistyped email never arrived, it is sent again to the corrected one.
+	dataToSendInvite := mailpit.SendInviteToParticipants{
+		Trip: trip,
+		Invites: []mailpit.InviteParticipantsToTrip{{
+			TripID: tripUUID,
+			Participant: mailpit.Participant{
+				ParticipantId: participant.ID,
+				Email:         email,
+				Name:          participant.Name.String,
+			},
+		}},
+		Locale: i18n.FromRequest(r),
+	}
+
+	sendEmail := func() error { return api.mailer.SendConfirmTripEmailToParticipants(dataToSendInvite) }
+	if err := api.dispatcher.Enqueue(r.Context(), "PutTripsTripIDParticipantsParticipantID", sendEmail, zap.String("tripID", tripID)); err != nil {
+		api.loggerFor(r.Context()).Error(
+			"failed to enqueue email on PutTripsTripIDParticipantsParticipantID",
+			zap.Error(err),
+			zap.String("tripID", tripID),
+		)
+	}
+
+	return spec.PutTripsTripIDParticipantsParticipantIDJSON204Response(nil)
+}
+
+// Remove a participant from a trip.
+// (DELETE /trips/{tripId}/participants/{participantId})
+func (api *API) DeleteTripsTripIDParticipantsParticipantID(w http.ResponseWriter, r *http.Request, tripID string, participantID string, params spec.DeleteTripsTripIDParticipantsParticipantIDParams) *spec.Response {
+	tripUUID := pathUUID(r, "tripId")
+	participantUUID := pathUUID(r, "participantId")
+
+	trip, err := api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		return spec.DeleteTripsTripIDParticipantsParticipantIDJSON404Response(api.notFound(r, i18n.TripNotFound))
+	}
+
+	if err := api.authorizeTripOwner(r, trip); err != nil {
+		if errors.Is(err, errMissingOwnerToken) {
+			return spec.DeleteTripsTripIDParticipantsParticipantIDJSON401Response(api.unauthorized(r, i18n.MissingOwnerToken))
+		}
+		return spec.DeleteTripsTripIDParticipantsParticipantIDJSON403Response(api.forbidden(r, refusedOwnerTokenKey(err)))
+	}
+
+	if isTripClosed(trip) {
+		return spec.DeleteTripsTripIDParticipantsParticipantIDJSON409Response(api.conflict(r, i18n.TripClosed))
+	}
+
+	participant, err := api.store.GetParticipantForTrip(r.Context(), pgstore.GetParticipantForTripParams{
+		ID:     participantUUID,
+		TripID: tripUUID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return spec.DeleteTripsTripIDParticipantsParticipantIDJSON404Response(api.notFound(r, i18n.ParticipantNotFound))
+	}
+	if err != nil {
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed on route: '%v: %v'", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripID),
+			zap.String("participantID", participantID),
+		)
+		return spec.DeleteTripsTripIDParticipantsParticipantIDJSON500Response(api.internalServerError(r, i18n.UnableToRemoveParticipant))
+	}
+
+	// Once confirmed the participant counts on the trip, it is only removed on purpose.
+	if participant.IsConfirmed && (params.Force == nil || !*params.Force) {
+		return spec.DeleteTripsTripIDParticipantsParticipantIDJSON400Response(api.badRequest(r, i18n.ParticipantAlreadyConfirmed))
+	}
+
+	deleted, err := api.store.DeleteParticipant(r.Context(), pgstore.DeleteParticipantParams{
+		ID:     participantUUID,
+		TripID: tripUUID,
+	})
+	if err != nil {
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed route: '%v: %v' when removing a participant: ", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripID),
+			zap.String("participantID", participantID),
+		)
+		return spec.DeleteTripsTripIDParticipantsParticipantIDJSON500Response(api.internalServerError(r, i18n.UnableToRemoveParticipant))
+	}
+	// removed meanwhile by another request.
+	if deleted == 0 {
+		return spec.DeleteTripsTripIDParticipantsParticipantIDJSON404Response(api.notFound(r, i18n.ParticipantNotFound))
+	}
+
+	return spec.DeleteTripsTripIDParticipantsParticipantIDJSON204Response(nil)
+}
+
+// Get a trip details.
+// (GET /trips/{tripId})
+func (api *API) GetTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID := pathUUID(r, "tripId")
+
+	tripDetail, err := api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		return spec.GetTripsTripIDJSON404Response(api.notFound(r, i18n.TripNotFound))
+	}
+
+	etag := tripETag(tripDetail)
+	w.Header().Set("ETag", etag)
+	if ifNoneMatch := r.Header.Get("If-None-Match"); ifNoneMatch != "" && etagMatches(ifNoneMatch, etag) {
+		return (&spec.Response{}).Status(http.StatusNotModified)
+	}
+
+	// TODO: Verificar como garantir a geracao do spec da API garantindo a ordenacao mais amigavel das propriedades
+	return spec.GetTripsTripIDJSON200Response(spec.GetTripDetailsResponse{Trip: tripDetailsResponse(tripDetail)})
+}
+
+// Get a trip with its activities, links and participants.
+// (GET /trips/{tripId}/full)
+func (api *API) GetTripsTripIDFull(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID := pathUUID(r, "tripId")
+
+	// The collections are read concurrently. Each query checks the trip exists, a missing trip fails all of
+	// them and the group answers its first failure only.
+	var (
+		trip         pgstore.Trip
+		activities   []pgstore.Activity
+		links        []pgstore.Link
+		participants []pgstore.Participant
+	)
+	group, ctx := errgroup.WithContext(r.Context())
+	group.Go(func() (err error) {
+		trip, activities, err = api.store.GetTripWithActivities(ctx, pgstore.GetTripAndActivitiesParams{ID: tripUUID})
+		return err
+	})
+	group.Go(func() (err error) {
+		_, links, err = api.store.GetTripWithLinks(ctx, tripUUID)
+		return err
+	})
+	group.Go(func() (err error) {
+		_, participants, err = api.store.GetTripWithParticipants(ctx, tripUUID)
+		return err
+	})
+
+	err := group.Wait()
+	if errors.Is(err, pgx.ErrNoRows) {
+		return spec.GetTripsTripIDFullJSON404Response(api.notFound(r, i18n.TripNotFound))
+	}
+	if err != nil {
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed route: '%v: %v'", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripID),
+		)
+
+		return spec.GetTripsTripIDFullJSON500Response(api.internalServerError(r, i18n.UnableToGetTrip))
+	}
+
+	return spec.GetTripsTripIDFullJSON200Response(spec.GetTripFullResponse{
+		Trip:         tripDetailsResponse(trip),
+		Activities:   api.activitiesByDay(r.Context(), trip, activities, nil, nil),
+		Links:        linksResponse(links),
+		Participants: participantsResponse(participants),
+	})
+}
+
+// Update a trip.
+// (PUT /trips/{tripId})
+func (api *API) PutTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID := pathUUID(r, "tripId")
+
+	tripActual, err := api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		return spec.PutTripsTripIDJSON404Response(api.notFound(r, i18n.TripNotFound))
+	}
+
+	if err := api.authorizeTripOwner(r, tripActual); err != nil {
+		if errors.Is(err, errMissingOwnerToken) {
+			return spec.PutTripsTripIDJSON401Response(api.unauthorized(r, i18n.MissingOwnerToken))
+		}
+		return spec.PutTripsTripIDJSON403Response(api.forbidden(r, refusedOwnerTokenKey(err)))
+	}
+
+	var body spec.PutTripsTripIDJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PutTripsTripIDJSON400Response(api.badRequest(r, i18n.InvalidRequest, err.Error()))
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostTripsJSON400Response(api.invalidFieldsRequest(r, err))
+	}
+
+	activitiesFromActualTrip, err := api.store.GetTripActivities(r.Context(), tripUUID)
+	if err != nil {
+		return spec.PutTripsTripIDJSON400Response(api.badRequest(r, i18n.UnableToCheckTripActivities, err.Error()))
+	}
+
+	if badRequest := api.checkTripPeriod(r, body.StartsAt, body.EndsAt); badRequest != nil {
+		return spec.PutTripsTripIDJSON400Response(*badRequest)
+	}
+
+	from, until := tripWindow(body.StartsAt, body.EndsAt, api.tripLocation(r.Context(), tripActual))
+	activitiesOutFromChangesInTrip := api.filterActivities(activitiesFromActualTrip, func(activity pgstore.Activity) bool {
+		return activity.OccursAt.Time.Before(from) || activityEndsAt(activity).After(until)
+	})
+
+	if len(activitiesOutFromChangesInTrip) > 0 {
+		activitiesId := make([]string, len(activitiesOutFromChangesInTrip))
+		for index := 0; index < len(activitiesOutFromChangesInTrip); index++ {
+			activitiesId[index] = activitiesOutFromChangesInTrip[index].ID.String()
+		}
+
+		return spec.PutTripsTripIDJSON400Response(api.badRequest(r, i18n.ActivitiesOutOfTripPeriod, strings.Join(activitiesId, ", ")))
+	}
+
+	var trip = pgstore.UpdateTripParams{
+		Destination:     body.Destination,
+		EndsAt:          pgtype.Timestamp{Valid: true, Time: body.EndsAt},
+		StartsAt:        pgtype.Timestamp{Valid: true, Time: body.StartsAt},
+		IsConfirmed:     tripActual.IsConfirmed,
+		Notes:           tripActual.Notes,
+		Latitude:        tripActual.Latitude,
+		Longitude:       tripActual.Longitude,
+		MaxParticipants: tripActual.MaxParticipants,
+		ID:              tripActual.ID,
+	}
+	if body.Notes != nil {
+		trip.Notes = notesText(body.Notes)
+	}
+	if body.MaxParticipants != nil {
+		trip.MaxParticipants = maxParticipantsInt(*body.MaxParticipants)
+	}
+	// The coordinates belong to the destination, a new one without them is resolved again rather than keeping
+	// the pin of the former.
+	if body.Latitude != nil {
+		trip.Latitude, trip.Longitude = coordinateFloat(body.Latitude), coordinateFloat(body.Longitude)
+	} else if body.Destination != tripActual.Destination {
+		trip.Latitude, trip.Longitude = pgtype.Float8{}, pgtype.Float8{}
+		if coordinates := api.geocode(r.Context(), body.Destination); coordinates != nil {
+			trip.Latitude, trip.Longitude = coordinateFloat(&coordinates.Latitude), coordinateFloat(&coordinates.Longitude)
+		}
+	}
+
+	if err := api.store.UpdateTrip(r.Context(), trip); err != nil {
+
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed route: '%v: %v' when updating trip: ", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripID),
+		)
+
+		return spec.PutTripsTripIDJSON500Response(api.internalServerError(r, i18n.UnableToUpdateTrip))
+	}
+
+	return spec.PutTripsTripIDJSON204Response(nil)
+}
+
+// Delete a trip.
+// (DELETE /trips/{tripId})
+func (api *API) DeleteTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID := pathUUID(r, "tripId")
+
+	trip, err := api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		return spec.DeleteTripsTripIDJSON404Response(api.notFound(r, i18n.TripNotFound))
+	}
+
+	if err := api.authorizeTripOwner(r, trip); err != nil {
+		if errors.Is(err, errMissingOwnerToken) {
+			return spec.DeleteTripsTripIDJSON401Response(api.unauthorized(r, i18n.MissingOwnerToken))
+		}
+		return spec.DeleteTripsTripIDJSON403Response(api.forbidden(r, refusedOwnerTokenKey(err)))
+	}
+
+	err = api.store.DeleteTrip(r.Context(), api.pool, tripUUID)
+	// deleted meanwhile by another request.
+	if errors.Is(err, pgx.ErrNoRows) {
+		return spec.DeleteTripsTripIDJSON404Response(api.notFound(r, i18n.TripNotFound))
+	}
+	if err != nil {
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed route: '%v: %v' when deleting a trip: ", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripID),
+		)
+
+		return spec.DeleteTripsTripIDJSON500Response(api.internalServerError(r, i18n.UnableToDeleteTrip))
+	}
+
+	return spec.DeleteTripsTripIDJSON204Response(nil)
+}
+
+// Restore a deleted trip.
+// (POST /trips/{tripId}/restore)
+func (api *API) PostTripsTripIDRestore(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID := pathUUID(r, "tripId")
+
+	trip, err := api.store.GetDeletedTrip(r.Context(), tripUUID)
+	if err != nil {
+		return spec.PostTripsTripIDRestoreJSON404Response(api.notFound(r, i18n.TripNotFound))
+	}
+
+	if err := api.authorizeTripOwner(r, trip); err != nil {
+		if errors.Is(err, errMissingOwnerToken) {
+			return spec.PostTripsTripIDRestoreJSON401Response(api.unauthorized(r, i18n.MissingOwnerToken))
+		}
+		return spec.PostTripsTripIDRestoreJSON403Response(api.forbidden(r, refusedOwnerTokenKey(err)))
+	}
+
+	err = api.store.RestoreTrip(r.Context(), api.pool, tripUUID, trip.DeletedAt)
+	// restored meanwhile by another request.
+	if errors.Is(err, pgx.ErrNoRows) {
+		return spec.PostTripsTripIDRestoreJSON404Response(api.notFound(r, i18n.TripNotFound))
+	}
+	if err != nil {
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed route: '%v: %v' when restoring a trip: ", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripID),
+		)
+
+		return spec.PostTripsTripIDRestoreJSON500Response(api.internalServerError(r, i18n.UnableToRestoreTrip))
 	}
 
-	trip, err := api.store.GetTrip(r.Context(), tripIdConverted)
+	return spec.PostTripsTripIDRestoreJSON204Response(nil)
+}
+
+// Move a trip and its activities by some days.
+// (POST /trips/{tripId}/reschedule)
+func (api *API) PostTripsTripIDReschedule(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID := pathUUID(r, "tripId")
+
+	trip, err := api.store.GetTrip(r.Context(), tripUUID)
 	if err != nil {
-		return spec.GetTripsTripIDActivitiesJSON404Response(spec.NotFoundRequest{
-			Message: "trip not found",
-		})
+		return spec.PostTripsTripIDRescheduleJSON404Response(api.notFound(r, i18n.TripNotFound))
 	}
 
-	activities, err := api.store.GetTripActivities(r.Context(), tripIdConverted)
-	if err != nil {
+	if err := api.authorizeTripOwner(r, trip); err != nil {
+		if errors.Is(err, errMissingOwnerToken) {
+			return spec.PostTripsTripIDRescheduleJSON401Response(api.unauthorized(r, i18n.MissingOwnerToken))
+		}
+		return spec.PostTripsTripIDRescheduleJSON403Response(api.forbidden(r, refusedOwnerTokenKey(err)))
+	}
 
-		api.logger.Error(
-			fmt.Sprintf("failed route: '%v: %v'", r.URL.RawPath, r.URL.Path),
+	if isTripClosed(trip) {
+		return spec.PostTripsTripIDRescheduleJSON409Response(api.conflict(r, i18n.TripClosed))
+	}
+
+	var body spec.PostTripsTripIDRescheduleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostTripsTripIDRescheduleJSON400Response(api.badRequest(r, i18n.InvalidRequest, err.Error()))
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostTripsTripIDRescheduleJSON400Response(api.invalidFieldsRequest(r, err))
+	}
+
+	// The days are counted in the trip timezone, the trip and its activities keep their time of the day across
+	// a daylight saving change.
+	location := api.tripLocation(r.Context(), trip)
+	shift := func(t time.Time) time.Time {
+		return t.In(location).AddDate(0, 0, body.Days).UTC()
+	}
+
+	startsAt, endsAt := shift(trip.StartsAt.Time), shift(trip.EndsAt.Time)
+	if badRequest := api.checkTripPeriod(r, startsAt, endsAt); badRequest != nil {
+		return spec.PostTripsTripIDRescheduleJSON400Response(*badRequest)
+	}
+
+	activities, err := api.store.GetTripActivities(r.Context(), tripUUID)
+	if err != nil {
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed route: '%v: %v' when reading the activities: ", r.URL.RawPath, r.URL.Path),
 			zap.Error(err),
 			zap.String("tripID", tripID),
 		)
+		return spec.PostTripsTripIDRescheduleJSON500Response(api.internalServerError(r, i18n.UnableToUpdateTrip))
+	}
 
-		return spec.GetTripsTripIDActivitiesJSON500Response(spec.InternalServerErrorRequest{
-			Message: "anything wrong to get activities",
-		})
+	from, until := tripWindow(startsAt, endsAt, location)
+	var activitiesOutOfTrip []string
+	moved := make([]pgstore.UpdateActivityOccursAtParams, len(activities))
+	for index, activity := range activities {
+		activity.OccursAt.Time = shift(activity.OccursAt.Time)
+		if activity.OccursAt.Time.Before(from) || activityEndsAt(activity).After(until) {
+			activitiesOutOfTrip = append(activitiesOutOfTrip, activity.ID.String())
+		}
+		moved[index] = pgstore.UpdateActivityOccursAtParams{
+			OccursAt: pgtype.Timestamp{Valid: true, Time: activity.OccursAt.Time},
+			ID:       activity.ID,
+			TripID:   tripUUID,
+		}
 	}
 
-	numberOfDaysOfTheTrip := ((int)(trip.EndsAt.Time.Sub(trip.StartsAt.Time).Hours()/24) + 1)
-	tripDays := make([]time.Time, numberOfDaysOfTheTrip)
-	activitiesParsedToResponse := make([]spec.GetTripActivitiesResponseOuterArray, numberOfDaysOfTheTrip)
+	if len(activitiesOutOfTrip) > 0 {
+		return spec.PostTripsTripIDRescheduleJSON400Response(api.badRequest(r, i18n.ActivitiesOutOfTripPeriod, strings.Join(activitiesOutOfTrip, ", ")))
+	}
 
-	for index := 0; index < numberOfDaysOfTheTrip; index++ {
-		year, month, day := trip.StartsAt.Time.AddDate(0, 0, index).Date()
-		tripDays[index] = time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	if err := api.store.RescheduleTrip(r.Context(), api.pool, pgstore.RescheduleTripParams{
+		Trip: pgstore.UpdateTripPeriodParams{
+			StartsAt: pgtype.Timestamp{Valid: true, Time: startsAt},
+			EndsAt:   pgtype.Timestamp{Valid: true, Time: endsAt},
+			ID:       tripUUID,
+		},
+		Activities: moved,
+	}); err != nil {
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed route: '%v: %v' when rescheduling trip: ", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripID),
+		)
+		return spec.PostTripsTripIDRescheduleJSON500Response(api.internalServerError(r, i18n.UnableToUpdateTrip))
 	}
 
-	for indexTripDays := 0; indexTripDays < len(tripDays); indexTripDays++ {
+	return spec.PostTripsTripIDRescheduleJSON204Response(nil)
+}
 
-		tripDay := tripDays[indexTripDays]
+// Get a trip activities.
+// (GET /trips/{tripId}/activities)
+func (api *API) GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDActivitiesParams) *spec.Response {
+	tripIdConverted := pathUUID(r, "tripId")
 
-		activitiesFiltered := api.filterActivities(activities, func(activity pgstore.Activity) bool {
-			return activity.OccursAt.Time.Truncate(24 * time.Hour).Equal(tripDay.Truncate(24 * time.Hour))
-		})
+	from, to := dateBound(params.From), dateBound(params.To)
+	if from != nil && to != nil && to.Before(from.Time) {
+		return spec.GetTripsTripIDActivitiesJSON400Response(api.badRequest(r, i18n.InvalidActivitiesRange))
+	}
 
-		activitiesFilteredParsed := make([]spec.GetTripActivitiesResponseInnerArray, len(activitiesFiltered))
+	// The days are filtered on the trip timezone by the query, as the grouping below.
+	filter := pgstore.GetTripAndActivitiesParams{ID: tripIdConverted}
+	if from != nil {
+		filter.FromDate = pgtype.Date{Valid: true, Time: from.Time}
+	}
+	if to != nil {
+		filter.ToDate = pgtype.Date{Valid: true, Time: to.Time}
+	}
+	if params.Q != nil && *params.Q != "" {
+		filter.Title = pgtype.Text{Valid: true, String: *params.Q}
+	}
 
-		for indexActivitiesFiltered := 0; indexActivitiesFiltered < len(activitiesFiltered); indexActivitiesFiltered++ {
-			activitiesFilteredParsed[indexActivitiesFiltered] = spec.GetTripActivitiesResponseInnerArray{
-				ID:       activitiesFiltered[indexActivitiesFiltered].ID.String(),
-				Title:    activitiesFiltered[indexActivitiesFiltered].Title,
-				OccursAt: activitiesFiltered[indexActivitiesFiltered].OccursAt.Time,
-			}
-		}
+	trip, activities, err := api.store.GetTripWithActivities(r.Context(), filter)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return spec.GetTripsTripIDActivitiesJSON404Response(api.notFound(r, i18n.TripNotFound))
+	}
+	if err != nil {
 
-		activitiesParsedToResponse[indexTripDays] = spec.GetTripActivitiesResponseOuterArray{
-			Date:       tripDay,
-			Activities: activitiesFilteredParsed,
-		}
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed route: '%v: %v'", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripID),
+		)
+
+		return spec.GetTripsTripIDActivitiesJSON500Response(api.internalServerError(r, i18n.UnableToGetActivities))
 	}
 
 	return spec.GetTripsTripIDActivitiesJSON200Response(spec.GetTripActivitiesResponse{
-		Activities: activitiesParsedToResponse,
+		Activities: api.activitiesByDay(r.Context(), trip, activities, from, to),
 	})
 }
 
+// Get a trip activities as an iCalendar feed.
+// (GET /trips/{tripId}/activities.ics)
+func (api *API) GetTripsTripIDActivitiesICS(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID := pathUUID(r, "tripId")
+
+	trip, activities, err := api.store.GetTripWithActivities(r.Context(), pgstore.GetTripAndActivitiesParams{ID: tripUUID})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return spec.GetTripsTripIDActivitiesICSJSON404Response(api.notFound(r, i18n.TripNotFound))
+	}
+	if err != nil {
+
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed route: '%v: %v'", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripID),
+		)
+
+		return spec.GetTripsTripIDActivitiesICSJSON500Response(api.internalServerError(r, i18n.UnableToGetActivities))
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"trip-%s.ics\"", trip.ID))
+	w.WriteHeader(http.StatusOK)
+
+	if err := calendar.WriteTrip(w, trip, activities, api.clock.Now()); err != nil {
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed route: '%v: %v' when writing the calendar", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripID),
+		)
+	}
+
+	return nil
+}
+
 // Create a trip activity.
 // (POST /trips/{tripId}/activities)
 func (api *API) PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	tripIdConverted, friendlyMessageError, err := api.tryParseUUID("tripID", tripID)
+	tripIdConverted := pathUUID(r, "tripId")
+
+	trip, err := api.store.GetTrip(r.Context(), tripIdConverted)
 	if err != nil {
-		return spec.PostTripsTripIDActivitiesJSON400Response(spec.BadRequest{
-			Message: friendlyMessageError,
-		})
+		return spec.PostTripsTripIDActivitiesJSON404Response(api.notFound(r, i18n.TripNotFound))
+	}
+
+	if err := api.authorizeTripOwner(r, trip); err != nil {
+		if errors.Is(err, errMissingOwnerToken) {
+			return spec.PostTripsTripIDActivitiesJSON401Response(api.unauthorized(r, i18n.MissingOwnerToken))
+		}
+		return spec.PostTripsTripIDActivitiesJSON403Response(api.forbidden(r, refusedOwnerTokenKey(err)))
+	}
+
+	if isTripClosed(trip) {
+		return spec.PostTripsTripIDActivitiesJSON409Response(api.conflict(r, i18n.TripClosed))
 	}
 
 	var body spec.PostTripsTripIDActivitiesJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		return spec.PostTripsTripIDActivitiesJSON400Response(spec.BadRequest{
-			Message: "invalid request: " + err.Error(),
-		})
+		return spec.PostTripsTripIDActivitiesJSON400Response(api.badRequest(r, i18n.InvalidRequest, err.Error()))
 	}
 
 	if err := api.validator.Struct(body); err != nil {
-		return spec.PostTripsTripIDActivitiesJSON400Response(spec.BadRequest{
-			Message: "invalid request: " + err.Error(),
-		})
+		return spec.PostTripsTripIDActivitiesJSON400Response(api.invalidFieldsRequest(r, err))
 	}
 
-	trip, err := api.store.GetTrip(r.Context(), tripIdConverted)
+	var durationMinutes int
+	if body.DurationMinutes != nil {
+		durationMinutes = *body.DurationMinutes
+	}
+	endsAt := body.OccursAt.Add(time.Duration(durationMinutes) * time.Minute)
+
+	location := api.tripLocation(r.Context(), trip)
+	from, until := tripWindow(trip.StartsAt.Time, trip.EndsAt.Time, location)
+	if body.OccursAt.Before(from) || endsAt.After(until) {
+		return spec.PostTripsTripIDActivitiesJSON400Response(api.badRequest(r, i18n.ActivityOutOfTripPeriod,
+			trip.StartsAt.Time.In(location).Format(time.DateOnly), trip.EndsAt.Time.In(location).Format(time.DateOnly),
+		))
+	}
+
+	activity := pgstore.CreateActivityParams{
+		TripID:          tripIdConverted,
+		Title:           body.Title,
+		OccursAt:        pgtype.Timestamp{Valid: true, Time: body.OccursAt},
+		DurationMinutes: int32(durationMinutes),
+		Notes:           notesText(body.Notes),
+	}
+
+	activityId, err := api.store.CreateActivity(r.Context(), activity)
 	if err != nil {
-		return spec.PostTripsTripIDActivitiesJSON404Response(spec.NotFoundRequest{
-			Message: "trip not found",
-		})
+
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed route: '%v: %v' when create a activitie: ", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripID),
+		)
+
+		if storeFailureStatus(err) == http.StatusNotFound {
+			return spec.PostTripsTripIDActivitiesJSON404Response(api.notFound(r, i18n.TripNotFound))
+		}
+		return spec.PostTripsTripIDActivitiesJSON500Response(api.internalServerError(r, i18n.UnableToCreateActivity))
+	}
+
+	w.Header().Set("Location", fmt.Sprintf("/trips/%s/activities/%s", tripIdConverted, activityId))
+	return spec.PostTripsTripIDActivitiesJSON201Response(spec.CreateActivityResponse{
+		ActivityID: activityId.String(),
+		Activity: activityResponse(pgstore.Activity{
+			ID:              activityId,
+			TripID:          activity.TripID,
+			Title:           activity.Title,
+			OccursAt:        activity.OccursAt,
+			DurationMinutes: activity.DurationMinutes,
+			Notes:           activity.Notes,
+		}),
+	})
+}
+
+// Create a trip activities at once.
+// (POST /trips/{tripId}/activities/batch)
+func (api *API) PostTripsTripIDActivitiesBatch(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID := pathUUID(r, "tripId")
+
+	trip, err := api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		return spec.PostTripsTripIDActivitiesBatchJSON404Response(api.notFound(r, i18n.TripNotFound))
+	}
+
+	if err := api.authorizeTripOwner(r, trip); err != nil {
+		if errors.Is(err, errMissingOwnerToken) {
+			return spec.PostTripsTripIDActivitiesBatchJSON401Response(api.unauthorized(r, i18n.MissingOwnerToken))
+		}
+		return spec.PostTripsTripIDActivitiesBatchJSON403Response(api.forbidden(r, refusedOwnerTokenKey(err)))
+	}
+
+	if isTripClosed(trip) {
+		return spec.PostTripsTripIDActivitiesBatchJSON409Response(api.conflict(r, i18n.TripClosed))
+	}
+
+	var body spec.PostTripsTripIDActivitiesBatchJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostTripsTripIDActivitiesBatchJSON400Response(api.badRequest(r, i18n.InvalidRequest, err.Error()))
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostTripsTripIDActivitiesBatchJSON400Response(api.invalidFieldsRequest(r, err))
+	}
+
+	// A single activity out of the trip period rejects the whole batch, the message lists all of them.
+	location := api.tripLocation(r.Context(), trip)
+	from, until := tripWindow(trip.StartsAt.Time, trip.EndsAt.Time, location)
+	activities := make([]pgstore.CreateActivityParams, len(body.Activities))
+	var outOfPeriod []string
+	for index, activity := range body.Activities {
+		var durationMinutes int
+		if activity.DurationMinutes != nil {
+			durationMinutes = *activity.DurationMinutes
+		}
+		endsAt := activity.OccursAt.Add(time.Duration(durationMinutes) * time.Minute)
+		if activity.OccursAt.Before(from) || endsAt.After(until) {
+			outOfPeriod = append(outOfPeriod, strconv.Itoa(index))
+		}
+
+		activities[index] = pgstore.CreateActivityParams{
+			TripID:          tripUUID,
+			Title:           activity.Title,
+			OccursAt:        pgtype.Timestamp{Valid: true, Time: activity.OccursAt},
+			DurationMinutes: int32(durationMinutes),
+			Notes:           notesText(activity.Notes),
+		}
+	}
+
+	if len(outOfPeriod) > 0 {
+		return spec.PostTripsTripIDActivitiesBatchJSON400Response(api.badRequest(r, i18n.BatchOutOfTripPeriod,
+			strings.Join(outOfPeriod, ", "),
+			trip.StartsAt.Time.In(location).Format(time.DateOnly), trip.EndsAt.Time.In(location).Format(time.DateOnly),
+		))
+	}
+
+	activityIDs, err := api.store.CreateActivities(r.Context(), api.pool, activities)
+	if err != nil {
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed route: '%v: %v' when creating the activities", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripID),
+		)
+
+		if storeFailureStatus(err) == http.StatusNotFound {
+			return spec.PostTripsTripIDActivitiesBatchJSON404Response(api.notFound(r, i18n.TripNotFound))
+		}
+		return spec.PostTripsTripIDActivitiesBatchJSON500Response(api.internalServerError(r, i18n.UnableToCreateActivity))
+	}
+
+	created := make([]string, len(activityIDs))
+	for index, activityID := range activityIDs {
+		created[index] = activityID.String()
+	}
+
+	return spec.PostTripsTripIDActivitiesBatchJSON201Response(spec.CreateActivitiesBatchResponse{ActivityIds: created})
+}
+
+// Search a trip activities by title.
+// (GET /trips/{tripId}/activities/search)
+func (api *API) GetTripsTripIDActivitiesSearch(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDActivitiesSearchParams) *spec.Response {
+	tripUUID := pathUUID(r, "tripId")
+
+	text := strings.TrimSpace(params.Q)
+	if text == "" || len(text) > maxActivitiesSearchLength {
+		return spec.GetTripsTripIDActivitiesSearchJSON400Response(api.badRequest(r, i18n.InvalidActivitiesSearch, maxActivitiesSearchLength))
+	}
+
+	activities, err := api.store.SearchActivities(r.Context(), tripUUID, text)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return spec.GetTripsTripIDActivitiesSearchJSON404Response(api.notFound(r, i18n.TripNotFound))
+	}
+	if err != nil {
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed route: '%v: %v'", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripID),
+		)
+
+		return spec.GetTripsTripIDActivitiesSearchJSON500Response(api.internalServerError(r, i18n.UnableToGetActivities))
+	}
+
+	return spec.GetTripsTripIDActivitiesSearchJSON200Response(spec.SearchActivitiesResponse{Activities: activitiesResponse(activities)})
+}
+
+// Count a trip activities by day.
+// (GET /trips/{tripId}/activities/summary)
+func (api *API) GetTripsTripIDActivitiesSummary(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID := pathUUID(r, "tripId")
+
+	trip, counts, err := api.store.GetTripWithActivityCounts(r.Context(), tripUUID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return spec.GetTripsTripIDActivitiesSummaryJSON404Response(api.notFound(r, i18n.TripNotFound))
+	}
+	if err != nil {
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed route: '%v: %v'", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripID),
+		)
+
+		return spec.GetTripsTripIDActivitiesSummaryJSON500Response(api.internalServerError(r, i18n.UnableToGetActivities))
+	}
+
+	// The counts are by date of the trip timezone, only the days with activities, matched here on the days of
+	// the trip so the empty ones are counted 0.
+	countByDate := make(map[string]int, len(counts))
+	for _, count := range counts {
+		countByDate[count.Day.Format(time.DateOnly)] = int(count.Count)
+	}
+
+	tripDays := api.daysOfTrip(r.Context(), trip, api.tripLocation(r.Context(), trip))
+	days := make([]spec.GetTripActivitiesSummaryResponseArray, len(tripDays))
+	for index, tripDay := range tripDays {
+		days[index] = spec.GetTripActivitiesSummaryResponseArray{Date: tripDay, Count: countByDate[tripDay.Format(time.DateOnly)]}
+	}
+
+	return spec.GetTripsTripIDActivitiesSummaryJSON200Response(spec.GetTripActivitiesSummaryResponse{Days: days})
+}
+
+// Delete a trip activity.
+// (DELETE /trips/{tripId}/activities/{activityId})
+func (api *API) DeleteTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *spec.Response {
+	tripUUID := pathUUID(r, "tripId")
+
+	activityUUID := pathUUID(r, "activityId")
+
+	trip, err := api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		return spec.DeleteTripsTripIDActivitiesActivityIDJSON404Response(api.notFound(r, i18n.TripNotFound))
+	}
+
+	if err := api.authorizeTripOwner(r, trip); err != nil {
+		if errors.Is(err, errMissingOwnerToken) {
+			return spec.DeleteTripsTripIDActivitiesActivityIDJSON401Response(api.unauthorized(r, i18n.MissingOwnerToken))
+		}
+		return spec.DeleteTripsTripIDActivitiesActivityIDJSON403Response(api.forbidden(r, refusedOwnerTokenKey(err)))
+	}
+
+	if isTripClosed(trip) {
+		return spec.DeleteTripsTripIDActivitiesActivityIDJSON409Response(api.conflict(r, i18n.TripClosed))
+	}
+
+	// the activity of another trip is as missing as an unknown one, it is only deleted on its own trip.
+	deleted, err := api.store.DeleteActivity(r.Context(), pgstore.DeleteActivityParams{
+		ID:     activityUUID,
+		TripID: tripUUID,
+	})
+	if err != nil {
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed route: '%v: %v' when deleting an activity: ", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripID),
+			zap.String("activityID", activityID),
+		)
+
+		return spec.DeleteTripsTripIDActivitiesActivityIDJSON500Response(api.internalServerError(r, i18n.UnableToDeleteActivity))
+	}
+	if deleted == 0 {
+		return spec.DeleteTripsTripIDActivitiesActivityIDJSON404Response(api.notFound(r, i18n.ActivityNotFound))
+	}
+
+	return spec.DeleteTripsTripIDActivitiesActivityIDJSON204Response(nil)
+}
+
+// Get a trip activity.
+// (GET /trips/{tripId}/activities/{activityId})
+func (api *API) GetTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *spec.Response {
+	tripUUID := pathUUID(r, "tripId")
+
+	activityUUID := pathUUID(r, "activityId")
+
+	activity, err := api.store.GetActivity(r.Context(), pgstore.GetActivityParams{
+		ID:     activityUUID,
+		TripID: tripUUID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			// the activity is read on its trip, the missing trip is only told apart on a miss.
+			if exists, err := api.store.TripExists(r.Context(), tripUUID); err == nil && !exists {
+				return spec.GetTripsTripIDActivitiesActivityIDJSON404Response(api.notFound(r, i18n.TripNotFound))
+			}
+			return spec.GetTripsTripIDActivitiesActivityIDJSON404Response(api.notFound(r, i18n.ActivityNotFound))
+		}
+
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed on route: '%v: %v'", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripID),
+			zap.String("activityID", activityID),
+		)
+
+		return spec.GetTripsTripIDActivitiesActivityIDJSON500Response(api.internalServerError(r, i18n.UnableToGetActivity))
+	}
+
+	return spec.GetTripsTripIDActivitiesActivityIDJSON200Response(spec.GetActivityResponse{
+		Activity: activityResponse(activity),
+	})
+}
+
+// Update a trip activity.
+// (PUT /trips/{tripId}/activities/{activityId})
+func (api *API) PutTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *spec.Response {
+	tripUUID := pathUUID(r, "tripId")
+
+	activityUUID := pathUUID(r, "activityId")
+
+	trip, err := api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		return spec.PutTripsTripIDActivitiesActivityIDJSON404Response(api.notFound(r, i18n.TripNotFound))
+	}
+
+	if err := api.authorizeTripOwner(r, trip); err != nil {
+		if errors.Is(err, errMissingOwnerToken) {
+			return spec.PutTripsTripIDActivitiesActivityIDJSON401Response(api.unauthorized(r, i18n.MissingOwnerToken))
+		}
+		return spec.PutTripsTripIDActivitiesActivityIDJSON403Response(api.forbidden(r, refusedOwnerTokenKey(err)))
+	}
+
+	if isTripClosed(trip) {
+		return spec.PutTripsTripIDActivitiesActivityIDJSON409Response(api.conflict(r, i18n.TripClosed))
+	}
+
+	var body spec.PutTripsTripIDActivitiesActivityIDJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PutTripsTripIDActivitiesActivityIDJSON400Response(api.badRequest(r, i18n.InvalidRequest, err.Error()))
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PutTripsTripIDActivitiesActivityIDJSON400Response(api.invalidFieldsRequest(r, err))
+	}
+
+	var durationMinutes int
+	if body.DurationMinutes != nil {
+		durationMinutes = *body.DurationMinutes
+	}
+	endsAt := body.OccursAt.Add(time.Duration(durationMinutes) * time.Minute)
+
+	location := api.tripLocation(r.Context(), trip)
+	from, until := tripWindow(trip.StartsAt.Time, trip.EndsAt.Time, location)
+	if body.OccursAt.Before(from) || endsAt.After(until) {
+		return spec.PutTripsTripIDActivitiesActivityIDJSON400Response(api.badRequest(r, i18n.ActivityOutOfTripPeriod,
+			trip.StartsAt.Time.In(location).Format(time.DateOnly), trip.EndsAt.Time.In(location).Format(time.DateOnly),
+		))
+	}
+
+	activity, err := api.store.UpdateActivity(r.Context(), pgstore.UpdateActivityParams{
+		Title:           body.Title,
+		OccursAt:        pgtype.Timestamp{Valid: true, Time: body.OccursAt},
+		DurationMinutes: int32(durationMinutes),
+		Notes:           notesText(body.Notes),
+		ID:              activityUUID,
+		TripID:          tripUUID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return spec.PutTripsTripIDActivitiesActivityIDJSON404Response(api.notFound(r, i18n.ActivityNotFound))
+	}
+	if err != nil {
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed route: '%v: %v' when updating an activity: ", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripID),
+			zap.String("activityID", activityID),
+		)
+
+		return spec.PutTripsTripIDActivitiesActivityIDJSON500Response(api.internalServerError(r, i18n.UnableToUpdateActivity))
+	}
+
+	return spec.PutTripsTripIDActivitiesActivityIDJSON200Response(spec.GetActivityResponse{
+		Activity: activityResponse(activity),
+	})
+}
+
+// Mark a trip activity as done or not.
+// (PATCH /trips/{tripId}/activities/{activityId}/done)
+func (api *API) PatchTripsTripIDActivitiesActivityIDDone(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *spec.Response {
+	tripUUID := pathUUID(r, "tripId")
+
+	activityUUID := pathUUID(r, "activityId")
+
+	trip, err := api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		return spec.PatchTripsTripIDActivitiesActivityIDDoneJSON404Response(api.notFound(r, i18n.TripNotFound))
+	}
+
+	if err := api.authorizeTripOwner(r, trip); err != nil {
+		if errors.Is(err, errMissingOwnerToken) {
+			return spec.PatchTripsTripIDActivitiesActivityIDDoneJSON401Response(api.unauthorized(r, i18n.MissingOwnerToken))
+		}
+		return spec.PatchTripsTripIDActivitiesActivityIDDoneJSON403Response(api.forbidden(r, refusedOwnerTokenKey(err)))
+	}
+
+	if isTripClosed(trip) {
+		return spec.PatchTripsTripIDActivitiesActivityIDDoneJSON409Response(api.conflict(r, i18n.TripClosed))
 	}
 
-	if body.OccursAt.UTC().Before(trip.StartsAt.Time.UTC()) || body.OccursAt.UTC().After(trip.EndsAt.Time.UTC()) {
-		message := fmt.Sprintf("invalid activity,  date of occurrence outside the travel periods ( '%s' to '%s')", trip.StartsAt.Time, trip.EndsAt.Time)
-		return spec.PostTripsTripIDActivitiesJSON400Response(spec.BadRequest{
-			Message: message,
-		})
+	var body spec.PatchTripsTripIDActivitiesActivityIDDoneJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PatchTripsTripIDActivitiesActivityIDDoneJSON400Response(api.badRequest(r, i18n.InvalidRequest, err.Error()))
 	}
 
-	activity := pgstore.CreateActivityParams{
-		TripID:   tripIdConverted,
-		Title:    body.Title,
-		OccursAt: pgtype.Timestamp{Valid: true, Time: body.OccursAt},
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PatchTripsTripIDActivitiesActivityIDDoneJSON400Response(api.invalidFieldsRequest(r, err))
 	}
 
-	activityId, err := api.store.CreateActivity(r.Context(), activity)
+	// The state is set rather than flipped, so a request sent again leaves it as the first one did. The activities
+	// yet to come can be checked off too, the plans change along the trip.
+	activity, err := api.store.UpdateActivityDone(r.Context(), pgstore.UpdateActivityDoneParams{
+		IsDone: *body.IsDone,
+		ID:     activityUUID,
+		TripID: tripUUID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return spec.PatchTripsTripIDActivitiesActivityIDDoneJSON404Response(api.notFound(r, i18n.ActivityNotFound))
+	}
 	if err != nil {
-
-		api.logger.Error(
-			fmt.Sprintf("failed route: '%v: %v' when create a activitie: ", r.URL.RawPath, r.URL.Path),
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed on route: '%v: %v'", r.URL.RawPath, r.URL.Path),
 			zap.Error(err),
 			zap.String("tripID", tripID),
+			zap.String("activityID", activityID),
 		)
 
-		return spec.PostTripsTripIDActivitiesJSON500Response(spec.InternalServerErrorRequest{
-			Message: "unable to create activity, contact adm",
-		})
+		return spec.PatchTripsTripIDActivitiesActivityIDDoneJSON500Response(api.internalServerError(r, i18n.UnableToUpdateActivity))
 	}
 
-	return spec.PostTripsTripIDActivitiesJSON201Response(spec.CreateActivityResponse{ActivityID: activityId.String()})
+	return spec.PatchTripsTripIDActivitiesActivityIDDoneJSON200Response(spec.GetActivityResponse{
+		Activity: activityResponse(activity),
+	})
 }
 
 // Invite someone to the trip.
 // (POST /trips/{tripId}/invites)
 func (api *API) PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	tripUUID, friendlyErrorMessage, err := api.tryParseUUID("tripID", tripID)
+	tripUUID := pathUUID(r, "tripId")
+
+	trip, err := api.store.GetTrip(r.Context(), tripUUID)
 	if err != nil {
-		return spec.PostTripsTripIDInvitesJSON400Response(spec.BadRequest{
-			Message: friendlyErrorMessage,
-		})
+		return spec.PostTripsTripIDInvitesJSON404Response(api.notFound(r, i18n.TripNotFound))
+	}
+
+	if err := api.authorizeTripOwner(r, trip); err != nil {
+		if errors.Is(err, errMissingOwnerToken) {
+			return spec.PostTripsTripIDInvitesJSON401Response(api.unauthorized(r, i18n.MissingOwnerToken))
+		}
+		return spec.PostTripsTripIDInvitesJSON403Response(api.forbidden(r, refusedOwnerTokenKey(err)))
+	}
+
+	if isTripClosed(trip) {
+		return spec.PostTripsTripIDInvitesJSON409Response(api.conflict(r, i18n.TripClosed))
 	}
 
 	var body spec.PostTripsTripIDInvitesJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		return spec.PostTripsTripIDActivitiesJSON400Response(spec.BadRequest{
-			Message: "invalid request: " + err.Error(),
-		})
+	if err := decodeBody(r, &body); err != nil {
+		return spec.PostTripsTripIDInvitesJSON400Response(api.badRequest(r, i18n.InvalidRequest, err.Error()))
 	}
 
 	if err := api.validator.Struct(body); err != nil {
-		return spec.PostTripsTripIDActivitiesJSON400Response(spec.BadRequest{
-			Message: "invalid request: " + err.Error(),
-		})
+		return spec.PostTripsTripIDInvitesJSON400Response(api.invalidFieldsRequest(r, err))
 	}
 
-	trip, err := api.store.GetTrip(r.Context(), tripUUID)
-	if err != nil {
-		return spec.PostTripsTripIDInvitesJSON404Response(spec.NotFoundRequest{
-			Message: "trip not found",
-		})
+	emails := inviteEmails(body)
+	if undeliverable := api.undeliverableEmails(r.Context(), emails); len(undeliverable) > 0 {
+		return spec.PostTripsTripIDInvitesJSON400Response(api.badRequest(r, i18n.UndeliverableEmails, strings.Join(undeliverable, ", ")))
 	}
 
 	participants, err := api.store.GetParticipants(r.Context(), tripUUID)
 	if err != nil {
-		return spec.PostTripsTripIDInvitesJSON500Response(spec.InternalServerErrorRequest{
-			Message: "unable to obtain participants and consists of whether the new participant sent already exists",
-		})
+		return spec.PostTripsTripIDInvitesJSON500Response(api.internalServerError(r, i18n.UnableToCheckParticipants))
 	}
 
-	participantsAlreadyExists := api.filterParticipants(participants, func(participant pgstore.Participant) bool {
-		return strings.TrimSpace(participant.Email) == strings.TrimSpace(string(body.Email))
-	})
-
-	if len(participantsAlreadyExists) > 0 {
-		return spec.PostTripsTripIDInvitesJSON400Response(spec.BadRequest{
-			Message: "new participant already exists",
+	// The emails already participating are left out, the request fails only when none is left to invite. Past
+	// the trip capacity the rest of them are waitlisted by the store, in the order they were sent.
+	participating := make(map[string]bool, len(participants))
+	for _, participant := range participants {
+		participating[normalizeEmail(participant.Email)] = true
+	}
+	invitesToInsert := make([]pgstore.InviteParticipantsToTripParams, 0, len(emails))
+	for _, email := range emails {
+		if participating[email] {
+			continue
+		}
+		participating[email] = true
+		invitesToInsert = append(invitesToInsert, pgstore.InviteParticipantsToTripParams{
+			TripID: trip.ID,
+			Email:  email,
+			Name:   participantText(body.Name),
+			Phone:  participantText(body.Phone),
 		})
 	}
 
-	invitesToInsert := make([]pgstore.InviteParticipantsToTripParams, 1)
-	invitesToInsert[0] = pgstore.InviteParticipantsToTripParams{
-		TripID: trip.ID,
-		Email:  string(body.Email),
+	if len(invitesToInsert) == 0 {
+		return spec.PostTripsTripIDInvitesJSON400Response(api.badRequest(r, i18n.ParticipantAlreadyInvited))
 	}
 
-	if _, err := api.store.InviteParticipantsToTrip(r.Context(), invitesToInsert); err != nil {
-		return spec.PostTripsTripIDInvitesJSON400Response(spec.BadRequest{
-			Message: "unable to insert new participant",
-		})
+	if _, err := api.store.InviteParticipantsWithinCapacity(r.Context(), api.pool, invitesToInsert); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PostTripsTripIDInvitesJSON404Response(api.notFound(r, i18n.TripNotFound))
+		}
+		switch storeFailureStatus(err) {
+		case http.StatusConflict:
+			return spec.PostTripsTripIDInvitesJSON409Response(api.conflict(r, i18n.ParticipantAlreadyInvited))
+		case http.StatusNotFound:
+			return spec.PostTripsTripIDInvitesJSON404Response(api.notFound(r, i18n.TripNotFound))
+		}
+
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed route: '%v: %v' when inviting participants: ", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripID),
+			zap.Int("invites", len(invitesToInsert)),
+		)
+		return spec.PostTripsTripIDInvitesJSON500Response(api.internalServerError(r, i18n.UnableToInviteParticipant))
 	}
 
 	participants, err = api.store.GetParticipants(r.Context(), tripUUID)
 	if err != nil {
-		return spec.PostTripsTripIDInvitesJSON400Response(spec.BadRequest{
-			Message: "new participant registered, but don't was possible recovery operation id",
-		})
+		return spec.PostTripsTripIDInvitesJSON400Response(api.badRequest(r, i18n.ParticipantInvitedWithoutID))
 	}
 
-	participantsNoninvited := api.filterParticipants(participants, func(participant pgstore.Participant) bool {
-		return !participant.IsConfirmed
-	})
-
-	var participantId uuid.UUID
+	// Listed in the order the emails were sent, only the participants just invited are sent their invitation,
+	// the waitlisted ones aside.
+	insertedAt := make(map[string]int, len(invitesToInsert))
+	for index, invite := range invitesToInsert {
+		insertedAt[invite.Email] = index
+	}
+	invited := make([]pgstore.Participant, len(invitesToInsert))
 	for _, participant := range participants {
-		if participant.Email == string(body.Email) {
-			participantId = participant.ID
-			break
+		if index, found := insertedAt[normalizeEmail(participant.Email)]; found {
+			invited[index] = participant
 		}
 	}
 
-	invitesToSend := make([]mailpit.InviteParticipantsToTrip, len(participantsNoninvited))
-	for index, participantToInvite := range participantsNoninvited {
-		invite := mailpit.InviteParticipantsToTrip{
+	invitesToSend := make([]mailpit.InviteParticipantsToTrip, 0, len(invited))
+	invitedResponse := make([]spec.GetTripParticipantsResponseArray, len(invited))
+	for index, participantToInvite := range invited {
+		invitedResponse[index] = participantResponse(participantToInvite)
+		if participantToInvite.IsWaitlisted {
+			continue
+		}
+		invitesToSend = append(invitesToSend, mailpit.InviteParticipantsToTrip{
 			TripID: tripUUID,
 			Participant: mailpit.Participant{
 				ParticipantId: participantToInvite.ID,
 				Email:         participantToInvite.Email,
+				Name:          participantToInvite.Name.String,
 			},
-		}
-		invitesToSend[index] = invite
+		})
 	}
 
-	dataToSendInvite := mailpit.SendInviteToParticipants{
-		Trip:    trip,
-		Invites: invitesToSend,
-	}
+	if len(invitesToSend) > 0 {
+		dataToSendInvite := mailpit.SendInviteToParticipants{
+			Trip:    trip,
+			Invites: invitesToSend,
+			Locale:  i18n.FromRequest(r),
+		}
 
-	go func() {
-		if err := api.mailer.SendConfirmTripEmailToParticipants(dataToSendInvite); err != nil {
-			api.logger.Error(
-				"failed to send email on PostTripsTripIDInvites",
+		sendEmail := func() error { return api.mailer.SendConfirmTripEmailToParticipants(dataToSendInvite) }
+		if err := api.dispatcher.Enqueue(r.Context(), "PostTripsTripIDInvites", sendEmail, zap.String("tripID", tripID)); err != nil {
+			api.loggerFor(r.Context()).Error(
+				"failed to enqueue email on PostTripsTripIDInvites",
 				zap.Error(err),
 				zap.String("tripID", tripID),
 			)
 		}
-	}()
+	}
 
+	// The participants have no route of their own, the Location is the trip participants they are listed in.
+	w.Header().Set("Location", fmt.Sprintf("/trips/%s/participants", tripUUID))
 	return spec.PostTripsTripIDInvitesJSON201Response(spec.InviteParticipantResponse{
-		ParticipantID: participantId.String(),
+		ParticipantID: invited[0].ID.String(),
+		Participant:   invitedResponse[0],
+		Participants:  invitedResponse,
 	})
 }
 
 // Get a trip links.
 // (GET /trips/{tripId}/links)
 func (api *API) GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	tripUUID, friendlyErrorMessage, err := api.tryParseUUID("tripID", tripID)
-	if err != nil {
-		return spec.GetTripsTripIDLinksJSON400Response(spec.BadRequest{
-			Message: friendlyErrorMessage,
-		})
-	}
+	tripUUID := pathUUID(r, "tripId")
 
-	if _, err := api.store.GetTrip(r.Context(), tripUUID); err != nil {
-		return spec.GetTripsTripIDLinksJSON404Response(spec.NotFoundRequest{
-			Message: "trip not found",
-		})
+	_, links, err := api.store.GetTripWithLinks(r.Context(), tripUUID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return spec.GetTripsTripIDLinksJSON404Response(api.notFound(r, i18n.TripNotFound))
 	}
-
-	links, err := api.store.GetTripLinks(r.Context(), tripUUID)
 	if err != nil {
-		return spec.GetTripsTripIDLinksJSON400Response(spec.BadRequest{
-			Message: "unable to get link to trip",
-		})
-	}
-
-	linksParsed := make([]spec.GetLinksResponseArray, len(links))
-	for index := 0; index < len(links); index++ {
-		link := links[index]
-		linksParsed[index] = spec.GetLinksResponseArray{
-			ID:    link.ID.String(),
-			Title: link.Title,
-			URL:   link.Url,
-		}
+		return spec.GetTripsTripIDLinksJSON400Response(api.badRequest(r, i18n.UnableToGetLinks))
 	}
 
 	return spec.GetTripsTripIDLinksJSON200Response(spec.GetLinksResponse{
-		Links: linksParsed,
+		Links: linksResponse(links),
 	})
 }
 
 // Create a trip link.
 // (POST /trips/{tripId}/links)
 func (api *API) PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	tripUUID, friendlyErrorMessage, err := api.tryParseUUID("tripID", tripID)
+	tripUUID := pathUUID(r, "tripId")
+
+	// the links are read along the trip, the new one is checked against them.
+	trip, links, err := api.store.GetTripWithLinks(r.Context(), tripUUID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return spec.PostTripsTripIDLinksJSON404Response(api.notFound(r, i18n.TripNotFound))
+	}
 	if err != nil {
-		return spec.PostTripsTripIDLinksJSON400Response(spec.BadRequest{
-			Message: friendlyErrorMessage,
-		})
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed route: '%v: %v' when creating a link: ", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripID),
+		)
+		return spec.PostTripsTripIDLinksJSON500Response(api.internalServerError(r, i18n.UnableToGetLinks))
+	}
+
+	if err := api.authorizeTripOwner(r, trip); err != nil {
+		if errors.Is(err, errMissingOwnerToken) {
+			return spec.PostTripsTripIDLinksJSON401Response(api.unauthorized(r, i18n.MissingOwnerToken))
+		}
+		return spec.PostTripsTripIDLinksJSON403Response(api.forbidden(r, refusedOwnerTokenKey(err)))
+	}
+
+	if isTripClosed(trip) {
+		return spec.PostTripsTripIDLinksJSON409Response(api.conflict(r, i18n.TripClosed))
 	}
 
 	var body spec.CreateLinkRequest
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		return spec.PostTripsTripIDLinksJSON400Response(spec.BadRequest{
-			Message: "request invalid " + err.Error(),
-		})
+		return spec.PostTripsTripIDLinksJSON400Response(api.badRequest(r, i18n.InvalidRequest, err.Error()))
 	}
 
+	// Normalized before the validation, so the surrounding spaces don't fail the url format.
+	body.URL = normalizeLinkURL(body.URL)
+
 	if err := api.validator.Struct(body); err != nil {
-		return spec.PostTripsTripIDLinksJSON400Response(spec.BadRequest{
-			Message: "request invalid " + err.Error(),
-		})
+		return spec.PostTripsTripIDLinksJSON400Response(api.invalidFieldsRequest(r, err))
 	}
 
-	if _, err := api.store.GetTrip(r.Context(), tripUUID); err != nil {
-		return spec.PostTripsTripIDLinksJSON404Response(spec.NotFoundRequest{
-			Message: "trip not found",
-		})
+	// the links stored before the urls were normalized are normalized to be compared.
+	for _, link := range links {
+		if normalizeLinkURL(link.Url) == body.URL {
+			return spec.PostTripsTripIDLinksJSON409Response(api.conflict(r, i18n.LinkAlreadyExists, body.URL))
+		}
 	}
 
 	link := pgstore.CreateTripLinkParams{
@@ -769,18 +2351,231 @@ func (api *API) PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tri
 
 	linkId, err := api.store.CreateTripLink(r.Context(), link)
 	if err != nil {
-		return spec.PostTripsTripIDLinksJSON500Response(spec.InternalServerErrorRequest{
-			Message: "unable to create link to trip",
-		})
+		// a concurrent request may have created the same link meanwhile, refused by the unique index.
+		switch storeFailureStatus(err) {
+		case http.StatusConflict:
+			return spec.PostTripsTripIDLinksJSON409Response(api.conflict(r, i18n.LinkAlreadyExists, body.URL))
+		case http.StatusNotFound:
+			return spec.PostTripsTripIDLinksJSON404Response(api.notFound(r, i18n.TripNotFound))
+		}
+
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed route: '%v: %v' when creating a link: ", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripID),
+		)
+		return spec.PostTripsTripIDLinksJSON500Response(api.internalServerError(r, i18n.UnableToCreateLink))
 	}
 
+	w.Header().Set("Location", fmt.Sprintf("/trips/%s/links/%s", tripUUID, linkId))
 	return spec.PostTripsTripIDLinksJSON201Response(spec.CreateLinkResponse{
 		LinkID: linkId.String(),
+		Link: linkResponse(pgstore.Link{
+			ID:     linkId,
+			TripID: link.TripID,
+			Title:  link.Title,
+			Url:    link.Url,
+		}),
+	})
+}
+
+// Get a trip link.
+// (GET /trips/{tripId}/links/{linkId})
+func (api *API) GetTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request, tripID string, linkID string) *spec.Response {
+	tripUUID := pathUUID(r, "tripId")
+
+	linkUUID := pathUUID(r, "linkId")
+
+	link, err := api.store.GetLink(r.Context(), pgstore.GetLinkParams{
+		ID:     linkUUID,
+		TripID: tripUUID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			// the link is read on its trip, the missing trip is only told apart on a miss.
+			if exists, err := api.store.TripExists(r.Context(), tripUUID); err == nil && !exists {
+				return spec.GetTripsTripIDLinksLinkIDJSON404Response(api.notFound(r, i18n.TripNotFound))
+			}
+			return spec.GetTripsTripIDLinksLinkIDJSON404Response(api.notFound(r, i18n.LinkNotFound))
+		}
+
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed on route: '%v: %v'", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripID),
+			zap.String("linkID", linkID),
+		)
+
+		return spec.GetTripsTripIDLinksLinkIDJSON500Response(api.internalServerError(r, i18n.UnableToGetLink))
+	}
+
+	return spec.GetTripsTripIDLinksLinkIDJSON200Response(spec.GetLinkResponse{
+		Link: linkResponse(link),
 	})
 }
 
+// activitiesByDay groups the activities by the trip days, on its timezone. Filtered by from and to, only
+// the days of the range are listed.
+func (api *API) activitiesByDay(ctx context.Context, trip pgstore.Trip, activities []pgstore.Activity, from, to *types.Date) []spec.GetTripActivitiesResponseOuterArray {
+	// The days are the ones of the destination, an activity late in the evening there is still on that day.
+	location := api.tripLocation(ctx, trip)
+	allTripDays := api.daysOfTrip(ctx, trip, location)
+	tripDays := make([]time.Time, 0, len(allTripDays))
+	from, to = dateBound(from), dateBound(to)
+
+	// Filtered, only the days of the range are listed, the ones without activities included.
+	for _, tripDay := range allTripDays {
+		if from != nil && tripDay.Before(dayIn(from.Time, location)) {
+			continue
+		}
+		if to != nil && tripDay.After(dayIn(to.Time, location)) {
+			continue
+		}
+		tripDays = append(tripDays, tripDay)
+	}
+	activitiesParsedToResponse := make([]spec.GetTripActivitiesResponseOuterArray, len(tripDays))
+
+	for indexTripDays := 0; indexTripDays < len(tripDays); indexTripDays++ {
+
+		tripDay := tripDays[indexTripDays]
+
+		activitiesFiltered := api.filterActivities(activities, func(activity pgstore.Activity) bool {
+			year, month, day := activity.OccursAt.Time.In(location).Date()
+			return time.Date(year, month, day, 0, 0, 0, 0, location).Equal(tripDay)
+		})
+
+		activitiesParsedToResponse[indexTripDays] = spec.GetTripActivitiesResponseOuterArray{
+			Date:       tripDay,
+			Activities: activitiesResponse(activitiesFiltered),
+		}
+	}
+
+	return activitiesParsedToResponse
+}
+
+// tripDetailsResponse is the trip as the details answer it.
+func tripDetailsResponse(trip pgstore.Trip) spec.GetTripDetailsResponseTripObj {
+	return spec.GetTripDetailsResponseTripObj{
+		ID:              trip.ID.String(),
+		Destination:     trip.Destination,
+		StartsAt:        trip.StartsAt.Time,
+		EndsAt:          trip.EndsAt.Time,
+		IsConfirmed:     trip.IsConfirmed,
+		Status:          trip.Status,
+		Timezone:        trip.Timezone,
+		Notes:           notesResponse(trip.Notes),
+		Latitude:        coordinateResponse(trip.Latitude),
+		Longitude:       coordinateResponse(trip.Longitude),
+		MaxParticipants: maxParticipantsResponse(trip.MaxParticipants),
+	}
+}
+
+func activityResponse(activity pgstore.Activity) spec.GetTripActivitiesResponseInnerArray {
+	return spec.GetTripActivitiesResponseInnerArray{
+		ID:              activity.ID.String(),
+		Title:           activity.Title,
+		OccursAt:        activity.OccursAt.Time,
+		DurationMinutes: int(activity.DurationMinutes),
+		EndsAt:          activityEndsAt(activity),
+		Notes:           notesResponse(activity.Notes),
+		IsDone:          activity.IsDone,
+	}
+}
+
+// notesText is the notes of a request as stored, null when missing or empty.
+func notesText(notes *string) pgtype.Text {
+	if notes == nil || *notes == "" {
+		return pgtype.Text{}
+	}
+	return pgtype.Text{String: *notes, Valid: true}
+}
+
+// notesResponse is the stored notes as answered, missing when null.
+func notesResponse(notes pgtype.Text) *string {
+	if !notes.Valid {
+		return nil
+	}
+	return &notes.String
+}
+
+func activitiesResponse(activities []pgstore.Activity) []spec.GetTripActivitiesResponseInnerArray {
+	parsed := make([]spec.GetTripActivitiesResponseInnerArray, len(activities))
+	for index, activity := range activities {
+		parsed[index] = activityResponse(activity)
+	}
+	return parsed
+}
+
+func participantResponse(participant pgstore.Participant) spec.GetTripParticipantsResponseArray {
+	parsed := spec.GetTripParticipantsResponseArray{
+		ID:           participant.ID.String(),
+		Email:        types.Email(participant.Email),
+		IsConfirmed:  participant.IsConfirmed,
+		IsWaitlisted: participant.IsWaitlisted,
+		InviteStatus: participant.InviteStatus,
+	}
+	if participant.InviteLastAttemptAt.Valid {
+		parsed.InviteLastAttemptAt = &participant.InviteLastAttemptAt.Time
+	}
+	if participant.Name.Valid {
+		parsed.Name = &participant.Name.String
+	}
+	if participant.Phone.Valid {
+		parsed.Phone = &participant.Phone.String
+	}
+	return parsed
+}
+
+func participantsResponse(participants []pgstore.Participant) []spec.GetTripParticipantsResponseArray {
+	parsed := make([]spec.GetTripParticipantsResponseArray, len(participants))
+	for index, participant := range participants {
+		parsed[index] = participantResponse(participant)
+	}
+	return parsed
+}
+
+func linkResponse(link pgstore.Link) spec.GetLinksResponseArray {
+	return spec.GetLinksResponseArray{
+		ID:    link.ID.String(),
+		Title: link.Title,
+		URL:   link.Url,
+	}
+}
+
+func linksResponse(links []pgstore.Link) []spec.GetLinksResponseArray {
+	parsed := make([]spec.GetLinksResponseArray, len(links))
+	for index, link := range links {
+		parsed[index] = linkResponse(link)
+	}
+	return parsed
+}
+
 type filterFuncToActivity func(activity pgstore.Activity) bool
 
+// daysOfTrip lists the start of each day of the trip in location.
+func (api *API) daysOfTrip(ctx context.Context, trip pgstore.Trip, location *time.Location) []time.Time {
+	firstDay, afterLastDay := tripWindow(trip.StartsAt.Time, trip.EndsAt.Time, location)
+
+	// The trips saved before the period validation may end before they start, their activities are then
+	// grouped in the start day rather than the slices built with a negative length.
+	numberOfDaysOfTheTrip := int(math.Round(afterLastDay.Sub(firstDay).Hours() / 24))
+	if trip.EndsAt.Time.Before(trip.StartsAt.Time) || numberOfDaysOfTheTrip < 1 {
+		api.loggerFor(ctx).Warn(
+			"trip ends before it starts, grouping its activities in a single day",
+			zap.String("tripID", trip.ID.String()),
+			zap.Time("startsAt", trip.StartsAt.Time),
+			zap.Time("endsAt", trip.EndsAt.Time),
+		)
+		numberOfDaysOfTheTrip = 1
+	}
+
+	tripDays := make([]time.Time, numberOfDaysOfTheTrip)
+	for index := range tripDays {
+		tripDays[index] = firstDay.AddDate(0, 0, index)
+	}
+	return tripDays
+}
+
 func (api *API) filterActivities(activities []pgstore.Activity, f filterFuncToActivity) []pgstore.Activity {
 	var activiesFiltered []pgstore.Activity
 
@@ -806,25 +2601,258 @@ func (api *API) filterParticipants(participants []pgstore.Participant, f filterF
 	return participantsFiltered
 }
 
-func (api *API) tryParseUUID(nameOfParameterArgument string, id string) (idParsed uuid.UUID, friendlyErrorMessage string, err error) {
-	idParsed, err = uuid.Parse(id)
+// message is the text of key on the locale asked by the request, formatted with args.
+func (api *API) message(r *http.Request, key i18n.Key, args ...any) string {
+	return i18n.Message(i18n.FromRequest(r), key, args...)
+}
+
+// checkTripPeriod tells why the period is invalid: starting in the past or more than
+// maxTripStartYearsAhead ahead, ending before it starts or lasting more than maxTripDays. Nil when valid.
+func (api *API) checkTripPeriod(r *http.Request, startsAt, endsAt time.Time) *spec.BadRequest {
+	var badRequest spec.BadRequest
+	now := api.clock.Now().UTC()
+
+	switch {
+	case startsAt.UTC().Before(now):
+		badRequest = api.badRequest(r, i18n.TripStartsInThePast)
+	case startsAt.UTC().After(now.AddDate(maxTripStartYearsAhead, 0, 0)):
+		badRequest = api.badRequest(r, i18n.TripStartsTooFarAhead, maxTripStartYearsAhead)
+	case endsAt.UTC().Before(startsAt.UTC()):
+		badRequest = api.badRequest(r, i18n.TripEndsBeforeStart)
+	case tripDays(startsAt, endsAt) > api.maxTripDays:
+		badRequest = api.badRequest(r, i18n.TripTooLong, api.maxTripDays)
+	default:
+		return nil
+	}
+
+	return &badRequest
+}
+
+// tripLocation is the timezone of the trip destination, UTC when unknown. The timezones are validated on
+// creation, an unknown one is logged.
+func (api *API) tripLocation(ctx context.Context, trip pgstore.Trip) *time.Location {
+	location, err := time.LoadLocation(trip.Timezone)
+	if err != nil {
+		api.loggerFor(ctx).Warn(
+			"unknown trip timezone, grouping its activities by UTC days",
+			zap.Error(err),
+			zap.String("tripID", trip.ID.String()),
+		)
+		return time.UTC
+	}
+	return location
+}
+
+// dayIn is the midnight, in location, of the date.
+func dayIn(date time.Time, location *time.Location) time.Time {
+	year, month, day := date.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, location)
+}
+
+// dateBound is the date bounding a range, nil when it doesn't: the generated wrapper binds an optional date
+// missing from the query to a zero date rather than leaving it nil.
+func dateBound(date *types.Date) *types.Date {
+	if date == nil || date.IsZero() {
+		return nil
+	}
+	return date
+}
+
+// tripWindow is when the activities of a trip may happen. The trips are date based, as their activities are
+// grouped by day: the window runs from the midnight of the first day to the end of the last one, on location,
+// whatever the time of day the trip starts and ends at.
+func tripWindow(startsAt, endsAt time.Time, location *time.Location) (time.Time, time.Time) {
+	from := dayIn(startsAt.In(location), location)
+	until := dayIn(endsAt.In(location), location).AddDate(0, 0, 1)
+	return from, until
+}
+
+// tripDays is how many days the trip lasts, counting the day it starts.
+func tripDays(startsAt, endsAt time.Time) int {
+	return int(endsAt.Sub(startsAt).Hours()/24) + 1
+}
+
+// activityEndsAt is when the activity ends, its occurrence plus its duration.
+func activityEndsAt(activity pgstore.Activity) time.Time {
+	return activity.OccursAt.Time.Add(time.Duration(activity.DurationMinutes) * time.Minute)
+}
+
+// normalizeEmail is the form the emails are compared on, trimmed and lower-cased.
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// participantText is a name or a phone of an invite as stored, trimmed and null when missing or empty. The
+// name and the phone are only sent along a single email, the one participant invited.
+func participantText(value *string) pgtype.Text {
+	if value == nil || strings.TrimSpace(*value) == "" {
+		return pgtype.Text{}
+	}
+	return pgtype.Text{String: strings.TrimSpace(*value), Valid: true}
+}
+
+// inviteEmails are the emails a request invites, normalized and without the repeated ones, in the order sent.
+func inviteEmails(body spec.PostTripsTripIDInvitesJSONRequestBody) []string {
+	var sent []types.Email
+	if body.Email != nil {
+		sent = []types.Email{*body.Email}
+	} else if body.Emails != nil {
+		sent = *body.Emails
+	}
+
+	emails := make([]string, 0, len(sent))
+	for _, email := range sent {
+		normalized := normalizeEmail(string(email))
+		if !slices.Contains(emails, normalized) {
+			emails = append(emails, normalized)
+		}
+	}
+	return emails
+}
+
+// normalizeLinkURL is the form the link urls are stored and compared on, trimmed and with the scheme and host
+// lower-cased. The path and the query are kept as sent, they may be case sensitive.
+func normalizeLinkURL(rawURL string) string {
+	trimmed := strings.TrimSpace(rawURL)
+	parsed, err := url.Parse(trimmed)
+	if err != nil {
+		return trimmed
+	}
+	parsed.Scheme = strings.ToLower(parsed.Scheme)
+	parsed.Host = strings.ToLower(parsed.Host)
+	return parsed.String()
+}
+
+// loggerFor is the logger of the request, its entries carrying the request id.
+func (api *API) loggerFor(ctx context.Context) *zap.Logger {
+	if requestID := httplog.RequestIDFromContext(ctx); requestID != "" {
+		return api.logger.With(zap.String("request_id", requestID))
+	}
+	return api.logger
+}
+
+// confirmTrip confirms the trip and enqueues the e-mail invitations to its participants, only once: an already
+// confirmed trip answers errTripAlreadyConfirmed, sending nothing again.
+func (api *API) confirmTrip(ctx context.Context, tripID uuid.UUID, locale i18n.Locale) error {
+	trip, err := api.store.GetTrip(ctx, tripID)
+	if err != nil {
+		return errTripNotFound
+	}
+
+	if isTripClosed(trip) {
+		return errTripClosed
+	}
+
+	if trip.IsConfirmed {
+		return errTripAlreadyConfirmed
+	}
+
+	confirmTrip := pgstore.UpdateTripConfirmParams{
+		IsConfirmed: true,
+		ID:          tripID,
+	}
+
+	// Only the trip still unconfirmed is confirmed, the one confirmed meanwhile by another request answers as
+	// already confirmed so its invitations are sent once.
+	confirmed, err := api.store.UpdateTripConfirm(ctx, confirmTrip)
+	if err != nil {
+		return fmt.Errorf("unable to confirm trip: %w", err)
+	}
+	if confirmed == 0 {
+		return errTripAlreadyConfirmed
+	}
+
+	participants, err := api.store.GetParticipants(ctx, tripID)
 	if err != nil {
-		api.logger.Error(err.Error())
-		friendlyErrorMessage = nameOfParameterArgument + " is not recognize with a valid uuid"
+		return fmt.Errorf("unable to get participants to invite: %w", err)
+	}
+
+	// the participants who already confirmed themselves are not asked to confirm again, the waitlisted ones are
+	// asked once promoted.
+	unconfirmed := api.filterParticipants(participants, func(participant pgstore.Participant) bool {
+		return !participant.IsConfirmed && !participant.IsWaitlisted
+	})
+
+	invites := make([]mailpit.InviteParticipantsToTrip, len(unconfirmed))
+	for index, participant := range unconfirmed {
+		invites[index] = mailpit.InviteParticipantsToTrip{
+			TripID: trip.ID,
+			Participant: mailpit.Participant{
+				ParticipantId: participant.ID,
+				Email:         participant.Email,
+				Name:          participant.Name.String,
+			},
+		}
+	}
+
+	dataToSendInvite := mailpit.SendInviteToParticipants{
+		Trip:    trip,
+		Invites: invites,
+		Locale:  locale,
+	}
+
+	sendEmail := func() error { return api.mailer.SendConfirmTripEmailToParticipants(dataToSendInvite) }
+	if err := api.dispatcher.Enqueue(ctx, "confirmTrip", sendEmail, zap.String("tripID", tripID.String())); err != nil {
+		api.loggerFor(ctx).Error(
+			"failed to enqueue email on confirmTrip",
+			zap.Error(err),
+			zap.String("tripID", tripID.String()),
+		)
+	}
+
+	if api.webhook != nil {
+		// the seats taken, the waitlisted participants aside.
+		seated := api.filterParticipants(participants, func(participant pgstore.Participant) bool {
+			return !participant.IsWaitlisted
+		})
+		event := webhook.TripConfirmed{
+			TripID:           trip.ID.String(),
+			Destination:      trip.Destination,
+			StartsAt:         trip.StartsAt.Time,
+			EndsAt:           trip.EndsAt.Time,
+			ParticipantCount: len(seated),
+		}
+
+		// the delivery outlives the request, its context is not the request one.
+		notify := func() error { return api.webhook.NotifyTripConfirmed(context.Background(), event) }
+		if err := api.dispatcher.Enqueue(ctx, "webhook.TripConfirmed", notify, zap.String("tripID", tripID.String())); err != nil {
+			api.loggerFor(ctx).Error(
+				"failed to enqueue webhook on confirmTrip",
+				zap.Error(err),
+				zap.String("tripID", tripID.String()),
+			)
+		}
 	}
-	return
+
+	return nil
 }
 
-func (api *API) buildRedirectRequestUsingRequestsWithParametersInTheURL(r *http.Request, requestURI string) (*http.Response, error) {
+// confirmParticipant confirms the participant presence on its trip.
+func (api *API) confirmParticipant(ctx context.Context, participantID uuid.UUID) error {
+	participant, err := api.store.GetParticipant(ctx, participantID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errParticipantNotFound
+		}
+		return fmt.Errorf("unable to retrieve participant: %w", err)
+	}
+
+	if participant.IsConfirmed {
+		return errParticipantAlreadyConfirmed
+	}
 
-	urlBase := baseurl.MustGet(r)
-	fullURL := fmt.Sprintf("%s%s", urlBase, requestURI)
-	client := http.Client{}
+	if participant.IsWaitlisted {
+		return errParticipantWaitlisted
+	}
 
-	newRequest, _ := http.NewRequest(http.MethodPatch, fullURL, nil)
-	newRequest.Header = r.Header
+	confirmParticipant := pgstore.ConfirmParticipantParams{
+		IsConfirmed: true,
+		ID:          participantID,
+	}
 
-	response, err := client.Do(newRequest)
+	if err := api.store.ConfirmParticipant(ctx, confirmParticipant); err != nil {
+		return fmt.Errorf("unable to update confirmation: %w", err)
+	}
 
-	return response, err
+	return nil
 }
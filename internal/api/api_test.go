@@ -0,0 +1,1453 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"journey/internal/api/spec"
+	"journey/internal/i18n"
+	"journey/internal/mailer/mailpit"
+	"journey/internal/pgstore"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+// TEST_OWNER_TOKEN is the owner token of the trips built by newTestTrip.
+const TEST_OWNER_TOKEN = "test-owner-token"
+
+// testNow is the fixed now of the tests, trips built relative to it never depend on the real time.
+var testNow = time.Date(2030, time.March, 10, 12, 0, 0, 0, time.UTC)
+
+// fixedClock always answers the same now.
+type fixedClock struct {
+	now time.Time
+}
+
+func (c fixedClock) Now() time.Time {
+	return c.now
+}
+
+// fakeMailer records the emails sent, failing the sends when err is set.
+type fakeMailer struct {
+	mu                 sync.Mutex
+	err                error
+	ownerConfirmations []uuid.UUID
+	invites            []mailpit.SendInviteToParticipants
+	cancellations      []mailpit.SendTripCancellation
+	magicLinks         []mailpit.SendOwnerMagicLink
+}
+
+func (m *fakeMailer) SendConfirmTripEmailToTripOwner(tripID uuid.UUID, _ i18n.Locale) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.ownerConfirmations = append(m.ownerConfirmations, tripID)
+	return m.err
+}
+
+func (m *fakeMailer) SendConfirmTripEmailToTripOwnerOnce(_ context.Context, tripID uuid.UUID, locale i18n.Locale) error {
+	return m.SendConfirmTripEmailToTripOwner(tripID, locale)
+}
+
+func (m *fakeMailer) SendConfirmTripEmailToParticipants(invite mailpit.SendInviteToParticipants) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.invites = append(m.invites, invite)
+	return m.err
+}
+
+func (m *fakeMailer) SendTripCancellationToParticipants(cancellation mailpit.SendTripCancellation) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.cancellations = append(m.cancellations, cancellation)
+	return m.err
+}
+
+func (m *fakeMailer) SendOwnerMagicLink(magicLink mailpit.SendOwnerMagicLink) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.magicLinks = append(m.magicLinks, magicLink)
+	return m.err
+}
+
+func (m *fakeMailer) Ping(context.Context) error {
+	return m.err
+}
+
+// syncDispatcher sends the emails before returning, so the tests see them once the request is answered.
+type syncDispatcher struct{}
+
+func (syncDispatcher) Enqueue(_ context.Context, _ string, send func() error, _ ...zap.Field) error {
+	_ = send()
+	return nil
+}
+
+// fakeStore keeps the rows of the tests in memory. It embeds the store interface, so a call the tests
+// don't need panics on the nil interface instead of silently answering.
+type fakeStore struct {
+	store
+	mu           sync.Mutex
+	trips        map[uuid.UUID]pgstore.Trip
+	participants map[uuid.UUID]pgstore.Participant
+	activities   map[uuid.UUID]pgstore.Activity
+	links        map[uuid.UUID]pgstore.Link
+	keys         map[string]pgstore.IdempotencyKey
+	// deletedTrips, deletedActivities and deletedLinks keep the rows soft deleted, out of sight of the other
+	// calls until restored.
+	deletedTrips      map[uuid.UUID]pgstore.Trip
+	deletedActivities map[uuid.UUID]pgstore.Activity
+	deletedLinks      map[uuid.UUID]pgstore.Link
+	// schemaVersion is the version of the last migration applied.
+	schemaVersion int32
+	// calls counts the calls of each method, by name.
+	calls map[string]int
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{
+		trips:        make(map[uuid.UUID]pgstore.Trip),
+		participants: make(map[uuid.UUID]pgstore.Participant),
+		activities:   make(map[uuid.UUID]pgstore.Activity),
+		links:        make(map[uuid.UUID]pgstore.Link),
+		keys:         make(map[string]pgstore.IdempotencyKey),
+		calls:        make(map[string]int),
+
+		deletedTrips:      make(map[uuid.UUID]pgstore.Trip),
+		deletedActivities: make(map[uuid.UUID]pgstore.Activity),
+		deletedLinks:      make(map[uuid.UUID]pgstore.Link),
+	}
+}
+
+func (s *fakeStore) called(name string) {
+	s.calls[name]++
+}
+
+func (s *fakeStore) addTrip(trip pgstore.Trip) pgstore.Trip {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.trips[trip.ID] = trip
+	return trip
+}
+
+func (s *fakeStore) addActivity(tripID uuid.UUID, title string, occursAt time.Time) pgstore.Activity {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	activity := pgstore.Activity{
+		ID:       uuid.New(),
+		TripID:   tripID,
+		Title:    title,
+		OccursAt: pgtype.Timestamp{Valid: true, Time: occursAt},
+	}
+	s.activities[activity.ID] = activity
+	return activity
+}
+
+func (s *fakeStore) addLink(tripID uuid.UUID, title string, url string) pgstore.Link {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	link := pgstore.Link{
+		ID:     uuid.New(),
+		TripID: tripID,
+		Title:  title,
+		Url:    url,
+	}
+	s.links[link.ID] = link
+	return link
+}
+
+func (s *fakeStore) addParticipant(tripID uuid.UUID, email string) pgstore.Participant {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	participant := pgstore.Participant{
+		ID:           uuid.New(),
+		TripID:       tripID,
+		Email:        email,
+		InviteStatus: pgstore.InviteStatusPending,
+		InvitedAt:    pgtype.Timestamp{Valid: true, Time: testNow},
+	}
+	s.participants[participant.ID] = participant
+	return participant
+}
+
+// invite backdates the invite of the participant to invitedAt.
+func (s *fakeStore) invite(id uuid.UUID, invitedAt time.Time) pgstore.Participant {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	participant := s.participants[id]
+	participant.InvitedAt = pgtype.Timestamp{Valid: true, Time: invitedAt}
+	s.participants[id] = participant
+	return participant
+}
+
+func (s *fakeStore) activity(id uuid.UUID) pgstore.Activity {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.activities[id]
+}
+
+func (s *fakeStore) participant(id uuid.UUID) pgstore.Participant {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.participants[id]
+}
+
+func (s *fakeStore) trip(id uuid.UUID) pgstore.Trip {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.trips[id]
+}
+
+func (s *fakeStore) callsOf(name string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls[name]
+}
+
+// totalCalls counts the calls of all the methods, each a round trip on the real store.
+func (s *fakeStore) totalCalls() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var total int
+	for _, calls := range s.calls {
+		total += calls
+	}
+	return total
+}
+
+func (s *fakeStore) GetActivity(_ context.Context, arg pgstore.GetActivityParams) (pgstore.Activity, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("GetActivity")
+
+	activity, found := s.activities[arg.ID]
+	if !found || activity.TripID != arg.TripID {
+		return pgstore.Activity{}, pgx.ErrNoRows
+	}
+	return activity, nil
+}
+
+func (s *fakeStore) UpdateActivity(_ context.Context, arg pgstore.UpdateActivityParams) (pgstore.Activity, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("UpdateActivity")
+
+	activity, found := s.activities[arg.ID]
+	if !found || activity.TripID != arg.TripID {
+		return pgstore.Activity{}, pgx.ErrNoRows
+	}
+	activity.Title = arg.Title
+	activity.OccursAt = arg.OccursAt
+	activity.DurationMinutes = arg.DurationMinutes
+	activity.Notes = arg.Notes
+	s.activities[arg.ID] = activity
+	return activity, nil
+}
+
+func (s *fakeStore) UpdateActivityDone(_ context.Context, arg pgstore.UpdateActivityDoneParams) (pgstore.Activity, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("UpdateActivityDone")
+
+	activity, found := s.activities[arg.ID]
+	if !found || activity.TripID != arg.TripID {
+		return pgstore.Activity{}, pgx.ErrNoRows
+	}
+	activity.IsDone = arg.IsDone
+	s.activities[arg.ID] = activity
+	return activity, nil
+}
+
+// DeleteActivity mirrors the query, moving the activity to the soft deleted ones stamped at testNow.
+func (s *fakeStore) DeleteActivity(_ context.Context, arg pgstore.DeleteActivityParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("DeleteActivity")
+
+	activity, found := s.activities[arg.ID]
+	if !found || activity.TripID != arg.TripID {
+		return 0, nil
+	}
+	activity.DeletedAt = pgtype.Timestamp{Valid: true, Time: testNow}
+	s.deletedActivities[arg.ID] = activity
+	delete(s.activities, arg.ID)
+	return 1, nil
+}
+
+func (s *fakeStore) GetLink(_ context.Context, arg pgstore.GetLinkParams) (pgstore.Link, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("GetLink")
+
+	link, found := s.links[arg.ID]
+	if !found || link.TripID != arg.TripID {
+		return pgstore.Link{}, pgx.ErrNoRows
+	}
+	return link, nil
+}
+
+func (s *fakeStore) GetSchemaVersion(context.Context) (int32, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("GetSchemaVersion")
+
+	return s.schemaVersion, nil
+}
+
+func (s *fakeStore) GetTrip(_ context.Context, id uuid.UUID) (pgstore.Trip, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("GetTrip")
+
+	trip, found := s.trips[id]
+	if !found {
+		return pgstore.Trip{}, pgx.ErrNoRows
+	}
+	return trip, nil
+}
+
+func (s *fakeStore) TripExists(_ context.Context, id uuid.UUID) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("TripExists")
+
+	_, found := s.trips[id]
+	return found, nil
+}
+
+// GetTripsByEmail mirrors the query, matching the lower-cased emails and ordering by the trip start.
+func (s *fakeStore) GetTripsByEmail(_ context.Context, arg pgstore.GetTripsByEmailParams) ([]pgstore.GetTripsByEmailRow, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("GetTripsByEmail")
+
+	rows := s.tripsByEmail(arg.Email)
+	offset := min(int(arg.PageOffset), len(rows))
+	end := min(offset+int(arg.PageLimit), len(rows))
+	return rows[offset:end], nil
+}
+
+func (s *fakeStore) CountTripsByEmail(_ context.Context, email string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("CountTripsByEmail")
+
+	return int64(len(s.tripsByEmail(email))), nil
+}
+
+// ListTripsByOwner mirrors the query, matching the lower-cased owner emails and ordering by the trip start.
+func (s *fakeStore) ListTripsByOwner(_ context.Context, arg pgstore.ListTripsByOwnerParams) ([]pgstore.ListTripsByOwnerRow, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("ListTripsByOwner")
+
+	rows := s.tripsByOwner(arg.Email)
+	offset := min(int(arg.PageOffset), len(rows))
+	end := min(offset+int(arg.PageLimit), len(rows))
+	return rows[offset:end], nil
+}
+
+func (s *fakeStore) CountTripsByOwner(_ context.Context, email string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("CountTripsByOwner")
+
+	return int64(len(s.tripsByOwner(email))), nil
+}
+
+// tripsByOwner are the trips email owns, by their start. The caller holds the lock.
+func (s *fakeStore) tripsByOwner(email string) []pgstore.ListTripsByOwnerRow {
+	var rows []pgstore.ListTripsByOwnerRow
+	for _, trip := range s.tripsByEmail(email) {
+		if trip.IsOwner {
+			rows = append(rows, pgstore.ListTripsByOwnerRow{
+				ID:          trip.ID,
+				Destination: trip.Destination,
+				StartsAt:    trip.StartsAt,
+				EndsAt:      trip.EndsAt,
+				IsConfirmed: trip.IsConfirmed,
+			})
+		}
+	}
+	return rows
+}
+
+// tripsByEmail are the trips email owns or participates in, by their start. The caller holds the lock.
+func (s *fakeStore) tripsByEmail(email string) []pgstore.GetTripsByEmailRow {
+	var rows []pgstore.GetTripsByEmailRow
+	for _, trip := range s.trips {
+		row := pgstore.GetTripsByEmailRow{
+			ID:          trip.ID,
+			Destination: trip.Destination,
+			StartsAt:    trip.StartsAt,
+			EndsAt:      trip.EndsAt,
+			IsOwner:     strings.ToLower(trip.OwnerEmail) == email,
+		}
+		row.IsConfirmed = row.IsOwner && trip.IsConfirmed
+
+		participates := false
+		for _, participant := range s.participants {
+			if participant.TripID == trip.ID && strings.ToLower(participant.Email) == email {
+				participates = true
+				row.IsConfirmed = row.IsConfirmed || (!row.IsOwner && participant.IsConfirmed)
+			}
+		}
+
+		if row.IsOwner || participates {
+			rows = append(rows, row)
+		}
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		if !rows[i].StartsAt.Time.Equal(rows[j].StartsAt.Time) {
+			return rows[i].StartsAt.Time.Before(rows[j].StartsAt.Time)
+		}
+		return rows[i].ID.String() < rows[j].ID.String()
+	})
+	return rows
+}
+
+func (s *fakeStore) GetTripActivities(_ context.Context, tripID uuid.UUID) ([]pgstore.Activity, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("GetTripActivities")
+
+	var activities []pgstore.Activity
+	for _, activity := range s.activities {
+		if activity.TripID == tripID {
+			activities = append(activities, activity)
+		}
+	}
+	return activities, nil
+}
+
+func (s *fakeStore) GetTripWithActivityCounts(_ context.Context, id uuid.UUID) (pgstore.Trip, []pgstore.ActivityDayCount, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("GetTripWithActivityCounts")
+
+	trip, ok := s.trips[id]
+	if !ok {
+		return pgstore.Trip{}, nil, pgx.ErrNoRows
+	}
+
+	// as the query, the days are the ones of the trip timezone, answered as dates.
+	location, err := time.LoadLocation(trip.Timezone)
+	if err != nil {
+		location = time.UTC
+	}
+
+	byDay := make(map[time.Time]int64)
+	for _, activity := range s.activities {
+		if activity.TripID == id {
+			byDay[dayIn(activity.OccursAt.Time.In(location), time.UTC)]++
+		}
+	}
+	counts := make([]pgstore.ActivityDayCount, 0, len(byDay))
+	for day, count := range byDay {
+		counts = append(counts, pgstore.ActivityDayCount{Day: day, Count: count})
+	}
+	sort.Slice(counts, func(i, j int) bool { return counts[i].Day.Before(counts[j].Day) })
+	return trip, counts, nil
+}
+
+func (s *fakeStore) GetTripWithActivities(_ context.Context, arg pgstore.GetTripAndActivitiesParams) (pgstore.Trip, []pgstore.Activity, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("GetTripWithActivities")
+
+	trip, ok := s.trips[arg.ID]
+	if !ok {
+		return pgstore.Trip{}, nil, pgx.ErrNoRows
+	}
+
+	// as the query, the days are the ones of the trip timezone.
+	location, err := time.LoadLocation(trip.Timezone)
+	if err != nil {
+		location = time.UTC
+	}
+
+	var activities []pgstore.Activity
+	for _, activity := range s.activities {
+		if activity.TripID != arg.ID {
+			continue
+		}
+		day := dayIn(activity.OccursAt.Time.In(location), time.UTC)
+		if arg.FromDate.Valid && day.Before(arg.FromDate.Time) {
+			continue
+		}
+		if arg.ToDate.Valid && day.After(arg.ToDate.Time) {
+			continue
+		}
+		if arg.Title.Valid && !strings.Contains(strings.ToLower(activity.Title), strings.ToLower(arg.Title.String)) {
+			continue
+		}
+		activities = append(activities, activity)
+	}
+	sortActivities(activities)
+	return trip, activities, nil
+}
+
+// sortActivities sorts the activities as the queries: by the start, then the manual order and the id.
+func sortActivities(activities []pgstore.Activity) {
+	sort.Slice(activities, func(i, j int) bool {
+		if !activities[i].OccursAt.Time.Equal(activities[j].OccursAt.Time) {
+			return activities[i].OccursAt.Time.Before(activities[j].OccursAt.Time)
+		}
+		if activities[i].SortOrder != activities[j].SortOrder {
+			return activities[i].SortOrder < activities[j].SortOrder
+		}
+		return activities[i].ID.String() < activities[j].ID.String()
+	})
+}
+
+// GetTripWithActivitiesPage mirrors the query, by the start and then the id.
+func (s *fakeStore) GetTripWithActivitiesPage(_ context.Context, arg pgstore.GetTripAndActivitiesPageParams) (pgstore.Trip, []pgstore.Activity, int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("GetTripWithActivitiesPage")
+
+	trip, ok := s.trips[arg.ID]
+	if !ok {
+		return pgstore.Trip{}, nil, 0, pgx.ErrNoRows
+	}
+
+	var activities []pgstore.Activity
+	for _, activity := range s.activities {
+		if activity.TripID == arg.ID {
+			activities = append(activities, activity)
+		}
+	}
+	sortActivities(activities)
+
+	total := int64(len(activities))
+	offset := min(int(arg.PageOffset), len(activities))
+	return trip, activities[offset:min(offset+int(arg.PageLimit), len(activities))], total, nil
+}
+
+// SearchActivities mirrors the query, matching the titles ignoring the case, by the start and capped.
+func (s *fakeStore) SearchActivities(_ context.Context, tripID uuid.UUID, text string) ([]pgstore.Activity, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("SearchActivities")
+
+	if _, ok := s.trips[tripID]; !ok {
+		return nil, pgx.ErrNoRows
+	}
+
+	activities := []pgstore.Activity{}
+	for _, activity := range s.activities {
+		if activity.TripID == tripID && strings.Contains(strings.ToLower(activity.Title), strings.ToLower(text)) {
+			activities = append(activities, activity)
+		}
+	}
+	sortActivities(activities)
+	if len(activities) > pgstore.SearchActivitiesLimit {
+		activities = activities[:pgstore.SearchActivitiesLimit]
+	}
+	return activities, nil
+}
+
+func (s *fakeStore) UpdateTrip(_ context.Context, arg pgstore.UpdateTripParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("UpdateTrip")
+
+	trip, found := s.trips[arg.ID]
+	if !found {
+		return pgx.ErrNoRows
+	}
+	trip.Destination = arg.Destination
+	trip.StartsAt = arg.StartsAt
+	trip.EndsAt = arg.EndsAt
+	trip.IsConfirmed = arg.IsConfirmed
+	trip.Notes = arg.Notes
+	trip.Latitude = arg.Latitude
+	trip.Longitude = arg.Longitude
+	trip.MaxParticipants = arg.MaxParticipants
+	trip.UpdatedAt = pgtype.Timestamp{Valid: true, Time: time.Now()}
+	s.trips[arg.ID] = trip
+	return nil
+}
+
+func (s *fakeStore) UpdateTripConfirm(_ context.Context, arg pgstore.UpdateTripConfirmParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("UpdateTripConfirm")
+
+	trip, found := s.trips[arg.ID]
+	// as the update, a trip already in the state asked is left as is.
+	if !found || trip.IsConfirmed == arg.IsConfirmed {
+		return 0, nil
+	}
+	trip.IsConfirmed = arg.IsConfirmed
+	trip.Status = pgstore.TripStatusPlanning
+	if arg.IsConfirmed {
+		trip.Status = pgstore.TripStatusConfirmed
+	}
+	trip.UpdatedAt = pgtype.Timestamp{Valid: true, Time: time.Now()}
+	s.trips[arg.ID] = trip
+	return 1, nil
+}
+
+func (s *fakeStore) UpdateTripOwnerTokenHash(_ context.Context, arg pgstore.UpdateTripOwnerTokenHashParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("UpdateTripOwnerTokenHash")
+
+	trip, found := s.trips[arg.ID]
+	if !found {
+		return pgx.ErrNoRows
+	}
+	trip.OwnerTokenHash = arg.OwnerTokenHash
+	s.trips[arg.ID] = trip
+	return nil
+}
+
+func (s *fakeStore) UpdateTripStatus(_ context.Context, arg pgstore.UpdateTripStatusParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("UpdateTripStatus")
+
+	trip, found := s.trips[arg.ID]
+	if !found {
+		return pgx.ErrNoRows
+	}
+	trip.Status = arg.Status
+	trip.IsConfirmed = arg.Status == pgstore.TripStatusConfirmed || arg.Status == pgstore.TripStatusCompleted
+	trip.UpdatedAt = pgtype.Timestamp{Valid: true, Time: time.Now()}
+	s.trips[arg.ID] = trip
+	return nil
+}
+
+// RescheduleTrip mirrors the transaction, moving nothing when any of the activities is not the trip one.
+func (s *fakeStore) RescheduleTrip(_ context.Context, _ *pgxpool.Pool, arg pgstore.RescheduleTripParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("RescheduleTrip")
+
+	trip, found := s.trips[arg.Trip.ID]
+	if !found {
+		return pgx.ErrNoRows
+	}
+	for _, moved := range arg.Activities {
+		if activity, found := s.activities[moved.ID]; !found || activity.TripID != moved.TripID {
+			return pgx.ErrNoRows
+		}
+	}
+
+	trip.StartsAt = arg.Trip.StartsAt
+	trip.EndsAt = arg.Trip.EndsAt
+	trip.UpdatedAt = pgtype.Timestamp{Valid: true, Time: time.Now()}
+	s.trips[trip.ID] = trip
+	for _, moved := range arg.Activities {
+		activity := s.activities[moved.ID]
+		activity.OccursAt = moved.OccursAt
+		s.activities[moved.ID] = activity
+	}
+	return nil
+}
+
+// TransferTripOwner mirrors the transaction, confirming the participants of the trip with the email of the new
+// owner, or of the previous one when set, seating them when waitlisted and adding them confirmed when there are
+// none. Nothing is moved when the trip has no seat left for them.
+func (s *fakeStore) TransferTripOwner(_ context.Context, _ *pgxpool.Pool, arg pgstore.TransferTripOwnerParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("TransferTripOwner")
+
+	trip, found := s.trips[arg.Owner.ID]
+	if !found {
+		return pgx.ErrNoRows
+	}
+
+	seats, limited, err := s.seatsLeft(trip.ID)
+	if err != nil {
+		return err
+	}
+	emails := []string{arg.Owner.OwnerEmail}
+	if arg.PreviousOwnerEmail != "" {
+		emails = append(emails, arg.PreviousOwnerEmail)
+	}
+	for _, email := range emails {
+		seated := false
+		for _, participant := range s.participants {
+			if participant.TripID == trip.ID && strings.EqualFold(participant.Email, email) && !participant.IsWaitlisted {
+				seated = true
+			}
+		}
+		if !seated {
+			seats--
+		}
+	}
+	if limited && seats < 0 {
+		return pgstore.ErrTripFull
+	}
+
+	trip.OwnerEmail = arg.Owner.OwnerEmail
+	trip.OwnerName = arg.Owner.OwnerName
+	trip.OwnerTokenHash = arg.Owner.OwnerTokenHash
+	trip.UpdatedAt = pgtype.Timestamp{Valid: true, Time: time.Now()}
+	s.trips[trip.ID] = trip
+
+	for _, email := range emails {
+		confirmed := false
+		for id, participant := range s.participants {
+			if participant.TripID == trip.ID && strings.EqualFold(participant.Email, email) {
+				participant.IsConfirmed = true
+				participant.IsWaitlisted = false
+				s.participants[id] = participant
+				confirmed = true
+			}
+		}
+		if !confirmed {
+			participant := pgstore.Participant{
+				ID:           uuid.New(),
+				TripID:       trip.ID,
+				Email:        email,
+				IsConfirmed:  true,
+				InviteStatus: pgstore.InviteStatusPending,
+				InvitedAt:    pgtype.Timestamp{Valid: true, Time: testNow},
+			}
+			s.participants[participant.ID] = participant
+		}
+	}
+	return nil
+}
+
+// DeleteTrip mirrors the transaction, moving the trip along with its activities and links to the soft deleted
+// ones, all stamped at testNow. The participants are kept.
+func (s *fakeStore) DeleteTrip(_ context.Context, _ *pgxpool.Pool, tripID uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("DeleteTrip")
+
+	trip, found := s.trips[tripID]
+	if !found {
+		return pgx.ErrNoRows
+	}
+	deletedAt := pgtype.Timestamp{Valid: true, Time: testNow}
+	for id, activity := range s.activities {
+		if activity.TripID == tripID {
+			activity.DeletedAt = deletedAt
+			s.deletedActivities[id] = activity
+			delete(s.activities, id)
+		}
+	}
+	for id, link := range s.links {
+		if link.TripID == tripID {
+			link.DeletedAt = deletedAt
+			s.deletedLinks[id] = link
+			delete(s.links, id)
+		}
+	}
+	trip.DeletedAt = deletedAt
+	s.deletedTrips[tripID] = trip
+	delete(s.trips, tripID)
+	return nil
+}
+
+func (s *fakeStore) GetDeletedTrip(_ context.Context, id uuid.UUID) (pgstore.Trip, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("GetDeletedTrip")
+
+	trip, found := s.deletedTrips[id]
+	if !found {
+		return pgstore.Trip{}, pgx.ErrNoRows
+	}
+	return trip, nil
+}
+
+// RestoreTrip mirrors the transaction, bringing back the trip along with the activities and links deleted at
+// the same time.
+func (s *fakeStore) RestoreTrip(_ context.Context, _ *pgxpool.Pool, tripID uuid.UUID, deletedAt pgtype.Timestamp) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("RestoreTrip")
+
+	trip, found := s.deletedTrips[tripID]
+	if !found || !trip.DeletedAt.Time.Equal(deletedAt.Time) {
+		return pgx.ErrNoRows
+	}
+	for id, activity := range s.deletedActivities {
+		if activity.TripID == tripID && activity.DeletedAt.Time.Equal(deletedAt.Time) {
+			activity.DeletedAt = pgtype.Timestamp{}
+			s.activities[id] = activity
+			delete(s.deletedActivities, id)
+		}
+	}
+	for id, link := range s.deletedLinks {
+		if link.TripID == tripID && link.DeletedAt.Time.Equal(deletedAt.Time) {
+			link.DeletedAt = pgtype.Timestamp{}
+			s.links[id] = link
+			delete(s.deletedLinks, id)
+		}
+	}
+	trip.DeletedAt = pgtype.Timestamp{}
+	s.trips[tripID] = trip
+	delete(s.deletedTrips, tripID)
+	return nil
+}
+
+// ReorderActivities mirrors the transaction, sorting nothing unless the ids are all the activities of the day in
+// the trip timezone, each once.
+func (s *fakeStore) ReorderActivities(_ context.Context, _ *pgxpool.Pool, arg pgstore.ReorderActivitiesParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("ReorderActivities")
+
+	trip, found := s.trips[arg.TripID]
+	if !found {
+		return pgstore.ErrActivitiesNotOfDay
+	}
+	location, err := time.LoadLocation(trip.Timezone)
+	if err != nil {
+		location = time.UTC
+	}
+
+	ofDay := make(map[uuid.UUID]bool)
+	for _, activity := range s.activities {
+		if activity.TripID == arg.TripID && dayIn(activity.OccursAt.Time.In(location), time.UTC).Equal(arg.Day.Time) {
+			ofDay[activity.ID] = true
+		}
+	}
+	if len(arg.Ids) != len(ofDay) {
+		return pgstore.ErrActivitiesNotOfDay
+	}
+	for _, id := range arg.Ids {
+		if !ofDay[id] {
+			return pgstore.ErrActivitiesNotOfDay
+		}
+		delete(ofDay, id)
+	}
+
+	for index, id := range arg.Ids {
+		activity := s.activities[id]
+		activity.SortOrder = int32(index)
+		s.activities[id] = activity
+	}
+	return nil
+}
+
+func (s *fakeStore) GetParticipant(_ context.Context, id uuid.UUID) (pgstore.Participant, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("GetParticipant")
+
+	participant, found := s.participants[id]
+	if _, deleted := s.deletedTrips[participant.TripID]; !found || deleted {
+		return pgstore.Participant{}, pgx.ErrNoRows
+	}
+	return participant, nil
+}
+
+func (s *fakeStore) GetParticipantForTrip(_ context.Context, arg pgstore.GetParticipantForTripParams) (pgstore.Participant, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("GetParticipantForTrip")
+
+	participant, found := s.participants[arg.ID]
+	if !found || participant.TripID != arg.TripID {
+		return pgstore.Participant{}, pgx.ErrNoRows
+	}
+	return participant, nil
+}
+
+func (s *fakeStore) GetParticipants(_ context.Context, tripID uuid.UUID) ([]pgstore.Participant, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("GetParticipants")
+
+	var participants []pgstore.Participant
+	for _, participant := range s.participants {
+		if participant.TripID == tripID {
+			participants = append(participants, participant)
+		}
+	}
+	return participants, nil
+}
+
+// GetAdminParticipants mirrors the query, filtering on the confirmation and the invite time and ordering by the
+// invite.
+func (s *fakeStore) GetAdminParticipants(_ context.Context, arg pgstore.GetAdminParticipantsParams) ([]pgstore.GetAdminParticipantsRow, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("GetAdminParticipants")
+
+	rows := s.adminParticipants(arg.IsConfirmed, arg.InvitedBefore)
+	offset := min(int(arg.PageOffset), len(rows))
+	end := min(offset+int(arg.PageLimit), len(rows))
+	return rows[offset:end], nil
+}
+
+func (s *fakeStore) CountAdminParticipants(_ context.Context, arg pgstore.CountAdminParticipantsParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("CountAdminParticipants")
+
+	return int64(len(s.adminParticipants(arg.IsConfirmed, arg.InvitedBefore))), nil
+}
+
+// adminParticipants are the participants of all the trips passing the filters set, from the oldest invite. The
+// caller holds the lock.
+func (s *fakeStore) adminParticipants(isConfirmed pgtype.Bool, invitedBefore pgtype.Timestamp) []pgstore.GetAdminParticipantsRow {
+	var rows []pgstore.GetAdminParticipantsRow
+	for _, participant := range s.participants {
+		trip, found := s.trips[participant.TripID]
+		if !found {
+			continue
+		}
+		if isConfirmed.Valid && participant.IsConfirmed != isConfirmed.Bool {
+			continue
+		}
+		if invitedBefore.Valid && !participant.InvitedAt.Time.Before(invitedBefore.Time) {
+			continue
+		}
+		rows = append(rows, pgstore.GetAdminParticipantsRow{
+			ID:              participant.ID,
+			TripID:          participant.TripID,
+			Email:           participant.Email,
+			IsConfirmed:     participant.IsConfirmed,
+			InviteStatus:    participant.InviteStatus,
+			InvitedAt:       participant.InvitedAt,
+			TripDestination: trip.Destination,
+		})
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		if !rows[i].InvitedAt.Time.Equal(rows[j].InvitedAt.Time) {
+			return rows[i].InvitedAt.Time.Before(rows[j].InvitedAt.Time)
+		}
+		return rows[i].ID.String() < rows[j].ID.String()
+	})
+	return rows
+}
+
+// CountPendingInvitesByEmail mirrors the query, matching the lower-cased emails.
+func (s *fakeStore) CountPendingInvitesByEmail(_ context.Context, email string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("CountPendingInvitesByEmail")
+
+	var total int64
+	for _, participant := range s.participants {
+		if _, deleted := s.deletedTrips[participant.TripID]; deleted {
+			continue
+		}
+		if strings.ToLower(participant.Email) == email && !participant.IsConfirmed {
+			total++
+		}
+	}
+	return total, nil
+}
+
+func (s *fakeStore) GetParticipantsSummary(_ context.Context, tripID uuid.UUID) (pgstore.GetParticipantsSummaryRow, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("GetParticipantsSummary")
+
+	summary := pgstore.GetParticipantsSummaryRow{PendingEmails: []string{}}
+	for _, participant := range s.participants {
+		if participant.TripID != tripID {
+			continue
+		}
+		summary.Total++
+		switch {
+		case participant.IsConfirmed:
+			summary.Confirmed++
+		case participant.IsWaitlisted:
+			summary.Waitlisted++
+		default:
+			summary.Pending++
+			summary.PendingEmails = append(summary.PendingEmails, participant.Email)
+		}
+	}
+	// as the query, the pending emails are in alphabetical order.
+	sort.Strings(summary.PendingEmails)
+	return summary, nil
+}
+
+func (s *fakeStore) GetTripWithParticipants(_ context.Context, tripID uuid.UUID) (pgstore.Trip, []pgstore.Participant, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("GetTripWithParticipants")
+
+	trip, ok := s.trips[tripID]
+	if !ok {
+		return pgstore.Trip{}, nil, pgx.ErrNoRows
+	}
+
+	var participants []pgstore.Participant
+	for _, participant := range s.participants {
+		if participant.TripID == tripID {
+			participants = append(participants, participant)
+		}
+	}
+	return trip, participants, nil
+}
+
+func (s *fakeStore) GetTripWithParticipantsPage(_ context.Context, arg pgstore.GetTripAndParticipantsPageParams) (pgstore.Trip, []pgstore.Participant, int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("GetTripWithParticipantsPage")
+
+	trip, ok := s.trips[arg.ID]
+	if !ok {
+		return pgstore.Trip{}, nil, 0, pgx.ErrNoRows
+	}
+
+	var participants []pgstore.Participant
+	for _, participant := range s.participants {
+		if participant.TripID == arg.ID {
+			participants = append(participants, participant)
+		}
+	}
+	sort.Slice(participants, func(i, j int) bool { return participants[i].Email < participants[j].Email })
+
+	total := int64(len(participants))
+	offset := min(int(arg.PageOffset), len(participants))
+	return trip, participants[offset:min(offset+int(arg.PageLimit), len(participants))], total, nil
+}
+
+func (s *fakeStore) ConfirmParticipant(_ context.Context, arg pgstore.ConfirmParticipantParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("ConfirmParticipant")
+
+	participant, found := s.participants[arg.ID]
+	if !found {
+		return pgx.ErrNoRows
+	}
+	participant.IsConfirmed = arg.IsConfirmed
+	s.participants[arg.ID] = participant
+	return nil
+}
+
+func (s *fakeStore) ConfirmParticipantsBatch(_ context.Context, _ *pgxpool.Pool, arg pgstore.ConfirmTripParticipantsParams) ([]pgstore.Participant, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("ConfirmParticipantsBatch")
+
+	var participants []pgstore.Participant
+	for _, id := range arg.Ids {
+		participant, found := s.participants[id]
+		if !found || participant.TripID != arg.TripID {
+			continue
+		}
+		participants = append(participants, participant)
+		participant.IsConfirmed = true
+		s.participants[id] = participant
+	}
+	return participants, nil
+}
+
+func (s *fakeStore) CreateTrip(_ context.Context, _ *pgxpool.Pool, params spec.CreateTripRequest, ownerTokenHash string) (uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("CreateTrip")
+
+	trip := pgstore.Trip{
+		ID:             uuid.New(),
+		Destination:    params.Destination,
+		OwnerEmail:     string(params.OwnerEmail),
+		OwnerName:      params.OwnerName,
+		StartsAt:       pgtype.Timestamp{Valid: true, Time: params.StartsAt},
+		EndsAt:         pgtype.Timestamp{Valid: true, Time: params.EndsAt},
+		OwnerTokenHash: ownerTokenHash,
+		Timezone:       pgstore.DefaultTripTimezone,
+		Status:         pgstore.TripStatusPlanning,
+		UpdatedAt:      pgtype.Timestamp{Valid: true, Time: time.Now()},
+	}
+	if params.Timezone != nil && *params.Timezone != "" {
+		trip.Timezone = *params.Timezone
+	}
+	if params.Notes != nil && *params.Notes != "" {
+		trip.Notes = pgtype.Text{String: *params.Notes, Valid: true}
+	}
+	if params.Latitude != nil && params.Longitude != nil {
+		trip.Latitude = pgtype.Float8{Float64: *params.Latitude, Valid: true}
+		trip.Longitude = pgtype.Float8{Float64: *params.Longitude, Valid: true}
+	}
+	if params.MaxParticipants != nil {
+		trip.MaxParticipants = pgtype.Int4{Int32: int32(*params.MaxParticipants), Valid: true}
+	}
+	s.trips[trip.ID] = trip
+	for index, email := range params.EmailsToInvite {
+		participant := pgstore.Participant{
+			ID:           uuid.New(),
+			TripID:       trip.ID,
+			Email:        string(email),
+			InvitedAt:    pgtype.Timestamp{Valid: true, Time: testNow},
+			IsWaitlisted: trip.MaxParticipants.Valid && index >= int(trip.MaxParticipants.Int32),
+		}
+		s.participants[participant.ID] = participant
+	}
+	return trip.ID, nil
+}
+
+func (s *fakeStore) CloneTrip(_ context.Context, _ *pgxpool.Pool, params pgstore.CloneTripParams) (uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("CloneTrip")
+
+	trip := pgstore.Trip{
+		ID:              uuid.New(),
+		Destination:     params.Trip.Destination,
+		OwnerEmail:      params.Trip.OwnerEmail,
+		OwnerName:       params.Trip.OwnerName,
+		StartsAt:        params.Trip.StartsAt,
+		EndsAt:          params.Trip.EndsAt,
+		OwnerTokenHash:  params.Trip.OwnerTokenHash,
+		Timezone:        params.Trip.Timezone,
+		Notes:           params.Trip.Notes,
+		Latitude:        params.Trip.Latitude,
+		Longitude:       params.Trip.Longitude,
+		MaxParticipants: params.Trip.MaxParticipants,
+		Status:          pgstore.TripStatusPlanning,
+		UpdatedAt:       pgtype.Timestamp{Valid: true, Time: time.Now()},
+	}
+	s.trips[trip.ID] = trip
+	for _, arg := range params.Activities {
+		activity := pgstore.Activity{
+			ID:              uuid.New(),
+			TripID:          trip.ID,
+			Title:           arg.Title,
+			OccursAt:        arg.OccursAt,
+			DurationMinutes: arg.DurationMinutes,
+			Notes:           arg.Notes,
+		}
+		s.activities[activity.ID] = activity
+	}
+	for _, arg := range params.Links {
+		link := pgstore.Link{ID: uuid.New(), TripID: trip.ID, Title: arg.Title, Url: arg.Url}
+		s.links[link.ID] = link
+	}
+	return trip.ID, nil
+}
+
+func (s *fakeStore) CreateActivity(_ context.Context, arg pgstore.CreateActivityParams) (uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("CreateActivity")
+
+	activity := pgstore.Activity{
+		ID:              uuid.New(),
+		TripID:          arg.TripID,
+		Title:           arg.Title,
+		OccursAt:        arg.OccursAt,
+		DurationMinutes: arg.DurationMinutes,
+		Notes:           arg.Notes,
+	}
+	s.activities[activity.ID] = activity
+	return activity.ID, nil
+}
+
+func (s *fakeStore) CreateActivities(_ context.Context, _ *pgxpool.Pool, arg []pgstore.CreateActivityParams) ([]uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("CreateActivities")
+
+	ids := make([]uuid.UUID, len(arg))
+	for i, params := range arg {
+		activity := pgstore.Activity{
+			ID:              uuid.New(),
+			TripID:          params.TripID,
+			Title:           params.Title,
+			OccursAt:        params.OccursAt,
+			DurationMinutes: params.DurationMinutes,
+			Notes:           params.Notes,
+		}
+		s.activities[activity.ID] = activity
+		ids[i] = activity.ID
+	}
+	return ids, nil
+}
+
+func (s *fakeStore) CreateTripLink(_ context.Context, arg pgstore.CreateTripLinkParams) (uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("CreateTripLink")
+
+	// mirrors the unique index on the trip and the url.
+	for _, link := range s.links {
+		if link.TripID == arg.TripID && link.Url == arg.Url {
+			return uuid.UUID{}, &pgconn.PgError{Code: "23505", ConstraintName: "links_trip_id_url_key"}
+		}
+	}
+
+	link := pgstore.Link{
+		ID:     uuid.New(),
+		TripID: arg.TripID,
+		Title:  arg.Title,
+		Url:    arg.Url,
+	}
+	s.links[link.ID] = link
+	return link.ID, nil
+}
+
+func (s *fakeStore) GetTripWithLinks(_ context.Context, tripID uuid.UUID) (pgstore.Trip, []pgstore.Link, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("GetTripWithLinks")
+
+	trip, ok := s.trips[tripID]
+	if !ok {
+		return pgstore.Trip{}, nil, pgx.ErrNoRows
+	}
+
+	var links []pgstore.Link
+	for _, link := range s.links {
+		if link.TripID == tripID {
+			links = append(links, link)
+		}
+	}
+	return trip, links, nil
+}
+
+// seatsLeft is the seats of the trip counted as the store does under its lock, s.mu held.
+func (s *fakeStore) seatsLeft(tripID uuid.UUID) (int, bool, error) {
+	trip, found := s.trips[tripID]
+	if !found {
+		return 0, false, pgx.ErrNoRows
+	}
+	if !trip.MaxParticipants.Valid {
+		return 0, false, nil
+	}
+
+	seated := 0
+	for _, participant := range s.participants {
+		if participant.TripID == tripID && !participant.IsWaitlisted {
+			seated++
+		}
+	}
+	return max(int(trip.MaxParticipants.Int32)-seated, 0), true, nil
+}
+
+func (s *fakeStore) InviteParticipantsWithinCapacity(_ context.Context, _ *pgxpool.Pool, arg []pgstore.InviteParticipantsToTripParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("InviteParticipantsWithinCapacity")
+
+	if len(arg) == 0 {
+		return 0, nil
+	}
+	seats, limited, err := s.seatsLeft(arg[0].TripID)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, invite := range arg {
+		invite.IsWaitlisted = limited && i >= seats
+		participant := pgstore.Participant{
+			ID:           uuid.New(),
+			TripID:       invite.TripID,
+			Email:        invite.Email,
+			InviteStatus: pgstore.InviteStatusPending,
+			InvitedAt:    pgtype.Timestamp{Valid: true, Time: testNow},
+			IsWaitlisted: invite.IsWaitlisted,
+			Name:         invite.Name,
+			Phone:        invite.Phone,
+		}
+		s.participants[participant.ID] = participant
+	}
+	return int64(len(arg)), nil
+}
+
+func (s *fakeStore) UpdateParticipantEmail(_ context.Context, arg pgstore.UpdateParticipantEmailParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("UpdateParticipantEmail")
+
+	participant := s.participants[arg.ID]
+	participant.Email = arg.Email
+	participant.InviteStatus = pgstore.InviteStatusPending
+	participant.InviteLastAttemptAt = pgtype.Timestamp{}
+	s.participants[arg.ID] = participant
+	return nil
+}
+
+func (s *fakeStore) DeleteParticipant(_ context.Context, arg pgstore.DeleteParticipantParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("DeleteParticipant")
+
+	participant, found := s.participants[arg.ID]
+	if !found || participant.TripID != arg.TripID {
+		return 0, nil
+	}
+	delete(s.participants, arg.ID)
+	return 1, nil
+}
+
+func (s *fakeStore) PromoteWaitlistedParticipant(_ context.Context, _ *pgxpool.Pool, arg pgstore.PromoteParticipantParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("PromoteWaitlistedParticipant")
+
+	seats, limited, err := s.seatsLeft(arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	if limited && seats == 0 {
+		return 0, pgstore.ErrTripFull
+	}
+
+	participant, found := s.participants[arg.ID]
+	if !found || participant.TripID != arg.TripID || !participant.IsWaitlisted {
+		return 0, nil
+	}
+	participant.IsWaitlisted = false
+	participant.InviteStatus = pgstore.InviteStatusPending
+	participant.InviteLastAttemptAt = pgtype.Timestamp{}
+	s.participants[arg.ID] = participant
+	return 1, nil
+}
+
+func (s *fakeStore) ClaimIdempotencyKey(_ context.Context, arg pgstore.ClaimIdempotencyKeyParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("ClaimIdempotencyKey")
+
+	// as the upsert, an existing key is only replaced once expired.
+	if existing, ok := s.keys[arg.Key]; ok && !existing.CreatedAt.Time.Before(arg.ExpiredBefore.Time) {
+		return 0, nil
+	}
+	s.keys[arg.Key] = pgstore.IdempotencyKey{
+		Key:         arg.Key,
+		RequestHash: arg.RequestHash,
+		CreatedAt:   arg.CreatedAt,
+	}
+	return 1, nil
+}
+
+func (s *fakeStore) GetIdempotencyKey(_ context.Context, key string) (pgstore.IdempotencyKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("GetIdempotencyKey")
+
+	existing, ok := s.keys[key]
+	if !ok {
+		return pgstore.IdempotencyKey{}, pgx.ErrNoRows
+	}
+	return existing, nil
+}
+
+func (s *fakeStore) CompleteIdempotencyKey(_ context.Context, arg pgstore.CompleteIdempotencyKeyParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("CompleteIdempotencyKey")
+
+	existing, ok := s.keys[arg.Key]
+	if !ok {
+		return nil
+	}
+	existing.TripID = arg.TripID
+	s.keys[arg.Key] = existing
+	return nil
+}
+
+func (s *fakeStore) ReleaseIdempotencyKey(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.called("ReleaseIdempotencyKey")
+
+	if existing, ok := s.keys[key]; ok && !existing.TripID.Valid {
+		delete(s.keys, key)
+	}
+	return nil
+}
+
+// newTestAPI builds the API on the fake store and mailer, with the clock fixed at testNow and the emails
+// sent synchronously.
+func newTestAPI(s store, mailer mailer, opts ...Option) *API {
+	opts = append([]Option{WithClock(fixedClock{testNow}), WithDispatcher(syncDispatcher{})}, opts...)
+	api := NewApi(nil, zap.NewNop(), mailer, opts...)
+	api.store = s
+	return &api
+}
+
+// newTestTrip is a trip starting the day after testNow.
+func newTestTrip(days int) pgstore.Trip {
+	startsAt := testNow.AddDate(0, 0, 1)
+	return pgstore.Trip{
+		ID:             uuid.New(),
+		Destination:    "Florianópolis",
+		OwnerEmail:     "owner@example.com",
+		OwnerName:      "Owner",
+		StartsAt:       pgtype.Timestamp{Valid: true, Time: startsAt},
+		EndsAt:         pgtype.Timestamp{Valid: true, Time: startsAt.AddDate(0, 0, days-1)},
+		OwnerTokenHash: hashOwnerToken(TEST_OWNER_TOKEN),
+		Status:         pgstore.TripStatusPlanning,
+		UpdatedAt:      pgtype.Timestamp{Valid: true, Time: testNow},
+	}
+}
+
+// newRequest builds a request with body, sent as is when a string and encoded as JSON otherwise.
+func newRequest(t *testing.T, method string, target string, body any) *http.Request {
+	t.Helper()
+
+	var reader io.Reader
+	switch value := body.(type) {
+	case nil:
+	case string:
+		reader = bytes.NewBufferString(value)
+	default:
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			t.Fatalf("failed to encode request body: %v", err)
+		}
+		reader = bytes.NewBuffer(encoded)
+	}
+
+	r := httptest.NewRequest(method, target, reader)
+	if reader != nil {
+		r.Header.Set("Content-Type", "application/json")
+	}
+	return r
+}
+
+// withOwnerToken sends the owner token as the Bearer authorization.
+func withOwnerToken(r *http.Request, token string) *http.Request {
+	r.Header.Set("Authorization", "Bearer "+token)
+	return r
+}
+
+// serve runs the request on the spec routes, mounted as the server does.
+func serve(api *API, r *http.Request) *httptest.ResponseRecorder {
+	handler := api.Handler()
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	return w
+}
+
+// decodeResponse decodes the JSON body of the response into v.
+func decodeResponse(t *testing.T, w *httptest.ResponseRecorder, v any) {
+	t.Helper()
+
+	if err := json.Unmarshal(w.Body.Bytes(), v); err != nil {
+		t.Fatalf("failed to decode response body %q: %v", w.Body.String(), err)
+	}
+}
+
+// assertStatus asserts the status of the response.
+func assertStatus(t *testing.T, w *httptest.ResponseRecorder, status int) {
+	t.Helper()
+
+	if w.Code != status {
+		t.Fatalf("expected status %d, got %d: %s", status, w.Code, w.Body.String())
+	}
+}
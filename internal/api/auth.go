@@ -0,0 +1,114 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"journey/internal/i18n"
+	"journey/internal/jwt"
+	"journey/internal/pgstore"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const ownerTokenSize = 32
+
+var (
+	errMissingOwnerToken = errors.New("missing the trip owner token, send it as a Bearer Authorization header")
+	errWrongOwnerToken   = errors.New("the token doesn't belong to the trip owner")
+	errOwnerTokenExpired = errors.New("the trip owner token expired, ask a new one through a magic link")
+)
+
+// WithOwnerTokens overrides the issuer of the owner JWTs, read from JOURNEY_JWT_SECRET and JOURNEY_JWT_TTL by
+// default.
+func WithOwnerTokens(issuer jwt.Issuer) Option {
+	return func(api *API) {
+		api.ownerTokens = issuer
+	}
+}
+
+// generateOwnerToken is a random secret for the owner of a new trip, and the hash of it to be stored.
+func generateOwnerToken() (token string, hash string, err error) {
+	secret := make([]byte, ownerTokenSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", fmt.Errorf("failed to generate the owner token: %w", err)
+	}
+
+	token = base64.RawURLEncoding.EncodeToString(secret)
+	return token, hashOwnerToken(token), nil
+}
+
+func hashOwnerToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// bearerToken is the token of the Bearer Authorization header, empty when there is none.
+func bearerToken(r *http.Request) string {
+	scheme, token, found := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
+
+// authorizeTripOwner checks the request carries an owner token of the trip, answering errMissingOwnerToken
+// (401), errOwnerTokenExpired or errWrongOwnerToken (403) otherwise. The token is either a JWT issued for the
+// trip and its current owner, or the opaque token returned before the JWTs were enabled.
+func (api *API) authorizeTripOwner(r *http.Request, trip pgstore.Trip) error {
+	token := bearerToken(r)
+	if token == "" {
+		return errMissingOwnerToken
+	}
+
+	if api.ownerTokens.Enabled() && jwt.LooksLikeJWT(token) {
+		claims, err := api.ownerTokens.Verify(jwt.ScopeOwner, token, api.clock.Now())
+		if errors.Is(err, jwt.ErrExpired) {
+			return errOwnerTokenExpired
+		}
+		// bound to the owner email, a token issued before the trip was transferred no longer changes it.
+		if err != nil || claims.TripID != trip.ID || normalizeEmail(claims.OwnerEmail) != normalizeEmail(trip.OwnerEmail) {
+			return errWrongOwnerToken
+		}
+		return nil
+	}
+
+	// trips created before the owner tokens have no hash, only a JWT or a token issued by the admin changes them.
+	if trip.OwnerTokenHash == "" || subtle.ConstantTimeCompare([]byte(hashOwnerToken(token)), []byte(trip.OwnerTokenHash)) != 1 {
+		return errWrongOwnerToken
+	}
+
+	return nil
+}
+
+// refusedOwnerTokenKey is the message of an owner token refused with a 403 by authorizeTripOwner.
+func refusedOwnerTokenKey(err error) i18n.Key {
+	if errors.Is(err, errOwnerTokenExpired) {
+		return i18n.OwnerTokenExpired
+	}
+	return i18n.WrongOwnerToken
+}
+
+// issueOwnerToken is the token answered to the owner of the trip: a JWT bound to the trip and ownerEmail when
+// they are enabled, the opaque token otherwise. The opaque token also answers a JWT failing to be issued, it
+// authorizes the owner all the same.
+func (api *API) issueOwnerToken(ctx context.Context, tripID uuid.UUID, ownerEmail string, opaqueToken string) string {
+	if !api.ownerTokens.Enabled() {
+		return opaqueToken
+	}
+
+	token, _, err := api.ownerTokens.Issue(jwt.ScopeOwner, tripID, normalizeEmail(ownerEmail), api.clock.Now())
+	if err != nil {
+		api.loggerFor(ctx).Error("failed to issue the owner JWT, answering the opaque token", zap.Error(err), zap.String("tripID", tripID.String()))
+		return opaqueToken
+	}
+	return token
+}
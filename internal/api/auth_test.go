@@ -0,0 +1,125 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestMutatingTripRoutesRequireTheOwnerToken(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	activity := store.addActivity(trip.ID, "Museum", trip.StartsAt.Time.Add(time.Hour))
+	participant := store.addParticipant(trip.ID, "checked-in@example.com")
+	api := newTestAPI(store, &fakeMailer{})
+	tripPath := "/trips/" + trip.ID.String()
+
+	routes := []struct {
+		name    string
+		method  string
+		target  string
+		body    any
+		success int
+	}{
+		{
+			name:   "update trip",
+			method: http.MethodPut,
+			target: tripPath,
+			body: map[string]any{
+				"destination": "Florianópolis",
+				"starts_at":   trip.StartsAt.Time,
+				"ends_at":     trip.EndsAt.Time,
+			},
+			success: http.StatusNoContent,
+		},
+		{
+			name:    "reorder activities",
+			method:  http.MethodPut,
+			target:  tripPath + "/activities/order",
+			body:    map[string]any{"day": activity.OccursAt.Time.Format(time.DateOnly), "activity_ids": []string{activity.ID.String()}},
+			success: http.StatusNoContent,
+		},
+		{
+			name:    "create activity",
+			method:  http.MethodPost,
+			target:  tripPath + "/activities",
+			body:    map[string]any{"title": "Museum", "occurs_at": trip.StartsAt.Time.Add(time.Hour)},
+			success: http.StatusCreated,
+		},
+		{
+			name:    "mark activity done",
+			method:  http.MethodPatch,
+			target:  tripPath + "/activities/" + activity.ID.String() + "/done",
+			body:    map[string]any{"is_done": true},
+			success: http.StatusOK,
+		},
+		{
+			name:    "invite participant",
+			method:  http.MethodPost,
+			target:  tripPath + "/invites",
+			body:    map[string]any{"email": "guest@example.com"},
+			success: http.StatusCreated,
+		},
+		{
+			name:    "confirm participants",
+			method:  http.MethodPatch,
+			target:  tripPath + "/participants/confirm/batch",
+			body:    map[string]any{"participant_ids": []string{participant.ID.String()}},
+			success: http.StatusOK,
+		},
+		{
+			name:    "create link",
+			method:  http.MethodPost,
+			target:  tripPath + "/links",
+			body:    map[string]any{"title": "Booking", "url": "https://example.com/booking"},
+			success: http.StatusCreated,
+		},
+	}
+
+	for _, route := range routes {
+		t.Run(route.name+" without token", func(t *testing.T) {
+			w := serve(api, newRequest(t, route.method, route.target, route.body))
+
+			assertStatus(t, w, http.StatusUnauthorized)
+		})
+
+		t.Run(route.name+" with wrong token", func(t *testing.T) {
+			w := serve(api, withOwnerToken(newRequest(t, route.method, route.target, route.body), "wrong-token"))
+
+			assertStatus(t, w, http.StatusForbidden)
+		})
+
+		t.Run(route.name+" with owner token", func(t *testing.T) {
+			w := serve(api, withOwnerToken(newRequest(t, route.method, route.target, route.body), TEST_OWNER_TOKEN))
+
+			assertStatus(t, w, route.success)
+		})
+	}
+}
+
+func TestTripsWithoutOwnerTokenHashRejectAnyToken(t *testing.T) {
+	store := newFakeStore()
+	legacy := newTestTrip(3)
+	legacy.OwnerTokenHash = ""
+	trip := store.addTrip(legacy)
+	api := newTestAPI(store, &fakeMailer{})
+
+	r := newRequest(t, http.MethodPost, "/trips/"+trip.ID.String()+"/links", map[string]any{"title": "Booking", "url": "https://example.com"})
+	w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+	assertStatus(t, w, http.StatusForbidden)
+}
+
+func TestGenerateOwnerToken(t *testing.T) {
+	token, hash, err := generateOwnerToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if token == "" || hash != hashOwnerToken(token) {
+		t.Fatalf("expected the hash of the token, got token %q and hash %q", token, hash)
+	}
+	if other, _, _ := generateOwnerToken(); other == token {
+		t.Fatal("expected a random token on each generation")
+	}
+}
@@ -0,0 +1,72 @@
+package api
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"journey/cmd/journey/config"
+	"journey/internal/i18n"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/render"
+)
+
+// DEFAULT_MAX_BODY_BYTES is the largest request body read when JOURNEY_MAX_BODY_BYTES is missing.
+const DEFAULT_MAX_BODY_BYTES = 1 << 20
+
+// GetMaxBodyBytes reads JOURNEY_MAX_BODY_BYTES, falling back to the default when missing or invalid.
+func GetMaxBodyBytes() int64 {
+	if value, err := config.GetSpecificEnvironmentVariable("JOURNEY_MAX_BODY_BYTES"); err == nil {
+		if maxBytes, err := strconv.ParseInt(value, 10, 64); err == nil && maxBytes > 0 {
+			return maxBytes
+		}
+	}
+	return DEFAULT_MAX_BODY_BYTES
+}
+
+// WithMaxBodyBytes overrides the largest request body read.
+func WithMaxBodyBytes(maxBytes int64) Option {
+	return func(api *API) {
+		api.maxBodyBytes = maxBytes
+	}
+}
+
+// limitBodies answers a 413 REQUEST_TOO_LARGE to the POST, PUT and PATCH requests whose body is over
+// maxBodyBytes. The body is read up front, so an oversized one is refused before the handler reaches the
+// store, most of them reading the trip before decoding it.
+func (api *API) limitBodies(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost, http.MethodPut, http.MethodPatch:
+		default:
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if r.ContentLength > api.maxBodyBytes {
+			api.tooLarge(w, r)
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, api.maxBodyBytes))
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				api.tooLarge(w, r)
+				return
+			}
+			render.Status(r, http.StatusBadRequest)
+			render.JSON(w, r, api.badRequest(r, i18n.InvalidRequest, err.Error()))
+			return
+		}
+
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (api *API) tooLarge(w http.ResponseWriter, r *http.Request) {
+	render.Status(r, http.StatusRequestEntityTooLarge)
+	render.JSON(w, r, api.badRequest(r, i18n.RequestTooLarge, api.maxBodyBytes))
+}
@@ -0,0 +1,49 @@
+package api
+
+import (
+	"journey/internal/api/spec"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestBodiesOverTheLimit(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	api := newTestAPI(store, &fakeMailer{}, WithMaxBodyBytes(256))
+
+	body := map[string]any{
+		"title":     strings.Repeat("a", 512),
+		"occurs_at": trip.StartsAt.Time,
+	}
+
+	t.Run("with a content length", func(t *testing.T) {
+		r := newRequest(t, http.MethodPost, "/trips/"+trip.ID.String()+"/activities", body)
+		w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+		assertStatus(t, w, http.StatusRequestEntityTooLarge)
+		var response spec.BadRequest
+		decodeResponse(t, w, &response)
+		if response.Code != string(ErrorCodeRequestTooLarge) {
+			t.Fatalf("expected the code %s, got %q", ErrorCodeRequestTooLarge, response.Code)
+		}
+	})
+
+	t.Run("streamed", func(t *testing.T) {
+		r := newRequest(t, http.MethodPost, "/trips/"+trip.ID.String()+"/activities", body)
+		r.ContentLength = -1
+		w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+		assertStatus(t, w, http.StatusRequestEntityTooLarge)
+	})
+
+	if calls := store.totalCalls(); calls != 0 {
+		t.Fatalf("expected the oversized bodies refused before the store, got %d calls", calls)
+	}
+
+	r := newRequest(t, http.MethodPost, "/trips/"+trip.ID.String()+"/activities", map[string]any{
+		"title":     "Trilha",
+		"occurs_at": trip.StartsAt.Time,
+	})
+	assertStatus(t, serve(api, withOwnerToken(r, TEST_OWNER_TOKEN)), http.StatusCreated)
+}
@@ -0,0 +1,146 @@
+package api
+
+import (
+	"journey/internal/calendar"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// calendarComponent is a parsed BEGIN/END block, with its properties by name.
+type calendarComponent struct {
+	name       string
+	properties map[string][]string
+	children   []*calendarComponent
+}
+
+// parseCalendar unfolds the CRLF lines and parses the components, failing on malformed content.
+func parseCalendar(t *testing.T, body string) *calendarComponent {
+	t.Helper()
+
+	if !strings.HasSuffix(body, "\r\n") {
+		t.Fatal("expected the calendar lines to be CRLF terminated")
+	}
+
+	var lines []string
+	for _, raw := range strings.Split(strings.TrimSuffix(body, "\r\n"), "\r\n") {
+		if len(raw) > calendar.LineLimit {
+			t.Fatalf("expected the lines folded at %d octets, got %q", calendar.LineLimit, raw)
+		}
+		if strings.HasPrefix(raw, " ") && len(lines) > 0 {
+			lines[len(lines)-1] += raw[1:]
+			continue
+		}
+		lines = append(lines, raw)
+	}
+
+	var stack []*calendarComponent
+	var root *calendarComponent
+	for _, line := range lines {
+		name, value, found := strings.Cut(line, ":")
+		if !found {
+			t.Fatalf("expected a property, got %q", line)
+		}
+		name, _, _ = strings.Cut(name, ";")
+
+		switch name {
+		case "BEGIN":
+			component := &calendarComponent{name: value, properties: make(map[string][]string)}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, component)
+			} else if root != nil {
+				t.Fatal("expected a single root component")
+			} else {
+				root = component
+			}
+			stack = append(stack, component)
+		case "END":
+			if len(stack) == 0 || stack[len(stack)-1].name != value {
+				t.Fatalf("unexpected END:%s", value)
+			}
+			stack = stack[:len(stack)-1]
+		default:
+			if len(stack) == 0 {
+				t.Fatalf("expected %q inside a component", line)
+			}
+			current := stack[len(stack)-1]
+			current.properties[name] = append(current.properties[name], value)
+		}
+	}
+
+	if root == nil || len(stack) != 0 {
+		t.Fatal("expected the components to be balanced")
+	}
+	return root
+}
+
+func TestGetTripsTripIDActivitiesICS(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	museum := store.addActivity(trip.ID, "Museum, then lunch; maybe", trip.StartsAt.Time.Add(2*time.Hour))
+	museum.DurationMinutes = 150
+	store.activities[museum.ID] = museum
+	beach := store.addActivity(trip.ID, strings.Repeat("Praia do Campeche ", 8), trip.StartsAt.Time.AddDate(0, 0, 1))
+	api := newTestAPI(store, &fakeMailer{})
+
+	w := serve(api, newRequest(t, http.MethodGet, "/trips/"+trip.ID.String()+"/activities.ics", nil))
+
+	assertStatus(t, w, http.StatusOK)
+	if contentType := w.Header().Get("Content-Type"); !strings.HasPrefix(contentType, "text/calendar") {
+		t.Fatalf("expected a text/calendar content type, got %q", contentType)
+	}
+
+	feed := parseCalendar(t, w.Body.String())
+	if feed.name != "VCALENDAR" || feed.properties["VERSION"][0] != "2.0" {
+		t.Fatalf("expected a 2.0 VCALENDAR, got %s %v", feed.name, feed.properties)
+	}
+
+	eventsByUID := make(map[string]int)
+	for _, event := range feed.children {
+		if event.name != "VEVENT" {
+			t.Fatalf("expected only VEVENT components, got %s", event.name)
+		}
+		if len(event.properties["UID"]) != 1 || len(event.properties["DTSTART"]) != 1 || len(event.properties["DTSTAMP"]) != 1 {
+			t.Fatalf("expected a single UID, DTSTART and DTSTAMP, got %v", event.properties)
+		}
+		if event.properties["LOCATION"][0] != trip.Destination {
+			t.Fatalf("expected the destination as LOCATION, got %v", event.properties["LOCATION"])
+		}
+		eventsByUID[event.properties["UID"][0]]++
+	}
+
+	if len(feed.children) != 3 {
+		t.Fatalf("expected the trip and 2 activities events, got %d", len(feed.children))
+	}
+	for _, id := range []uuid.UUID{trip.ID, museum.ID, beach.ID} {
+		if eventsByUID[id.String()+"@journey"] != 1 {
+			t.Fatalf("expected exactly one VEVENT for %s, got %d", id, eventsByUID[id.String()+"@journey"])
+		}
+	}
+
+	for _, event := range feed.children {
+		if event.properties["UID"][0] == museum.ID.String()+"@journey" {
+			if summary := event.properties["SUMMARY"][0]; summary != `Museum\, then lunch\; maybe` {
+				t.Fatalf("expected the summary escaped, got %q", summary)
+			}
+			if dtstart := event.properties["DTSTART"][0]; dtstart != museum.OccursAt.Time.UTC().Format(calendar.DateTimeLayout) {
+				t.Fatalf("expected DTSTART at the activity time, got %q", dtstart)
+			}
+			if dtend := event.properties["DTEND"][0]; dtend != museum.OccursAt.Time.Add(150*time.Minute).UTC().Format(calendar.DateTimeLayout) {
+				t.Fatalf("expected DTEND after the activity duration, got %q", dtend)
+			}
+		}
+	}
+}
+
+func TestGetTripsTripIDActivitiesICSNotFound(t *testing.T) {
+	api := newTestAPI(newFakeStore(), &fakeMailer{})
+
+	w := serve(api, newRequest(t, http.MethodGet, "/trips/"+uuid.NewString()+"/activities.ics", nil))
+
+	assertStatus(t, w, http.StatusNotFound)
+}
@@ -0,0 +1,62 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestPostTripsRejectsStartBeforeClockNow(t *testing.T) {
+	api := newTestAPI(newFakeStore(), &fakeMailer{})
+	yesterday := testNow.AddDate(0, 0, -1)
+
+	w := serve(api, newRequest(t, http.MethodPost, "/trips", map[string]any{
+		"destination":      "Florianópolis",
+		"owner_name":       "Owner",
+		"owner_email":      "owner@example.com",
+		"emails_to_invite": []string{},
+		"starts_at":        yesterday,
+		"ends_at":          yesterday.AddDate(0, 0, 3),
+	}))
+
+	assertStatus(t, w, http.StatusBadRequest)
+}
+
+func TestPutTripsTripIDRejectsStartBeforeClockNow(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	api := newTestAPI(store, &fakeMailer{})
+	yesterday := testNow.AddDate(0, 0, -1)
+
+	r := newRequest(t, http.MethodPut, "/trips/"+trip.ID.String(), map[string]any{
+		"destination": "Florianópolis",
+		"starts_at":   yesterday,
+		"ends_at":     yesterday.AddDate(0, 0, 3),
+	})
+	w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+	assertStatus(t, w, http.StatusBadRequest)
+	if store.callsOf("UpdateTrip") != 0 {
+		t.Fatal("expected the trip not to be updated")
+	}
+}
+
+func TestPutTripsTripIDFollowsTheClockNotTheRealTime(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	// the clock is years ahead of the real time, a start only a second after it is accepted.
+	api := newTestAPI(store, &fakeMailer{})
+	startsAt := testNow.Add(time.Second)
+
+	r := newRequest(t, http.MethodPut, "/trips/"+trip.ID.String(), map[string]any{
+		"destination": "Florianópolis",
+		"starts_at":   startsAt,
+		"ends_at":     startsAt.AddDate(0, 0, 3),
+	})
+	w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+	assertStatus(t, w, http.StatusNoContent)
+	if !store.trip(trip.ID).StartsAt.Time.Equal(startsAt) {
+		t.Fatalf("expected the trip to start at %v, got %v", startsAt, store.trip(trip.ID).StartsAt.Time)
+	}
+}
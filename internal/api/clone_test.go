@@ -0,0 +1,106 @@
+package api
+
+import (
+	"journey/internal/api/spec"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestPostTripsTripIDClone(t *testing.T) {
+	store := newFakeStore()
+	source := store.addTrip(newTestTrip(3))
+	store.addActivity(source.ID, "Trilha", source.StartsAt.Time.Add(26*time.Hour))
+	store.addLink(source.ID, "Reserva", "https://example.com/booking")
+	store.addParticipant(source.ID, "guest@example.com")
+	mailer := &fakeMailer{}
+	api := newTestAPI(store, mailer)
+
+	startsAt := source.StartsAt.Time.AddDate(0, 1, 0)
+	r := newRequest(t, http.MethodPost, "/trips/"+source.ID.String()+"/clone", map[string]any{
+		"starts_at": startsAt,
+		"ends_at":   startsAt.AddDate(0, 0, 2),
+	})
+	w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+	assertStatus(t, w, http.StatusCreated)
+	var response spec.CreateTripResponse
+	decodeResponse(t, w, &response)
+	cloneID := uuid.MustParse(response.TripID)
+	if w.Header().Get("Location") != "/trips/"+response.TripID {
+		t.Fatalf("expected the Location of the clone, got %q", w.Header().Get("Location"))
+	}
+
+	clone := store.trip(cloneID)
+	if clone.Destination != source.Destination || clone.IsConfirmed || !clone.StartsAt.Time.Equal(startsAt) {
+		t.Fatalf("expected an unconfirmed clone of %s from %v, got %+v", source.Destination, startsAt, clone)
+	}
+	if clone.OwnerTokenHash != hashOwnerToken(response.OwnerToken) {
+		t.Fatal("expected the clone owned by the new token")
+	}
+
+	var activities, links, participants int
+	for _, activity := range store.activities {
+		if activity.TripID != cloneID {
+			continue
+		}
+		activities++
+		if expected := startsAt.Add(26 * time.Hour); !activity.OccursAt.Time.Equal(expected) {
+			t.Fatalf("expected the activity shifted to %v, got %v", expected, activity.OccursAt.Time)
+		}
+	}
+	for _, link := range store.links {
+		if link.TripID == cloneID {
+			links++
+		}
+	}
+	for _, participant := range store.participants {
+		if participant.TripID == cloneID {
+			participants++
+		}
+	}
+	if activities != 1 || links != 1 || participants != 0 {
+		t.Fatalf("expected the activity and the link cloned without participants, got %d, %d and %d", activities, links, participants)
+	}
+	if len(mailer.ownerConfirmations) != 1 || mailer.ownerConfirmations[0] != cloneID {
+		t.Fatalf("expected the clone confirmation sent to its owner, got %v", mailer.ownerConfirmations)
+	}
+}
+
+func TestPostTripsTripIDCloneLeavingActivitiesOut(t *testing.T) {
+	store := newFakeStore()
+	source := store.addTrip(newTestTrip(5))
+	store.addActivity(source.ID, "Trilha", source.StartsAt.Time.AddDate(0, 0, 4))
+	api := newTestAPI(store, &fakeMailer{})
+
+	startsAt := source.StartsAt.Time.AddDate(0, 1, 0)
+	r := newRequest(t, http.MethodPost, "/trips/"+source.ID.String()+"/clone", map[string]any{
+		"starts_at": startsAt,
+		"ends_at":   startsAt.AddDate(0, 0, 1),
+	})
+	w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+	assertStatus(t, w, http.StatusBadRequest)
+	if store.callsOf("CloneTrip") != 0 {
+		t.Fatal("expected no clone with an activity out of its period")
+	}
+}
+
+func TestPostTripsTripIDCloneRequiresTheOwner(t *testing.T) {
+	store := newFakeStore()
+	source := store.addTrip(newTestTrip(3))
+	api := newTestAPI(store, &fakeMailer{})
+
+	startsAt := source.StartsAt.Time.AddDate(0, 1, 0)
+	r := newRequest(t, http.MethodPost, "/trips/"+source.ID.String()+"/clone", map[string]any{
+		"starts_at": startsAt,
+		"ends_at":   startsAt.AddDate(0, 0, 2),
+	})
+
+	assertStatus(t, serve(api, r), http.StatusUnauthorized)
+	if store.callsOf("CloneTrip") != 0 {
+		t.Fatal("expected no clone without the owner token")
+	}
+}
@@ -0,0 +1,87 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"journey/internal/api/spec"
+	"journey/internal/i18n"
+	"journey/internal/pgstore"
+	"net/http"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// The outcome of each participant of a batch confirmation.
+const (
+	batchConfirmed        = "confirmed"
+	batchAlreadyConfirmed = "already_confirmed"
+	batchNotFound         = "not_found"
+)
+
+// Confirm several participants of a trip at once.
+// (PATCH /trips/{tripId}/participants/confirm/batch)
+func (api *API) PatchTripsTripIDParticipantsConfirmBatch(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID := pathUUID(r, "tripId")
+
+	trip, err := api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		return spec.PatchTripsTripIDParticipantsConfirmBatchJSON404Response(api.notFound(r, i18n.TripNotFound))
+	}
+
+	if err := api.authorizeTripOwner(r, trip); err != nil {
+		if errors.Is(err, errMissingOwnerToken) {
+			return spec.PatchTripsTripIDParticipantsConfirmBatchJSON401Response(api.unauthorized(r, i18n.MissingOwnerToken))
+		}
+		return spec.PatchTripsTripIDParticipantsConfirmBatchJSON403Response(api.forbidden(r, refusedOwnerTokenKey(err)))
+	}
+
+	var body spec.PatchTripsTripIDParticipantsConfirmBatchJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PatchTripsTripIDParticipantsConfirmBatchJSON400Response(api.badRequest(r, i18n.InvalidRequest, err.Error()))
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PatchTripsTripIDParticipantsConfirmBatchJSON400Response(api.invalidFieldsRequest(r, err))
+	}
+
+	// the ids were validated as uuids, they always parse.
+	participantIDs := make([]uuid.UUID, len(body.ParticipantIds))
+	for index, participantID := range body.ParticipantIds {
+		participantIDs[index] = uuid.MustParse(participantID)
+	}
+
+	participants, err := api.store.ConfirmParticipantsBatch(r.Context(), api.pool, pgstore.ConfirmTripParticipantsParams{
+		TripID: tripUUID,
+		Ids:    participantIDs,
+	})
+	if err != nil {
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed on route: '%v: %v'", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripID),
+		)
+		return spec.PatchTripsTripIDParticipantsConfirmBatchJSON500Response(api.internalServerError(r, i18n.UnableToConfirmParticipant))
+	}
+
+	// The participants answered are the ones of the trip as they were before, the rest of the ids are of other
+	// trips or of no participant at all.
+	wasConfirmed := make(map[uuid.UUID]bool, len(participants))
+	for _, participant := range participants {
+		wasConfirmed[participant.ID] = participant.IsConfirmed
+	}
+
+	results := make([]spec.ConfirmParticipantsBatchResponseArray, len(participantIDs))
+	for index, participantID := range participantIDs {
+		status := batchConfirmed
+		if confirmed, found := wasConfirmed[participantID]; !found {
+			status = batchNotFound
+		} else if confirmed {
+			status = batchAlreadyConfirmed
+		}
+		results[index] = spec.ConfirmParticipantsBatchResponseArray{ParticipantID: participantID.String(), Status: status}
+	}
+
+	return spec.PatchTripsTripIDParticipantsConfirmBatchJSON200Response(spec.ConfirmParticipantsBatchResponse{Results: results})
+}
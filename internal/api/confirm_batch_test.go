@@ -0,0 +1,121 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"journey/internal/api/spec"
+	"journey/internal/pgstore"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// rolledBackStore fails the batch confirmation as a transaction rolled back would, confirming none of them.
+type rolledBackStore struct {
+	*fakeStore
+}
+
+func (s rolledBackStore) ConfirmParticipantsBatch(context.Context, *pgxpool.Pool, pgstore.ConfirmTripParticipantsParams) ([]pgstore.Participant, error) {
+	return nil, errors.New("pgstore: failed to commit tx for ConfirmParticipantsBatch: connection lost")
+}
+
+func TestPatchTripsTripIDParticipantsConfirmBatch(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	other := store.addTrip(newTestTrip(3))
+	pending := store.addParticipant(trip.ID, "pending@example.com")
+	confirmed := store.addParticipant(trip.ID, "confirmed@example.com")
+	if err := store.ConfirmParticipant(context.Background(), pgstore.ConfirmParticipantParams{IsConfirmed: true, ID: confirmed.ID}); err != nil {
+		t.Fatal(err)
+	}
+	foreign := store.addParticipant(other.ID, "foreign@example.com")
+	missing := uuid.New()
+	api := newTestAPI(store, &fakeMailer{})
+
+	r := newRequest(t, http.MethodPatch, "/trips/"+trip.ID.String()+"/participants/confirm/batch", map[string]any{
+		"participant_ids": []string{pending.ID.String(), confirmed.ID.String(), foreign.ID.String(), missing.String()},
+	})
+	w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+	assertStatus(t, w, http.StatusOK)
+	var response spec.ConfirmParticipantsBatchResponse
+	decodeResponse(t, w, &response)
+	expected := []spec.ConfirmParticipantsBatchResponseArray{
+		{ParticipantID: pending.ID.String(), Status: batchConfirmed},
+		{ParticipantID: confirmed.ID.String(), Status: batchAlreadyConfirmed},
+		{ParticipantID: foreign.ID.String(), Status: batchNotFound},
+		{ParticipantID: missing.String(), Status: batchNotFound},
+	}
+	if len(response.Results) != len(expected) {
+		t.Fatalf("expected %d results, got %+v", len(expected), response.Results)
+	}
+	for index, result := range response.Results {
+		if result != expected[index] {
+			t.Errorf("expected result %d to be %+v, got %+v", index, expected[index], result)
+		}
+	}
+
+	if !store.participant(pending.ID).IsConfirmed {
+		t.Error("expected the pending participant confirmed")
+	}
+	if store.participant(foreign.ID).IsConfirmed {
+		t.Error("expected the participant of the other trip left unconfirmed")
+	}
+}
+
+func TestPatchTripsTripIDParticipantsConfirmBatchRollsBack(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	first := store.addParticipant(trip.ID, "first@example.com")
+	second := store.addParticipant(trip.ID, "second@example.com")
+	api := newTestAPI(rolledBackStore{store}, &fakeMailer{})
+
+	r := newRequest(t, http.MethodPatch, "/trips/"+trip.ID.String()+"/participants/confirm/batch", map[string]any{
+		"participant_ids": []string{first.ID.String(), second.ID.String()},
+	})
+	w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+	assertStatus(t, w, http.StatusInternalServerError)
+	for _, participant := range []pgstore.Participant{first, second} {
+		if store.participant(participant.ID).IsConfirmed {
+			t.Errorf("expected %s left unconfirmed", participant.Email)
+		}
+	}
+	if calls := store.callsOf("ConfirmParticipant"); calls != 0 {
+		t.Fatalf("expected no participant confirmed one by one, got %d calls", calls)
+	}
+}
+
+func TestPatchTripsTripIDParticipantsConfirmBatchInvalid(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	participant := store.addParticipant(trip.ID, "guest@example.com")
+	api := newTestAPI(store, &fakeMailer{})
+	target := "/trips/" + trip.ID.String() + "/participants/confirm/batch"
+
+	cases := map[string]any{
+		"empty":       map[string]any{"participant_ids": []string{}},
+		"not an uuid": map[string]any{"participant_ids": []string{"not-an-uuid"}},
+		"duplicated":  map[string]any{"participant_ids": []string{participant.ID.String(), participant.ID.String()}},
+	}
+
+	for name, body := range cases {
+		t.Run(name, func(t *testing.T) {
+			w := serve(api, withOwnerToken(newRequest(t, http.MethodPatch, target, body), TEST_OWNER_TOKEN))
+
+			assertStatus(t, w, http.StatusBadRequest)
+		})
+	}
+	if calls := store.callsOf("ConfirmParticipantsBatch"); calls != 0 {
+		t.Fatalf("expected no confirmation attempted, got %d", calls)
+	}
+
+	t.Run("missing trip", func(t *testing.T) {
+		r := newRequest(t, http.MethodPatch, "/trips/"+uuid.NewString()+"/participants/confirm/batch", map[string]any{
+			"participant_ids": []string{participant.ID.String()},
+		})
+		assertStatus(t, serve(api, withOwnerToken(r, TEST_OWNER_TOKEN)), http.StatusNotFound)
+	})
+}
@@ -0,0 +1,46 @@
+package api
+
+import (
+	"fmt"
+	"html/template"
+	"journey/internal/i18n"
+	"net/http"
+
+	"go.uber.org/zap"
+)
+
+// confirmedPage is the page answered on the confirmation links opened from the emails, a browser rather than
+// a client of the API reading it.
+var confirmedPage = template.Must(template.New("confirmed").Parse(`<!DOCTYPE html>
+<html lang="{{.Lang}}">
+<head>
+  <meta charset="utf-8">
+  <meta name="viewport" content="width=device-width, initial-scale=1">
+  <title>{{.Title}}</title>
+</head>
+<body style="font-family: sans-serif; font-size: 16px; line-height: 1.6; max-width: 40em; margin: 4em auto; padding: 0 1em;">
+  <h1>{{.Title}}</h1>
+  <p>{{.Text}}</p>
+</body>
+</html>
+`))
+
+// writeConfirmedPage answers the confirmedPage, written in the locale of the request.
+func (api *API) writeConfirmedPage(w http.ResponseWriter, r *http.Request, titleKey, textKey i18n.Key) {
+	locale := i18n.FromRequest(r)
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+
+	// The status is already sent, a failed write is only logged.
+	if err := confirmedPage.Execute(w, struct {
+		Lang  i18n.Locale
+		Title string
+		Text  string
+	}{locale, i18n.Message(locale, titleKey), i18n.Message(locale, textKey)}); err != nil {
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed route: '%v: %v' when writing the confirmed page", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+		)
+	}
+}
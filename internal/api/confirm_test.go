@@ -0,0 +1,209 @@
+package api
+
+import (
+	"context"
+	"journey/internal/api/spec"
+	"journey/internal/pgstore"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// failingTransport fails the test on any outbound HTTP request.
+type failingTransport struct {
+	t *testing.T
+}
+
+func (ft failingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	ft.t.Errorf("unexpected outbound request: %s %s", r.Method, r.URL)
+	return nil, http.ErrNotSupported
+}
+
+// forbidOutboundHTTP swaps the default transport, so a loopback call made by the handlers fails the test.
+func forbidOutboundHTTP(t *testing.T) {
+	t.Helper()
+
+	original := http.DefaultTransport
+	http.DefaultTransport = failingTransport{t}
+	t.Cleanup(func() { http.DefaultTransport = original })
+}
+
+// assertConfirmedPage checks the answer is the HTML page of a confirmation, titled title.
+func assertConfirmedPage(t *testing.T, w *httptest.ResponseRecorder, title string) {
+	t.Helper()
+
+	if contentType := w.Header().Get("Content-Type"); !strings.HasPrefix(contentType, "text/html") {
+		t.Fatalf("expected an HTML page, got %q", contentType)
+	}
+	if body := w.Body.String(); !strings.Contains(body, "<title>"+title+"</title>") {
+		t.Fatalf("expected the page titled %q, got %q", title, body)
+	}
+}
+
+func TestGetTripsTripIDConfirm(t *testing.T) {
+	forbidOutboundHTTP(t)
+
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	store.addParticipant(trip.ID, "guest@example.com")
+	api := newTestAPI(store, &fakeMailer{})
+
+	t.Run("confirmed", func(t *testing.T) {
+		w := serve(api, newRequest(t, http.MethodGet, "/trips/"+trip.ID.String()+"/confirm", nil))
+
+		assertStatus(t, w, http.StatusOK)
+		assertConfirmedPage(t, w, "Viagem confirmada")
+		if !store.trip(trip.ID).IsConfirmed {
+			t.Fatal("expected the trip to be confirmed")
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		w := serve(api, newRequest(t, http.MethodGet, "/trips/"+uuid.NewString()+"/confirm", nil))
+
+		assertStatus(t, w, http.StatusNotFound)
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		w := serve(api, newRequest(t, http.MethodGet, "/trips/not-an-uuid/confirm", nil))
+
+		assertStatus(t, w, http.StatusBadRequest)
+	})
+}
+
+func TestPatchTripsTripIDConfirmOnlyOnce(t *testing.T) {
+	forbidOutboundHTTP(t)
+
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	store.addParticipant(trip.ID, "guest@example.com")
+	mailer := &fakeMailer{}
+	api := newTestAPI(store, mailer)
+
+	w := serve(api, newRequest(t, http.MethodPatch, "/trips/"+trip.ID.String()+"/confirm", nil))
+
+	assertStatus(t, w, http.StatusNoContent)
+	if len(mailer.invites) != 1 {
+		t.Fatalf("expected the invitations sent on the first confirmation, got %d", len(mailer.invites))
+	}
+
+	w = serve(api, newRequest(t, http.MethodPatch, "/trips/"+trip.ID.String()+"/confirm", nil))
+
+	assertStatus(t, w, http.StatusConflict)
+	var response spec.ConflictRequest
+	decodeResponse(t, w, &response)
+	if response.Code != string(ErrorCodeTripAlreadyConfirmed) {
+		t.Fatalf("expected %s, got %s", ErrorCodeTripAlreadyConfirmed, response.Code)
+	}
+	if len(mailer.invites) != 1 {
+		t.Fatalf("expected no invitation sent again, got %d", len(mailer.invites))
+	}
+	if calls := store.callsOf("UpdateTripConfirm"); calls != 1 {
+		t.Fatalf("expected the trip confirmed once, got %d updates", calls)
+	}
+}
+
+// racingStore holds each GetTrip until the reads of all the racing requests are done, so they all see the trip
+// as it was before any of them changed it.
+type racingStore struct {
+	*fakeStore
+	reads *sync.WaitGroup
+}
+
+func (s racingStore) GetTrip(ctx context.Context, id uuid.UUID) (pgstore.Trip, error) {
+	trip, err := s.fakeStore.GetTrip(ctx, id)
+	s.reads.Done()
+	s.reads.Wait()
+	return trip, err
+}
+
+func TestPatchTripsTripIDConfirmConcurrently(t *testing.T) {
+	forbidOutboundHTTP(t)
+
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	store.addParticipant(trip.ID, "guest@example.com")
+	mailer := &fakeMailer{}
+	reads := &sync.WaitGroup{}
+	reads.Add(2)
+	api := newTestAPI(racingStore{fakeStore: store, reads: reads}, mailer)
+
+	statuses := make([]int, 2)
+	var wg sync.WaitGroup
+	for i := range statuses {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			statuses[i] = serve(api, newRequest(t, http.MethodPatch, "/trips/"+trip.ID.String()+"/confirm", nil)).Code
+		}()
+	}
+	wg.Wait()
+
+	slices.Sort(statuses)
+	if statuses[0] != http.StatusNoContent || statuses[1] != http.StatusConflict {
+		t.Fatalf("expected the trip confirmed once and the other confirmation refused, got %v", statuses)
+	}
+	if len(mailer.invites) != 1 {
+		t.Fatalf("expected the invitations sent once, got %d", len(mailer.invites))
+	}
+}
+
+func TestPatchTripsTripIDConfirmInvitesOnlyTheUnconfirmed(t *testing.T) {
+	forbidOutboundHTTP(t)
+
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	pending := store.addParticipant(trip.ID, "pending@example.com")
+	confirmed := store.addParticipant(trip.ID, "confirmed@example.com")
+	confirmed.IsConfirmed = true
+	store.participants[confirmed.ID] = confirmed
+	mailer := &fakeMailer{}
+	api := newTestAPI(store, mailer)
+
+	w := serve(api, newRequest(t, http.MethodPatch, "/trips/"+trip.ID.String()+"/confirm", nil))
+
+	assertStatus(t, w, http.StatusNoContent)
+	if len(mailer.invites) != 1 {
+		t.Fatalf("expected a single invitation batch, got %d", len(mailer.invites))
+	}
+	invites := mailer.invites[0].Invites
+	if len(invites) != 1 || invites[0].Participant.ParticipantId != pending.ID {
+		t.Fatalf("expected only %s invited, got %+v", pending.Email, invites)
+	}
+}
+
+func TestGetParticipantsParticipantIDConfirm(t *testing.T) {
+	forbidOutboundHTTP(t)
+
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	participant := store.addParticipant(trip.ID, "guest@example.com")
+	api := newTestAPI(store, &fakeMailer{})
+
+	t.Run("confirmed", func(t *testing.T) {
+		w := serve(api, newRequest(t, http.MethodGet, "/participants/"+participant.ID.String()+"/confirm", nil))
+
+		assertStatus(t, w, http.StatusOK)
+		assertConfirmedPage(t, w, "Presença confirmada")
+		if !store.participant(participant.ID).IsConfirmed {
+			t.Fatal("expected the participant to be confirmed")
+		}
+	})
+
+	t.Run("already confirmed", func(t *testing.T) {
+		w := serve(api, newRequest(t, http.MethodGet, "/participants/"+participant.ID.String()+"/confirm", nil))
+
+		assertStatus(t, w, http.StatusBadRequest)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		w := serve(api, newRequest(t, http.MethodGet, "/participants/"+uuid.NewString()+"/confirm", nil))
+
+		assertStatus(t, w, http.StatusNotFound)
+	})
+}
@@ -0,0 +1,91 @@
+package api
+
+import (
+	"journey/internal/api/spec"
+	"journey/internal/token"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestConfirmRoutesVerifyTheSignedToken(t *testing.T) {
+	signer := token.New("shared-secret", time.Hour)
+	confirmed := map[string]int{http.MethodGet: http.StatusOK, http.MethodPatch: http.StatusNoContent}
+
+	for method, status := range confirmed {
+		t.Run(method+" trip", func(t *testing.T) {
+			store := newFakeStore()
+			trip := store.addTrip(newTestTrip(3))
+			api := newTestAPI(store, &fakeMailer{}, WithConfirmTokens(signer))
+			target := "/trips/" + trip.ID.String() + "/confirm?token=" + signer.Sign(token.PurposeTrip, trip.ID, testNow)
+
+			assertStatus(t, serve(api, newRequest(t, method, target, nil)), status)
+			if !store.trip(trip.ID).IsConfirmed {
+				t.Fatal("expected the trip confirmed with its token")
+			}
+		})
+
+		t.Run(method+" participant", func(t *testing.T) {
+			store := newFakeStore()
+			trip := store.addTrip(newTestTrip(3))
+			participant := store.addParticipant(trip.ID, "guest@example.com")
+			api := newTestAPI(store, &fakeMailer{}, WithConfirmTokens(signer))
+			target := "/participants/" + participant.ID.String() + "/confirm?token=" + signer.Sign(token.PurposeParticipant, participant.ID, testNow)
+
+			assertStatus(t, serve(api, newRequest(t, method, target, nil)), status)
+			if !store.participant(participant.ID).IsConfirmed {
+				t.Fatal("expected the participant confirmed with its token")
+			}
+		})
+	}
+}
+
+func TestConfirmRoutesRefuseTheTokenNotSigned(t *testing.T) {
+	signer := token.New("shared-secret", time.Hour)
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	participant := store.addParticipant(trip.ID, "guest@example.com")
+	api := newTestAPI(store, &fakeMailer{}, WithConfirmTokens(signer))
+	tripPath := "/trips/" + trip.ID.String() + "/confirm"
+	participantPath := "/participants/" + participant.ID.String() + "/confirm"
+
+	cases := []struct {
+		name   string
+		target string
+		status int
+		code   ErrorCode
+	}{
+		{"trip without token", tripPath, http.StatusUnauthorized, ErrorCodeMissingConfirmToken},
+		{"trip with an empty token", tripPath + "?token=", http.StatusUnauthorized, ErrorCodeMissingConfirmToken},
+		{"trip with a forged token", tripPath + "?token=9999999999.forged", http.StatusForbidden, ErrorCodeInvalidConfirmToken},
+		{"trip with the token of another trip", tripPath + "?token=" + signer.Sign(token.PurposeTrip, uuid.New(), testNow), http.StatusForbidden, ErrorCodeInvalidConfirmToken},
+		{"trip with the token of its participant", tripPath + "?token=" + signer.Sign(token.PurposeParticipant, trip.ID, testNow), http.StatusForbidden, ErrorCodeInvalidConfirmToken},
+		{"trip with an expired token", tripPath + "?token=" + signer.Sign(token.PurposeTrip, trip.ID, testNow.Add(-time.Hour)), http.StatusForbidden, ErrorCodeConfirmTokenExpired},
+		{"participant without token", participantPath, http.StatusUnauthorized, ErrorCodeMissingConfirmToken},
+		{"participant with the token of its trip", participantPath + "?token=" + signer.Sign(token.PurposeTrip, participant.ID, testNow), http.StatusForbidden, ErrorCodeInvalidConfirmToken},
+		{"participant with an expired token", participantPath + "?token=" + signer.Sign(token.PurposeParticipant, participant.ID, testNow.Add(-2*time.Hour)), http.StatusForbidden, ErrorCodeConfirmTokenExpired},
+	}
+
+	for _, c := range cases {
+		for _, method := range []string{http.MethodGet, http.MethodPatch} {
+			t.Run(method+" "+c.name, func(t *testing.T) {
+				w := serve(api, newRequest(t, method, c.target, nil))
+
+				assertStatus(t, w, c.status)
+				var response spec.ForbiddenRequest
+				decodeResponse(t, w, &response)
+				if response.Code != string(c.code) {
+					t.Fatalf("expected %s, got %s", c.code, response.Code)
+				}
+			})
+		}
+	}
+	if store.trip(trip.ID).IsConfirmed || store.participant(participant.ID).IsConfirmed {
+		t.Fatal("expected nothing confirmed without a valid token")
+	}
+	if calls := store.callsOf("GetTrip") + store.callsOf("GetParticipant"); calls != 0 {
+		t.Fatalf("expected the token refused before reaching the store, got %d calls", calls)
+	}
+}
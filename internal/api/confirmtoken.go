@@ -0,0 +1,26 @@
+package api
+
+import (
+	"journey/internal/token"
+
+	"github.com/google/uuid"
+)
+
+// WithConfirmTokens overrides the signer the confirmation links are verified with, read from
+// JOURNEY_CONFIRM_TOKEN_SECRET and JOURNEY_CONFIRM_TOKEN_TTL by default.
+func WithConfirmTokens(signer token.Signer) Option {
+	return func(api *API) {
+		api.confirmTokens = signer
+	}
+}
+
+// checkConfirmToken checks the token of a confirmation link was signed for the purpose and the id and has not
+// expired, answering token.ErrMissing (401), token.ErrExpired or token.ErrInvalid (403) otherwise. Any token
+// is accepted while the tokens are disabled.
+func (api *API) checkConfirmToken(purpose token.Purpose, id uuid.UUID, confirmToken *string) error {
+	var sent string
+	if confirmToken != nil {
+		sent = *confirmToken
+	}
+	return api.confirmTokens.Verify(purpose, id, sent, api.clock.Now())
+}
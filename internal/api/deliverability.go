@@ -0,0 +1,123 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"journey/cmd/journey/config"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// mxLookupTimeout bounds the lookups of the domains of an email, a slow DNS does not hold the request.
+const mxLookupTimeout = 2 * time.Second
+
+// reservedTopLevelDomains never reach the public mail, kept for the tests, the documentation and the local
+// networks (RFC 2606 and RFC 6762).
+var reservedTopLevelDomains = map[string]bool{
+	"example":   true,
+	"invalid":   true,
+	"local":     true,
+	"localhost": true,
+	"test":      true,
+}
+
+// mxResolver looks up the domains the emails are delivered to, as the net.Resolver.
+type mxResolver interface {
+	LookupMX(ctx context.Context, name string) ([]*net.MX, error)
+	LookupHost(ctx context.Context, host string) ([]string, error)
+}
+
+// GetMailCheckMX reads JOURNEY_MAIL_CHECK_MX, the invited emails are then rejected when their domain receives
+// no mail. It is false when missing or invalid, sparing the DNS lookups.
+func GetMailCheckMX() bool {
+	if value, err := config.GetSpecificEnvironmentVariable("JOURNEY_MAIL_CHECK_MX"); err == nil {
+		if checkMX, err := strconv.ParseBool(value); err == nil {
+			return checkMX
+		}
+	}
+	return false
+}
+
+// WithMXResolver overrides the resolver the domains of the invited emails are checked on, nil skipping the check.
+func WithMXResolver(resolver mxResolver) Option {
+	return func(api *API) {
+		api.mxResolver = resolver
+	}
+}
+
+// undeliverableEmails answers the emails passing the validator but no mail could reach, as "a@b": the domain
+// is not a public one or, when the MX check is on, it has no mail server.
+func (api *API) undeliverableEmails(ctx context.Context, emails []string) []string {
+	var undeliverable []string
+	for _, email := range emails {
+		if !hasDeliverableFormat(email) || !api.receivesMail(ctx, emailDomain(email)) {
+			undeliverable = append(undeliverable, email)
+		}
+	}
+	return undeliverable
+}
+
+// hasDeliverableFormat tells whether the domain of the email is a public domain name: dot separated labels of
+// letters, digits and inner hyphens, ending in a top level domain of letters that is not a reserved one.
+func hasDeliverableFormat(email string) bool {
+	at := strings.LastIndex(email, "@")
+	if at < 1 {
+		return false
+	}
+
+	labels := strings.Split(emailDomain(email), ".")
+	if len(labels) < 2 {
+		return false
+	}
+	for _, label := range labels {
+		if len(label) == 0 || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for _, char := range label {
+			if !(char >= 'a' && char <= 'z' || char >= '0' && char <= '9' || char == '-') {
+				return false
+			}
+		}
+	}
+
+	tld := labels[len(labels)-1]
+	if strings.HasPrefix(tld, "xn--") {
+		return true
+	}
+	return len(tld) >= 2 && strings.Trim(tld, "abcdefghijklmnopqrstuvwxyz") == "" && !reservedTopLevelDomains[tld]
+}
+
+func emailDomain(email string) string {
+	return strings.ToLower(email[strings.LastIndex(email, "@")+1:])
+}
+
+// receivesMail tells whether the domain has a mail server, its MX records or else its address. Only a domain
+// known not to exist or declaring no mail (a "." MX) is rejected, a failing lookup lets the email through.
+func (api *API) receivesMail(ctx context.Context, domain string) bool {
+	if api.mxResolver == nil {
+		return true
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, mxLookupTimeout)
+	defer cancel()
+
+	records, err := api.mxResolver.LookupMX(ctx, domain)
+	if err == nil {
+		return !(len(records) == 1 && records[0].Host == ".")
+	}
+	if !isNotFound(err) {
+		return true
+	}
+
+	if _, err := api.mxResolver.LookupHost(ctx, domain); err != nil && isNotFound(err) {
+		return false
+	}
+	return true
+}
+
+func isNotFound(err error) bool {
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr) && dnsErr.IsNotFound
+}
@@ -0,0 +1,121 @@
+package api
+
+import (
+	"context"
+	"journey/internal/api/spec"
+	"net"
+	"net/http"
+	"testing"
+)
+
+// fakeResolver answers the records of its domains, the other ones not found.
+type fakeResolver struct {
+	mx    map[string][]*net.MX
+	hosts map[string][]string
+}
+
+func (f fakeResolver) LookupMX(_ context.Context, name string) ([]*net.MX, error) {
+	if records, found := f.mx[name]; found {
+		return records, nil
+	}
+	return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+}
+
+func (f fakeResolver) LookupHost(_ context.Context, host string) ([]string, error) {
+	if addresses, found := f.hosts[host]; found {
+		return addresses, nil
+	}
+	return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+}
+
+func TestHasDeliverableFormat(t *testing.T) {
+	cases := map[string]bool{
+		"guest@example.com":            true,
+		"guest@mail.example.com":       true,
+		"Guest@Example.COM":            true,
+		"guest@xn--bcher-kva.xn--p1ai": true,
+		"a@b":                          false,
+		"guest@localhost":              false,
+		"guest@example.c":              false,
+		"guest@trip.invalid":           false,
+		"guest@mail.test":              false,
+		"guest@example.123":            false,
+		"guest@-example.com":           false,
+		"guest@example..com":           false,
+		"guest@[127.0.0.1]":            false,
+		"@example.com":                 false,
+	}
+
+	for email, expected := range cases {
+		if deliverable := hasDeliverableFormat(email); deliverable != expected {
+			t.Fatalf("expected %q deliverable to be %v", email, expected)
+		}
+	}
+}
+
+func TestPostTripsTripIDInvitesRejectsUndeliverableEmails(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	api := newTestAPI(store, &fakeMailer{})
+
+	invite := func(email string) *http.Request {
+		r := newRequest(t, http.MethodPost, "/trips/"+trip.ID.String()+"/invites", map[string]string{"email": email})
+		return withOwnerToken(r, TEST_OWNER_TOKEN)
+	}
+
+	w := serve(api, invite("guest@example.c"))
+
+	assertStatus(t, w, http.StatusBadRequest)
+	var response spec.BadRequest
+	decodeResponse(t, w, &response)
+	if response.Code != string(ErrorCodeUndeliverableEmail) {
+		t.Fatalf("expected the code %s, got %q", ErrorCodeUndeliverableEmail, response.Code)
+	}
+	if store.callsOf("InviteParticipantsWithinCapacity") != 0 {
+		t.Fatal("expected the undeliverable email not invited")
+	}
+
+	assertStatus(t, serve(api, invite("guest@example.com")), http.StatusCreated)
+}
+
+func TestPostTripsRejectsUndeliverableEmails(t *testing.T) {
+	store := newFakeStore()
+	api := newTestAPI(store, &fakeMailer{})
+	startsAt := testNow.AddDate(0, 0, 1)
+
+	body := newCreateTripBody("owner@example.com", startsAt, startsAt.AddDate(0, 0, 3))
+	body["emails_to_invite"] = []string{"guest@example.com", "other@trip.invalid"}
+	w := serve(api, newRequest(t, http.MethodPost, "/trips", body))
+
+	assertStatus(t, w, http.StatusBadRequest)
+	var response spec.BadRequest
+	decodeResponse(t, w, &response)
+	if response.Code != string(ErrorCodeUndeliverableEmail) {
+		t.Fatalf("expected the code %s, got %q", ErrorCodeUndeliverableEmail, response.Code)
+	}
+	if store.callsOf("CreateTrip") != 0 {
+		t.Fatal("expected no trip created with an undeliverable email")
+	}
+}
+
+func TestUndeliverableEmailsChecksTheMX(t *testing.T) {
+	resolver := fakeResolver{
+		mx: map[string][]*net.MX{
+			"example.com": {{Host: "mail.example.com.", Pref: 10}},
+			"nomail.com":  {{Host: ".", Pref: 0}},
+		},
+		hosts: map[string][]string{"fallback.com": {"192.0.2.1"}},
+	}
+	api := newTestAPI(newFakeStore(), &fakeMailer{}, WithMXResolver(resolver))
+
+	undeliverable := api.undeliverableEmails(context.Background(), []string{
+		"guest@example.com",
+		"guest@fallback.com",
+		"guest@nomail.com",
+		"guest@missing.com",
+	})
+
+	if len(undeliverable) != 2 || undeliverable[0] != "guest@nomail.com" || undeliverable[1] != "guest@missing.com" {
+		t.Fatalf("expected the domains without mail rejected, got %v", undeliverable)
+	}
+}
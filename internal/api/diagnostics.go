@@ -0,0 +1,87 @@
+package api
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"journey/cmd/journey/config"
+	"journey/internal/api/spec"
+	"journey/internal/i18n"
+	"journey/internal/mailer/mailpit"
+	"net/http"
+
+	"go.uber.org/zap"
+)
+
+// DEFAULT_VERSION is the version of the builds not setting one.
+const DEFAULT_VERSION = "dev"
+
+var (
+	errMissingAdminToken = errors.New("missing the admin token, send it as a Bearer Authorization header")
+	errWrongAdminToken   = errors.New("the token is not the admin one")
+)
+
+// GetAdminToken reads JOURNEY_ADMIN_TOKEN, the token of the admin routes. They refuse every request when it is
+// missing.
+func GetAdminToken() string {
+	token, _ := config.GetSpecificEnvironmentVariable("JOURNEY_ADMIN_TOKEN")
+	return token
+}
+
+// WithAdminToken overrides the token of the admin routes, empty refusing every request.
+func WithAdminToken(token string) Option {
+	return func(api *API) {
+		api.adminToken = token
+	}
+}
+
+// WithVersion sets the version the app was built as, reported on the diagnostics.
+func WithVersion(version string) Option {
+	return func(api *API) {
+		api.version = version
+	}
+}
+
+// authorizeAdmin checks the request carries the admin token, answering errMissingAdminToken (401) or
+// errWrongAdminToken (403) otherwise.
+func (api *API) authorizeAdmin(r *http.Request) error {
+	token := bearerToken(r)
+	if token == "" {
+		return errMissingAdminToken
+	}
+
+	if api.adminToken == "" || subtle.ConstantTimeCompare([]byte(token), []byte(api.adminToken)) != 1 {
+		return errWrongAdminToken
+	}
+
+	return nil
+}
+
+// Report the running app diagnostics.
+// (GET /diagnostics)
+func (api *API) GetDiagnostics(w http.ResponseWriter, r *http.Request) *spec.Response {
+	if err := api.authorizeAdmin(r); err != nil {
+		if errors.Is(err, errMissingAdminToken) {
+			return spec.GetDiagnosticsJSON401Response(api.unauthorized(r, i18n.MissingAdminToken))
+		}
+		return spec.GetDiagnosticsJSON403Response(api.forbidden(r, i18n.WrongAdminToken))
+	}
+
+	schemaVersion, err := api.store.GetSchemaVersion(r.Context())
+	if err != nil {
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed on route: '%v: %v'", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+		)
+		return spec.GetDiagnosticsJSON500Response(api.internalServerError(r, i18n.UnableToReadDiagnostics))
+	}
+
+	return spec.GetDiagnosticsJSON200Response(spec.DiagnosticsResponse{
+		Version:       api.version,
+		SchemaVersion: int(schemaVersion),
+		StartedAt:     api.startedAt,
+		UptimeSeconds: int(api.clock.Now().Sub(api.startedAt).Seconds()),
+		SMTPHost:      mailpit.SMTPAddress(),
+		MailDryRun:    mailpit.GetDryRun(),
+	})
+}
@@ -0,0 +1,65 @@
+package api
+
+import (
+	"journey/internal/api/spec"
+	"journey/internal/mailer/mailpit"
+	"net/http"
+	"testing"
+)
+
+const testAdminToken = "admin-secret"
+
+func TestGetDiagnostics(t *testing.T) {
+	store := newFakeStore()
+	store.schemaVersion = 13
+	api := newTestAPI(store, &fakeMailer{}, WithAdminToken(testAdminToken), WithVersion("1.4.2"))
+
+	r := newRequest(t, http.MethodGet, "/diagnostics", nil)
+	r.Header.Set("Authorization", "Bearer "+testAdminToken)
+	w := serve(api, r)
+
+	assertStatus(t, w, http.StatusOK)
+	var response spec.DiagnosticsResponse
+	decodeResponse(t, w, &response)
+	if response.Version != "1.4.2" || response.SchemaVersion != 13 {
+		t.Fatalf("expected the version 1.4.2 on the schema 13, got %s on %d", response.Version, response.SchemaVersion)
+	}
+	if !response.StartedAt.Equal(testNow) || response.UptimeSeconds != 0 {
+		t.Fatalf("expected the app started at %v, got %v up %ds", testNow, response.StartedAt, response.UptimeSeconds)
+	}
+	if response.SMTPHost != mailpit.SMTPAddress() {
+		t.Fatalf("expected the SMTP host %s, got %q", mailpit.SMTPAddress(), response.SMTPHost)
+	}
+}
+
+func TestGetDiagnosticsRequiresTheAdminToken(t *testing.T) {
+	store := newFakeStore()
+
+	cases := []struct {
+		name       string
+		adminToken string
+		token      string
+		status     int
+	}{
+		{"missing token", testAdminToken, "", http.StatusUnauthorized},
+		{"wrong token", testAdminToken, "not-the-admin", http.StatusForbidden},
+		{"no admin token configured", "", "anything", http.StatusForbidden},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			api := newTestAPI(store, &fakeMailer{}, WithAdminToken(c.adminToken))
+
+			r := newRequest(t, http.MethodGet, "/diagnostics", nil)
+			if c.token != "" {
+				r.Header.Set("Authorization", "Bearer "+c.token)
+			}
+
+			assertStatus(t, serve(api, r), c.status)
+		})
+	}
+
+	if store.callsOf("GetSchemaVersion") != 0 {
+		t.Fatal("expected no diagnostics read without the admin token")
+	}
+}
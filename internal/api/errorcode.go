@@ -0,0 +1,130 @@
+package api
+
+import (
+	"journey/internal/api/spec"
+	"journey/internal/i18n"
+	"net/http"
+)
+
+// ErrorCode is the stable, machine-readable code of an error response. Clients match on it, the message
+// is free text and changes with the locale.
+type ErrorCode string
+
+const (
+	ErrorCodeInvalidRequest              ErrorCode = "INVALID_REQUEST"
+	ErrorCodeInvalidUUID                 ErrorCode = "INVALID_UUID"
+	ErrorCodeMissingOwnerToken           ErrorCode = "MISSING_OWNER_TOKEN"
+	ErrorCodeWrongOwnerToken             ErrorCode = "WRONG_OWNER_TOKEN"
+	ErrorCodeMissingAdminToken           ErrorCode = "MISSING_ADMIN_TOKEN"
+	ErrorCodeWrongAdminToken             ErrorCode = "WRONG_ADMIN_TOKEN"
+	ErrorCodeMissingConfirmToken         ErrorCode = "MISSING_CONFIRM_TOKEN"
+	ErrorCodeInvalidConfirmToken         ErrorCode = "INVALID_CONFIRM_TOKEN"
+	ErrorCodeConfirmTokenExpired         ErrorCode = "CONFIRM_TOKEN_EXPIRED"
+	ErrorCodeOwnerTokenExpired           ErrorCode = "OWNER_TOKEN_EXPIRED"
+	ErrorCodeMagicLinksDisabled          ErrorCode = "MAGIC_LINKS_DISABLED"
+	ErrorCodeInvalidMagicLink            ErrorCode = "INVALID_MAGIC_LINK"
+	ErrorCodeMagicLinkExpired            ErrorCode = "MAGIC_LINK_EXPIRED"
+	ErrorCodeTripNotFound                ErrorCode = "TRIP_NOT_FOUND"
+	ErrorCodeTripPeriodInvalid           ErrorCode = "TRIP_PERIOD_INVALID"
+	ErrorCodeTripClosed                  ErrorCode = "TRIP_CLOSED"
+	ErrorCodeTripAlreadyConfirmed        ErrorCode = "TRIP_ALREADY_CONFIRMED"
+	ErrorCodeTripFull                    ErrorCode = "TRIP_FULL"
+	ErrorCodeInvalidStatusTransition     ErrorCode = "INVALID_STATUS_TRANSITION"
+	ErrorCodeActivityNotFound            ErrorCode = "ACTIVITY_NOT_FOUND"
+	ErrorCodeActivityOutOfRange          ErrorCode = "ACTIVITY_OUT_OF_RANGE"
+	ErrorCodeParticipantNotFound         ErrorCode = "PARTICIPANT_NOT_FOUND"
+	ErrorCodeParticipantAlreadyConfirmed ErrorCode = "PARTICIPANT_ALREADY_CONFIRMED"
+	ErrorCodeParticipantAlreadyInvited   ErrorCode = "PARTICIPANT_ALREADY_INVITED"
+	ErrorCodeParticipantIsOwner          ErrorCode = "PARTICIPANT_IS_OWNER"
+	ErrorCodeParticipantWaitlisted       ErrorCode = "PARTICIPANT_WAITLISTED"
+	ErrorCodeParticipantNotWaitlisted    ErrorCode = "PARTICIPANT_NOT_WAITLISTED"
+	ErrorCodeUndeliverableEmail          ErrorCode = "UNDELIVERABLE_EMAIL"
+	ErrorCodeRequestTooLarge             ErrorCode = "REQUEST_TOO_LARGE"
+	ErrorCodeLinkNotFound                ErrorCode = "LINK_NOT_FOUND"
+	ErrorCodeLinkAlreadyExists           ErrorCode = "LINK_ALREADY_EXISTS"
+	ErrorCodeIdempotencyKeyConflict      ErrorCode = "IDEMPOTENCY_KEY_CONFLICT"
+	ErrorCodeIdempotencyKeyInProgress    ErrorCode = "IDEMPOTENCY_KEY_IN_PROGRESS"
+	ErrorCodeInternal                    ErrorCode = "INTERNAL_ERROR"
+)
+
+// errorCodes is the code answered with each message, the messages missing here are internal errors.
+var errorCodes = map[i18n.Key]ErrorCode{
+	i18n.InvalidRequest:              ErrorCodeInvalidRequest,
+	i18n.InvalidFields:               ErrorCodeInvalidRequest,
+	i18n.InvalidPagination:           ErrorCodeInvalidRequest,
+	i18n.InvalidOlderThan:            ErrorCodeInvalidRequest,
+	i18n.InvalidTripsEmail:           ErrorCodeInvalidRequest,
+	i18n.InvalidUUID:                 ErrorCodeInvalidUUID,
+	i18n.MissingOwnerToken:           ErrorCodeMissingOwnerToken,
+	i18n.WrongOwnerToken:             ErrorCodeWrongOwnerToken,
+	i18n.MissingAdminToken:           ErrorCodeMissingAdminToken,
+	i18n.WrongAdminToken:             ErrorCodeWrongAdminToken,
+	i18n.MissingConfirmToken:         ErrorCodeMissingConfirmToken,
+	i18n.InvalidConfirmToken:         ErrorCodeInvalidConfirmToken,
+	i18n.ConfirmTokenExpired:         ErrorCodeConfirmTokenExpired,
+	i18n.OwnerTokenExpired:           ErrorCodeOwnerTokenExpired,
+	i18n.MagicLinksDisabled:          ErrorCodeMagicLinksDisabled,
+	i18n.InvalidMagicLink:            ErrorCodeInvalidMagicLink,
+	i18n.MagicLinkExpired:            ErrorCodeMagicLinkExpired,
+	i18n.TripNotFound:                ErrorCodeTripNotFound,
+	i18n.TripStartsInThePast:         ErrorCodeTripPeriodInvalid,
+	i18n.TripEndsBeforeStart:         ErrorCodeTripPeriodInvalid,
+	i18n.TripTooLong:                 ErrorCodeTripPeriodInvalid,
+	i18n.TripStartsTooFarAhead:       ErrorCodeTripPeriodInvalid,
+	i18n.TripClosed:                  ErrorCodeTripClosed,
+	i18n.TripAlreadyConfirmed:        ErrorCodeTripAlreadyConfirmed,
+	i18n.TripFull:                    ErrorCodeTripFull,
+	i18n.InvalidTripStatusTransition: ErrorCodeInvalidStatusTransition,
+	i18n.AlreadyTheTripOwner:         ErrorCodeInvalidRequest,
+	i18n.ActivitiesOutOfTripPeriod:   ErrorCodeActivityOutOfRange,
+	i18n.ActivityOutOfTripPeriod:     ErrorCodeActivityOutOfRange,
+	i18n.BatchOutOfTripPeriod:        ErrorCodeActivityOutOfRange,
+	i18n.InvalidActivitiesRange:      ErrorCodeInvalidRequest,
+	i18n.InvalidActivitiesSearch:     ErrorCodeInvalidRequest,
+	i18n.ActivitiesNotOfDay:          ErrorCodeInvalidRequest,
+	i18n.ActivityNotFound:            ErrorCodeActivityNotFound,
+	i18n.ParticipantNotFound:         ErrorCodeParticipantNotFound,
+	i18n.ParticipantAlreadyConfirmed: ErrorCodeParticipantAlreadyConfirmed,
+	i18n.ParticipantAlreadyInvited:   ErrorCodeParticipantAlreadyInvited,
+	i18n.ParticipantIsTheOwner:       ErrorCodeParticipantIsOwner,
+	i18n.ParticipantWaitlisted:       ErrorCodeParticipantWaitlisted,
+	i18n.ParticipantNotWaitlisted:    ErrorCodeParticipantNotWaitlisted,
+	i18n.UndeliverableEmails:         ErrorCodeUndeliverableEmail,
+	i18n.RequestTooLarge:             ErrorCodeRequestTooLarge,
+	i18n.LinkNotFound:                ErrorCodeLinkNotFound,
+	i18n.LinkAlreadyExists:           ErrorCodeLinkAlreadyExists,
+	i18n.InvalidIdempotencyKey:       ErrorCodeInvalidRequest,
+	i18n.IdempotencyKeyConflict:      ErrorCodeIdempotencyKeyConflict,
+	i18n.IdempotencyKeyInProgress:    ErrorCodeIdempotencyKeyInProgress,
+}
+
+func errorCodeOf(key i18n.Key) ErrorCode {
+	if code, found := errorCodes[key]; found {
+		return code
+	}
+	return ErrorCodeInternal
+}
+
+func (api *API) badRequest(r *http.Request, key i18n.Key, args ...any) spec.BadRequest {
+	return spec.BadRequest{Code: string(errorCodeOf(key)), Message: api.message(r, key, args...)}
+}
+
+func (api *API) notFound(r *http.Request, key i18n.Key, args ...any) spec.NotFoundRequest {
+	return spec.NotFoundRequest{Code: string(errorCodeOf(key)), Message: api.message(r, key, args...)}
+}
+
+func (api *API) internalServerError(r *http.Request, key i18n.Key, args ...any) spec.InternalServerErrorRequest {
+	return spec.InternalServerErrorRequest{Code: string(errorCodeOf(key)), Message: api.message(r, key, args...)}
+}
+
+func (api *API) unauthorized(r *http.Request, key i18n.Key, args ...any) spec.UnauthorizedRequest {
+	return spec.UnauthorizedRequest{Code: string(errorCodeOf(key)), Message: api.message(r, key, args...)}
+}
+
+func (api *API) forbidden(r *http.Request, key i18n.Key, args ...any) spec.ForbiddenRequest {
+	return spec.ForbiddenRequest{Code: string(errorCodeOf(key)), Message: api.message(r, key, args...)}
+}
+
+func (api *API) conflict(r *http.Request, key i18n.Key, args ...any) spec.ConflictRequest {
+	return spec.ConflictRequest{Code: string(errorCodeOf(key)), Message: api.message(r, key, args...)}
+}
@@ -0,0 +1,76 @@
+package api
+
+import (
+	"journey/internal/api/spec"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestErrorResponsesCarryTheirCode(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	participant := store.addParticipant(trip.ID, "guest@example.com")
+	participant.IsConfirmed = true
+	store.participants[participant.ID] = participant
+	api := newTestAPI(store, &fakeMailer{})
+
+	tests := []struct {
+		name    string
+		request *http.Request
+		status  int
+		code    ErrorCode
+	}{
+		{
+			name:    "trip not found",
+			request: newRequest(t, http.MethodGet, "/trips/"+uuid.NewString(), nil),
+			status:  http.StatusNotFound,
+			code:    ErrorCodeTripNotFound,
+		},
+		{
+			name:    "invalid uuid",
+			request: newRequest(t, http.MethodGet, "/trips/not-an-uuid/participants", nil),
+			status:  http.StatusBadRequest,
+			code:    ErrorCodeInvalidUUID,
+		},
+		{
+			name: "activity out of range",
+			request: withOwnerToken(newRequest(t, http.MethodPost, "/trips/"+trip.ID.String()+"/activities", map[string]any{
+				"title":     "Museum",
+				"occurs_at": trip.EndsAt.Time.Add(24 * time.Hour),
+			}), TEST_OWNER_TOKEN),
+			status: http.StatusBadRequest,
+			code:   ErrorCodeActivityOutOfRange,
+		},
+		{
+			name:    "participant already confirmed",
+			request: newRequest(t, http.MethodPatch, "/participants/"+participant.ID.String()+"/confirm", nil),
+			status:  http.StatusBadRequest,
+			code:    ErrorCodeParticipantAlreadyConfirmed,
+		},
+		{
+			name:    "missing owner token",
+			request: newRequest(t, http.MethodPost, "/trips/"+trip.ID.String()+"/links", map[string]any{"title": "Map", "url": "https://example.com"}),
+			status:  http.StatusUnauthorized,
+			code:    ErrorCodeMissingOwnerToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := serve(api, tt.request)
+
+			assertStatus(t, w, tt.status)
+			var response spec.BadRequest
+			decodeResponse(t, w, &response)
+			if response.Code != string(tt.code) {
+				t.Fatalf("expected the code %s, got %q", tt.code, response.Code)
+			}
+			if response.Message == "" {
+				t.Fatal("expected the message alongside the code")
+			}
+		})
+	}
+}
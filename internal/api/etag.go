@@ -0,0 +1,29 @@
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"journey/internal/pgstore"
+	"strconv"
+	"strings"
+)
+
+// tripETag is the weak validator of the trip details, changing along its updated_at.
+func tripETag(trip pgstore.Trip) string {
+	sum := sha256.Sum256([]byte(trip.ID.String() + ":" + strconv.FormatInt(trip.UpdatedAt.Time.UnixNano(), 10)))
+	return `W/"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// etagMatches tells whether the If-None-Match header holds etag, compared weakly as RFC 9110 asks for.
+func etagMatches(ifNoneMatch string, etag string) bool {
+	if strings.TrimSpace(ifNoneMatch) == "*" {
+		return true
+	}
+
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(candidate), "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
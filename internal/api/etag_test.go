@@ -0,0 +1,65 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestGetTripsTripIDConditional(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	api := newTestAPI(store, &fakeMailer{})
+
+	w := serve(api, newRequest(t, http.MethodGet, "/trips/"+trip.ID.String(), nil))
+
+	assertStatus(t, w, http.StatusOK)
+	etag := w.Header().Get("ETag")
+	if etag == "" {
+		t.Fatal("expected an ETag on the trip details")
+	}
+
+	r := newRequest(t, http.MethodGet, "/trips/"+trip.ID.String(), nil)
+	r.Header.Set("If-None-Match", etag)
+	w = serve(api, r)
+
+	assertStatus(t, w, http.StatusNotModified)
+	if w.Body.Len() != 0 {
+		t.Fatalf("expected no body on the 304, got %q", w.Body.String())
+	}
+	if w.Header().Get("ETag") != etag {
+		t.Fatalf("expected the 304 to carry the ETag %s, got %s", etag, w.Header().Get("ETag"))
+	}
+
+	r = newRequest(t, http.MethodPut, "/trips/"+trip.ID.String(), map[string]any{
+		"destination": "Garopaba",
+		"starts_at":   trip.StartsAt.Time,
+		"ends_at":     trip.EndsAt.Time,
+	})
+	assertStatus(t, serve(api, withOwnerToken(r, TEST_OWNER_TOKEN)), http.StatusNoContent)
+
+	r = newRequest(t, http.MethodGet, "/trips/"+trip.ID.String(), nil)
+	r.Header.Set("If-None-Match", etag)
+	w = serve(api, r)
+
+	assertStatus(t, w, http.StatusOK)
+	if changed := w.Header().Get("ETag"); changed == "" || changed == etag {
+		t.Fatalf("expected the update to change the ETag %s, got %q", etag, changed)
+	}
+}
+
+func TestEtagMatches(t *testing.T) {
+	etag := `W/"abc"`
+	cases := map[string]bool{
+		`W/"abc"`:          true,
+		`"abc"`:            true,
+		`"other", W/"abc"`: true,
+		`*`:                true,
+		`W/"other"`:        false,
+	}
+
+	for ifNoneMatch, expected := range cases {
+		if matches := etagMatches(ifNoneMatch, etag); matches != expected {
+			t.Fatalf("expected %q matching %s to be %v", ifNoneMatch, etag, expected)
+		}
+	}
+}
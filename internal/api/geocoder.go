@@ -0,0 +1,78 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// geocodeTimeout bounds the resolution of a destination, a slow geocoder does not hold the request.
+const geocodeTimeout = 2 * time.Second
+
+// Coordinates are a point on the map, in degrees.
+type Coordinates struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// Geocoder resolves the coordinates of a destination, found false when it knows no such place.
+type Geocoder interface {
+	Geocode(ctx context.Context, destination string) (coordinates Coordinates, found bool, err error)
+}
+
+// noopGeocoder resolves no destination, the trips only have the coordinates sent on the requests.
+type noopGeocoder struct{}
+
+func (noopGeocoder) Geocode(context.Context, string) (Coordinates, bool, error) {
+	return Coordinates{}, false, nil
+}
+
+// WithGeocoder sets the geocoder the destinations sent without coordinates are resolved on, none by default.
+func WithGeocoder(geocoder Geocoder) Option {
+	return func(api *API) {
+		if geocoder == nil {
+			geocoder = noopGeocoder{}
+		}
+		api.geocoder = geocoder
+	}
+}
+
+// geocode resolves the destination, nil when it can't be. A failing geocoder is only logged, the trip goes on
+// without coordinates.
+func (api *API) geocode(ctx context.Context, destination string) *Coordinates {
+	ctx, cancel := context.WithTimeout(ctx, geocodeTimeout)
+	defer cancel()
+
+	coordinates, found, err := api.geocoder.Geocode(ctx, destination)
+	if err != nil {
+		api.loggerFor(ctx).Warn("failed to geocode the destination", zap.Error(err), zap.String("destination", destination))
+		return nil
+	}
+	if !found {
+		return nil
+	}
+	// out of range, the coordinates would be refused by the store.
+	if coordinates.Latitude < -90 || coordinates.Latitude > 90 || coordinates.Longitude < -180 || coordinates.Longitude > 180 {
+		api.loggerFor(ctx).Warn("geocoded the destination out of range", zap.String("destination", destination))
+		return nil
+	}
+	return &coordinates
+}
+
+// coordinateFloat is a coordinate as stored, null when missing.
+func coordinateFloat(coordinate *float64) pgtype.Float8 {
+	if coordinate == nil {
+		return pgtype.Float8{}
+	}
+	return pgtype.Float8{Float64: *coordinate, Valid: true}
+}
+
+// coordinateResponse is the stored coordinate as answered, missing when null.
+func coordinateResponse(coordinate pgtype.Float8) *float64 {
+	if !coordinate.Valid {
+		return nil
+	}
+	return &coordinate.Float64
+}
@@ -0,0 +1,216 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"journey/internal/api/spec"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+// stubGeocoder resolves the destinations of places, failing with err when set.
+type stubGeocoder struct {
+	mu     sync.Mutex
+	places map[string]Coordinates
+	err    error
+	// resolved lists the destinations asked for, in order.
+	resolved []string
+}
+
+func (g *stubGeocoder) Geocode(_ context.Context, destination string) (Coordinates, bool, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.resolved = append(g.resolved, destination)
+
+	if g.err != nil {
+		return Coordinates{}, false, g.err
+	}
+	coordinates, found := g.places[destination]
+	return coordinates, found, nil
+}
+
+func (g *stubGeocoder) calls() []string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return append([]string(nil), g.resolved...)
+}
+
+// createTrip creates the trip of body, failing the test unless it is created.
+func createTrip(t *testing.T, api *API, body map[string]any) spec.CreateTripResponse {
+	t.Helper()
+
+	w := serve(api, newRequest(t, http.MethodPost, "/trips", body))
+	assertStatus(t, w, http.StatusCreated)
+	var created spec.CreateTripResponse
+	decodeResponse(t, w, &created)
+	return created
+}
+
+// tripDetails answers the trip as on GET /trips/{tripId}.
+func tripDetails(t *testing.T, api *API, tripID string) spec.GetTripDetailsResponseTripObj {
+	t.Helper()
+
+	w := serve(api, newRequest(t, http.MethodGet, "/trips/"+tripID, nil))
+	assertStatus(t, w, http.StatusOK)
+	var response spec.GetTripDetailsResponse
+	decodeResponse(t, w, &response)
+	return response.Trip
+}
+
+func assertCoordinates(t *testing.T, trip spec.GetTripDetailsResponseTripObj, latitude, longitude float64) {
+	t.Helper()
+
+	if trip.Latitude == nil || trip.Longitude == nil {
+		t.Fatalf("expected the coordinates %v, %v, got none", latitude, longitude)
+	}
+	if *trip.Latitude != latitude || *trip.Longitude != longitude {
+		t.Fatalf("expected the coordinates %v, %v, got %v, %v", latitude, longitude, *trip.Latitude, *trip.Longitude)
+	}
+}
+
+func TestTripCoordinatesSentOnTheRequests(t *testing.T) {
+	geocoder := &stubGeocoder{places: map[string]Coordinates{"Florianópolis": {Latitude: -27.59, Longitude: -48.54}}}
+	api := newTestAPI(newFakeStore(), &fakeMailer{}, WithGeocoder(geocoder))
+	startsAt := testNow.Add(time.Hour)
+
+	body := newCreateTripBody("owner@trip.com", startsAt, startsAt.AddDate(0, 0, 3))
+	body["latitude"], body["longitude"] = -27.6, -48.5
+	created := createTrip(t, api, body)
+
+	assertCoordinates(t, tripDetails(t, api, created.TripID), -27.6, -48.5)
+	if calls := geocoder.calls(); len(calls) != 0 {
+		t.Fatalf("expected the coordinates sent to spare the geocoder, got %v", calls)
+	}
+
+	update := func(t *testing.T, body map[string]any) {
+		t.Helper()
+		body["starts_at"], body["ends_at"] = startsAt, startsAt.AddDate(0, 0, 3)
+		r := withOwnerToken(newRequest(t, http.MethodPut, "/trips/"+created.TripID, body), created.OwnerToken)
+		assertStatus(t, serve(api, r), http.StatusNoContent)
+	}
+
+	t.Run("replaced", func(t *testing.T) {
+		update(t, map[string]any{"destination": "Florianópolis", "latitude": -27.5, "longitude": -48.4})
+
+		assertCoordinates(t, tripDetails(t, api, created.TripID), -27.5, -48.4)
+	})
+
+	t.Run("kept when omitted", func(t *testing.T) {
+		update(t, map[string]any{"destination": "Florianópolis"})
+
+		assertCoordinates(t, tripDetails(t, api, created.TripID), -27.5, -48.4)
+	})
+
+	t.Run("resolved again on a new destination", func(t *testing.T) {
+		geocoder.places["Porto Alegre"] = Coordinates{Latitude: -30.03, Longitude: -51.23}
+		update(t, map[string]any{"destination": "Porto Alegre"})
+
+		assertCoordinates(t, tripDetails(t, api, created.TripID), -30.03, -51.23)
+	})
+
+	t.Run("cleared on a new destination not resolved", func(t *testing.T) {
+		update(t, map[string]any{"destination": "Atlantis"})
+
+		if trip := tripDetails(t, api, created.TripID); trip.Latitude != nil || trip.Longitude != nil {
+			t.Fatalf("expected the coordinates of the former destination cleared, got %v, %v", trip.Latitude, trip.Longitude)
+		}
+	})
+}
+
+func TestTripCoordinatesOutOfRange(t *testing.T) {
+	store := newFakeStore()
+	api := newTestAPI(store, &fakeMailer{})
+	startsAt := testNow.Add(time.Hour)
+
+	cases := map[string]map[string]any{
+		"latitude over 90":       {"latitude": 90.5, "longitude": 0},
+		"latitude under -90":     {"latitude": -91, "longitude": 0},
+		"longitude over 180":     {"latitude": 0, "longitude": 180.1},
+		"longitude under -180":   {"latitude": 0, "longitude": -181},
+		"latitude only":          {"latitude": 10},
+		"longitude only":         {"longitude": 10},
+		"latitude not a number":  {"latitude": "north", "longitude": 0},
+		"longitude not a number": {"latitude": 0, "longitude": "west"},
+	}
+
+	for name, coordinates := range cases {
+		t.Run(name, func(t *testing.T) {
+			body := newCreateTripBody("owner@trip.com", startsAt, startsAt.AddDate(0, 0, 3))
+			for key, value := range coordinates {
+				body[key] = value
+			}
+
+			assertStatus(t, serve(api, newRequest(t, http.MethodPost, "/trips", body)), http.StatusBadRequest)
+		})
+	}
+	if calls := store.callsOf("CreateTrip"); calls != 0 {
+		t.Fatalf("expected no trip created, got %d", calls)
+	}
+
+	t.Run("edges", func(t *testing.T) {
+		body := newCreateTripBody("owner@trip.com", startsAt, startsAt.AddDate(0, 0, 3))
+		body["latitude"], body["longitude"] = -90, 180
+		created := createTrip(t, api, body)
+
+		assertCoordinates(t, tripDetails(t, api, created.TripID), -90, 180)
+	})
+}
+
+func TestTripCoordinatesResolvedByTheGeocoder(t *testing.T) {
+	startsAt := testNow.Add(time.Hour)
+
+	t.Run("found", func(t *testing.T) {
+		geocoder := &stubGeocoder{places: map[string]Coordinates{"Florianópolis": {Latitude: -27.59, Longitude: -48.54}}}
+		api := newTestAPI(newFakeStore(), &fakeMailer{}, WithGeocoder(geocoder))
+
+		created := createTrip(t, api, newCreateTripBody("owner@trip.com", startsAt, startsAt.AddDate(0, 0, 3)))
+
+		assertCoordinates(t, tripDetails(t, api, created.TripID), -27.59, -48.54)
+		if calls := geocoder.calls(); len(calls) != 1 || calls[0] != "Florianópolis" {
+			t.Fatalf("expected the destination resolved once, got %v", calls)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		api := newTestAPI(newFakeStore(), &fakeMailer{}, WithGeocoder(&stubGeocoder{}))
+
+		created := createTrip(t, api, newCreateTripBody("owner@trip.com", startsAt, startsAt.AddDate(0, 0, 3)))
+
+		if trip := tripDetails(t, api, created.TripID); trip.Latitude != nil || trip.Longitude != nil {
+			t.Fatalf("expected no coordinates, got %v, %v", trip.Latitude, trip.Longitude)
+		}
+	})
+
+	t.Run("failing", func(t *testing.T) {
+		api := newTestAPI(newFakeStore(), &fakeMailer{}, WithGeocoder(&stubGeocoder{err: errors.New("geocoder unavailable")}))
+
+		created := createTrip(t, api, newCreateTripBody("owner@trip.com", startsAt, startsAt.AddDate(0, 0, 3)))
+
+		if trip := tripDetails(t, api, created.TripID); trip.Latitude != nil || trip.Longitude != nil {
+			t.Fatalf("expected the trip created without coordinates, got %v, %v", trip.Latitude, trip.Longitude)
+		}
+	})
+
+	t.Run("out of range", func(t *testing.T) {
+		geocoder := &stubGeocoder{places: map[string]Coordinates{"Florianópolis": {Latitude: -480, Longitude: -270}}}
+		api := newTestAPI(newFakeStore(), &fakeMailer{}, WithGeocoder(geocoder))
+
+		created := createTrip(t, api, newCreateTripBody("owner@trip.com", startsAt, startsAt.AddDate(0, 0, 3)))
+
+		if trip := tripDetails(t, api, created.TripID); trip.Latitude != nil || trip.Longitude != nil {
+			t.Fatalf("expected the coordinates out of range dropped, got %v, %v", trip.Latitude, trip.Longitude)
+		}
+	})
+
+	t.Run("none by default", func(t *testing.T) {
+		api := newTestAPI(newFakeStore(), &fakeMailer{})
+
+		created := createTrip(t, api, newCreateTripBody("owner@trip.com", startsAt, startsAt.AddDate(0, 0, 3)))
+
+		if trip := tripDetails(t, api, created.TripID); trip.Latitude != nil || trip.Longitude != nil {
+			t.Fatalf("expected no coordinates, got %v, %v", trip.Latitude, trip.Longitude)
+		}
+	})
+}
@@ -0,0 +1,91 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"journey/internal/api/spec"
+	"net/http"
+	"testing"
+)
+
+// stubPinger answers the pings with err.
+type stubPinger struct {
+	err error
+}
+
+func (p stubPinger) Ping(context.Context) error {
+	return p.err
+}
+
+func TestGetHealthz(t *testing.T) {
+	unreachable := errors.New("unreachable")
+
+	tests := []struct {
+		name     string
+		database error
+		mailer   error
+		target   string
+		status   int
+		want     spec.HealthResponse
+	}{
+		{
+			name:   "healthy",
+			target: "/healthz",
+			status: http.StatusOK,
+			want:   spec.HealthResponse{Status: "ok", Database: "ok"},
+		},
+		{
+			name:     "database unreachable",
+			database: unreachable,
+			target:   "/healthz",
+			status:   http.StatusServiceUnavailable,
+			want:     spec.HealthResponse{Status: "unavailable", Database: "unavailable"},
+		},
+		{
+			name:   "mailer unreachable is ignored when not deep",
+			mailer: unreachable,
+			target: "/healthz",
+			status: http.StatusOK,
+			want:   spec.HealthResponse{Status: "ok", Database: "ok"},
+		},
+		{
+			name:   "deep healthy",
+			target: "/healthz?deep=true",
+			status: http.StatusOK,
+			want:   spec.HealthResponse{Status: "ok", Database: "ok", Mailer: ptr("ok")},
+		},
+		{
+			name:   "deep mailer unreachable",
+			mailer: unreachable,
+			target: "/healthz?deep=true",
+			status: http.StatusServiceUnavailable,
+			want:   spec.HealthResponse{Status: "unavailable", Database: "ok", Mailer: ptr("unavailable")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := newTestAPI(newFakeStore(), &fakeMailer{err: tt.mailer}, WithDatabasePinger(stubPinger{tt.database}))
+
+			w := serve(api, newRequest(t, http.MethodGet, tt.target, nil))
+
+			assertStatus(t, w, tt.status)
+			var response spec.HealthResponse
+			decodeResponse(t, w, &response)
+			if response.Status != tt.want.Status || response.Database != tt.want.Database || !equalPtr(response.Mailer, tt.want.Mailer) {
+				t.Fatalf("expected %+v, got %+v", tt.want, response)
+			}
+		})
+	}
+}
+
+func ptr[T any](value T) *T {
+	return &value
+}
+
+func equalPtr[T comparable](a *T, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,75 @@
+package api
+
+import (
+	"journey/internal/api/spec"
+	"journey/internal/i18n"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestMessagesFollowAcceptLanguage(t *testing.T) {
+	api := newTestAPI(newFakeStore(), &fakeMailer{})
+
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		notFound       string
+		invalidUUID    string
+	}{
+		{"default", "", "viagem não encontrada", "tripID não é reconhecido como um uuid válido"},
+		{"portuguese", "pt-BR,pt;q=0.9", "viagem não encontrada", "tripID não é reconhecido como um uuid válido"},
+		{"english", "en", "trip not found", "tripID is not recognized as a valid uuid"},
+		{"english region", "en-US,en;q=0.9,pt;q=0.5", "trip not found", "tripID is not recognized as a valid uuid"},
+		{"unsupported", "fr-FR", "viagem não encontrada", "tripID não é reconhecido como um uuid válido"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newRequest(t, http.MethodGet, "/trips/"+uuid.NewString(), nil)
+			if tt.acceptLanguage != "" {
+				r.Header.Set("Accept-Language", tt.acceptLanguage)
+			}
+			w := serve(api, r)
+
+			assertStatus(t, w, http.StatusNotFound)
+			var notFound spec.NotFoundRequest
+			decodeResponse(t, w, &notFound)
+			if notFound.Message != tt.notFound {
+				t.Fatalf("expected the message %q, got %q", tt.notFound, notFound.Message)
+			}
+
+			r = newRequest(t, http.MethodGet, "/trips/not-an-uuid", nil)
+			if tt.acceptLanguage != "" {
+				r.Header.Set("Accept-Language", tt.acceptLanguage)
+			}
+			w = serve(api, r)
+
+			assertStatus(t, w, http.StatusBadRequest)
+			var badRequest spec.BadRequest
+			decodeResponse(t, w, &badRequest)
+			if badRequest.Message != tt.invalidUUID {
+				t.Fatalf("expected the message %q, got %q", tt.invalidUUID, badRequest.Message)
+			}
+		})
+	}
+}
+
+func TestConfirmTripInvitesInTheRequestLanguage(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	store.addParticipant(trip.ID, "guest@example.com")
+	mailer := &fakeMailer{}
+	api := newTestAPI(store, mailer)
+
+	r := newRequest(t, http.MethodGet, "/trips/"+trip.ID.String()+"/confirm", nil)
+	r.Header.Set("Accept-Language", "en-GB")
+	w := serve(api, r)
+
+	assertStatus(t, w, http.StatusOK)
+	assertConfirmedPage(t, w, "Trip confirmed")
+	if len(mailer.invites) != 1 || mailer.invites[0].Locale != i18n.English {
+		t.Fatalf("expected the invites sent in English, got %+v", mailer.invites)
+	}
+}
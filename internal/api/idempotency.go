@@ -0,0 +1,161 @@
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"journey/cmd/journey/config"
+	"journey/internal/api/spec"
+	"journey/internal/jwt"
+	"journey/internal/pgstore"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+const DEFAULT_IDEMPOTENCY_KEY_TTL = 24 * time.Hour
+
+// maxIdempotencyKeyLength is the size of the key column.
+const maxIdempotencyKeyLength = 255
+
+var (
+	errIdempotencyKeyConflict   = errors.New("idempotency key used with another request")
+	errIdempotencyKeyInProgress = errors.New("idempotency key request in progress")
+)
+
+// GetIdempotencyKeyTTL reads JOURNEY_IDEMPOTENCY_KEY_TTL (a duration as "24h"), falling back to the
+// default when missing or invalid.
+func GetIdempotencyKeyTTL() time.Duration {
+	if value, err := config.GetSpecificEnvironmentVariable("JOURNEY_IDEMPOTENCY_KEY_TTL"); err == nil {
+		if ttl, err := time.ParseDuration(value); err == nil && ttl > 0 {
+			return ttl
+		}
+	}
+	return DEFAULT_IDEMPOTENCY_KEY_TTL
+}
+
+// hashCreateTripRequest fingerprints the request once decoded, so only a different trip, not a different
+// spacing of the same JSON, conflicts with the key.
+func hashCreateTripRequest(body spec.CreateTripRequest) (string, error) {
+	encoded, err := json.Marshal(body)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// claimIdempotencyKey reserves the key for the request body, before the trip is created. When the key is still
+// alive, it answers the trip created with it, or errIdempotencyKeyConflict when the request differs and
+// errIdempotencyKeyInProgress while the first request runs. A nil response means the key was claimed.
+//
+// The key only keeps the trip: the owner token of a replay is issued again, the client retrying never got the
+// first one and only its hash is stored.
+func (api *API) claimIdempotencyKey(ctx context.Context, key string, body spec.CreateTripRequest) (*spec.CreateTripResponse, error) {
+	requestHash, err := hashCreateTripRequest(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash the request: %w", err)
+	}
+
+	now := api.clock.Now().UTC()
+	claimed, err := api.store.ClaimIdempotencyKey(ctx, pgstore.ClaimIdempotencyKeyParams{
+		Key:           key,
+		RequestHash:   requestHash,
+		CreatedAt:     pgtype.Timestamp{Valid: true, Time: now},
+		ExpiredBefore: pgtype.Timestamp{Valid: true, Time: now.Add(-api.idempotencyKeyTTL)},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim the idempotency key: %w", err)
+	}
+	if claimed > 0 {
+		return nil, nil
+	}
+
+	existing, err := api.store.GetIdempotencyKey(ctx, key)
+	if err != nil {
+		// released by a failed creation in between, the client may retry it.
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errIdempotencyKeyInProgress
+		}
+		return nil, fmt.Errorf("failed to get the idempotency key: %w", err)
+	}
+
+	if existing.RequestHash != requestHash {
+		return nil, errIdempotencyKeyConflict
+	}
+	if !existing.TripID.Valid {
+		return nil, errIdempotencyKeyInProgress
+	}
+
+	tripID := uuid.UUID(existing.TripID.Bytes)
+	ownerToken, err := api.reissueOwnerToken(ctx, tripID, string(body.OwnerEmail))
+	if err != nil {
+		return nil, err
+	}
+	return &spec.CreateTripResponse{TripID: tripID.String(), OwnerToken: ownerToken}, nil
+}
+
+// reissueOwnerToken is a new owner token of the trip created for ownerEmail, errIdempotencyKeyConflict when
+// the trip is gone or was transferred since. The opaque token is rotated, leaving the one first answered
+// refused.
+func (api *API) reissueOwnerToken(ctx context.Context, tripID uuid.UUID, ownerEmail string) (string, error) {
+	trip, err := api.store.GetTrip(ctx, tripID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", errIdempotencyKeyConflict
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get the trip of the idempotency key: %w", err)
+	}
+	if normalizeEmail(trip.OwnerEmail) != normalizeEmail(ownerEmail) {
+		return "", errIdempotencyKeyConflict
+	}
+
+	if api.ownerTokens.Enabled() {
+		token, _, err := api.ownerTokens.Issue(jwt.ScopeOwner, trip.ID, normalizeEmail(trip.OwnerEmail), api.clock.Now())
+		if err != nil {
+			return "", fmt.Errorf("failed to issue the owner JWT: %w", err)
+		}
+		return token, nil
+	}
+
+	token, tokenHash, err := generateOwnerToken()
+	if err != nil {
+		return "", err
+	}
+	if err := api.store.UpdateTripOwnerTokenHash(ctx, pgstore.UpdateTripOwnerTokenHashParams{OwnerTokenHash: tokenHash, ID: trip.ID}); err != nil {
+		return "", fmt.Errorf("failed to rotate the owner token: %w", err)
+	}
+	return token, nil
+}
+
+// completeIdempotencyKey keeps the trip created on the key, if any, for the retries to replay it.
+func (api *API) completeIdempotencyKey(ctx context.Context, key string, tripID uuid.UUID) {
+	if key == "" {
+		return
+	}
+
+	err := api.store.CompleteIdempotencyKey(ctx, pgstore.CompleteIdempotencyKeyParams{
+		TripID: pgtype.UUID{Valid: true, Bytes: tripID},
+		Key:    key,
+	})
+	if err != nil {
+		api.loggerFor(ctx).Error("failed to complete the idempotency key", zap.Error(err), zap.String("trip_id", tripID.String()))
+	}
+}
+
+// releaseIdempotencyKey frees the key, if any, of a creation that failed so it can be retried.
+func (api *API) releaseIdempotencyKey(ctx context.Context, key string) {
+	if key == "" {
+		return
+	}
+
+	if err := api.store.ReleaseIdempotencyKey(ctx, key); err != nil {
+		api.loggerFor(ctx).Error("failed to release the idempotency key", zap.Error(err))
+	}
+}
@@ -0,0 +1,163 @@
+package api
+
+import (
+	"journey/internal/api/spec"
+	"journey/internal/jwt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPostTripsReplaysIdempotencyKey(t *testing.T) {
+	store := newFakeStore()
+	mailer := &fakeMailer{}
+	api := newTestAPI(store, mailer)
+	startsAt := testNow.AddDate(0, 0, 1)
+	body := newCreateTripBody("owner@example.com", startsAt, startsAt.AddDate(0, 0, 3))
+
+	var responses []spec.CreateTripResponse
+	for range 2 {
+		r := newRequest(t, http.MethodPost, "/trips", body)
+		r.Header.Set("Idempotency-Key", "retry-me")
+		w := serve(api, r)
+
+		assertStatus(t, w, http.StatusCreated)
+		var response spec.CreateTripResponse
+		decodeResponse(t, w, &response)
+		responses = append(responses, response)
+	}
+
+	if responses[0].TripID != responses[1].TripID {
+		t.Fatalf("expected the retry to answer the same trip, got %v and %v", responses[0], responses[1])
+	}
+	trip := tripDetails(t, api, responses[0].TripID)
+	if status := renameTrip(t, api, trip, responses[0].OwnerToken); status != http.StatusForbidden {
+		t.Fatalf("expected the owner token first answered rotated, got %d", status)
+	}
+	if status := renameTrip(t, api, trip, responses[1].OwnerToken); status != http.StatusNoContent {
+		t.Fatalf("expected the trip changed with the owner token replayed, got %d", status)
+	}
+	if store.callsOf("CreateTrip") != 1 {
+		t.Fatalf("expected a single trip created, got %d", store.callsOf("CreateTrip"))
+	}
+	if len(mailer.ownerConfirmations) != 1 {
+		t.Fatalf("expected a single confirmation email, got %d", len(mailer.ownerConfirmations))
+	}
+}
+
+func TestPostTripsReplaysIdempotencyKeyWithJWT(t *testing.T) {
+	store := newFakeStore()
+	api := newTestAPI(store, &fakeMailer{}, WithOwnerTokens(testOwnerTokens))
+	startsAt := testNow.AddDate(0, 0, 1)
+	body := newCreateTripBody("owner@example.com", startsAt, startsAt.AddDate(0, 0, 3))
+
+	create := func() *httptest.ResponseRecorder {
+		r := newRequest(t, http.MethodPost, "/trips", body)
+		r.Header.Set("Idempotency-Key", "retry-me")
+		return serve(api, r)
+	}
+
+	w := create()
+	assertStatus(t, w, http.StatusCreated)
+	var created spec.CreateTripResponse
+	decodeResponse(t, w, &created)
+
+	w = create()
+	assertStatus(t, w, http.StatusCreated)
+	var replayed spec.CreateTripResponse
+	decodeResponse(t, w, &replayed)
+
+	if replayed.TripID != created.TripID || store.callsOf("CreateTrip") != 1 {
+		t.Fatalf("expected the retry to answer the trip created, got %v and %v", created, replayed)
+	}
+	if !jwt.LooksLikeJWT(replayed.OwnerToken) {
+		t.Fatalf("expected a JWT replayed, got %q", replayed.OwnerToken)
+	}
+	trip := tripDetails(t, api, created.TripID)
+	for _, token := range []string{created.OwnerToken, replayed.OwnerToken} {
+		if status := renameTrip(t, api, trip, token); status != http.StatusNoContent {
+			t.Fatalf("expected the trip changed with the JWTs answered, got %d", status)
+		}
+	}
+
+	t.Run("transferred", func(t *testing.T) {
+		r := newRequest(t, http.MethodPatch, "/trips/"+created.TripID+"/owner", map[string]any{"owner_email": "new.owner@example.com", "owner_name": "New Owner"})
+		assertStatus(t, serve(api, withOwnerToken(r, replayed.OwnerToken)), http.StatusOK)
+
+		w := create()
+
+		assertStatus(t, w, http.StatusConflict)
+		var response spec.ConflictRequest
+		decodeResponse(t, w, &response)
+		if response.Code != "IDEMPOTENCY_KEY_CONFLICT" {
+			t.Fatalf("expected the IDEMPOTENCY_KEY_CONFLICT code, got %q", response.Code)
+		}
+	})
+}
+
+func TestPostTripsRejectsIdempotencyKeyOfAnotherRequest(t *testing.T) {
+	store := newFakeStore()
+	api := newTestAPI(store, &fakeMailer{})
+	startsAt := testNow.AddDate(0, 0, 1)
+
+	r := newRequest(t, http.MethodPost, "/trips", newCreateTripBody("owner@example.com", startsAt, startsAt.AddDate(0, 0, 3)))
+	r.Header.Set("Idempotency-Key", "retry-me")
+	assertStatus(t, serve(api, r), http.StatusCreated)
+
+	r = newRequest(t, http.MethodPost, "/trips", newCreateTripBody("owner@example.com", startsAt, startsAt.AddDate(0, 0, 5)))
+	r.Header.Set("Idempotency-Key", "retry-me")
+	w := serve(api, r)
+
+	assertStatus(t, w, http.StatusConflict)
+	var response spec.ConflictRequest
+	decodeResponse(t, w, &response)
+	if response.Code != "IDEMPOTENCY_KEY_CONFLICT" {
+		t.Fatalf("expected the IDEMPOTENCY_KEY_CONFLICT code, got %q", response.Code)
+	}
+	if store.callsOf("CreateTrip") != 1 {
+		t.Fatalf("expected a single trip created, got %d", store.callsOf("CreateTrip"))
+	}
+}
+
+func TestPostTripsCreatesAgainOnceIdempotencyKeyExpires(t *testing.T) {
+	store := newFakeStore()
+	startsAt := testNow.AddDate(0, 0, 2)
+	body := newCreateTripBody("owner@example.com", startsAt, startsAt.AddDate(0, 0, 3))
+
+	var responses []spec.CreateTripResponse
+	for _, now := range []time.Time{testNow, testNow.Add(DEFAULT_IDEMPOTENCY_KEY_TTL + time.Minute)} {
+		api := newTestAPI(store, &fakeMailer{}, WithClock(fixedClock{now}))
+		r := newRequest(t, http.MethodPost, "/trips", body)
+		r.Header.Set("Idempotency-Key", "retry-me")
+		w := serve(api, r)
+
+		assertStatus(t, w, http.StatusCreated)
+		var response spec.CreateTripResponse
+		decodeResponse(t, w, &response)
+		responses = append(responses, response)
+	}
+
+	if responses[0].TripID == responses[1].TripID {
+		t.Fatal("expected a new trip once the key expired")
+	}
+	if store.callsOf("CreateTrip") != 2 {
+		t.Fatalf("expected 2 trips created, got %d", store.callsOf("CreateTrip"))
+	}
+}
+
+func TestPostTripsRejectsInvalidIdempotencyKey(t *testing.T) {
+	store := newFakeStore()
+	api := newTestAPI(store, &fakeMailer{})
+	startsAt := testNow.AddDate(0, 0, 1)
+
+	r := newRequest(t, http.MethodPost, "/trips", newCreateTripBody("owner@example.com", startsAt, startsAt.AddDate(0, 0, 3)))
+	r.Header.Set("Idempotency-Key", strings.Repeat("k", maxIdempotencyKeyLength+1))
+	w := serve(api, r)
+
+	assertStatus(t, w, http.StatusBadRequest)
+	if store.callsOf("CreateTrip") != 0 {
+		t.Fatal("expected no trip created")
+	}
+}
@@ -0,0 +1,151 @@
+package api
+
+import (
+	"context"
+	"journey/internal/api/spec"
+	"journey/internal/pgstore"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestGetLink(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	other := store.addTrip(newTestTrip(3))
+	link := store.addLink(trip.ID, "Booking", "https://example.com/booking")
+	api := newTestAPI(store, &fakeMailer{})
+
+	t.Run("found", func(t *testing.T) {
+		w := serve(api, newRequest(t, http.MethodGet, "/trips/"+trip.ID.String()+"/links/"+link.ID.String(), nil))
+
+		assertStatus(t, w, http.StatusOK)
+		var response spec.GetLinkResponse
+		decodeResponse(t, w, &response)
+		if response.Link.ID != link.ID.String() || response.Link.URL != link.Url {
+			t.Fatalf("expected link %s, got %+v", link.ID, response.Link)
+		}
+		if calls := store.callsOf("TripExists"); calls != 0 {
+			t.Fatalf("expected the trip only checked on a miss, got %d checks", calls)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		w := serve(api, newRequest(t, http.MethodGet, "/trips/"+trip.ID.String()+"/links/"+uuid.NewString(), nil))
+
+		assertStatus(t, w, http.StatusNotFound)
+		var response spec.NotFoundRequest
+		decodeResponse(t, w, &response)
+		if response.Code != string(ErrorCodeLinkNotFound) {
+			t.Fatalf("expected %s on the existing trip, got %s", ErrorCodeLinkNotFound, response.Code)
+		}
+	})
+
+	t.Run("missing trip", func(t *testing.T) {
+		w := serve(api, newRequest(t, http.MethodGet, "/trips/"+uuid.NewString()+"/links/"+link.ID.String(), nil))
+
+		assertStatus(t, w, http.StatusNotFound)
+		var response spec.NotFoundRequest
+		decodeResponse(t, w, &response)
+		if response.Code != string(ErrorCodeTripNotFound) {
+			t.Fatalf("expected %s, got %s", ErrorCodeTripNotFound, response.Code)
+		}
+	})
+
+	t.Run("wrong trip", func(t *testing.T) {
+		w := serve(api, newRequest(t, http.MethodGet, "/trips/"+other.ID.String()+"/links/"+link.ID.String(), nil))
+
+		assertStatus(t, w, http.StatusNotFound)
+	})
+}
+
+func TestPostTripsTripIDLinksAnswersTheLink(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	api := newTestAPI(store, &fakeMailer{})
+	linksPath := "/trips/" + trip.ID.String() + "/links"
+
+	r := newRequest(t, http.MethodPost, linksPath, map[string]string{"title": "Booking", "url": "https://example.com/booking"})
+	w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+	assertStatus(t, w, http.StatusCreated)
+	var created spec.CreateLinkResponse
+	decodeResponse(t, w, &created)
+	if created.LinkID == "" || created.Link.ID != created.LinkID {
+		t.Fatalf("expected the link id on both fields, got %+v", created)
+	}
+
+	w = serve(api, newRequest(t, http.MethodGet, linksPath+"/"+created.LinkID, nil))
+
+	var fetched spec.GetLinkResponse
+	decodeResponse(t, w, &fetched)
+	if !reflect.DeepEqual(created.Link, fetched.Link) {
+		t.Fatalf("expected the created link as fetched %+v, got %+v", fetched.Link, created.Link)
+	}
+}
+
+func TestPostTripsTripIDLinksRejectsDuplicatedURLs(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	other := store.addTrip(newTestTrip(3))
+	store.addLink(trip.ID, "Stored before the normalization", "HTTPS://Example.com/Stored")
+	api := newTestAPI(store, &fakeMailer{})
+
+	post := func(t *testing.T, tripID uuid.UUID, title, url string) *httptest.ResponseRecorder {
+		t.Helper()
+		r := newRequest(t, http.MethodPost, "/trips/"+tripID.String()+"/links", map[string]string{"title": title, "url": url})
+		return serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+	}
+
+	assertStatus(t, post(t, trip.ID, "Hotel booking", "https://example.com/booking"), http.StatusCreated)
+
+	duplicates := map[string]string{
+		"same url":           "https://example.com/booking",
+		"surrounding spaces": "  https://example.com/booking ",
+		"scheme and host":    "HTTPS://EXAMPLE.com/booking",
+		"stored before":      "https://example.com/Stored",
+	}
+	for name, url := range duplicates {
+		t.Run(name, func(t *testing.T) {
+			w := post(t, trip.ID, "Another title", url)
+
+			assertStatus(t, w, http.StatusConflict)
+			var response spec.ConflictRequest
+			decodeResponse(t, w, &response)
+			if response.Code != string(ErrorCodeLinkAlreadyExists) {
+				t.Fatalf("expected %s, got %s", ErrorCodeLinkAlreadyExists, response.Code)
+			}
+		})
+	}
+
+	t.Run("different urls", func(t *testing.T) {
+		assertStatus(t, post(t, trip.ID, "Hotel booking", "https://example.com/BOOKING"), http.StatusCreated)
+		assertStatus(t, post(t, trip.ID, "Hotel booking", "https://example.com/booking?room=2"), http.StatusCreated)
+	})
+
+	t.Run("on another trip", func(t *testing.T) {
+		assertStatus(t, post(t, other.ID, "Hotel booking", "https://example.com/booking"), http.StatusCreated)
+	})
+
+	t.Run("created concurrently", func(t *testing.T) {
+		// the link created meanwhile is only refused by the store.
+		store.addLink(trip.ID, "Created meanwhile", "https://example.com/meanwhile")
+		api := newTestAPI(linksUnseenStore{store}, &fakeMailer{})
+		r := newRequest(t, http.MethodPost, "/trips/"+trip.ID.String()+"/links", map[string]string{"title": "Meanwhile", "url": "https://example.com/meanwhile"})
+
+		assertStatus(t, serve(api, withOwnerToken(r, TEST_OWNER_TOKEN)), http.StatusConflict)
+	})
+}
+
+// linksUnseenStore reads the trips without their links, as read before a concurrent request created them.
+type linksUnseenStore struct {
+	*fakeStore
+}
+
+func (s linksUnseenStore) GetTripWithLinks(ctx context.Context, tripID uuid.UUID) (pgstore.Trip, []pgstore.Link, error) {
+	trip, _, err := s.fakeStore.GetTripWithLinks(ctx, tripID)
+	return trip, nil, err
+}
@@ -0,0 +1,98 @@
+package api
+
+import (
+	"journey/internal/api/spec"
+	"net/http"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestCreatedResourcesLocation(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	api := newTestAPI(store, &fakeMailer{})
+	startsAt := testNow.AddDate(0, 0, 1)
+	tripPath := "/trips/" + trip.ID.String()
+	uuidPattern := "[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}"
+
+	cases := map[string]struct {
+		r        *http.Request
+		location *regexp.Regexp
+		// idField is the body field holding the id the Location carries.
+		idField string
+	}{
+		"trip": {
+			r:        newRequest(t, http.MethodPost, "/trips", newCreateTripBody("owner@example.com", startsAt, startsAt.AddDate(0, 0, 3))),
+			location: regexp.MustCompile("^/trips/(" + uuidPattern + ")$"),
+			idField:  "tripId",
+		},
+		"activity": {
+			r: withOwnerToken(newRequest(t, http.MethodPost, tripPath+"/activities", map[string]any{
+				"title": "Museum", "occurs_at": trip.StartsAt.Time.Add(2 * time.Hour),
+			}), TEST_OWNER_TOKEN),
+			location: regexp.MustCompile("^" + tripPath + "/activities/(" + uuidPattern + ")$"),
+			idField:  "activityId",
+		},
+		"link": {
+			r: withOwnerToken(newRequest(t, http.MethodPost, tripPath+"/links", map[string]string{
+				"title": "Booking", "url": "https://example.com/booking",
+			}), TEST_OWNER_TOKEN),
+			location: regexp.MustCompile("^" + tripPath + "/links/(" + uuidPattern + ")$"),
+			idField:  "linkId",
+		},
+		"invite": {
+			r:        withOwnerToken(newRequest(t, http.MethodPost, tripPath+"/invites", map[string]string{"email": "guest@example.com"}), TEST_OWNER_TOKEN),
+			location: regexp.MustCompile("^" + tripPath + "/participants$"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			w := serve(api, tc.r)
+
+			assertStatus(t, w, http.StatusCreated)
+			location := w.Header().Get("Location")
+			match := tc.location.FindStringSubmatch(location)
+			if match == nil {
+				t.Fatalf("expected a Location matching %s, got %q", tc.location, location)
+			}
+			if tc.idField != "" {
+				var created map[string]any
+				decodeResponse(t, w, &created)
+				if match[1] != created[tc.idField] {
+					t.Fatalf("expected the Location to carry the %s %v, got %q", tc.idField, created[tc.idField], location)
+				}
+			}
+
+			// the Location is followed to the created resource.
+			w = serve(api, newRequest(t, http.MethodGet, location, nil))
+			assertStatus(t, w, http.StatusOK)
+		})
+	}
+}
+
+func TestPostTripsReplaysTheLocation(t *testing.T) {
+	api := newTestAPI(newFakeStore(), &fakeMailer{})
+	startsAt := testNow.AddDate(0, 0, 1)
+	body := newCreateTripBody("owner@example.com", startsAt, startsAt.AddDate(0, 0, 3))
+
+	var locations []string
+	for range 2 {
+		r := newRequest(t, http.MethodPost, "/trips", body)
+		r.Header.Set("Idempotency-Key", "retry-me")
+		w := serve(api, r)
+
+		assertStatus(t, w, http.StatusCreated)
+		var response spec.CreateTripResponse
+		decodeResponse(t, w, &response)
+		if location := w.Header().Get("Location"); location != "/trips/"+response.TripID {
+			t.Fatalf("expected the Location of trip %s, got %q", response.TripID, location)
+		}
+		locations = append(locations, w.Header().Get("Location"))
+	}
+
+	if locations[0] != locations[1] {
+		t.Fatalf("expected the retry to answer the same Location, got %v", locations)
+	}
+}
@@ -0,0 +1,81 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// plainTextContentType is the content type of the errors answered to the clients asking for text/plain.
+const plainTextContentType = "text/plain; charset=utf-8"
+
+// negotiateErrors answers the JSON errors of next as a "CODE: message" line to the clients whose Accept
+// header prefers text/plain, as the command line ones. Any other client keeps the JSON errors untouched.
+func negotiateErrors(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !prefersPlainText(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		pw := &plainTextErrorWriter{ResponseWriter: w}
+		next.ServeHTTP(pw, r)
+		pw.flush()
+	})
+}
+
+// prefersPlainText tells whether text/plain is the first media type of the Accept header. A missing header
+// or a wildcard keep the JSON default.
+func prefersPlainText(r *http.Request) bool {
+	first, _, _ := strings.Cut(r.Header.Get("Accept"), ",")
+	mediaType, _, _ := strings.Cut(first, ";")
+	return strings.EqualFold(strings.TrimSpace(mediaType), "text/plain")
+}
+
+// plainTextErrorWriter holds back the JSON error bodies, written as plain text on flush. The other responses
+// pass through.
+type plainTextErrorWriter struct {
+	http.ResponseWriter
+	code     int
+	buffered bool
+	body     bytes.Buffer
+}
+
+func (w *plainTextErrorWriter) WriteHeader(code int) {
+	if code >= http.StatusBadRequest && strings.HasPrefix(w.Header().Get("Content-Type"), "application/json") {
+		w.code = code
+		w.buffered = true
+		return
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *plainTextErrorWriter) Write(b []byte) (int, error) {
+	if w.buffered {
+		return w.body.Write(b)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// flush writes the error held back, as is when it is not one of the spec error bodies.
+func (w *plainTextErrorWriter) flush() {
+	if !w.buffered {
+		return
+	}
+
+	var apiErr struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(w.body.Bytes(), &apiErr); err != nil || apiErr.Message == "" {
+		w.ResponseWriter.WriteHeader(w.code)
+		_, _ = w.ResponseWriter.Write(w.body.Bytes())
+		return
+	}
+
+	w.Header().Set("Content-Type", plainTextContentType)
+	w.ResponseWriter.WriteHeader(w.code)
+	_, _ = fmt.Fprintf(w.ResponseWriter, "%s: %s\n", apiErr.Code, apiErr.Message)
+}
@@ -0,0 +1,73 @@
+package api
+
+import (
+	"journey/internal/api/spec"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestErrorsAsPlainText(t *testing.T) {
+	api := newTestAPI(newFakeStore(), &fakeMailer{})
+
+	cases := map[string]struct {
+		status int
+		body   string
+	}{
+		"/trips/" + uuid.NewString(): {status: http.StatusNotFound, body: "TRIP_NOT_FOUND: trip not found\n"},
+		"/trips/not-an-uuid":         {status: http.StatusBadRequest, body: "INVALID_UUID: "},
+	}
+
+	for target, expected := range cases {
+		r := newRequest(t, http.MethodGet, target, nil)
+		r.Header.Set("Accept", "text/plain, application/json;q=0.5")
+		r.Header.Set("Accept-Language", "en")
+		w := serve(api, r)
+
+		assertStatus(t, w, expected.status)
+		if contentType := w.Header().Get("Content-Type"); !strings.HasPrefix(contentType, "text/plain") {
+			t.Fatalf("expected a text/plain error on %s, got %q", target, contentType)
+		}
+		if body := w.Body.String(); !strings.HasPrefix(body, expected.body) {
+			t.Fatalf("expected the error %q on %s, got %q", expected.body, target, body)
+		}
+	}
+}
+
+func TestErrorsDefaultToJSON(t *testing.T) {
+	api := newTestAPI(newFakeStore(), &fakeMailer{})
+
+	for _, accept := range []string{"", "*/*", "application/json"} {
+		r := newRequest(t, http.MethodGet, "/trips/"+uuid.NewString(), nil)
+		if accept != "" {
+			r.Header.Set("Accept", accept)
+		}
+		w := serve(api, r)
+
+		assertStatus(t, w, http.StatusNotFound)
+		var response spec.NotFoundRequest
+		decodeResponse(t, w, &response)
+		if response.Code != string(ErrorCodeTripNotFound) {
+			t.Fatalf("expected the JSON error with Accept %q, got %s", accept, w.Body.String())
+		}
+	}
+}
+
+func TestPlainTextKeepsTheSuccessesAsJSON(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	api := newTestAPI(store, &fakeMailer{})
+
+	r := newRequest(t, http.MethodGet, "/trips/"+trip.ID.String(), nil)
+	r.Header.Set("Accept", "text/plain")
+	w := serve(api, r)
+
+	assertStatus(t, w, http.StatusOK)
+	var response spec.GetTripDetailsResponse
+	decodeResponse(t, w, &response)
+	if response.Trip.ID != trip.ID.String() {
+		t.Fatalf("expected the trip details, got %s", w.Body.String())
+	}
+}
@@ -0,0 +1,206 @@
+package api
+
+import (
+	"context"
+	"journey/internal/api/spec"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestTripNotes(t *testing.T) {
+	store := newFakeStore()
+	api := newTestAPI(store, &fakeMailer{})
+	startsAt := testNow.Add(time.Hour)
+
+	body := newCreateTripBody("owner@trip.com", startsAt, startsAt.AddDate(0, 0, 3))
+	body["notes"] = "bring passport"
+	w := serve(api, newRequest(t, http.MethodPost, "/trips", body))
+
+	assertStatus(t, w, http.StatusCreated)
+	var created spec.CreateTripResponse
+	decodeResponse(t, w, &created)
+
+	details := func(t *testing.T) spec.GetTripDetailsResponseTripObj {
+		t.Helper()
+		w := serve(api, newRequest(t, http.MethodGet, "/trips/"+created.TripID, nil))
+		assertStatus(t, w, http.StatusOK)
+		var response spec.GetTripDetailsResponse
+		decodeResponse(t, w, &response)
+		return response.Trip
+	}
+	update := func(t *testing.T, notes *string) int {
+		t.Helper()
+		body := map[string]any{
+			"destination": "Florianópolis",
+			"starts_at":   startsAt,
+			"ends_at":     startsAt.AddDate(0, 0, 3),
+		}
+		if notes != nil {
+			body["notes"] = *notes
+		}
+		r := withOwnerToken(newRequest(t, http.MethodPut, "/trips/"+created.TripID, body), created.OwnerToken)
+		return serve(api, r).Code
+	}
+
+	if trip := details(t); trip.Notes == nil || *trip.Notes != "bring passport" {
+		t.Fatalf("expected the notes set on the creation, got %v", trip.Notes)
+	}
+
+	t.Run("kept when omitted", func(t *testing.T) {
+		if code := update(t, nil); code != http.StatusNoContent {
+			t.Fatalf("expected %d, got %d", http.StatusNoContent, code)
+		}
+		if trip := details(t); trip.Notes == nil || *trip.Notes != "bring passport" {
+			t.Fatalf("expected the notes kept, got %v", trip.Notes)
+		}
+	})
+
+	t.Run("replaced", func(t *testing.T) {
+		notes := "reservation under Smith"
+		if code := update(t, &notes); code != http.StatusNoContent {
+			t.Fatalf("expected %d, got %d", http.StatusNoContent, code)
+		}
+		if trip := details(t); trip.Notes == nil || *trip.Notes != notes {
+			t.Fatalf("expected the notes replaced, got %v", trip.Notes)
+		}
+	})
+
+	t.Run("too long", func(t *testing.T) {
+		notes := strings.Repeat("a", 1001)
+		if code := update(t, &notes); code != http.StatusBadRequest {
+			t.Fatalf("expected %d, got %d", http.StatusBadRequest, code)
+		}
+		if trip := details(t); trip.Notes == nil || *trip.Notes != "reservation under Smith" {
+			t.Fatalf("expected the notes untouched, got %v", trip.Notes)
+		}
+	})
+
+	t.Run("cleared when empty", func(t *testing.T) {
+		notes := ""
+		if code := update(t, &notes); code != http.StatusNoContent {
+			t.Fatalf("expected %d, got %d", http.StatusNoContent, code)
+		}
+		if trip := details(t); trip.Notes != nil {
+			t.Fatalf("expected the notes cleared, got %q", *trip.Notes)
+		}
+		w := serve(api, newRequest(t, http.MethodGet, "/trips/"+created.TripID, nil))
+		if strings.Contains(w.Body.String(), `"notes"`) {
+			t.Fatalf("expected no notes on the details, got %s", w.Body.String())
+		}
+	})
+}
+
+func TestPostTripsRejectsLongNotes(t *testing.T) {
+	store := newFakeStore()
+	api := newTestAPI(store, &fakeMailer{})
+	startsAt := testNow.Add(time.Hour)
+
+	body := newCreateTripBody("owner@trip.com", startsAt, startsAt.AddDate(0, 0, 3))
+	body["notes"] = strings.Repeat("a", 1001)
+	w := serve(api, newRequest(t, http.MethodPost, "/trips", body))
+
+	assertStatus(t, w, http.StatusBadRequest)
+	if calls := store.callsOf("CreateTrip"); calls != 0 {
+		t.Fatalf("expected no trip created, got %d", calls)
+	}
+}
+
+func TestActivityNotes(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	api := newTestAPI(store, &fakeMailer{})
+	occursAt := trip.StartsAt.Time.Add(2 * time.Hour)
+
+	create := func(t *testing.T, notes *string) *httptest.ResponseRecorder {
+		t.Helper()
+		body := map[string]any{"title": "Museum", "occurs_at": occursAt}
+		if notes != nil {
+			body["notes"] = *notes
+		}
+		r := withOwnerToken(newRequest(t, http.MethodPost, "/trips/"+trip.ID.String()+"/activities", body), TEST_OWNER_TOKEN)
+		return serve(api, r)
+	}
+
+	t.Run("set", func(t *testing.T) {
+		notes := "bring passport"
+		w := create(t, &notes)
+
+		assertStatus(t, w, http.StatusCreated)
+		var created spec.CreateActivityResponse
+		decodeResponse(t, w, &created)
+		if created.Activity.Notes == nil || *created.Activity.Notes != notes {
+			t.Fatalf("expected the notes answered, got %v", created.Activity.Notes)
+		}
+
+		w = serve(api, newRequest(t, http.MethodGet, "/trips/"+trip.ID.String()+"/activities/"+created.ActivityID, nil))
+		assertStatus(t, w, http.StatusOK)
+		var response spec.GetActivityResponse
+		decodeResponse(t, w, &response)
+		if response.Activity.Notes == nil || *response.Activity.Notes != notes {
+			t.Fatalf("expected the notes stored, got %v", response.Activity.Notes)
+		}
+	})
+
+	t.Run("empty or omitted are null", func(t *testing.T) {
+		empty := ""
+		for _, notes := range []*string{nil, &empty} {
+			w := create(t, notes)
+
+			assertStatus(t, w, http.StatusCreated)
+			var created spec.CreateActivityResponse
+			decodeResponse(t, w, &created)
+			if created.Activity.Notes != nil {
+				t.Fatalf("expected no notes, got %q", *created.Activity.Notes)
+			}
+			if stored := store.activity(uuid.MustParse(created.ActivityID)); stored.Notes.Valid {
+				t.Fatalf("expected null notes stored, got %q", stored.Notes.String)
+			}
+		}
+	})
+
+	t.Run("too long", func(t *testing.T) {
+		notes := strings.Repeat("a", 1001)
+		before := store.callsOf("CreateActivity")
+
+		assertStatus(t, create(t, &notes), http.StatusBadRequest)
+		if calls := store.callsOf("CreateActivity"); calls != before {
+			t.Fatalf("expected no activity created, got %d more", calls-before)
+		}
+	})
+}
+
+func TestPostTripsTripIDActivitiesBatchNotes(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	api := newTestAPI(store, &fakeMailer{})
+	occursAt := trip.StartsAt.Time.Add(2 * time.Hour)
+
+	r := newRequest(t, http.MethodPost, "/trips/"+trip.ID.String()+"/activities/batch", map[string]any{
+		"activities": []map[string]any{
+			{"title": "Museum", "occurs_at": occursAt, "notes": "bring passport"},
+			{"title": "Dinner", "occurs_at": occursAt.Add(time.Hour), "notes": strings.Repeat("a", 1001)},
+		},
+	})
+	assertStatus(t, serve(api, withOwnerToken(r, TEST_OWNER_TOKEN)), http.StatusBadRequest)
+
+	r = newRequest(t, http.MethodPost, "/trips/"+trip.ID.String()+"/activities/batch", map[string]any{
+		"activities": []map[string]any{
+			{"title": "Museum", "occurs_at": occursAt, "notes": "bring passport"},
+		},
+	})
+	assertStatus(t, serve(api, withOwnerToken(r, TEST_OWNER_TOKEN)), http.StatusCreated)
+
+	activities, err := store.GetTripActivities(context.Background(), trip.ID)
+	if err != nil || len(activities) != 1 {
+		t.Fatalf("expected the activity created, got %v, %v", activities, err)
+	}
+	if activities[0].Notes != (pgtype.Text{String: "bring passport", Valid: true}) {
+		t.Fatalf("expected the notes stored, got %+v", activities[0].Notes)
+	}
+}
@@ -0,0 +1,76 @@
+package api
+
+import (
+	"context"
+	"journey/cmd/journey/config"
+	"journey/internal/api/spec"
+	"journey/internal/i18n"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// SYNC_OWNER_CONFIRMATION_TIMEOUT is how long the creation waits on the confirmation email of the owner, well
+// under the 5s write timeout of the server so the created trip is still answered.
+const SYNC_OWNER_CONFIRMATION_TIMEOUT = 2 * time.Second
+
+// GetMailSyncOwnerConfirmation reads JOURNEY_MAIL_SYNC_OWNER_CONFIRMATION, the confirmation email of the owner
+// is then sent before the created trip is answered. It is false when missing or invalid, the email queued.
+func GetMailSyncOwnerConfirmation() bool {
+	if value, err := config.GetSpecificEnvironmentVariable("JOURNEY_MAIL_SYNC_OWNER_CONFIRMATION"); err == nil {
+		if sync, err := strconv.ParseBool(value); err == nil {
+			return sync
+		}
+	}
+	return false
+}
+
+// WithSyncOwnerConfirmation overrides whether the confirmation email of the owner is sent before the created
+// trip is answered, JOURNEY_MAIL_SYNC_OWNER_CONFIRMATION by default.
+func WithSyncOwnerConfirmation(sync bool) Option {
+	return func(api *API) {
+		api.syncOwnerConfirmation = sync
+	}
+}
+
+// sendsOwnerConfirmationSync tells whether the creation waits on the confirmation email of the owner, as the
+// sync query parameter asks or else as configured.
+func (api *API) sendsOwnerConfirmationSync(params spec.PostTripsParams) bool {
+	if params.Sync != nil {
+		return *params.Sync
+	}
+	return api.syncOwnerConfirmation
+}
+
+// sendOwnerConfirmation sends the confirmation email of the owner of the trip created, answering the warning
+// of the response when it fails or outlasts syncOwnerConfirmationTimeout. The trip is kept all the same, the
+// failure only logged.
+func (api *API) sendOwnerConfirmation(r *http.Request, tripID uuid.UUID, ownerEmail string, send func(context.Context) error) *string {
+	ctx, cancel := context.WithTimeout(r.Context(), api.syncOwnerConfirmationTimeout)
+	defer cancel()
+
+	// waited on apart, a mailer ignoring ctx doesn't hold the response past the timeout.
+	sent := make(chan error, 1)
+	go func() { sent <- send(ctx) }()
+
+	var err error
+	select {
+	case err = <-sent:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	if err != nil {
+		api.loggerFor(r.Context()).Warn(
+			"failed to send email on PostTrips",
+			zap.Error(err),
+			zap.String("trip_id", tripID.String()),
+		)
+		warning := api.message(r, i18n.OwnerConfirmationNotSent, ownerEmail)
+		return &warning
+	}
+	return nil
+}
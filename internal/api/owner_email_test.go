@@ -0,0 +1,204 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"journey/internal/api/spec"
+	"journey/internal/i18n"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+func newCreateTripBody(ownerEmail string, startsAt, endsAt time.Time) map[string]any {
+	return map[string]any{
+		"destination":      "Florianópolis",
+		"owner_name":       "Owner",
+		"owner_email":      ownerEmail,
+		"emails_to_invite": []string{},
+		"starts_at":        startsAt,
+		"ends_at":          endsAt,
+	}
+}
+
+func TestPostTripsRejectsMalformedOwnerEmail(t *testing.T) {
+	store := newFakeStore()
+	api := newTestAPI(store, &fakeMailer{})
+	startsAt := testNow.Add(time.Hour)
+
+	for _, email := range []string{"", "not-an-email", "owner@", "@example.com", "owner @example.com"} {
+		t.Run(email, func(t *testing.T) {
+			w := serve(api, newRequest(t, http.MethodPost, "/trips", newCreateTripBody(email, startsAt, startsAt.AddDate(0, 0, 3))))
+
+			assertStatus(t, w, http.StatusBadRequest)
+			var response spec.BadRequest
+			decodeResponse(t, w, &response)
+			if !strings.Contains(response.Message, "owner_email (") {
+				t.Fatalf("expected the message to list owner_email, got %q", response.Message)
+			}
+		})
+	}
+
+	if store.callsOf("CreateTrip") != 0 {
+		t.Fatal("expected no trip persisted")
+	}
+}
+
+func TestPostTripsNormalizesOwnerEmail(t *testing.T) {
+	store := newFakeStore()
+	api := newTestAPI(store, &fakeMailer{})
+	startsAt := testNow.Add(time.Hour)
+
+	w := serve(api, newRequest(t, http.MethodPost, "/trips", newCreateTripBody("  Traveler@Example.COM ", startsAt, startsAt.AddDate(0, 0, 3))))
+
+	assertStatus(t, w, http.StatusCreated)
+	var response spec.CreateTripResponse
+	decodeResponse(t, w, &response)
+	if email := store.trip(uuid.MustParse(response.TripID)).OwnerEmail; email != "traveler@example.com" {
+		t.Fatalf("expected the owner email trimmed and lower-cased, got %q", email)
+	}
+}
+
+// heldDispatcher keeps the emails enqueued without sending them.
+type heldDispatcher struct {
+	mu    sync.Mutex
+	names []string
+}
+
+func (d *heldDispatcher) Enqueue(_ context.Context, name string, _ func() error, _ ...zap.Field) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.names = append(d.names, name)
+	return nil
+}
+
+func (d *heldDispatcher) enqueued() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return len(d.names)
+}
+
+// blockingMailer never finishes sending the confirmation of the owner, until released.
+type blockingMailer struct {
+	*fakeMailer
+	release  chan struct{}
+	deadline chan time.Time
+}
+
+func (m blockingMailer) SendConfirmTripEmailToTripOwnerOnce(ctx context.Context, _ uuid.UUID, _ i18n.Locale) error {
+	deadline, _ := ctx.Deadline()
+	m.deadline <- deadline
+	<-m.release
+	return nil
+}
+
+func TestPostTripsSendsOwnerConfirmationSync(t *testing.T) {
+	startsAt := testNow.Add(time.Hour)
+	body := newCreateTripBody("owner@example.com", startsAt, startsAt.AddDate(0, 0, 3))
+
+	t.Run("failing", func(t *testing.T) {
+		store := newFakeStore()
+		mailer := &fakeMailer{err: errors.New("mailbox unavailable")}
+		dispatcher := &heldDispatcher{}
+		api := newTestAPI(store, mailer, WithDispatcher(dispatcher))
+
+		w := serve(api, newRequest(t, http.MethodPost, "/trips?sync=true", body))
+
+		assertStatus(t, w, http.StatusCreated)
+		var response spec.CreateTripResponse
+		decodeResponse(t, w, &response)
+		if response.Warning == nil || !strings.Contains(*response.Warning, "owner@example.com") {
+			t.Fatalf("expected a warning of the email not sent, got %v", response.Warning)
+		}
+		if trip := store.trip(uuid.MustParse(response.TripID)); trip.OwnerEmail != "owner@example.com" {
+			t.Fatalf("expected the trip persisted, got %+v", trip)
+		}
+		if len(mailer.ownerConfirmations) != 1 || dispatcher.enqueued() != 0 {
+			t.Fatalf("expected the email sent once without queueing, got %d sent and %d queued", len(mailer.ownerConfirmations), dispatcher.enqueued())
+		}
+	})
+
+	t.Run("sent", func(t *testing.T) {
+		mailer := &fakeMailer{}
+		api := newTestAPI(newFakeStore(), mailer, WithDispatcher(&heldDispatcher{}))
+
+		w := serve(api, newRequest(t, http.MethodPost, "/trips?sync=true", body))
+
+		assertStatus(t, w, http.StatusCreated)
+		var response spec.CreateTripResponse
+		decodeResponse(t, w, &response)
+		if response.Warning != nil {
+			t.Fatalf("expected no warning, got %q", *response.Warning)
+		}
+		if len(mailer.ownerConfirmations) != 1 {
+			t.Fatalf("expected the email sent before answering, got %d", len(mailer.ownerConfirmations))
+		}
+	})
+
+	t.Run("blocking", func(t *testing.T) {
+		mailer := blockingMailer{fakeMailer: &fakeMailer{}, release: make(chan struct{}), deadline: make(chan time.Time, 1)}
+		defer close(mailer.release)
+		api := newTestAPI(newFakeStore(), mailer, WithDispatcher(&heldDispatcher{}))
+		api.syncOwnerConfirmationTimeout = 50 * time.Millisecond
+
+		started := time.Now()
+		w := serve(api, newRequest(t, http.MethodPost, "/trips?sync=true", body))
+
+		assertStatus(t, w, http.StatusCreated)
+		if elapsed := time.Since(started); elapsed > time.Second {
+			t.Fatalf("expected the trip answered once the send timed out, took %v", elapsed)
+		}
+		var response spec.CreateTripResponse
+		decodeResponse(t, w, &response)
+		if response.Warning == nil {
+			t.Fatal("expected a warning of the email not sent")
+		}
+		if deadline := <-mailer.deadline; deadline.IsZero() || deadline.Sub(started) > SYNC_OWNER_CONFIRMATION_TIMEOUT {
+			t.Fatalf("expected the send bound by the timeout, got the deadline %v", deadline)
+		}
+	})
+
+	t.Run("configured by default", func(t *testing.T) {
+		mailer := &fakeMailer{err: errors.New("mailbox unavailable")}
+		api := newTestAPI(newFakeStore(), mailer, WithDispatcher(&heldDispatcher{}), WithSyncOwnerConfirmation(true))
+
+		w := serve(api, newRequest(t, http.MethodPost, "/trips", body))
+
+		assertStatus(t, w, http.StatusCreated)
+		var response spec.CreateTripResponse
+		decodeResponse(t, w, &response)
+		if response.Warning == nil {
+			t.Fatal("expected a warning of the email not sent")
+		}
+	})
+
+	t.Run("queued when asked", func(t *testing.T) {
+		mailer := &fakeMailer{err: errors.New("mailbox unavailable")}
+		dispatcher := &heldDispatcher{}
+		api := newTestAPI(newFakeStore(), mailer, WithDispatcher(dispatcher), WithSyncOwnerConfirmation(true))
+
+		w := serve(api, newRequest(t, http.MethodPost, "/trips?sync=false", body))
+
+		assertStatus(t, w, http.StatusCreated)
+		var response spec.CreateTripResponse
+		decodeResponse(t, w, &response)
+		if response.Warning != nil || dispatcher.enqueued() != 1 {
+			t.Fatalf("expected the email queued without warning, got %v and %d queued", response.Warning, dispatcher.enqueued())
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		store := newFakeStore()
+		api := newTestAPI(store, &fakeMailer{})
+
+		assertStatus(t, serve(api, newRequest(t, http.MethodPost, "/trips?sync=maybe", body)), http.StatusBadRequest)
+		if store.callsOf("CreateTrip") != 0 {
+			t.Fatal("expected no trip persisted")
+		}
+	})
+}
@@ -0,0 +1,111 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"journey/internal/api/spec"
+	"journey/internal/i18n"
+	"journey/internal/jwt"
+	"journey/internal/mailer/mailpit"
+	"net/http"
+
+	"go.uber.org/zap"
+)
+
+// Send the trip owner a magic link to get a new owner token.
+// (POST /trips/{tripId}/owner/magic-link)
+func (api *API) PostTripsTripIDOwnerMagicLink(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID := pathUUID(r, "tripId")
+
+	if !api.ownerTokens.Enabled() {
+		return spec.PostTripsTripIDOwnerMagicLinkJSON409Response(api.conflict(r, i18n.MagicLinksDisabled))
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		return spec.PostTripsTripIDOwnerMagicLinkJSON404Response(api.notFound(r, i18n.TripNotFound))
+	}
+
+	var body spec.PostTripsTripIDOwnerMagicLinkJSONRequestBody
+	if err := decodeBody(r, &body); err != nil {
+		return spec.PostTripsTripIDOwnerMagicLinkJSON400Response(api.badRequest(r, i18n.InvalidRequest, err.Error()))
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostTripsTripIDOwnerMagicLinkJSON400Response(api.invalidFieldsRequest(r, err))
+	}
+
+	// Answered the same for another email, so the route doesn't tell who owns the trip.
+	if string(body.Email) != normalizeEmail(trip.OwnerEmail) {
+		return spec.PostTripsTripIDOwnerMagicLinkJSON202Response(nil)
+	}
+
+	magicLink, _, err := api.ownerTokens.Issue(jwt.ScopeMagicLink, trip.ID, normalizeEmail(trip.OwnerEmail), api.clock.Now())
+	if err != nil {
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed on route: '%v: %v'", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripID),
+		)
+		return spec.PostTripsTripIDOwnerMagicLinkJSON500Response(api.internalServerError(r, i18n.UnableToSendMagicLink))
+	}
+
+	dataToSendMagicLink := mailpit.SendOwnerMagicLink{
+		Trip:   trip,
+		Token:  magicLink,
+		Locale: i18n.FromRequest(r),
+	}
+
+	// The link is all the route does, a link failing to be queued is answered rather than only logged.
+	sendEmail := func() error { return api.mailer.SendOwnerMagicLink(dataToSendMagicLink) }
+	if err := api.dispatcher.Enqueue(r.Context(), "PostTripsTripIDOwnerMagicLink", sendEmail, zap.String("tripID", tripID)); err != nil {
+		api.loggerFor(r.Context()).Error(
+			"failed to enqueue email on PostTripsTripIDOwnerMagicLink",
+			zap.Error(err),
+			zap.String("tripID", tripID),
+		)
+		return spec.PostTripsTripIDOwnerMagicLinkJSON500Response(api.internalServerError(r, i18n.UnableToSendMagicLink))
+	}
+
+	return spec.PostTripsTripIDOwnerMagicLinkJSON202Response(nil)
+}
+
+// Exchange the token of a magic link for an owner token.
+// (GET /trips/{tripId}/owner/token)
+func (api *API) GetTripsTripIDOwnerToken(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDOwnerTokenParams) *spec.Response {
+	tripUUID := pathUUID(r, "tripId")
+
+	if !api.ownerTokens.Enabled() {
+		return spec.GetTripsTripIDOwnerTokenJSON409Response(api.conflict(r, i18n.MagicLinksDisabled))
+	}
+
+	claims, err := api.ownerTokens.Verify(jwt.ScopeMagicLink, params.Token, api.clock.Now())
+	if errors.Is(err, jwt.ErrExpired) {
+		return spec.GetTripsTripIDOwnerTokenJSON403Response(api.forbidden(r, i18n.MagicLinkExpired))
+	}
+	if err != nil || claims.TripID != tripUUID {
+		return spec.GetTripsTripIDOwnerTokenJSON403Response(api.forbidden(r, i18n.InvalidMagicLink))
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		return spec.GetTripsTripIDOwnerTokenJSON404Response(api.notFound(r, i18n.TripNotFound))
+	}
+
+	// A link sent before the trip was transferred doesn't give the new owner's trip to the previous one.
+	if normalizeEmail(claims.OwnerEmail) != normalizeEmail(trip.OwnerEmail) {
+		return spec.GetTripsTripIDOwnerTokenJSON403Response(api.forbidden(r, i18n.InvalidMagicLink))
+	}
+
+	ownerToken, issued, err := api.ownerTokens.Issue(jwt.ScopeOwner, trip.ID, normalizeEmail(trip.OwnerEmail), api.clock.Now())
+	if err != nil {
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed on route: '%v: %v'", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripID),
+		)
+		return spec.GetTripsTripIDOwnerTokenJSON500Response(api.internalServerError(r, i18n.UnexpectedError))
+	}
+
+	return spec.GetTripsTripIDOwnerTokenJSON200Response(spec.OwnerTokenResponse{OwnerToken: ownerToken, ExpiresAt: issued.Expiry()})
+}
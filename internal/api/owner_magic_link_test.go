@@ -0,0 +1,209 @@
+package api
+
+import (
+	"journey/internal/api/spec"
+	"journey/internal/jwt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var testOwnerTokens = jwt.New("shared-secret", time.Hour)
+
+// issueTestToken issues a token of the trip owned by ownerEmail for scope at issuedAt.
+func issueTestToken(t *testing.T, scope jwt.Scope, tripID uuid.UUID, ownerEmail string, issuedAt time.Time) string {
+	t.Helper()
+
+	token, _, err := testOwnerTokens.Issue(scope, tripID, ownerEmail, issuedAt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+// renameTrip answers the status of renaming the trip with the owner token.
+func renameTrip(t *testing.T, api *API, trip spec.GetTripDetailsResponseTripObj, ownerToken string) int {
+	t.Helper()
+
+	r := newRequest(t, http.MethodPut, "/trips/"+trip.ID, map[string]any{
+		"destination": "Salvador",
+		"starts_at":   trip.StartsAt,
+		"ends_at":     trip.EndsAt,
+	})
+	return serve(api, withOwnerToken(r, ownerToken)).Code
+}
+
+func TestOwnerJWT(t *testing.T) {
+	store := newFakeStore()
+	api := newTestAPI(store, &fakeMailer{}, WithOwnerTokens(testOwnerTokens))
+	startsAt := testNow.Add(time.Hour)
+
+	created := createTrip(t, api, newCreateTripBody("owner@trip.com", startsAt, startsAt.AddDate(0, 0, 3)))
+	if !jwt.LooksLikeJWT(created.OwnerToken) {
+		t.Fatalf("expected the owner token a JWT, got %q", created.OwnerToken)
+	}
+	trip := tripDetails(t, api, created.TripID)
+	tripUUID := uuid.MustParse(created.TripID)
+
+	if status := renameTrip(t, api, trip, created.OwnerToken); status != http.StatusNoContent {
+		t.Fatalf("expected the trip changed with its JWT, got %d", status)
+	}
+
+	refused := []struct {
+		name  string
+		token string
+		code  ErrorCode
+	}{
+		{"issued for another trip", issueTestToken(t, jwt.ScopeOwner, uuid.New(), "owner@trip.com", testNow), ErrorCodeWrongOwnerToken},
+		{"issued for another owner", issueTestToken(t, jwt.ScopeOwner, tripUUID, "someone@trip.com", testNow), ErrorCodeWrongOwnerToken},
+		{"issued for a magic link", issueTestToken(t, jwt.ScopeMagicLink, tripUUID, "owner@trip.com", testNow), ErrorCodeWrongOwnerToken},
+		{"signed with another secret", func() string {
+			token, _, _ := jwt.New("other-secret", time.Hour).Issue(jwt.ScopeOwner, tripUUID, "owner@trip.com", testNow)
+			return token
+		}(), ErrorCodeWrongOwnerToken},
+		{"expired", issueTestToken(t, jwt.ScopeOwner, tripUUID, "owner@trip.com", testNow.Add(-time.Hour)), ErrorCodeOwnerTokenExpired},
+	}
+	for _, c := range refused {
+		t.Run(c.name, func(t *testing.T) {
+			r := newRequest(t, http.MethodPatch, "/trips/"+created.TripID+"/status", map[string]string{"status": "cancelled"})
+			w := serve(api, withOwnerToken(r, c.token))
+
+			assertStatus(t, w, http.StatusForbidden)
+			var response spec.ForbiddenRequest
+			decodeResponse(t, w, &response)
+			if response.Code != string(c.code) {
+				t.Fatalf("expected %s, got %s", c.code, response.Code)
+			}
+		})
+	}
+
+	t.Run("the opaque owner token still works", func(t *testing.T) {
+		trip := store.addTrip(newTestTrip(3))
+
+		if status := renameTrip(t, api, tripDetails(t, api, trip.ID.String()), TEST_OWNER_TOKEN); status != http.StatusNoContent {
+			t.Fatalf("expected the trip changed with its opaque token, got %d", status)
+		}
+	})
+
+	t.Run("transferred", func(t *testing.T) {
+		r := newRequest(t, http.MethodPatch, "/trips/"+created.TripID+"/owner", map[string]any{"owner_email": "new.owner@trip.com", "owner_name": "New Owner"})
+		w := serve(api, withOwnerToken(r, created.OwnerToken))
+		assertStatus(t, w, http.StatusOK)
+		var transferred spec.TransferTripOwnerResponse
+		decodeResponse(t, w, &transferred)
+
+		if status := renameTrip(t, api, trip, created.OwnerToken); status != http.StatusForbidden {
+			t.Fatalf("expected the JWT of the previous owner refused, got %d", status)
+		}
+		if status := renameTrip(t, api, trip, transferred.OwnerToken); status != http.StatusNoContent {
+			t.Fatalf("expected the JWT of the new owner accepted, got %d", status)
+		}
+	})
+}
+
+func TestPostTripsTripIDOwnerMagicLink(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	mailer := &fakeMailer{}
+	api := newTestAPI(store, mailer, WithOwnerTokens(testOwnerTokens))
+	target := "/trips/" + trip.ID.String() + "/owner/magic-link"
+
+	assertStatus(t, serve(api, newRequest(t, http.MethodPost, target, map[string]string{"email": " Owner@Example.com "})), http.StatusAccepted)
+
+	if len(mailer.magicLinks) != 1 || mailer.magicLinks[0].Trip.ID != trip.ID {
+		t.Fatalf("expected the magic link sent to the owner, got %+v", mailer.magicLinks)
+	}
+	claims, err := testOwnerTokens.Verify(jwt.ScopeMagicLink, mailer.magicLinks[0].Token, testNow)
+	if err != nil || claims.TripID != trip.ID || claims.OwnerEmail != "owner@example.com" {
+		t.Fatalf("expected the magic link issued for the trip owner, got %+v (%v)", claims, err)
+	}
+
+	t.Run("another email", func(t *testing.T) {
+		mailer.magicLinks = nil
+		w := serve(api, newRequest(t, http.MethodPost, target, map[string]string{"email": "someone@example.com"}))
+
+		assertStatus(t, w, http.StatusAccepted)
+		if len(mailer.magicLinks) != 0 {
+			t.Fatalf("expected no magic link sent, got %+v", mailer.magicLinks)
+		}
+	})
+
+	cases := []struct {
+		name   string
+		api    *API
+		target string
+		body   any
+		status int
+		code   ErrorCode
+	}{
+		{"invalid email", api, target, map[string]string{"email": "not-an-email"}, http.StatusBadRequest, ErrorCodeInvalidRequest},
+		{"missing email", api, target, map[string]string{}, http.StatusBadRequest, ErrorCodeInvalidRequest},
+		{"missing trip", api, "/trips/" + uuid.NewString() + "/owner/magic-link", map[string]string{"email": "owner@example.com"}, http.StatusNotFound, ErrorCodeTripNotFound},
+		{"disabled", newTestAPI(store, mailer), target, map[string]string{"email": "owner@example.com"}, http.StatusConflict, ErrorCodeMagicLinksDisabled},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			mailer.magicLinks = nil
+			w := serve(c.api, newRequest(t, http.MethodPost, c.target, c.body))
+
+			assertStatus(t, w, c.status)
+			var response spec.BadRequest
+			decodeResponse(t, w, &response)
+			if response.Code != string(c.code) {
+				t.Fatalf("expected %s, got %s", c.code, response.Code)
+			}
+			if len(mailer.magicLinks) != 0 {
+				t.Fatalf("expected no magic link sent, got %+v", mailer.magicLinks)
+			}
+		})
+	}
+}
+
+func TestGetTripsTripIDOwnerToken(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	api := newTestAPI(store, &fakeMailer{}, WithOwnerTokens(testOwnerTokens))
+	target := "/trips/" + trip.ID.String() + "/owner/token?token="
+
+	w := serve(api, newRequest(t, http.MethodGet, target+issueTestToken(t, jwt.ScopeMagicLink, trip.ID, "owner@example.com", testNow), nil))
+
+	assertStatus(t, w, http.StatusOK)
+	var response spec.OwnerTokenResponse
+	decodeResponse(t, w, &response)
+	if !response.ExpiresAt.Equal(testNow.Add(time.Hour)) {
+		t.Fatalf("expected the owner token expiring in an hour, got %v", response.ExpiresAt)
+	}
+	if status := renameTrip(t, api, tripDetails(t, api, trip.ID.String()), response.OwnerToken); status != http.StatusNoContent {
+		t.Fatalf("expected the trip changed with the owner token, got %d", status)
+	}
+
+	cases := []struct {
+		name   string
+		api    *API
+		target string
+		status int
+		code   ErrorCode
+	}{
+		{"expired", api, target + issueTestToken(t, jwt.ScopeMagicLink, trip.ID, "owner@example.com", testNow.Add(-jwt.MAGIC_LINK_TTL)), http.StatusForbidden, ErrorCodeMagicLinkExpired},
+		{"issued for another trip", api, target + issueTestToken(t, jwt.ScopeMagicLink, uuid.New(), "owner@example.com", testNow), http.StatusForbidden, ErrorCodeInvalidMagicLink},
+		{"issued for a previous owner", api, target + issueTestToken(t, jwt.ScopeMagicLink, trip.ID, "previous@example.com", testNow), http.StatusForbidden, ErrorCodeInvalidMagicLink},
+		{"an owner token", api, target + issueTestToken(t, jwt.ScopeOwner, trip.ID, "owner@example.com", testNow), http.StatusForbidden, ErrorCodeInvalidMagicLink},
+		{"a forged token", api, target + "not.a.token", http.StatusForbidden, ErrorCodeInvalidMagicLink},
+		{"missing trip", api, "/trips/" + uuid.Nil.String() + "/owner/token?token=" + issueTestToken(t, jwt.ScopeMagicLink, uuid.Nil, "owner@example.com", testNow), http.StatusNotFound, ErrorCodeTripNotFound},
+		{"disabled", newTestAPI(store, &fakeMailer{}), target + issueTestToken(t, jwt.ScopeMagicLink, trip.ID, "owner@example.com", testNow), http.StatusConflict, ErrorCodeMagicLinksDisabled},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			w := serve(c.api, newRequest(t, http.MethodGet, c.target, nil))
+
+			assertStatus(t, w, c.status)
+			var response spec.ForbiddenRequest
+			decodeResponse(t, w, &response)
+			if response.Code != string(c.code) {
+				t.Fatalf("expected %s, got %s", c.code, response.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package api
+
+import (
+	"journey/internal/api/spec"
+	"journey/internal/pgstore"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestDeleteTripsTripIDParticipantsParticipantID(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	mistaken := store.addParticipant(trip.ID, "gueest@example.com")
+	kept := store.addParticipant(trip.ID, "guest@example.com")
+	api := newTestAPI(store, &fakeMailer{})
+	target := "/trips/" + trip.ID.String() + "/participants/" + mistaken.ID.String()
+
+	r := newRequest(t, http.MethodDelete, target, nil)
+	assertStatus(t, serve(api, withOwnerToken(r, TEST_OWNER_TOKEN)), http.StatusNoContent)
+
+	if _, found := store.participants[mistaken.ID]; found {
+		t.Fatal("expected the participant removed")
+	}
+	if store.participant(kept.ID).ID != kept.ID {
+		t.Fatal("expected the other participant kept")
+	}
+
+	t.Run("is then missing", func(t *testing.T) {
+		r := newRequest(t, http.MethodDelete, target, nil)
+		assertStatus(t, serve(api, withOwnerToken(r, TEST_OWNER_TOKEN)), http.StatusNotFound)
+	})
+}
+
+func TestDeleteTripsTripIDParticipantsParticipantIDConfirmed(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	confirmed := store.addParticipant(trip.ID, "guest@example.com")
+	confirmed.IsConfirmed = true
+	store.participants[confirmed.ID] = confirmed
+	api := newTestAPI(store, &fakeMailer{})
+	target := "/trips/" + trip.ID.String() + "/participants/" + confirmed.ID.String()
+
+	for _, query := range []string{"", "?force=false"} {
+		r := newRequest(t, http.MethodDelete, target+query, nil)
+		w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+		assertStatus(t, w, http.StatusBadRequest)
+		var response spec.BadRequest
+		decodeResponse(t, w, &response)
+		if response.Code != string(ErrorCodeParticipantAlreadyConfirmed) {
+			t.Fatalf("expected %s, got %s", ErrorCodeParticipantAlreadyConfirmed, response.Code)
+		}
+	}
+	if store.participant(confirmed.ID).ID != confirmed.ID {
+		t.Fatal("expected the confirmed participant kept")
+	}
+
+	t.Run("forced", func(t *testing.T) {
+		r := newRequest(t, http.MethodDelete, target+"?force=true", nil)
+		assertStatus(t, serve(api, withOwnerToken(r, TEST_OWNER_TOKEN)), http.StatusNoContent)
+
+		if _, found := store.participants[confirmed.ID]; found {
+			t.Fatal("expected the confirmed participant removed on force")
+		}
+	})
+}
+
+func TestDeleteTripsTripIDParticipantsParticipantIDRefused(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	closed := newTestTrip(3)
+	closed.Status = pgstore.TripStatusCancelled
+	store.addTrip(closed)
+	other := store.addTrip(newTestTrip(3))
+	participant := store.addParticipant(trip.ID, "guest@example.com")
+	closedParticipant := store.addParticipant(closed.ID, "guest@example.com")
+	api := newTestAPI(store, &fakeMailer{})
+
+	cases := []struct {
+		name   string
+		target string
+		token  string
+		status int
+	}{
+		{"missing trip", "/trips/" + uuid.NewString() + "/participants/" + participant.ID.String(), TEST_OWNER_TOKEN, http.StatusNotFound},
+		{"missing participant", "/trips/" + trip.ID.String() + "/participants/" + uuid.NewString(), TEST_OWNER_TOKEN, http.StatusNotFound},
+		{"participant of another trip", "/trips/" + other.ID.String() + "/participants/" + participant.ID.String(), TEST_OWNER_TOKEN, http.StatusNotFound},
+		{"without the owner token", "/trips/" + trip.ID.String() + "/participants/" + participant.ID.String(), "", http.StatusUnauthorized},
+		{"with a wrong token", "/trips/" + trip.ID.String() + "/participants/" + participant.ID.String(), "not-the-owner", http.StatusForbidden},
+		{"closed trip", "/trips/" + closed.ID.String() + "/participants/" + closedParticipant.ID.String(), TEST_OWNER_TOKEN, http.StatusConflict},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			r := newRequest(t, http.MethodDelete, c.target, nil)
+			if c.token != "" {
+				r = withOwnerToken(r, c.token)
+			}
+
+			assertStatus(t, serve(api, r), c.status)
+		})
+	}
+	if len(store.participants) != 2 {
+		t.Fatalf("expected no participant removed, got %d left", len(store.participants))
+	}
+	if calls := store.callsOf("DeleteParticipant"); calls != 0 {
+		t.Fatalf("expected no participant removed, got %d removes", calls)
+	}
+}
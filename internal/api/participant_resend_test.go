@@ -0,0 +1,96 @@
+package api
+
+import (
+	"context"
+	"journey/internal/api/spec"
+	"journey/internal/mailer/dispatcher"
+	"journey/internal/pgstore"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// closedDispatcher refuses every send, as a dispatcher shut down.
+type closedDispatcher struct{}
+
+func (closedDispatcher) Enqueue(context.Context, string, func() error, ...zap.Field) error {
+	return dispatcher.ErrClosed
+}
+
+func TestPostParticipantsParticipantIDResendInvite(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	participant := store.addParticipant(trip.ID, "guest@example.com")
+	store.addParticipant(trip.ID, "other@example.com")
+	mailer := &fakeMailer{}
+	api := newTestAPI(store, mailer)
+
+	r := newRequest(t, http.MethodPost, "/participants/"+participant.ID.String()+"/resend-invite", nil)
+	assertStatus(t, serve(api, withOwnerToken(r, TEST_OWNER_TOKEN)), http.StatusNoContent)
+
+	if len(mailer.invites) != 1 || len(mailer.invites[0].Invites) != 1 {
+		t.Fatalf("expected a single invitation sent, got %+v", mailer.invites)
+	}
+	invite := mailer.invites[0]
+	if invite.Trip.ID != trip.ID || invite.Invites[0].Participant.ParticipantId != participant.ID || invite.Invites[0].Participant.Email != "guest@example.com" {
+		t.Fatalf("expected the invitation of the participant sent again, got %+v", invite)
+	}
+}
+
+func TestPostParticipantsParticipantIDResendInviteRefused(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	closed := newTestTrip(3)
+	closed.Status = pgstore.TripStatusCancelled
+	store.addTrip(closed)
+	participant := store.addParticipant(trip.ID, "guest@example.com")
+	confirmed := store.addParticipant(trip.ID, "confirmed@example.com")
+	confirmed.IsConfirmed = true
+	store.participants[confirmed.ID] = confirmed
+	closedParticipant := store.addParticipant(closed.ID, "guest@example.com")
+	mailer := &fakeMailer{}
+	api := newTestAPI(store, mailer)
+
+	cases := []struct {
+		name        string
+		participant string
+		token       string
+		status      int
+		code        ErrorCode
+	}{
+		{"missing participant", uuid.NewString(), TEST_OWNER_TOKEN, http.StatusNotFound, ErrorCodeParticipantNotFound},
+		{"without the owner token", participant.ID.String(), "", http.StatusUnauthorized, ErrorCodeMissingOwnerToken},
+		{"with a wrong token", participant.ID.String(), "not-the-owner", http.StatusForbidden, ErrorCodeWrongOwnerToken},
+		{"closed trip", closedParticipant.ID.String(), TEST_OWNER_TOKEN, http.StatusConflict, ErrorCodeTripClosed},
+		{"confirmed participant", confirmed.ID.String(), TEST_OWNER_TOKEN, http.StatusBadRequest, ErrorCodeParticipantAlreadyConfirmed},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			r := newRequest(t, http.MethodPost, "/participants/"+c.participant+"/resend-invite", nil)
+			if c.token != "" {
+				r = withOwnerToken(r, c.token)
+			}
+			w := serve(api, r)
+
+			assertStatus(t, w, c.status)
+			var response spec.BadRequest
+			decodeResponse(t, w, &response)
+			if response.Code != string(c.code) {
+				t.Fatalf("expected %s, got %s", c.code, response.Code)
+			}
+		})
+	}
+	if len(mailer.invites) != 0 {
+		t.Fatalf("expected no invitation sent, got %+v", mailer.invites)
+	}
+
+	t.Run("unable to queue the invitation", func(t *testing.T) {
+		api := newTestAPI(store, mailer, WithDispatcher(closedDispatcher{}))
+
+		r := newRequest(t, http.MethodPost, "/participants/"+participant.ID.String()+"/resend-invite", nil)
+		assertStatus(t, serve(api, withOwnerToken(r, TEST_OWNER_TOKEN)), http.StatusInternalServerError)
+	})
+}
@@ -0,0 +1,495 @@
+package api
+
+import (
+	"encoding/csv"
+	"fmt"
+	"journey/internal/api/spec"
+	"journey/internal/pgstore"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/discord-gophers/goapi-gen/types"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestGetTripsTripIDParticipantsCSV(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	pathological := store.addParticipant(trip.ID, `"guest, the first"@example.com`)
+	api := newTestAPI(store, &fakeMailer{})
+
+	w := serve(api, newRequest(t, http.MethodGet, "/trips/"+trip.ID.String()+"/participants.csv", nil))
+
+	assertStatus(t, w, http.StatusOK)
+	if contentType := w.Header().Get("Content-Type"); !strings.HasPrefix(contentType, "text/csv") {
+		t.Fatalf("expected a text/csv content type, got %q", contentType)
+	}
+	if disposition := w.Header().Get("Content-Disposition"); !strings.HasPrefix(disposition, "attachment;") {
+		t.Fatalf("expected an attachment, got %q", disposition)
+	}
+	if !strings.Contains(w.Body.String(), `"""guest, the first""@example.com"`) {
+		t.Fatalf("expected the email quoted, got %q", w.Body.String())
+	}
+
+	records, err := csv.NewReader(strings.NewReader(w.Body.String())).ReadAll()
+	if err != nil {
+		t.Fatalf("expected a valid csv, got %v", err)
+	}
+	if len(records) != 2 || strings.Join(records[0], ",") != "id,email,is_confirmed" {
+		t.Fatalf("expected the header row and one participant, got %v", records)
+	}
+	if records[1][0] != pathological.ID.String() || records[1][1] != pathological.Email || records[1][2] != "false" {
+		t.Fatalf("expected the participant row, got %v", records[1])
+	}
+}
+
+func TestGetTripsTripIDParticipantsCSVNotFound(t *testing.T) {
+	api := newTestAPI(newFakeStore(), &fakeMailer{})
+
+	w := serve(api, newRequest(t, http.MethodGet, "/trips/"+uuid.NewString()+"/participants.csv", nil))
+
+	assertStatus(t, w, http.StatusNotFound)
+}
+
+// newTripWithParticipants is a trip with the given number of participants, guest-000@example.com onward.
+func newTripWithParticipants(store *fakeStore, count int) uuid.UUID {
+	trip := store.addTrip(newTestTrip(3))
+	for index := 0; index < count; index++ {
+		store.addParticipant(trip.ID, fmt.Sprintf("guest-%03d@example.com", index))
+	}
+	return trip.ID
+}
+
+func TestGetTripsTripIDParticipantsPagination(t *testing.T) {
+	store := newFakeStore()
+	tripID := newTripWithParticipants(store, 260)
+	api := newTestAPI(store, &fakeMailer{})
+
+	tests := []struct {
+		name       string
+		query      string
+		page       int
+		perPage    int
+		count      int
+		firstEmail string
+		totalPages int
+	}{
+		{"default", "", 1, 50, 50, "guest-000@example.com", 6},
+		{"second page", "?page=2&perPage=100", 2, 100, 100, "guest-100@example.com", 3},
+		{"last page", "?page=3&perPage=100", 3, 100, 60, "guest-200@example.com", 3},
+		{"past the last page", "?page=9&perPage=100", 9, 100, 0, "", 3},
+		{"size clamped to the max", "?perPage=1000", 1, 200, 200, "guest-000@example.com", 2},
+		{"invalid values clamped", "?page=-3&perPage=0", 1, 1, 1, "guest-000@example.com", 260},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := serve(api, newRequest(t, http.MethodGet, "/trips/"+tripID.String()+"/participants"+test.query, nil))
+
+			assertStatus(t, w, http.StatusOK)
+			var response spec.GetTripParticipantsResponse
+			decodeResponse(t, w, &response)
+			if response.Page != test.page || response.PageSize != test.perPage || response.TotalPages != test.totalPages || response.Total != 260 {
+				t.Fatalf("expected page %d of %d out of %d pages of 260, got %+v",
+					test.page, test.perPage, test.totalPages, response)
+			}
+			if len(response.Items) != test.count {
+				t.Fatalf("expected %d participants, got %d", test.count, len(response.Items))
+			}
+			if test.count > 0 && string(response.Items[0].Email) != test.firstEmail {
+				t.Fatalf("expected the page to start at %s, got %s", test.firstEmail, response.Items[0].Email)
+			}
+		})
+	}
+}
+
+func TestGetTripsTripIDParticipantsInviteStatus(t *testing.T) {
+	store := newFakeStore()
+	tripID := newTripWithParticipants(store, 1)
+	failed := store.addParticipant(tripID, "failed@example.com")
+	failed.InviteStatus = pgstore.InviteStatusFailed
+	failed.InviteLastAttemptAt = pgtype.Timestamp{Valid: true, Time: testNow}
+	store.participants[failed.ID] = failed
+	api := newTestAPI(store, &fakeMailer{})
+
+	w := serve(api, newRequest(t, http.MethodGet, "/trips/"+tripID.String()+"/participants", nil))
+
+	assertStatus(t, w, http.StatusOK)
+	var response spec.GetTripParticipantsResponse
+	decodeResponse(t, w, &response)
+	for _, participant := range response.Items {
+		switch participant.Email {
+		case "failed@example.com":
+			if participant.InviteStatus != pgstore.InviteStatusFailed || participant.InviteLastAttemptAt == nil || !participant.InviteLastAttemptAt.Equal(testNow) {
+				t.Fatalf("expected the failed invite attempted at %v, got %+v", testNow, participant)
+			}
+		default:
+			if participant.InviteStatus != pgstore.InviteStatusPending || participant.InviteLastAttemptAt != nil {
+				t.Fatalf("expected the invite pending and never attempted, got %+v", participant)
+			}
+		}
+	}
+}
+
+func TestPostTripsTripIDInvitesAnswersTheParticipant(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	api := newTestAPI(store, &fakeMailer{})
+
+	r := newRequest(t, http.MethodPost, "/trips/"+trip.ID.String()+"/invites", map[string]string{"email": "guest@example.com"})
+	w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+	assertStatus(t, w, http.StatusCreated)
+	var created spec.InviteParticipantResponse
+	decodeResponse(t, w, &created)
+	if created.ParticipantID == "" || created.Participant.ID != created.ParticipantID {
+		t.Fatalf("expected the participant id on both fields, got %+v", created)
+	}
+
+	w = serve(api, newRequest(t, http.MethodGet, "/trips/"+trip.ID.String()+"/participants", nil))
+
+	var fetched spec.GetTripParticipantsResponse
+	decodeResponse(t, w, &fetched)
+	if len(fetched.Items) != 1 || !reflect.DeepEqual(created.Participant, fetched.Items[0]) {
+		t.Fatalf("expected the invited participant as listed %+v, got %+v", fetched.Items, created.Participant)
+	}
+}
+
+func TestPostTripsTripIDInvitesBulk(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	pending := store.addParticipant(trip.ID, "pending@example.com")
+	mailer := &fakeMailer{}
+	api := newTestAPI(store, mailer)
+
+	r := newRequest(t, http.MethodPost, "/trips/"+trip.ID.String()+"/invites", map[string]any{
+		"emails": []string{"zoe@example.com", "Pending@Example.com", "ana@example.com", "ZOE@example.com"},
+	})
+	w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+	assertStatus(t, w, http.StatusCreated)
+	var created spec.InviteParticipantResponse
+	decodeResponse(t, w, &created)
+	var emails []types.Email
+	for _, participant := range created.Participants {
+		emails = append(emails, participant.Email)
+	}
+	if expected := []types.Email{"zoe@example.com", "ana@example.com"}; !reflect.DeepEqual(emails, expected) {
+		t.Fatalf("expected the participants invited %v, got %v", expected, emails)
+	}
+	if created.ParticipantID != created.Participants[0].ID || created.Participant != created.Participants[0] {
+		t.Fatalf("expected the first invited answered as the participant, got %+v", created)
+	}
+
+	if calls := store.callsOf("InviteParticipantsWithinCapacity"); calls != 1 {
+		t.Fatalf("expected the participants inserted in a single batch, got %d inserts", calls)
+	}
+	if len(store.participants) != 3 {
+		t.Fatalf("expected 2 participants added to the pending one, got %d", len(store.participants))
+	}
+
+	if len(mailer.invites) != 1 || len(mailer.invites[0].Invites) != 2 {
+		t.Fatalf("expected a single batch inviting the 2 participants, got %+v", mailer.invites)
+	}
+	for _, invite := range mailer.invites[0].Invites {
+		if invite.Participant.ParticipantId == pending.ID {
+			t.Fatal("expected the participant already invited not sent its invitation again")
+		}
+	}
+}
+
+func TestPostTripsTripIDInvitesBulkInvalid(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	store.addParticipant(trip.ID, "guest@example.com")
+	api := newTestAPI(store, &fakeMailer{})
+
+	tooMany := make([]string, 51)
+	for index := range tooMany {
+		tooMany[index] = fmt.Sprintf("guest%d@example.com", index)
+	}
+
+	cases := map[string]struct {
+		body any
+		code ErrorCode
+	}{
+		"neither email":           {map[string]any{}, ErrorCodeInvalidRequest},
+		"both emails":             {map[string]any{"email": "ana@example.com", "emails": []string{"zoe@example.com"}}, ErrorCodeInvalidRequest},
+		"no emails":               {map[string]any{"emails": []string{}}, ErrorCodeInvalidRequest},
+		"too many emails":         {map[string]any{"emails": tooMany}, ErrorCodeInvalidRequest},
+		"an invalid email":        {map[string]any{"emails": []string{"ana@example.com", "not an email"}}, ErrorCodeInvalidRequest},
+		"an undeliverable email":  {map[string]any{"emails": []string{"ana@example.com", "zoe@example.c"}}, ErrorCodeUndeliverableEmail},
+		"all already participate": {map[string]any{"emails": []string{"Guest@example.com", "guest@example.com"}}, ErrorCodeParticipantAlreadyInvited},
+	}
+
+	for name, test := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := newRequest(t, http.MethodPost, "/trips/"+trip.ID.String()+"/invites", test.body)
+			w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+			assertStatus(t, w, http.StatusBadRequest)
+			var response spec.BadRequest
+			decodeResponse(t, w, &response)
+			if response.Code != string(test.code) {
+				t.Fatalf("expected %s, got %s", test.code, response.Code)
+			}
+		})
+	}
+	if calls := store.callsOf("InviteParticipantsWithinCapacity"); calls != 0 {
+		t.Fatalf("expected no participant invited, got %d inserts", calls)
+	}
+}
+
+func TestPostTripsTripIDInvitesWithNameAndPhone(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	mailer := &fakeMailer{}
+	api := newTestAPI(store, mailer)
+
+	r := newRequest(t, http.MethodPost, "/trips/"+trip.ID.String()+"/invites", map[string]any{
+		"email": "ana@example.com",
+		"name":  "  Ana Souza ",
+		"phone": "+5548999999999",
+	})
+	assertStatus(t, serve(api, withOwnerToken(r, TEST_OWNER_TOKEN)), http.StatusCreated)
+
+	w := serve(api, newRequest(t, http.MethodGet, "/trips/"+trip.ID.String()+"/participants", nil))
+	assertStatus(t, w, http.StatusOK)
+	var response spec.GetTripParticipantsResponse
+	decodeResponse(t, w, &response)
+	if len(response.Items) != 1 {
+		t.Fatalf("expected the participant listed, got %+v", response.Items)
+	}
+	participant := response.Items[0]
+	if participant.Name == nil || *participant.Name != "Ana Souza" || participant.Phone == nil || *participant.Phone != "+5548999999999" {
+		t.Fatalf("expected the name and the phone listed, got %v, %v", participant.Name, participant.Phone)
+	}
+
+	if len(mailer.invites) != 1 || mailer.invites[0].Invites[0].Participant.Name != "Ana Souza" {
+		t.Fatalf("expected the invitation sent on the participant name, got %+v", mailer.invites)
+	}
+
+	t.Run("null when not told", func(t *testing.T) {
+		r := newRequest(t, http.MethodPost, "/trips/"+trip.ID.String()+"/invites", map[string]any{"email": "zoe@example.com"})
+		w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+		assertStatus(t, w, http.StatusCreated)
+		var created spec.InviteParticipantResponse
+		decodeResponse(t, w, &created)
+		if created.Participant.Name != nil || created.Participant.Phone != nil {
+			t.Fatalf("expected no name nor phone, got %v, %v", created.Participant.Name, created.Participant.Phone)
+		}
+	})
+
+	cases := map[string]map[string]any{
+		"a name along several emails":  {"emails": []string{"bia@example.com"}, "name": "Bia"},
+		"a phone along several emails": {"emails": []string{"bia@example.com"}, "phone": "+5548999999999"},
+		"a blank name":                 {"email": "bia@example.com", "name": "   "},
+		"a name too long":              {"email": "bia@example.com", "name": strings.Repeat("a", 256)},
+		"a phone not in E.164":         {"email": "bia@example.com", "phone": "(48) 99999-9999"},
+	}
+	for name, body := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := newRequest(t, http.MethodPost, "/trips/"+trip.ID.String()+"/invites", body)
+			w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+			assertStatus(t, w, http.StatusBadRequest)
+			var response spec.BadRequest
+			decodeResponse(t, w, &response)
+			if response.Code != string(ErrorCodeInvalidRequest) {
+				t.Fatalf("expected %s, got %s", ErrorCodeInvalidRequest, response.Code)
+			}
+		})
+	}
+}
+
+func TestGetTripsTripIDParticipantsSummary(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	other := store.addTrip(newTestTrip(3))
+	store.addParticipant(trip.ID, "zoe@example.com")
+	store.addParticipant(trip.ID, "ana@example.com")
+	confirmed := store.addParticipant(trip.ID, "confirmed@example.com")
+	confirmed.IsConfirmed = true
+	store.participants[confirmed.ID] = confirmed
+	store.addWaitlisted(trip.ID, "waiting@example.com")
+	store.addParticipant(other.ID, "elsewhere@example.com")
+	api := newTestAPI(store, &fakeMailer{})
+	summaryPath := "/trips/" + trip.ID.String() + "/participants/summary"
+
+	t.Run("mixed participants", func(t *testing.T) {
+		w := serve(api, withOwnerToken(newRequest(t, http.MethodGet, summaryPath, nil), TEST_OWNER_TOKEN))
+
+		assertStatus(t, w, http.StatusOK)
+		var response spec.GetTripParticipantsSummaryResponse
+		decodeResponse(t, w, &response)
+		if response.Total != 4 || response.Confirmed != 1 || response.Pending != 2 || response.Waitlisted != 1 {
+			t.Fatalf("expected 4 participants, 1 confirmed, 2 pending and 1 waitlisted, got %+v", response)
+		}
+		expected := []types.Email{"ana@example.com", "zoe@example.com"}
+		if !reflect.DeepEqual(response.PendingEmails, expected) {
+			t.Fatalf("expected the pending emails %v, got %v", expected, response.PendingEmails)
+		}
+		if store.callsOf("GetParticipants") != 0 || store.callsOf("GetTripWithParticipants") != 0 {
+			t.Fatal("expected the summary aggregated rather than the participants loaded")
+		}
+	})
+
+	t.Run("without the owner token", func(t *testing.T) {
+		w := serve(api, newRequest(t, http.MethodGet, summaryPath, nil))
+
+		assertStatus(t, w, http.StatusUnauthorized)
+	})
+
+	t.Run("missing trip", func(t *testing.T) {
+		r := newRequest(t, http.MethodGet, "/trips/"+uuid.NewString()+"/participants/summary", nil)
+		w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+		assertStatus(t, w, http.StatusNotFound)
+	})
+}
+
+func TestPutTripsTripIDParticipantsParticipantID(t *testing.T) {
+	t.Run("correction", func(t *testing.T) {
+		store := newFakeStore()
+		trip := store.addTrip(newTestTrip(3))
+		mistyped := store.addParticipant(trip.ID, "guest@exmaple.com")
+		mailer := &fakeMailer{}
+		api := newTestAPI(store, mailer)
+
+		r := newRequest(t, http.MethodPut, "/trips/"+trip.ID.String()+"/participants/"+mistyped.ID.String(), map[string]string{"email": "Guest@Example.com"})
+		w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+		assertStatus(t, w, http.StatusNoContent)
+		if corrected := store.participant(mistyped.ID); corrected.Email != "guest@example.com" || corrected.InviteStatus != pgstore.InviteStatusPending {
+			t.Fatalf("expected the normalized email pending an invite, got %q %q", corrected.Email, corrected.InviteStatus)
+		}
+		if len(mailer.invites) != 1 || len(mailer.invites[0].Invites) != 1 || mailer.invites[0].Invites[0].Participant.Email != "guest@example.com" {
+			t.Fatalf("expected the invite sent again to the corrected email only, got %+v", mailer.invites)
+		}
+	})
+
+	t.Run("collisions", func(t *testing.T) {
+		store := newFakeStore()
+		trip := store.addTrip(newTestTrip(3))
+		mistyped := store.addParticipant(trip.ID, "guest@exmaple.com")
+		store.addParticipant(trip.ID, "other@example.com")
+		api := newTestAPI(store, &fakeMailer{})
+
+		cases := map[string]ErrorCode{
+			"OTHER@example.com": ErrorCodeParticipantAlreadyInvited,
+			"owner@example.com": ErrorCodeParticipantIsOwner,
+		}
+
+		for email, code := range cases {
+			r := newRequest(t, http.MethodPut, "/trips/"+trip.ID.String()+"/participants/"+mistyped.ID.String(), map[string]string{"email": email})
+			w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+			assertStatus(t, w, http.StatusBadRequest)
+			var response spec.BadRequest
+			decodeResponse(t, w, &response)
+			if response.Code != string(code) {
+				t.Fatalf("expected %s for %s, got %s", code, email, response.Code)
+			}
+		}
+		if calls := store.callsOf("UpdateParticipantEmail"); calls != 0 {
+			t.Fatalf("expected nothing updated on a collision, got %d updates", calls)
+		}
+	})
+
+	t.Run("confirmed participant", func(t *testing.T) {
+		store := newFakeStore()
+		trip := store.addTrip(newTestTrip(3))
+		confirmed := store.addParticipant(trip.ID, "guest@example.com")
+		confirmed.IsConfirmed = true
+		store.participants[confirmed.ID] = confirmed
+		api := newTestAPI(store, &fakeMailer{})
+
+		r := newRequest(t, http.MethodPut, "/trips/"+trip.ID.String()+"/participants/"+confirmed.ID.String(), map[string]string{"email": "another@example.com"})
+		w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+		assertStatus(t, w, http.StatusBadRequest)
+		var response spec.BadRequest
+		decodeResponse(t, w, &response)
+		if response.Code != string(ErrorCodeParticipantAlreadyConfirmed) {
+			t.Fatalf("expected %s, got %s", ErrorCodeParticipantAlreadyConfirmed, response.Code)
+		}
+		if store.participant(confirmed.ID).Email != "guest@example.com" {
+			t.Fatalf("expected the confirmed email kept, got %q", store.participant(confirmed.ID).Email)
+		}
+	})
+
+	t.Run("participant of another trip", func(t *testing.T) {
+		store := newFakeStore()
+		trip := store.addTrip(newTestTrip(3))
+		other := store.addTrip(newTestTrip(3))
+		elsewhere := store.addParticipant(other.ID, "guest@exmaple.com")
+		api := newTestAPI(store, &fakeMailer{})
+
+		r := newRequest(t, http.MethodPut, "/trips/"+trip.ID.String()+"/participants/"+elsewhere.ID.String(), map[string]string{"email": "guest@example.com"})
+		w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+		assertStatus(t, w, http.StatusNotFound)
+		if store.callsOf("GetParticipantForTrip") != 1 || store.callsOf("GetParticipant") != 0 {
+			t.Fatal("expected the participant looked up on the trip of the route only")
+		}
+		if store.participant(elsewhere.ID).Email != "guest@exmaple.com" {
+			t.Fatalf("expected the participant of the other trip untouched, got %q", store.participant(elsewhere.ID).Email)
+		}
+	})
+}
+
+func TestGetParticipantsPendingCount(t *testing.T) {
+	store := newFakeStore()
+	lisbon := store.addTrip(newTestTrip(3))
+	porto := store.addTrip(newTestTrip(3))
+	madrid := store.addTrip(newTestTrip(3))
+	store.addParticipant(lisbon.ID, "guest@example.com")
+	store.addParticipant(porto.ID, "Guest@Example.com")
+	confirmed := store.addParticipant(madrid.ID, "guest@example.com")
+	confirmed.IsConfirmed = true
+	store.participants[confirmed.ID] = confirmed
+	store.addParticipant(lisbon.ID, "someone@example.com")
+	alreadyConfirmed := store.addParticipant(porto.ID, "confirmed@example.com")
+	alreadyConfirmed.IsConfirmed = true
+	store.participants[alreadyConfirmed.ID] = alreadyConfirmed
+	api := newTestAPI(store, &fakeMailer{})
+
+	pendingCount := func(t *testing.T, email string) int {
+		t.Helper()
+
+		w := serve(api, newRequest(t, http.MethodGet, "/participants/pending-count?email="+email, nil))
+		assertStatus(t, w, http.StatusOK)
+		var response spec.GetParticipantsPendingCountResponse
+		decodeResponse(t, w, &response)
+		return response.Count
+	}
+
+	cases := []struct {
+		name  string
+		email string
+		count int
+	}{
+		{"several pending invites", "guest@example.com", 2},
+		{"the email in another case", "GUEST@example.COM", 2},
+		{"only confirmed invites", "confirmed@example.com", 0},
+		{"no invites", "nobody@example.com", 0},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if count := pendingCount(t, c.email); count != c.count {
+				t.Fatalf("expected %d pending invites of %s, got %d", c.count, c.email, count)
+			}
+		})
+	}
+
+	t.Run("invalid email", func(t *testing.T) {
+		for _, target := range []string{"/participants/pending-count?email=not-an-email", "/participants/pending-count"} {
+			assertStatus(t, serve(api, newRequest(t, http.MethodGet, target, nil)), http.StatusBadRequest)
+		}
+	})
+}
@@ -0,0 +1,66 @@
+package api
+
+import (
+	"context"
+	"journey/internal/api/spec"
+	"journey/internal/i18n"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+)
+
+// pathUUIDsKey is the context key of the UUID path params, parsed by parsePathUUIDs.
+type pathUUIDsKey struct{}
+
+// Handler is the spec routes, their bodies bounded by limitBodies, their UUID path params parsed before
+// reaching the handlers, their panics answered by recoverPanics and their errors negotiated by negotiateErrors.
+func (api *API) Handler() http.Handler {
+	router := chi.NewRouter()
+	spec.Handler(api, spec.WithRouter(router))
+	return negotiateErrors(api.recoverPanics(api.limitBodies(api.parsePathUUIDs(router, router))))
+}
+
+// parsePathUUIDs parses every {...Id} path param of the route matched, answering a 400 INVALID_UUID on the
+// first malformed one. The handlers read them parsed with pathUUID.
+func (api *API) parsePathUUIDs(routes chi.Routes, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := r.URL.Path
+		if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePath != "" {
+			path = rctx.RoutePath
+		}
+
+		// the params are only known once routed, the route is matched ahead on a context of its own.
+		matched := chi.NewRouteContext()
+		if !routes.Match(matched, r.Method, path) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ids := make(map[string]uuid.UUID)
+		for index, name := range matched.URLParams.Keys {
+			if !strings.HasSuffix(name, "Id") {
+				continue
+			}
+
+			id, err := uuid.Parse(matched.URLParams.Values[index])
+			if err != nil {
+				render.Status(r, http.StatusBadRequest)
+				// named as the handlers always did, tripID rather than tripId.
+				render.JSON(w, r, api.badRequest(r, i18n.InvalidUUID, strings.TrimSuffix(name, "Id")+"ID"))
+				return
+			}
+			ids[name] = id
+		}
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), pathUUIDsKey{}, ids)))
+	})
+}
+
+// pathUUID is the UUID path param parsed by parsePathUUIDs, the nil UUID when missing.
+func pathUUID(r *http.Request, name string) uuid.UUID {
+	ids, _ := r.Context().Value(pathUUIDsKey{}).(map[string]uuid.UUID)
+	return ids[name]
+}
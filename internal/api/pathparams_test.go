@@ -0,0 +1,89 @@
+package api
+
+import (
+	"journey/internal/api/spec"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestMalformedPathUUIDs(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	api := newTestAPI(store, &fakeMailer{})
+	valid := trip.ID.String()
+
+	tests := []struct {
+		method string
+		target string
+		param  string
+	}{
+		{http.MethodGet, "/trips/not-an-uuid", "tripID"},
+		{http.MethodPut, "/trips/not-an-uuid", "tripID"},
+		{http.MethodDelete, "/trips/not-an-uuid", "tripID"},
+		{http.MethodPatch, "/trips/not-an-uuid/cancel", "tripID"},
+		{http.MethodPost, "/trips/not-an-uuid/clone", "tripID"},
+		{http.MethodGet, "/trips/not-an-uuid/confirm", "tripID"},
+		{http.MethodPatch, "/trips/not-an-uuid/confirm", "tripID"},
+		{http.MethodPatch, "/trips/not-an-uuid/status", "tripID"},
+		{http.MethodPatch, "/trips/not-an-uuid/owner", "tripID"},
+		{http.MethodGet, "/trips/not-an-uuid/full", "tripID"},
+		{http.MethodGet, "/trips/not-an-uuid/participants", "tripID"},
+		{http.MethodGet, "/trips/not-an-uuid/participants.csv", "tripID"},
+		{http.MethodPatch, "/trips/not-an-uuid/participants/confirm/batch", "tripID"},
+		{http.MethodGet, "/trips/not-an-uuid/participants/summary", "tripID"},
+		{http.MethodPut, "/trips/not-an-uuid/participants/" + uuid.NewString(), "tripID"},
+		{http.MethodPut, "/trips/" + valid + "/participants/not-an-uuid", "participantID"},
+		{http.MethodDelete, "/trips/not-an-uuid/participants/" + uuid.NewString(), "tripID"},
+		{http.MethodDelete, "/trips/" + valid + "/participants/not-an-uuid", "participantID"},
+		{http.MethodPost, "/trips/not-an-uuid/participants/" + uuid.NewString() + "/promote", "tripID"},
+		{http.MethodPost, "/trips/" + valid + "/participants/not-an-uuid/promote", "participantID"},
+		{http.MethodPost, "/trips/not-an-uuid/invites", "tripID"},
+		{http.MethodGet, "/trips/not-an-uuid/activities", "tripID"},
+		{http.MethodPost, "/trips/not-an-uuid/activities", "tripID"},
+		{http.MethodGet, "/trips/not-an-uuid/activities.ics", "tripID"},
+		{http.MethodPost, "/trips/not-an-uuid/activities/batch", "tripID"},
+		{http.MethodGet, "/trips/not-an-uuid/activities/search?q=museum", "tripID"},
+		{http.MethodPut, "/trips/not-an-uuid/activities/order", "tripID"},
+		{http.MethodGet, "/trips/not-an-uuid/activities/summary", "tripID"},
+		{http.MethodGet, "/trips/not-an-uuid/activities/" + uuid.NewString(), "tripID"},
+		{http.MethodGet, "/trips/" + valid + "/activities/not-an-uuid", "activityID"},
+		{http.MethodPut, "/trips/not-an-uuid/activities/" + uuid.NewString(), "tripID"},
+		{http.MethodPut, "/trips/" + valid + "/activities/not-an-uuid", "activityID"},
+		{http.MethodDelete, "/trips/not-an-uuid/activities/" + uuid.NewString(), "tripID"},
+		{http.MethodDelete, "/trips/" + valid + "/activities/not-an-uuid", "activityID"},
+		{http.MethodPatch, "/trips/not-an-uuid/activities/" + uuid.NewString() + "/done", "tripID"},
+		{http.MethodPatch, "/trips/" + valid + "/activities/not-an-uuid/done", "activityID"},
+		{http.MethodGet, "/trips/not-an-uuid/links", "tripID"},
+		{http.MethodPost, "/trips/not-an-uuid/links", "tripID"},
+		{http.MethodGet, "/trips/not-an-uuid/links/" + uuid.NewString(), "tripID"},
+		{http.MethodGet, "/trips/" + valid + "/links/not-an-uuid", "linkID"},
+		{http.MethodPost, "/trips/not-an-uuid/reschedule", "tripID"},
+		{http.MethodPost, "/trips/not-an-uuid/restore", "tripID"},
+		{http.MethodGet, "/trips/not-an-uuid/timeline", "tripID"},
+		{http.MethodGet, "/participants/not-an-uuid/confirm", "participantID"},
+		{http.MethodPatch, "/participants/not-an-uuid/confirm", "participantID"},
+		{http.MethodPost, "/participants/not-an-uuid/resend-invite", "participantID"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.method+" "+test.target, func(t *testing.T) {
+			w := serve(api, newRequest(t, test.method, test.target, nil))
+
+			assertStatus(t, w, http.StatusBadRequest)
+			var response spec.BadRequest
+			decodeResponse(t, w, &response)
+			if response.Code != string(ErrorCodeInvalidUUID) {
+				t.Fatalf("expected the %s code, got %q", ErrorCodeInvalidUUID, response.Code)
+			}
+			if expected := test.param + " não é reconhecido como um uuid válido"; response.Message != expected {
+				t.Fatalf("expected the message %q, got %q", expected, response.Message)
+			}
+		})
+	}
+
+	if calls := store.totalCalls(); calls != 0 {
+		t.Fatalf("expected the store never called, got %d calls", calls)
+	}
+}
@@ -0,0 +1,38 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"journey/internal/i18n"
+	"net/http"
+
+	"github.com/go-chi/render"
+	"go.uber.org/zap"
+)
+
+// recoverPanics answers a 500 INTERNAL_ERROR to the requests whose handler panics, logging the panic along its
+// stack, rather than dropping the connection. http.ErrAbortHandler is panicked again: it is the way a handler
+// aborts the response on purpose.
+func (api *API) recoverPanics(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			recovered := recover()
+			if recovered == nil {
+				return
+			}
+			if err, ok := recovered.(error); ok && errors.Is(err, http.ErrAbortHandler) {
+				panic(recovered)
+			}
+
+			api.loggerFor(r.Context()).Error(
+				fmt.Sprintf("panic on route: '%v: %v'", r.Method, r.URL.Path),
+				zap.Any("panic", recovered),
+				zap.Stack("stack"),
+			)
+			render.Status(r, http.StatusInternalServerError)
+			render.JSON(w, r, api.internalServerError(r, i18n.UnexpectedError))
+		}()
+
+		next.ServeHTTP(w, r)
+	})
+}
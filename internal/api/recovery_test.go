@@ -0,0 +1,91 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"journey/internal/api/spec"
+	"journey/internal/httplog"
+	"journey/internal/pgstore"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// panickingStore dereferences a nil trip on GetTrip, as a bug in a handler would.
+type panickingStore struct {
+	*fakeStore
+}
+
+func (s panickingStore) GetTrip(context.Context, uuid.UUID) (pgstore.Trip, error) {
+	var trip *pgstore.Trip
+	return *trip, nil
+}
+
+func TestPanicsAreAnsweredWithA500(t *testing.T) {
+	core, logs := observer.New(zapcore.ErrorLevel)
+	api := newTestAPI(panickingStore{newFakeStore()}, &fakeMailer{})
+	api.logger = zap.New(core)
+	server := httptest.NewServer(httplog.Middleware(zap.NewNop())(api.Handler()))
+	defer server.Close()
+
+	request, err := http.NewRequest(http.MethodGet, server.URL+"/trips/"+uuid.NewString(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	request.Header.Set(httplog.RequestIDHeader, "panicking-request")
+	response, err := server.Client().Do(request)
+	if err != nil {
+		t.Fatalf("expected the panic answered, got %v", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("expected status %d, got %d", http.StatusInternalServerError, response.StatusCode)
+	}
+	var body spec.InternalServerErrorRequest
+	if err := json.NewDecoder(response.Body).Decode(&body); err != nil {
+		t.Fatalf("expected a JSON error, got %v", err)
+	}
+	if body.Code != string(ErrorCodeInternal) || body.Message == "" {
+		t.Fatalf("expected an %s error, got %+v", ErrorCodeInternal, body)
+	}
+
+	entries := logs.All()
+	if len(entries) != 1 {
+		t.Fatalf("expected the panic logged once, got %d entries", len(entries))
+	}
+	fields := entries[0].ContextMap()
+	if fields["request_id"] != "panicking-request" || fields["panic"] == nil || fields["stack"] == "" {
+		t.Fatalf("expected the panic logged with its stack and the request id, got %v", fields)
+	}
+
+	// the server is still up for the next requests.
+	response, err = server.Client().Get(server.URL + "/trips?participantEmail=owner@example.com")
+	if err != nil {
+		t.Fatalf("expected the server still serving, got %v", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		t.Fatalf("expected status %d after the panic, got %d", http.StatusOK, response.StatusCode)
+	}
+}
+
+func TestAbortedHandlersAreNotRecovered(t *testing.T) {
+	api := newTestAPI(newFakeStore(), &fakeMailer{})
+	handler := api.recoverPanics(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		panic(http.ErrAbortHandler)
+	}))
+
+	defer func() {
+		if recovered := recover(); recovered != http.ErrAbortHandler {
+			t.Fatalf("expected http.ErrAbortHandler to reach the server, got %v", recovered)
+		}
+	}()
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/trips", nil))
+	t.Fatal("expected the abort panicked again")
+}
@@ -0,0 +1,79 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"journey/internal/api/spec"
+	"journey/internal/pgstore"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// failingRescheduleStore fails the reschedule as its transaction would, rolled back before touching the fakeStore.
+type failingRescheduleStore struct {
+	*fakeStore
+}
+
+func (s failingRescheduleStore) RescheduleTrip(context.Context, *pgxpool.Pool, pgstore.RescheduleTripParams) error {
+	return errors.New("connection lost mid transaction")
+}
+
+func TestPostTripsTripIDRescheduleMovesTheActivities(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	activity := store.addActivity(trip.ID, "Trilha", trip.StartsAt.Time.Add(9*time.Hour))
+	api := newTestAPI(store, &fakeMailer{})
+
+	r := newRequest(t, http.MethodPost, "/trips/"+trip.ID.String()+"/reschedule", map[string]any{"days": 7})
+	assertStatus(t, serve(api, withOwnerToken(r, TEST_OWNER_TOKEN)), http.StatusNoContent)
+
+	moved := store.trips[trip.ID]
+	if !moved.StartsAt.Time.Equal(trip.StartsAt.Time.AddDate(0, 0, 7)) || !moved.EndsAt.Time.Equal(trip.EndsAt.Time.AddDate(0, 0, 7)) {
+		t.Fatalf("expected the trip moved by 7 days, got %v to %v", moved.StartsAt.Time, moved.EndsAt.Time)
+	}
+	if occursAt := store.activities[activity.ID].OccursAt.Time; !occursAt.Equal(activity.OccursAt.Time.AddDate(0, 0, 7)) {
+		t.Fatalf("expected the activity moved by 7 days, got %v", occursAt)
+	}
+	if store.callsOf("RescheduleTrip") != 1 {
+		t.Fatal("expected the trip and its activities moved in a single store call")
+	}
+}
+
+func TestPostTripsTripIDRescheduleIntoThePast(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	api := newTestAPI(store, &fakeMailer{})
+
+	r := newRequest(t, http.MethodPost, "/trips/"+trip.ID.String()+"/reschedule", map[string]any{"days": -7})
+	w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+	assertStatus(t, w, http.StatusBadRequest)
+	var response spec.BadRequest
+	decodeResponse(t, w, &response)
+	if response.Code != string(ErrorCodeTripPeriodInvalid) {
+		t.Fatalf("expected the code %s, got %q", ErrorCodeTripPeriodInvalid, response.Code)
+	}
+	if store.callsOf("RescheduleTrip") != 0 {
+		t.Fatal("expected the trip not rescheduled")
+	}
+}
+
+func TestPostTripsTripIDRescheduleLeavesTheTripOnFailure(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	activity := store.addActivity(trip.ID, "Trilha", trip.StartsAt.Time)
+	api := newTestAPI(failingRescheduleStore{store}, &fakeMailer{})
+
+	r := newRequest(t, http.MethodPost, "/trips/"+trip.ID.String()+"/reschedule", map[string]any{"days": 7})
+	assertStatus(t, serve(api, withOwnerToken(r, TEST_OWNER_TOKEN)), http.StatusInternalServerError)
+
+	if unchanged := store.trips[trip.ID]; !unchanged.StartsAt.Time.Equal(trip.StartsAt.Time) || !unchanged.EndsAt.Time.Equal(trip.EndsAt.Time) {
+		t.Fatalf("expected the trip left as it was, got %v to %v", unchanged.StartsAt.Time, unchanged.EndsAt.Time)
+	}
+	if occursAt := store.activities[activity.ID].OccursAt.Time; !occursAt.Equal(activity.OccursAt.Time) {
+		t.Fatalf("expected the activity left as it was, got %v", occursAt)
+	}
+}
@@ -25,44 +25,189 @@ import (
 
 // Bad request
 type BadRequest struct {
+	// Stable, machine-readable code of the error, e.g. TRIP_NOT_FOUND
+	Code string `json:"code"`
+
+	// The fields failing the validation, on the requests with invalid fields.
+	Fields  *[]FieldError `json:"fields,omitempty"`
+	Message string        `json:"message"`
+}
+
+// CloneTripRequest defines model for CloneTripRequest.
+type CloneTripRequest struct {
+	EndsAt   time.Time `json:"ends_at" validate:"required"`
+	StartsAt time.Time `json:"starts_at" validate:"required"`
+}
+
+// ConfirmParticipantsBatchRequest defines model for ConfirmParticipantsBatchRequest.
+type ConfirmParticipantsBatchRequest struct {
+	// The participants to confirm, each of them once.
+	ParticipantIds []string `json:"participant_ids" validate:"required,min=1,max=100,unique,dive,uuid"`
+}
+
+// ConfirmParticipantsBatchResponse defines model for ConfirmParticipantsBatchResponse.
+type ConfirmParticipantsBatchResponse struct {
+	// The outcome of each participant, in the order of the request.
+	Results []ConfirmParticipantsBatchResponseArray `json:"results"`
+}
+
+// ConfirmParticipantsBatchResponseArray defines model for ConfirmParticipantsBatchResponseArray.
+type ConfirmParticipantsBatchResponseArray struct {
+	ParticipantID string `json:"participant_id"`
+
+	// confirmed, already_confirmed when it was confirmed before, or not_found when it is no participant of the trip.
+	Status string `json:"status"`
+}
+
+// Conflict request
+type ConflictRequest struct {
+	// Stable, machine-readable code of the error, e.g. TRIP_NOT_FOUND
+	Code    string `json:"code"`
 	Message string `json:"message"`
 }
 
+// CreateActivitiesBatchRequest defines model for CreateActivitiesBatchRequest.
+type CreateActivitiesBatchRequest struct {
+	Activities []CreateActivityRequest `json:"activities" validate:"required,min=1,max=100,dive"`
+}
+
+// CreateActivitiesBatchResponse defines model for CreateActivitiesBatchResponse.
+type CreateActivitiesBatchResponse struct {
+	// The ids of the created activities, in the order of the request.
+	ActivityIds []string `json:"activityIds"`
+}
+
 // CreateActivityRequest defines model for CreateActivityRequest.
 type CreateActivityRequest struct {
+	// How long the activity lasts, zero when omitted.
+	DurationMinutes *int `json:"duration_minutes,omitempty" validate:"omitempty,min=0"`
+
+	// Free-text notes of the activity, up to 1000 characters.
+	Notes    *string   `json:"notes,omitempty" validate:"omitempty,max=1000"`
 	OccursAt time.Time `json:"occurs_at" validate:"required"`
-	Title    string    `json:"title" validate:"required"`
+	Title    string    `json:"title" validate:"required,notblank,min=1,max=120"`
 }
 
 // CreateActivityResponse defines model for CreateActivityResponse.
 type CreateActivityResponse struct {
-	ActivityID string `json:"activityId"`
+	Activity   GetTripActivitiesResponseInnerArray `json:"activity"`
+	ActivityID string                              `json:"activityId"`
 }
 
 // CreateLinkRequest defines model for CreateLinkRequest.
 type CreateLinkRequest struct {
-	Title string `json:"title" validate:"required"`
+	Title string `json:"title" validate:"required,notblank,min=1,max=120"`
 	URL   string `json:"url" validate:"required,url"`
 }
 
 // CreateLinkResponse defines model for CreateLinkResponse.
 type CreateLinkResponse struct {
-	LinkID string `json:"linkId"`
+	Link   GetLinksResponseArray `json:"link"`
+	LinkID string                `json:"linkId"`
 }
 
 // CreateTripRequest defines model for CreateTripRequest.
 type CreateTripRequest struct {
-	Destination    string                `json:"destination" validate:"required,min=4"`
+	Destination    string                `json:"destination" validate:"required,notblank,min=4,max=255"`
 	EmailsToInvite []openapi_types.Email `json:"emails_to_invite" validate:"required,dive,email"`
 	EndsAt         time.Time             `json:"ends_at" validate:"required"`
-	OwnerEmail     openapi_types.Email   `json:"owner_email" validate:"required,email"`
-	OwnerName      string                `json:"owner_name" validate:"required"`
-	StartsAt       time.Time             `json:"starts_at" validate:"required"`
+
+	// Latitude of the destination, from -90 to 90, sent along with the longitude. Resolved from the destination when omitted and a geocoder is configured.
+	Latitude *float64 `json:"latitude,omitempty" validate:"required_with=Longitude,omitempty,min=-90,max=90"`
+
+	// Longitude of the destination, from -180 to 180, sent along with the latitude.
+	Longitude *float64 `json:"longitude,omitempty" validate:"required_with=Latitude,omitempty,min=-180,max=180"`
+
+	// Most participants invited to the trip, the owner aside. The emails invited past it are waitlisted, unlimited when omitted.
+	MaxParticipants *int `json:"max_participants,omitempty" validate:"omitempty,min=1"`
+
+	// Free-text notes of the trip, up to 1000 characters.
+	Notes      *string             `json:"notes,omitempty" validate:"omitempty,max=1000"`
+	OwnerEmail openapi_types.Email `json:"owner_email" validate:"required,email"`
+
+	// Name of the trip owner, the emails of the trip are sent on its name. Up to 255 characters.
+	OwnerName string    `json:"owner_name" validate:"required,notblank,min=1,max=255"`
+	StartsAt  time.Time `json:"starts_at" validate:"required"`
+
+	// IANA name of the destination timezone, as America/Sao_Paulo. The activities are grouped by day in it, UTC by default.
+	Timezone *string `json:"timezone,omitempty" validate:"omitempty,timezone"`
 }
 
 // CreateTripResponse defines model for CreateTripResponse.
 type CreateTripResponse struct {
-	TripID string `json:"tripId"`
+	// Secret of the trip owner, sent as a Bearer token on the routes changing the trip. It is returned only once. A JWT bound to the trip and its owner email when JOURNEY_JWT_SECRET is set, asked again through a magic link once expired.
+	OwnerToken string `json:"ownerToken"`
+	TripID     string `json:"tripId"`
+
+	// Why the confirmation email of the owner was not sent, missing unless it was sent synchronously and failed. The trip is created all the same.
+	Warning *string `json:"warning,omitempty"`
+}
+
+// DiagnosticsResponse defines model for DiagnosticsResponse.
+type DiagnosticsResponse struct {
+	// Whether the emails are logged instead of sent.
+	MailDryRun bool `json:"mail_dry_run"`
+
+	// Version of the last migration applied to the database.
+	SchemaVersion int `json:"schema_version"`
+
+	// Address of the SMTP server the emails are sent through.
+	SMTPHost      string    `json:"smtp_host"`
+	StartedAt     time.Time `json:"started_at"`
+	UptimeSeconds int       `json:"uptime_seconds"`
+
+	// Version the app was built as, dev when not set on the build.
+	Version string `json:"version"`
+}
+
+// FieldError defines model for FieldError.
+type FieldError struct {
+	// Path of the field by its JSON names, e.g. activities[0].title
+	Field string `json:"field"`
+
+	// What the field must be, in the language of the request.
+	Message string `json:"message"`
+
+	// Validation rule the field fails, with its parameter, e.g. max=255
+	Rule string `json:"rule"`
+}
+
+// Forbidden request
+type ForbiddenRequest struct {
+	// Stable, machine-readable code of the error, e.g. TRIP_NOT_FOUND
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// GetActivityResponse defines model for GetActivityResponse.
+type GetActivityResponse struct {
+	Activity GetTripActivitiesResponseInnerArray `json:"activity"`
+}
+
+// GetAdminParticipantsResponse A page of the list, in the envelope shared by all the paginated lists.
+type GetAdminParticipantsResponse = Paginated[GetAdminParticipantsResponseArray]
+
+// GetAdminParticipantsResponseArray defines model for GetAdminParticipantsResponseArray.
+type GetAdminParticipantsResponseArray struct {
+	Email openapi_types.Email `json:"email"`
+	ID    string              `json:"id"`
+
+	// Seconds since the participant was invited.
+	InviteAgeSeconds int `json:"invite_age_seconds"`
+
+	// Delivery of the invite email: pending until it is first attempted, then sent or failed.
+	InviteStatus string `json:"invite_status"`
+
+	// When the participant was invited.
+	InvitedAt       time.Time `json:"invited_at"`
+	IsConfirmed     bool      `json:"is_confirmed"`
+	TripDestination string    `json:"trip_destination"`
+	TripID          string    `json:"trip_id"`
+}
+
+// GetLinkResponse defines model for GetLinkResponse.
+type GetLinkResponse struct {
+	Link GetLinksResponseArray `json:"link"`
 }
 
 // GetLinksResponse defines model for GetLinksResponse.
@@ -77,6 +222,29 @@ type GetLinksResponseArray struct {
 	URL   string `json:"url"`
 }
 
+// A page of the list, in the envelope shared by all the paginated lists.
+type GetParticipantTripsResponse = Paginated[GetParticipantTripsResponseArray]
+
+// GetParticipantTripsResponseArray defines model for GetParticipantTripsResponseArray.
+type GetParticipantTripsResponseArray struct {
+	Destination string    `json:"destination"`
+	EndsAt      time.Time `json:"ends_at"`
+	ID          string    `json:"id"`
+
+	// Whether the email confirmed the trip, as its owner or as a participant.
+	IsConfirmed bool `json:"is_confirmed"`
+
+	// Whether the email is the trip owner, otherwise it is a participant.
+	IsOwner  bool      `json:"is_owner"`
+	StartsAt time.Time `json:"starts_at"`
+}
+
+// GetParticipantsPendingCountResponse defines model for GetParticipantsPendingCountResponse.
+type GetParticipantsPendingCountResponse struct {
+	// Invitations of the email not confirmed yet.
+	Count int `json:"count"`
+}
+
 // GetTripActivitiesResponse defines model for GetTripActivitiesResponse.
 type GetTripActivitiesResponse struct {
 	Activities []GetTripActivitiesResponseOuterArray `json:"activities"`
@@ -84,7 +252,17 @@ type GetTripActivitiesResponse struct {
 
 // GetTripActivitiesResponseInnerArray defines model for GetTripActivitiesResponseInnerArray.
 type GetTripActivitiesResponseInnerArray struct {
-	ID       string    `json:"id"`
+	DurationMinutes int `json:"duration_minutes"`
+
+	// When the activity ends, its occurrence plus its duration.
+	EndsAt time.Time `json:"ends_at"`
+	ID     string    `json:"id"`
+
+	// Whether the activity was checked off as done.
+	IsDone bool `json:"is_done"`
+
+	// Free-text notes of the activity, missing when none.
+	Notes    *string   `json:"notes,omitempty"`
 	OccursAt time.Time `json:"occurs_at"`
 	Title    string    `json:"title"`
 }
@@ -95,6 +273,20 @@ type GetTripActivitiesResponseOuterArray struct {
 	Date       time.Time                             `json:"date"`
 }
 
+// GetTripActivitiesSummaryResponse defines model for GetTripActivitiesSummaryResponse.
+type GetTripActivitiesSummaryResponse struct {
+	// The days of the trip, in order.
+	Days []GetTripActivitiesSummaryResponseArray `json:"days"`
+}
+
+// GetTripActivitiesSummaryResponseArray defines model for GetTripActivitiesSummaryResponseArray.
+type GetTripActivitiesSummaryResponseArray struct {
+	Count int `json:"count"`
+
+	// Start of the day in the trip timezone, as on the activities listing.
+	Date time.Time `json:"date"`
+}
+
 // GetTripDetailsResponse defines model for GetTripDetailsResponse.
 type GetTripDetailsResponse struct {
 	Trip GetTripDetailsResponseTripObj `json:"trip"`
@@ -106,47 +298,372 @@ type GetTripDetailsResponseTripObj struct {
 	EndsAt      time.Time `json:"ends_at"`
 	ID          string    `json:"id"`
 	IsConfirmed bool      `json:"is_confirmed"`
-	StartsAt    time.Time `json:"starts_at"`
+
+	// Latitude of the destination, missing when unknown.
+	Latitude *float64 `json:"latitude,omitempty"`
+
+	// Longitude of the destination, missing when unknown.
+	Longitude *float64 `json:"longitude,omitempty"`
+
+	// Most participants invited to the trip, the owner aside, missing when unlimited.
+	MaxParticipants *int `json:"max_participants,omitempty"`
+
+	// Free-text notes of the trip, missing when none.
+	Notes    *string   `json:"notes,omitempty"`
+	StartsAt time.Time `json:"starts_at"`
+
+	// Stage of the trip: planning, confirmed, cancelled or completed.
+	Status   string `json:"status"`
+	Timezone string `json:"timezone"`
 }
 
-// GetTripParticipantsResponse defines model for GetTripParticipantsResponse.
-type GetTripParticipantsResponse struct {
+// GetTripFullResponse defines model for GetTripFullResponse.
+type GetTripFullResponse struct {
+	// The trip days with their activities.
+	Activities []GetTripActivitiesResponseOuterArray `json:"activities"`
+	Links      []GetLinksResponseArray               `json:"links"`
+
+	// All the participants of the trip, not paged.
 	Participants []GetTripParticipantsResponseArray `json:"participants"`
+	Trip         GetTripDetailsResponseTripObj      `json:"trip"`
 }
 
+// A page of the list, in the envelope shared by all the paginated lists.
+type GetTripParticipantsResponse = Paginated[GetTripParticipantsResponseArray]
+
 // GetTripParticipantsResponseArray defines model for GetTripParticipantsResponseArray.
 type GetTripParticipantsResponseArray struct {
-	Email       openapi_types.Email `json:"email"`
-	ID          string              `json:"id"`
-	IsConfirmed bool                `json:"is_confirmed"`
-	Name        *string             `json:"name"`
+	Email openapi_types.Email `json:"email"`
+	ID    string              `json:"id"`
+
+	// When the invite email was last attempted.
+	InviteLastAttemptAt *time.Time `json:"invite_last_attempt_at"`
+
+	// Delivery of the invite email: pending until it is first attempted, then sent or failed.
+	InviteStatus string `json:"invite_status"`
+	IsConfirmed  bool   `json:"is_confirmed"`
+
+	// Invited past the trip capacity, the invite is only sent once promoted.
+	IsWaitlisted bool `json:"is_waitlisted"`
+
+	// Name of the participant, null when not told on its invite.
+	Name *string `json:"name"`
+
+	// Phone of the participant, null when not told on its invite.
+	Phone *string `json:"phone"`
+}
+
+// GetTripParticipantsSummaryResponse defines model for GetTripParticipantsSummaryResponse.
+type GetTripParticipantsSummaryResponse struct {
+	Confirmed int `json:"confirmed"`
+
+	// Participants who have not confirmed yet, the waitlisted ones aside.
+	Pending int `json:"pending"`
+
+	// Emails of the pending participants, in alphabetical order.
+	PendingEmails []openapi_types.Email `json:"pendingEmails"`
+	Total         int                   `json:"total"`
+
+	// Participants invited past the trip capacity, asked to confirm only once promoted.
+	Waitlisted int `json:"waitlisted"`
+}
+
+// A page of the list, in the envelope shared by all the paginated lists.
+type GetTripTimelineResponse = Paginated[GetTripTimelineResponseArray]
+
+// GetTripTimelineResponseArray defines model for GetTripTimelineResponseArray.
+type GetTripTimelineResponseArray struct {
+	// When the entry ends, its occurrence when it has no duration.
+	EndsAt time.Time `json:"ends_at"`
+	ID     string    `json:"id"`
+
+	// Free-text notes of the entry, missing when none.
+	Notes    *string   `json:"notes,omitempty"`
+	OccursAt time.Time `json:"occurs_at"`
+	Title    string    `json:"title"`
+
+	// Kind of the entry, only activity for now.
+	Type string `json:"type"`
+}
+
+// HealthResponse defines model for HealthResponse.
+type HealthResponse struct {
+	Database string  `json:"database"`
+	Mailer   *string `json:"mailer,omitempty"`
+	Status   string  `json:"status"`
 }
 
 // Internal Server Error request
 type InternalServerErrorRequest struct {
+	// Stable, machine-readable code of the error, e.g. TRIP_NOT_FOUND
+	Code    string `json:"code"`
 	Message string `json:"message"`
 }
 
 // InviteParticipantRequest defines model for InviteParticipantRequest.
 type InviteParticipantRequest struct {
-	Email openapi_types.Email `json:"email" validate:"required,email"`
+	// Email of the person to invite.
+	Email *openapi_types.Email `json:"email,omitempty" validate:"required_without=Emails,excluded_with=Emails,omitempty,email"`
+
+	// Emails of the people to invite. The repeated ones and the ones already participating are left out, the others are invited at once.
+	Emails *[]openapi_types.Email `json:"emails,omitempty" validate:"required_without=Email,excluded_with=Email,omitempty,min=1,max=50,dive,email"`
+
+	// Name of the person to invite, the invitation greets it by it. Up to 255 characters, sent along a single email.
+	Name *string `json:"name,omitempty" validate:"excluded_with=Emails,omitempty,notblank,max=255"`
+
+	// Phone of the person to invite, in the E.164 format as +5548999999999. Sent along a single email.
+	Phone *string `json:"phone,omitempty" validate:"excluded_with=Emails,omitempty,e164"`
 }
 
 // InviteParticipantResponse defines model for InviteParticipantResponse.
 type InviteParticipantResponse struct {
-	ParticipantID string `json:"participantId"`
+	Participant   GetTripParticipantsResponseArray `json:"participant"`
+	ParticipantID string                           `json:"participantId"`
+
+	// All the participants invited by the request.
+	Participants []GetTripParticipantsResponseArray `json:"participants"`
 }
 
 // Not Found request
 type NotFoundRequest struct {
+	// Stable, machine-readable code of the error, e.g. TRIP_NOT_FOUND
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// OwnerMagicLinkRequest defines model for OwnerMagicLinkRequest.
+type OwnerMagicLinkRequest struct {
+	// Email of the trip owner, the magic link is sent to it.
+	Email openapi_types.Email `json:"email" validate:"required,email"`
+}
+
+// OwnerTokenResponse defines model for OwnerTokenResponse.
+type OwnerTokenResponse struct {
+	// When the owner token expires, a new one is then asked through a magic link.
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// JWT of the trip owner, sent as a Bearer token on the routes changing the trip.
+	OwnerToken string `json:"ownerToken"`
+}
+
+// ReissueOwnerTokenResponse defines model for ReissueOwnerTokenResponse.
+type ReissueOwnerTokenResponse struct {
+	// Secret of the trip owner, sent as a Bearer token on the routes changing the trip. It is returned only once. A JWT bound to the trip and its owner email when JOURNEY_JWT_SECRET is set, asked again through a magic link once expired.
+	OwnerToken string `json:"ownerToken"`
+}
+
+// ReorderActivitiesRequest defines model for ReorderActivitiesRequest.
+type ReorderActivitiesRequest struct {
+	// All the activities of the day, each once, in their new order.
+	ActivityIds []string `json:"activity_ids" validate:"required,min=1,max=100,unique,dive,uuid"`
+
+	// The day of the activities, in the trip timezone.
+	Day openapi_types.Date `json:"day" validate:"required"`
+}
+
+// RescheduleTripRequest defines model for RescheduleTripRequest.
+type RescheduleTripRequest struct {
+	// Days the trip and its activities move by, forward when positive and backward when negative.
+	Days int `json:"days" validate:"required"`
+}
+
+// SearchActivitiesResponse defines model for SearchActivitiesResponse.
+type SearchActivitiesResponse struct {
+	// The matching activities by their start, at most 50.
+	Activities []GetTripActivitiesResponseInnerArray `json:"activities"`
+}
+
+// TransferTripOwnerRequest defines model for TransferTripOwnerRequest.
+type TransferTripOwnerRequest struct {
+	// Whether the previous owner stays on the trip as a confirmed participant, false by default.
+	DemotePreviousOwner *bool               `json:"demote_previous_owner,omitempty"`
+	OwnerEmail          openapi_types.Email `json:"owner_email" validate:"required,email"`
+
+	// Name of the new trip owner, the emails of the trip are sent on its name. Up to 255 characters.
+	OwnerName string `json:"owner_name" validate:"required,notblank,min=1,max=255"`
+}
+
+// TransferTripOwnerResponse defines model for TransferTripOwnerResponse.
+type TransferTripOwnerResponse struct {
+	// Secret of the new trip owner, sent as a Bearer token on the routes changing the trip. It is returned only once. A JWT bound to the trip and its owner email when JOURNEY_JWT_SECRET is set, asked again through a magic link once expired.
+	OwnerToken string `json:"ownerToken"`
+}
+
+// Unauthorized request
+type UnauthorizedRequest struct {
+	// Stable, machine-readable code of the error, e.g. TRIP_NOT_FOUND
+	Code    string `json:"code"`
 	Message string `json:"message"`
 }
 
+// UpdateActivityDoneRequest defines model for UpdateActivityDoneRequest.
+type UpdateActivityDoneRequest struct {
+	// Whether the activity is done. Setting it again to the same value changes nothing.
+	IsDone *bool `json:"is_done,omitempty" validate:"required"`
+}
+
+// UpdateActivityRequest defines model for UpdateActivityRequest.
+type UpdateActivityRequest struct {
+	// How long the activity lasts, zero when omitted.
+	DurationMinutes *int `json:"duration_minutes,omitempty" validate:"omitempty,min=0"`
+
+	// Free-text notes of the activity, up to 1000 characters, cleared when omitted.
+	Notes    *string   `json:"notes,omitempty" validate:"omitempty,max=1000"`
+	OccursAt time.Time `json:"occurs_at" validate:"required"`
+	Title    string    `json:"title" validate:"required,notblank,min=1,max=120"`
+}
+
+// UpdateParticipantRequest defines model for UpdateParticipantRequest.
+type UpdateParticipantRequest struct {
+	Email openapi_types.Email `json:"email" validate:"required,email"`
+}
+
 // UpdateTripRequest defines model for UpdateTripRequest.
 type UpdateTripRequest struct {
-	Destination string    `json:"destination" validate:"required,min=4"`
+	Destination string    `json:"destination" validate:"required,notblank,min=4,max=255"`
 	EndsAt      time.Time `json:"ends_at" validate:"required"`
-	StartsAt    time.Time `json:"starts_at" validate:"required"`
+
+	// Latitude of the destination, from -90 to 90, sent along with the longitude. Kept when omitted, unless the destination changes: it is then resolved again, or cleared when it can't be.
+	Latitude *float64 `json:"latitude,omitempty" validate:"required_with=Longitude,omitempty,min=-90,max=90"`
+
+	// Longitude of the destination, from -180 to 180, sent along with the latitude.
+	Longitude *float64 `json:"longitude,omitempty" validate:"required_with=Latitude,omitempty,min=-180,max=180"`
+
+	// Most participants invited to the trip, the owner aside. Kept when omitted, lifted when 0. The participants already invited are kept when lowered under them, only the next invites are waitlisted.
+	MaxParticipants *int `json:"max_participants,omitempty" validate:"omitempty,min=0"`
+
+	// Free-text notes of the trip, up to 1000 characters. Kept when omitted, cleared when empty.
+	Notes    *string   `json:"notes,omitempty" validate:"omitempty,max=1000"`
+	StartsAt time.Time `json:"starts_at" validate:"required"`
+}
+
+// UpdateTripStatusRequest defines model for UpdateTripStatusRequest.
+type UpdateTripStatusRequest struct {
+	// Stage the trip moves to: planning goes to confirmed or cancelled, confirmed to completed or cancelled.
+	Status string `json:"status" validate:"required,oneof=planning confirmed cancelled completed"`
+}
+
+// GetAdminParticipantsParams defines parameters for GetAdminParticipants.
+type GetAdminParticipantsParams struct {
+	// Only the participants who confirmed, or only the ones who did not.
+	Confirmed *bool `json:"confirmed,omitempty"`
+
+	// Only the participants invited longer ago than this duration, as 72h.
+	OlderThan *string `json:"olderThan,omitempty"`
+
+	// Page to list, starting at 1.
+	Page *int `json:"page,omitempty"`
+
+	// Participants per page, at most 200.
+	PerPage *int `json:"perPage,omitempty"`
+}
+
+// GetHealthzParams defines parameters for GetHealthz.
+type GetHealthzParams struct {
+	// Also checks the mailer (SMTP) is reachable.
+	Deep *bool `json:"deep,omitempty"`
+}
+
+// GetParticipantsPendingCountParams defines parameters for GetParticipantsPendingCount.
+type GetParticipantsPendingCountParams struct {
+	// Email of the participant.
+	Email openapi_types.Email `json:"email"`
+}
+
+// GetParticipantsParticipantIDConfirmParams defines parameters for GetParticipantsParticipantIDConfirm.
+type GetParticipantsParticipantIDConfirmParams struct {
+	// Signed token of the confirmation link sent by email. Required when JOURNEY_CONFIRM_TOKEN_SECRET is set.
+	Token *string `json:"token,omitempty"`
+}
+
+// PatchParticipantsParticipantIDConfirmParams defines parameters for PatchParticipantsParticipantIDConfirm.
+type PatchParticipantsParticipantIDConfirmParams struct {
+	// Signed token of the confirmation link sent by email. Required when JOURNEY_CONFIRM_TOKEN_SECRET is set.
+	Token *string `json:"token,omitempty"`
+}
+
+// GetParticipantsByEmailTripsParams defines parameters for GetParticipantsByEmailTrips.
+type GetParticipantsByEmailTripsParams struct {
+	// Email of the owner or participant.
+	ParticipantEmail *openapi_types.Email `json:"participantEmail,omitempty"`
+
+	// Email of the owner, listing only the trips it owns.
+	OwnerEmail *openapi_types.Email `json:"ownerEmail,omitempty"`
+
+	// Page to list, starting at 1.
+	Page *int `json:"page,omitempty"`
+
+	// Trips per page, at most 100.
+	PerPage *int `json:"perPage,omitempty"`
+}
+
+// PostTripsParams defines parameters for PostTrips.
+type PostTripsParams struct {
+	// Sends the confirmation email of the owner before answering, a failure answered as the warning of the created trip. JOURNEY_MAIL_SYNC_OWNER_CONFIRMATION by default.
+	Sync *bool `json:"sync,omitempty"`
+
+	// Key making the retries of a creation safe: repeated with the same body it answers the trip first created, with another body it conflicts. It expires after a day.
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
+}
+
+// GetTripsTripIDConfirmParams defines parameters for GetTripsTripIDConfirm.
+type GetTripsTripIDConfirmParams struct {
+	// Signed token of the confirmation link sent by email. Required when JOURNEY_CONFIRM_TOKEN_SECRET is set.
+	Token *string `json:"token,omitempty"`
+}
+
+// PatchTripsTripIDConfirmParams defines parameters for PatchTripsTripIDConfirm.
+type PatchTripsTripIDConfirmParams struct {
+	// Signed token of the confirmation link sent by email. Required when JOURNEY_CONFIRM_TOKEN_SECRET is set.
+	Token *string `json:"token,omitempty"`
+}
+
+// GetTripsTripIDParticipantsParams defines parameters for GetTripsTripIDParticipants.
+type GetTripsTripIDParticipantsParams struct {
+	// Page to list, starting at 1. Out of range it is clamped.
+	Page *int `json:"page,omitempty"`
+
+	// Participants per page, at most 200. Out of range it is clamped.
+	PerPage *int `json:"perPage,omitempty"`
+}
+
+// DeleteTripsTripIDParticipantsParticipantIDParams defines parameters for DeleteTripsTripIDParticipantsParticipantID.
+type DeleteTripsTripIDParticipantsParticipantIDParams struct {
+	// Remove the participant even when already confirmed.
+	Force *bool `json:"force,omitempty"`
+}
+
+// GetTripsTripIDActivitiesParams defines parameters for GetTripsTripIDActivities.
+type GetTripsTripIDActivitiesParams struct {
+	// First day of the activities, in the trip timezone.
+	From *openapi_types.Date `json:"from,omitempty"`
+
+	// Last day of the activities, in the trip timezone, not before from.
+	To *openapi_types.Date `json:"to,omitempty"`
+
+	// Text the activity titles contain, ignoring the case.
+	Q *string `json:"q,omitempty"`
+}
+
+// GetTripsTripIDActivitiesSearchParams defines parameters for GetTripsTripIDActivitiesSearch.
+type GetTripsTripIDActivitiesSearchParams struct {
+	// Text the activity titles contain, ignoring the case, not blank.
+	Q string `json:"q"`
+}
+
+// GetTripsTripIDOwnerTokenParams defines parameters for GetTripsTripIDOwnerToken.
+type GetTripsTripIDOwnerTokenParams struct {
+	// Token of the magic link sent by email.
+	Token string `json:"token"`
+}
+
+// GetTripsTripIDTimelineParams defines parameters for GetTripsTripIDTimeline.
+type GetTripsTripIDTimelineParams struct {
+	// Page to list, starting at 1. Out of range it is clamped.
+	Page *int `json:"page,omitempty"`
+
+	// Entries per page, at most 200. Out of range it is clamped.
+	PerPage *int `json:"perPage,omitempty"`
 }
 
 // PostTripsJSONBody defines parameters for PostTrips.
@@ -158,12 +675,45 @@ type PutTripsTripIDJSONBody UpdateTripRequest
 // PostTripsTripIDActivitiesJSONBody defines parameters for PostTripsTripIDActivities.
 type PostTripsTripIDActivitiesJSONBody CreateActivityRequest
 
+// PostTripsTripIDActivitiesBatchJSONBody defines parameters for PostTripsTripIDActivitiesBatch.
+type PostTripsTripIDActivitiesBatchJSONBody CreateActivitiesBatchRequest
+
+// PutTripsTripIDActivitiesOrderJSONBody defines parameters for PutTripsTripIDActivitiesOrder.
+type PutTripsTripIDActivitiesOrderJSONBody ReorderActivitiesRequest
+
+// PutTripsTripIDActivitiesActivityIDJSONBody defines parameters for PutTripsTripIDActivitiesActivityID.
+type PutTripsTripIDActivitiesActivityIDJSONBody UpdateActivityRequest
+
+// PatchTripsTripIDActivitiesActivityIDDoneJSONBody defines parameters for PatchTripsTripIDActivitiesActivityIDDone.
+type PatchTripsTripIDActivitiesActivityIDDoneJSONBody UpdateActivityDoneRequest
+
+// PostTripsTripIDCloneJSONBody defines parameters for PostTripsTripIDClone.
+type PostTripsTripIDCloneJSONBody CloneTripRequest
+
 // PostTripsTripIDInvitesJSONBody defines parameters for PostTripsTripIDInvites.
 type PostTripsTripIDInvitesJSONBody InviteParticipantRequest
 
 // PostTripsTripIDLinksJSONBody defines parameters for PostTripsTripIDLinks.
 type PostTripsTripIDLinksJSONBody CreateLinkRequest
 
+// PatchTripsTripIDOwnerJSONBody defines parameters for PatchTripsTripIDOwner.
+type PatchTripsTripIDOwnerJSONBody TransferTripOwnerRequest
+
+// PostTripsTripIDOwnerMagicLinkJSONBody defines parameters for PostTripsTripIDOwnerMagicLink.
+type PostTripsTripIDOwnerMagicLinkJSONBody OwnerMagicLinkRequest
+
+// PatchTripsTripIDParticipantsConfirmBatchJSONBody defines parameters for PatchTripsTripIDParticipantsConfirmBatch.
+type PatchTripsTripIDParticipantsConfirmBatchJSONBody ConfirmParticipantsBatchRequest
+
+// PutTripsTripIDParticipantsParticipantIDJSONBody defines parameters for PutTripsTripIDParticipantsParticipantID.
+type PutTripsTripIDParticipantsParticipantIDJSONBody UpdateParticipantRequest
+
+// PostTripsTripIDRescheduleJSONBody defines parameters for PostTripsTripIDReschedule.
+type PostTripsTripIDRescheduleJSONBody RescheduleTripRequest
+
+// PatchTripsTripIDStatusJSONBody defines parameters for PatchTripsTripIDStatus.
+type PatchTripsTripIDStatusJSONBody UpdateTripStatusRequest
+
 // PostTripsJSONRequestBody defines body for PostTrips for application/json ContentType.
 type PostTripsJSONRequestBody PostTripsJSONBody
 
@@ -188,6 +738,46 @@ func (PostTripsTripIDActivitiesJSONRequestBody) Bind(*http.Request) error {
 	return nil
 }
 
+// PostTripsTripIDActivitiesBatchJSONRequestBody defines body for PostTripsTripIDActivitiesBatch for application/json ContentType.
+type PostTripsTripIDActivitiesBatchJSONRequestBody PostTripsTripIDActivitiesBatchJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDActivitiesBatchJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDActivitiesOrderJSONRequestBody defines body for PutTripsTripIDActivitiesOrder for application/json ContentType.
+type PutTripsTripIDActivitiesOrderJSONRequestBody PutTripsTripIDActivitiesOrderJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDActivitiesOrderJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDActivitiesActivityIDJSONRequestBody defines body for PutTripsTripIDActivitiesActivityID for application/json ContentType.
+type PutTripsTripIDActivitiesActivityIDJSONRequestBody PutTripsTripIDActivitiesActivityIDJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDActivitiesActivityIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PatchTripsTripIDActivitiesActivityIDDoneJSONRequestBody defines body for PatchTripsTripIDActivitiesActivityIDDone for application/json ContentType.
+type PatchTripsTripIDActivitiesActivityIDDoneJSONRequestBody PatchTripsTripIDActivitiesActivityIDDoneJSONBody
+
+// Bind implements render.Binder.
+func (PatchTripsTripIDActivitiesActivityIDDoneJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDCloneJSONRequestBody defines body for PostTripsTripIDClone for application/json ContentType.
+type PostTripsTripIDCloneJSONRequestBody PostTripsTripIDCloneJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDCloneJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
 // PostTripsTripIDInvitesJSONRequestBody defines body for PostTripsTripIDInvites for application/json ContentType.
 type PostTripsTripIDInvitesJSONRequestBody PostTripsTripIDInvitesJSONBody
 
@@ -204,6 +794,54 @@ func (PostTripsTripIDLinksJSONRequestBody) Bind(*http.Request) error {
 	return nil
 }
 
+// PatchTripsTripIDOwnerJSONRequestBody defines body for PatchTripsTripIDOwner for application/json ContentType.
+type PatchTripsTripIDOwnerJSONRequestBody PatchTripsTripIDOwnerJSONBody
+
+// Bind implements render.Binder.
+func (PatchTripsTripIDOwnerJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDOwnerMagicLinkJSONRequestBody defines body for PostTripsTripIDOwnerMagicLink for application/json ContentType.
+type PostTripsTripIDOwnerMagicLinkJSONRequestBody PostTripsTripIDOwnerMagicLinkJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDOwnerMagicLinkJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PatchTripsTripIDParticipantsConfirmBatchJSONRequestBody defines body for PatchTripsTripIDParticipantsConfirmBatch for application/json ContentType.
+type PatchTripsTripIDParticipantsConfirmBatchJSONRequestBody PatchTripsTripIDParticipantsConfirmBatchJSONBody
+
+// Bind implements render.Binder.
+func (PatchTripsTripIDParticipantsConfirmBatchJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDParticipantsParticipantIDJSONRequestBody defines body for PutTripsTripIDParticipantsParticipantID for application/json ContentType.
+type PutTripsTripIDParticipantsParticipantIDJSONRequestBody PutTripsTripIDParticipantsParticipantIDJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDParticipantsParticipantIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDRescheduleJSONRequestBody defines body for PostTripsTripIDReschedule for application/json ContentType.
+type PostTripsTripIDRescheduleJSONRequestBody PostTripsTripIDRescheduleJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDRescheduleJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PatchTripsTripIDStatusJSONRequestBody defines body for PatchTripsTripIDStatus for application/json ContentType.
+type PatchTripsTripIDStatusJSONRequestBody PatchTripsTripIDStatusJSONBody
+
+// Bind implements render.Binder.
+func (PatchTripsTripIDStatusJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
 // Response is a common response struct for all the API calls.
 // A Response object may be instantiated via functions for specific operation responses.
 // It may also be instantiated directly, for the purpose of responding with a single status code.
@@ -245,19 +883,19 @@ func (resp *Response) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	return e.Encode(resp.body)
 }
 
-// GetParticipantsParticipantIDConfirmJSON204Response is a constructor method for a GetParticipantsParticipantIDConfirm response.
+// GetAdminParticipantsJSON200Response is a constructor method for a GetAdminParticipants response.
 // A *Response is returned with the configured status code and content type from the spec.
-func GetParticipantsParticipantIDConfirmJSON204Response(body interface{}) *Response {
+func GetAdminParticipantsJSON200Response(body GetAdminParticipantsResponse) *Response {
 	return &Response{
 		body:        body,
-		Code:        204,
+		Code:        200,
 		contentType: "application/json",
 	}
 }
 
-// GetParticipantsParticipantIDConfirmJSON400Response is a constructor method for a GetParticipantsParticipantIDConfirm response.
+// GetAdminParticipantsJSON400Response is a constructor method for a GetAdminParticipants response.
 // A *Response is returned with the configured status code and content type from the spec.
-func GetParticipantsParticipantIDConfirmJSON400Response(body BadRequest) *Response {
+func GetAdminParticipantsJSON400Response(body BadRequest) *Response {
 	return &Response{
 		body:        body,
 		Code:        400,
@@ -265,49 +903,69 @@ func GetParticipantsParticipantIDConfirmJSON400Response(body BadRequest) *Respon
 	}
 }
 
-// GetParticipantsParticipantIDConfirmJSON404Response is a constructor method for a GetParticipantsParticipantIDConfirm response.
+// GetAdminParticipantsJSON401Response is a constructor method for a GetAdminParticipants response.
 // A *Response is returned with the configured status code and content type from the spec.
-func GetParticipantsParticipantIDConfirmJSON404Response(body NotFoundRequest) *Response {
+func GetAdminParticipantsJSON401Response(body UnauthorizedRequest) *Response {
 	return &Response{
 		body:        body,
-		Code:        404,
+		Code:        401,
 		contentType: "application/json",
 	}
 }
 
-// GetParticipantsParticipantIDConfirmJSON500Response is a constructor method for a GetParticipantsParticipantIDConfirm response.
+// GetAdminParticipantsJSON403Response is a constructor method for a GetAdminParticipants response.
 // A *Response is returned with the configured status code and content type from the spec.
-func GetParticipantsParticipantIDConfirmJSON500Response(body InternalServerErrorRequest) *Response {
+func GetAdminParticipantsJSON403Response(body ForbiddenRequest) *Response {
 	return &Response{
 		body:        body,
-		Code:        500,
+		Code:        403,
 		contentType: "application/json",
 	}
 }
 
-// PatchParticipantsParticipantIDConfirmJSON204Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
+// GetAdminParticipantsJSON500Response is a constructor method for a GetAdminParticipants response.
 // A *Response is returned with the configured status code and content type from the spec.
-func PatchParticipantsParticipantIDConfirmJSON204Response(body interface{}) *Response {
+func GetAdminParticipantsJSON500Response(body InternalServerErrorRequest) *Response {
 	return &Response{
 		body:        body,
-		Code:        204,
+		Code:        500,
 		contentType: "application/json",
 	}
 }
 
-// PatchParticipantsParticipantIDConfirmJSON400Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
+// PostAdminTripsTripIDOwnerTokenJSON200Response is a constructor method for a PostAdminTripsTripIDOwnerToken response.
 // A *Response is returned with the configured status code and content type from the spec.
-func PatchParticipantsParticipantIDConfirmJSON400Response(body BadRequest) *Response {
+func PostAdminTripsTripIDOwnerTokenJSON200Response(body ReissueOwnerTokenResponse) *Response {
 	return &Response{
 		body:        body,
-		Code:        400,
+		Code:        200,
 		contentType: "application/json",
 	}
 }
 
-// PatchParticipantsParticipantIDConfirmJSON404Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
+// PostAdminTripsTripIDOwnerTokenJSON401Response is a constructor method for a PostAdminTripsTripIDOwnerToken response.
 // A *Response is returned with the configured status code and content type from the spec.
-func PatchParticipantsParticipantIDConfirmJSON404Response(body NotFoundRequest) *Response {
+func PostAdminTripsTripIDOwnerTokenJSON401Response(body UnauthorizedRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostAdminTripsTripIDOwnerTokenJSON403Response is a constructor method for a PostAdminTripsTripIDOwnerToken response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostAdminTripsTripIDOwnerTokenJSON403Response(body ForbiddenRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostAdminTripsTripIDOwnerTokenJSON404Response is a constructor method for a PostAdminTripsTripIDOwnerToken response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostAdminTripsTripIDOwnerTokenJSON404Response(body NotFoundRequest) *Response {
 	return &Response{
 		body:        body,
 		Code:        404,
@@ -315,9 +973,9 @@ func PatchParticipantsParticipantIDConfirmJSON404Response(body NotFoundRequest)
 	}
 }
 
-// PatchParticipantsParticipantIDConfirmJSON500Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
+// PostAdminTripsTripIDOwnerTokenJSON500Response is a constructor method for a PostAdminTripsTripIDOwnerToken response.
 // A *Response is returned with the configured status code and content type from the spec.
-func PatchParticipantsParticipantIDConfirmJSON500Response(body InternalServerErrorRequest) *Response {
+func PostAdminTripsTripIDOwnerTokenJSON500Response(body InternalServerErrorRequest) *Response {
 	return &Response{
 		body:        body,
 		Code:        500,
@@ -325,29 +983,39 @@ func PatchParticipantsParticipantIDConfirmJSON500Response(body InternalServerErr
 	}
 }
 
-// PostTripsJSON201Response is a constructor method for a PostTrips response.
+// GetDiagnosticsJSON200Response is a constructor method for a GetDiagnostics response.
 // A *Response is returned with the configured status code and content type from the spec.
-func PostTripsJSON201Response(body CreateTripResponse) *Response {
+func GetDiagnosticsJSON200Response(body DiagnosticsResponse) *Response {
 	return &Response{
 		body:        body,
-		Code:        201,
+		Code:        200,
 		contentType: "application/json",
 	}
 }
 
-// PostTripsJSON400Response is a constructor method for a PostTrips response.
+// GetDiagnosticsJSON401Response is a constructor method for a GetDiagnostics response.
 // A *Response is returned with the configured status code and content type from the spec.
-func PostTripsJSON400Response(body BadRequest) *Response {
+func GetDiagnosticsJSON401Response(body UnauthorizedRequest) *Response {
 	return &Response{
 		body:        body,
-		Code:        400,
+		Code:        401,
 		contentType: "application/json",
 	}
 }
 
-// PostTripsJSON500Response is a constructor method for a PostTrips response.
+// GetDiagnosticsJSON403Response is a constructor method for a GetDiagnostics response.
 // A *Response is returned with the configured status code and content type from the spec.
-func PostTripsJSON500Response(body InternalServerErrorRequest) *Response {
+func GetDiagnosticsJSON403Response(body ForbiddenRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetDiagnosticsJSON500Response is a constructor method for a GetDiagnostics response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetDiagnosticsJSON500Response(body InternalServerErrorRequest) *Response {
 	return &Response{
 		body:        body,
 		Code:        500,
@@ -355,9 +1023,9 @@ func PostTripsJSON500Response(body InternalServerErrorRequest) *Response {
 	}
 }
 
-// GetTripsTripIDJSON200Response is a constructor method for a GetTripsTripID response.
+// GetHealthzJSON200Response is a constructor method for a GetHealthz response.
 // A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDJSON200Response(body GetTripDetailsResponse) *Response {
+func GetHealthzJSON200Response(body HealthResponse) *Response {
 	return &Response{
 		body:        body,
 		Code:        200,
@@ -365,9 +1033,29 @@ func GetTripsTripIDJSON200Response(body GetTripDetailsResponse) *Response {
 	}
 }
 
-// GetTripsTripIDJSON400Response is a constructor method for a GetTripsTripID response.
+// GetHealthzJSON503Response is a constructor method for a GetHealthz response.
 // A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDJSON400Response(body BadRequest) *Response {
+func GetHealthzJSON503Response(body HealthResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        503,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsPendingCountJSON200Response is a constructor method for a GetParticipantsPendingCount response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsPendingCountJSON200Response(body GetParticipantsPendingCountResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsPendingCountJSON400Response is a constructor method for a GetParticipantsPendingCount response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsPendingCountJSON400Response(body BadRequest) *Response {
 	return &Response{
 		body:        body,
 		Code:        400,
@@ -375,9 +1063,49 @@ func GetTripsTripIDJSON400Response(body BadRequest) *Response {
 	}
 }
 
-// GetTripsTripIDJSON404Response is a constructor method for a GetTripsTripID response.
+// GetParticipantsPendingCountJSON500Response is a constructor method for a GetParticipantsPendingCount response.
 // A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDJSON404Response(body NotFoundRequest) *Response {
+func GetParticipantsPendingCountJSON500Response(body InternalServerErrorRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDConfirmJSON400Response is a constructor method for a GetParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDConfirmJSON400Response(body BadRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDConfirmJSON401Response is a constructor method for a GetParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDConfirmJSON401Response(body UnauthorizedRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDConfirmJSON403Response is a constructor method for a GetParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDConfirmJSON403Response(body ForbiddenRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDConfirmJSON404Response is a constructor method for a GetParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDConfirmJSON404Response(body NotFoundRequest) *Response {
 	return &Response{
 		body:        body,
 		Code:        404,
@@ -385,9 +1113,9 @@ func GetTripsTripIDJSON404Response(body NotFoundRequest) *Response {
 	}
 }
 
-// GetTripsTripIDJSON500Response is a constructor method for a GetTripsTripID response.
+// GetParticipantsParticipantIDConfirmJSON500Response is a constructor method for a GetParticipantsParticipantIDConfirm response.
 // A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDJSON500Response(body InternalServerErrorRequest) *Response {
+func GetParticipantsParticipantIDConfirmJSON500Response(body InternalServerErrorRequest) *Response {
 	return &Response{
 		body:        body,
 		Code:        500,
@@ -395,9 +1123,9 @@ func GetTripsTripIDJSON500Response(body InternalServerErrorRequest) *Response {
 	}
 }
 
-// PutTripsTripIDJSON204Response is a constructor method for a PutTripsTripID response.
+// PatchParticipantsParticipantIDConfirmJSON204Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
 // A *Response is returned with the configured status code and content type from the spec.
-func PutTripsTripIDJSON204Response(body interface{}) *Response {
+func PatchParticipantsParticipantIDConfirmJSON204Response(body interface{}) *Response {
 	return &Response{
 		body:        body,
 		Code:        204,
@@ -405,9 +1133,9 @@ func PutTripsTripIDJSON204Response(body interface{}) *Response {
 	}
 }
 
-// PutTripsTripIDJSON400Response is a constructor method for a PutTripsTripID response.
+// PatchParticipantsParticipantIDConfirmJSON400Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
 // A *Response is returned with the configured status code and content type from the spec.
-func PutTripsTripIDJSON400Response(body BadRequest) *Response {
+func PatchParticipantsParticipantIDConfirmJSON400Response(body BadRequest) *Response {
 	return &Response{
 		body:        body,
 		Code:        400,
@@ -415,9 +1143,29 @@ func PutTripsTripIDJSON400Response(body BadRequest) *Response {
 	}
 }
 
-// PutTripsTripIDJSON404Response is a constructor method for a PutTripsTripID response.
+// PatchParticipantsParticipantIDConfirmJSON401Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
 // A *Response is returned with the configured status code and content type from the spec.
-func PutTripsTripIDJSON404Response(body NotFoundRequest) *Response {
+func PatchParticipantsParticipantIDConfirmJSON401Response(body UnauthorizedRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDConfirmJSON403Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDConfirmJSON403Response(body ForbiddenRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDConfirmJSON404Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDConfirmJSON404Response(body NotFoundRequest) *Response {
 	return &Response{
 		body:        body,
 		Code:        404,
@@ -425,9 +1173,9 @@ func PutTripsTripIDJSON404Response(body NotFoundRequest) *Response {
 	}
 }
 
-// PutTripsTripIDJSON500Response is a constructor method for a PutTripsTripID response.
+// PatchParticipantsParticipantIDConfirmJSON500Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
 // A *Response is returned with the configured status code and content type from the spec.
-func PutTripsTripIDJSON500Response(body InternalServerErrorRequest) *Response {
+func PatchParticipantsParticipantIDConfirmJSON500Response(body InternalServerErrorRequest) *Response {
 	return &Response{
 		body:        body,
 		Code:        500,
@@ -435,19 +1183,19 @@ func PutTripsTripIDJSON500Response(body InternalServerErrorRequest) *Response {
 	}
 }
 
-// GetTripsTripIDActivitiesJSON200Response is a constructor method for a GetTripsTripIDActivities response.
+// PostParticipantsParticipantIDResendInviteJSON204Response is a constructor method for a PostParticipantsParticipantIDResendInvite response.
 // A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDActivitiesJSON200Response(body GetTripActivitiesResponse) *Response {
+func PostParticipantsParticipantIDResendInviteJSON204Response(body interface{}) *Response {
 	return &Response{
 		body:        body,
-		Code:        200,
+		Code:        204,
 		contentType: "application/json",
 	}
 }
 
-// GetTripsTripIDActivitiesJSON400Response is a constructor method for a GetTripsTripIDActivities response.
+// PostParticipantsParticipantIDResendInviteJSON400Response is a constructor method for a PostParticipantsParticipantIDResendInvite response.
 // A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDActivitiesJSON400Response(body BadRequest) *Response {
+func PostParticipantsParticipantIDResendInviteJSON400Response(body BadRequest) *Response {
 	return &Response{
 		body:        body,
 		Code:        400,
@@ -455,9 +1203,29 @@ func GetTripsTripIDActivitiesJSON400Response(body BadRequest) *Response {
 	}
 }
 
-// GetTripsTripIDActivitiesJSON404Response is a constructor method for a GetTripsTripIDActivities response.
+// PostParticipantsParticipantIDResendInviteJSON401Response is a constructor method for a PostParticipantsParticipantIDResendInvite response.
 // A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDActivitiesJSON404Response(body NotFoundRequest) *Response {
+func PostParticipantsParticipantIDResendInviteJSON401Response(body UnauthorizedRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostParticipantsParticipantIDResendInviteJSON403Response is a constructor method for a PostParticipantsParticipantIDResendInvite response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostParticipantsParticipantIDResendInviteJSON403Response(body ForbiddenRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostParticipantsParticipantIDResendInviteJSON404Response is a constructor method for a PostParticipantsParticipantIDResendInvite response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostParticipantsParticipantIDResendInviteJSON404Response(body NotFoundRequest) *Response {
 	return &Response{
 		body:        body,
 		Code:        404,
@@ -465,49 +1233,49 @@ func GetTripsTripIDActivitiesJSON404Response(body NotFoundRequest) *Response {
 	}
 }
 
-// GetTripsTripIDActivitiesJSON500Response is a constructor method for a GetTripsTripIDActivities response.
+// PostParticipantsParticipantIDResendInviteJSON409Response is a constructor method for a PostParticipantsParticipantIDResendInvite response.
 // A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDActivitiesJSON500Response(body InternalServerErrorRequest) *Response {
+func PostParticipantsParticipantIDResendInviteJSON409Response(body ConflictRequest) *Response {
 	return &Response{
 		body:        body,
-		Code:        500,
+		Code:        409,
 		contentType: "application/json",
 	}
 }
 
-// PostTripsTripIDActivitiesJSON201Response is a constructor method for a PostTripsTripIDActivities response.
+// PostParticipantsParticipantIDResendInviteJSON500Response is a constructor method for a PostParticipantsParticipantIDResendInvite response.
 // A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDActivitiesJSON201Response(body CreateActivityResponse) *Response {
+func PostParticipantsParticipantIDResendInviteJSON500Response(body InternalServerErrorRequest) *Response {
 	return &Response{
 		body:        body,
-		Code:        201,
+		Code:        500,
 		contentType: "application/json",
 	}
 }
 
-// PostTripsTripIDActivitiesJSON400Response is a constructor method for a PostTripsTripIDActivities response.
+// GetParticipantsByEmailTripsJSON200Response is a constructor method for a GetParticipantsByEmailTrips response.
 // A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDActivitiesJSON400Response(body BadRequest) *Response {
+func GetParticipantsByEmailTripsJSON200Response(body GetParticipantTripsResponse) *Response {
 	return &Response{
 		body:        body,
-		Code:        400,
+		Code:        200,
 		contentType: "application/json",
 	}
 }
 
-// PostTripsTripIDActivitiesJSON404Response is a constructor method for a PostTripsTripIDActivities response.
+// GetParticipantsByEmailTripsJSON400Response is a constructor method for a GetParticipantsByEmailTrips response.
 // A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDActivitiesJSON404Response(body NotFoundRequest) *Response {
+func GetParticipantsByEmailTripsJSON400Response(body BadRequest) *Response {
 	return &Response{
 		body:        body,
-		Code:        404,
+		Code:        400,
 		contentType: "application/json",
 	}
 }
 
-// PostTripsTripIDActivitiesJSON500Response is a constructor method for a PostTripsTripIDActivities response.
+// GetParticipantsByEmailTripsJSON500Response is a constructor method for a GetParticipantsByEmailTrips response.
 // A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDActivitiesJSON500Response(body InternalServerErrorRequest) *Response {
+func GetParticipantsByEmailTripsJSON500Response(body InternalServerErrorRequest) *Response {
 	return &Response{
 		body:        body,
 		Code:        500,
@@ -515,19 +1283,19 @@ func PostTripsTripIDActivitiesJSON500Response(body InternalServerErrorRequest) *
 	}
 }
 
-// GetTripsTripIDConfirmJSON204Response is a constructor method for a GetTripsTripIDConfirm response.
+// PostTripsJSON201Response is a constructor method for a PostTrips response.
 // A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDConfirmJSON204Response(body interface{}) *Response {
+func PostTripsJSON201Response(body CreateTripResponse) *Response {
 	return &Response{
 		body:        body,
-		Code:        204,
+		Code:        201,
 		contentType: "application/json",
 	}
 }
 
-// GetTripsTripIDConfirmJSON400Response is a constructor method for a GetTripsTripIDConfirm response.
+// PostTripsJSON400Response is a constructor method for a PostTrips response.
 // A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDConfirmJSON400Response(body BadRequest) *Response {
+func PostTripsJSON400Response(body BadRequest) *Response {
 	return &Response{
 		body:        body,
 		Code:        400,
@@ -535,19 +1303,19 @@ func GetTripsTripIDConfirmJSON400Response(body BadRequest) *Response {
 	}
 }
 
-// GetTripsTripIDConfirmJSON404Response is a constructor method for a GetTripsTripIDConfirm response.
+// PostTripsJSON409Response is a constructor method for a PostTrips response.
 // A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDConfirmJSON404Response(body NotFoundRequest) *Response {
+func PostTripsJSON409Response(body ConflictRequest) *Response {
 	return &Response{
 		body:        body,
-		Code:        404,
+		Code:        409,
 		contentType: "application/json",
 	}
 }
 
-// GetTripsTripIDConfirmJSON500Response is a constructor method for a GetTripsTripIDConfirm response.
+// PostTripsJSON500Response is a constructor method for a PostTrips response.
 // A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDConfirmJSON500Response(body InternalServerErrorRequest) *Response {
+func PostTripsJSON500Response(body InternalServerErrorRequest) *Response {
 	return &Response{
 		body:        body,
 		Code:        500,
@@ -555,9 +1323,9 @@ func GetTripsTripIDConfirmJSON500Response(body InternalServerErrorRequest) *Resp
 	}
 }
 
-// PatchTripsTripIDConfirmJSON204Response is a constructor method for a PatchTripsTripIDConfirm response.
+// DeleteTripsTripIDJSON204Response is a constructor method for a DeleteTripsTripID response.
 // A *Response is returned with the configured status code and content type from the spec.
-func PatchTripsTripIDConfirmJSON204Response(body interface{}) *Response {
+func DeleteTripsTripIDJSON204Response(body interface{}) *Response {
 	return &Response{
 		body:        body,
 		Code:        204,
@@ -565,9 +1333,9 @@ func PatchTripsTripIDConfirmJSON204Response(body interface{}) *Response {
 	}
 }
 
-// PatchTripsTripIDConfirmJSON400Response is a constructor method for a PatchTripsTripIDConfirm response.
+// DeleteTripsTripIDJSON400Response is a constructor method for a DeleteTripsTripID response.
 // A *Response is returned with the configured status code and content type from the spec.
-func PatchTripsTripIDConfirmJSON400Response(body BadRequest) *Response {
+func DeleteTripsTripIDJSON400Response(body BadRequest) *Response {
 	return &Response{
 		body:        body,
 		Code:        400,
@@ -575,9 +1343,29 @@ func PatchTripsTripIDConfirmJSON400Response(body BadRequest) *Response {
 	}
 }
 
-// PatchTripsTripIDConfirmJSON404Response is a constructor method for a PatchTripsTripIDConfirm response.
+// DeleteTripsTripIDJSON401Response is a constructor method for a DeleteTripsTripID response.
 // A *Response is returned with the configured status code and content type from the spec.
-func PatchTripsTripIDConfirmJSON404Response(body NotFoundRequest) *Response {
+func DeleteTripsTripIDJSON401Response(body UnauthorizedRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDJSON403Response is a constructor method for a DeleteTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDJSON403Response(body ForbiddenRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDJSON404Response is a constructor method for a DeleteTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDJSON404Response(body NotFoundRequest) *Response {
 	return &Response{
 		body:        body,
 		Code:        404,
@@ -585,9 +1373,9 @@ func PatchTripsTripIDConfirmJSON404Response(body NotFoundRequest) *Response {
 	}
 }
 
-// PatchTripsTripIDConfirmJSON500Response is a constructor method for a PatchTripsTripIDConfirm response.
+// DeleteTripsTripIDJSON500Response is a constructor method for a DeleteTripsTripID response.
 // A *Response is returned with the configured status code and content type from the spec.
-func PatchTripsTripIDConfirmJSON500Response(body InternalServerErrorRequest) *Response {
+func DeleteTripsTripIDJSON500Response(body InternalServerErrorRequest) *Response {
 	return &Response{
 		body:        body,
 		Code:        500,
@@ -595,19 +1383,19 @@ func PatchTripsTripIDConfirmJSON500Response(body InternalServerErrorRequest) *Re
 	}
 }
 
-// PostTripsTripIDInvitesJSON201Response is a constructor method for a PostTripsTripIDInvites response.
+// GetTripsTripIDJSON200Response is a constructor method for a GetTripsTripID response.
 // A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDInvitesJSON201Response(body InviteParticipantResponse) *Response {
+func GetTripsTripIDJSON200Response(body GetTripDetailsResponse) *Response {
 	return &Response{
 		body:        body,
-		Code:        201,
+		Code:        200,
 		contentType: "application/json",
 	}
 }
 
-// PostTripsTripIDInvitesJSON400Response is a constructor method for a PostTripsTripIDInvites response.
+// GetTripsTripIDJSON400Response is a constructor method for a GetTripsTripID response.
 // A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDInvitesJSON400Response(body BadRequest) *Response {
+func GetTripsTripIDJSON400Response(body BadRequest) *Response {
 	return &Response{
 		body:        body,
 		Code:        400,
@@ -615,9 +1403,9 @@ func PostTripsTripIDInvitesJSON400Response(body BadRequest) *Response {
 	}
 }
 
-// PostTripsTripIDInvitesJSON404Response is a constructor method for a PostTripsTripIDInvites response.
+// GetTripsTripIDJSON404Response is a constructor method for a GetTripsTripID response.
 // A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDInvitesJSON404Response(body NotFoundRequest) *Response {
+func GetTripsTripIDJSON404Response(body NotFoundRequest) *Response {
 	return &Response{
 		body:        body,
 		Code:        404,
@@ -625,9 +1413,9 @@ func PostTripsTripIDInvitesJSON404Response(body NotFoundRequest) *Response {
 	}
 }
 
-// PostTripsTripIDInvitesJSON500Response is a constructor method for a PostTripsTripIDInvites response.
+// GetTripsTripIDJSON500Response is a constructor method for a GetTripsTripID response.
 // A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDInvitesJSON500Response(body InternalServerErrorRequest) *Response {
+func GetTripsTripIDJSON500Response(body InternalServerErrorRequest) *Response {
 	return &Response{
 		body:        body,
 		Code:        500,
@@ -635,19 +1423,19 @@ func PostTripsTripIDInvitesJSON500Response(body InternalServerErrorRequest) *Res
 	}
 }
 
-// GetTripsTripIDLinksJSON200Response is a constructor method for a GetTripsTripIDLinks response.
+// PutTripsTripIDJSON204Response is a constructor method for a PutTripsTripID response.
 // A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDLinksJSON200Response(body GetLinksResponse) *Response {
+func PutTripsTripIDJSON204Response(body interface{}) *Response {
 	return &Response{
 		body:        body,
-		Code:        200,
+		Code:        204,
 		contentType: "application/json",
 	}
 }
 
-// GetTripsTripIDLinksJSON400Response is a constructor method for a GetTripsTripIDLinks response.
+// PutTripsTripIDJSON400Response is a constructor method for a PutTripsTripID response.
 // A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDLinksJSON400Response(body BadRequest) *Response {
+func PutTripsTripIDJSON400Response(body BadRequest) *Response {
 	return &Response{
 		body:        body,
 		Code:        400,
@@ -655,9 +1443,29 @@ func GetTripsTripIDLinksJSON400Response(body BadRequest) *Response {
 	}
 }
 
-// GetTripsTripIDLinksJSON404Response is a constructor method for a GetTripsTripIDLinks response.
+// PutTripsTripIDJSON401Response is a constructor method for a PutTripsTripID response.
 // A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDLinksJSON404Response(body NotFoundRequest) *Response {
+func PutTripsTripIDJSON401Response(body UnauthorizedRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJSON403Response is a constructor method for a PutTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJSON403Response(body ForbiddenRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJSON404Response is a constructor method for a PutTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJSON404Response(body NotFoundRequest) *Response {
 	return &Response{
 		body:        body,
 		Code:        404,
@@ -665,9 +1473,9 @@ func GetTripsTripIDLinksJSON404Response(body NotFoundRequest) *Response {
 	}
 }
 
-// GetTripsTripIDLinksJSON500Response is a constructor method for a GetTripsTripIDLinks response.
+// PutTripsTripIDJSON500Response is a constructor method for a PutTripsTripID response.
 // A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDLinksJSON500Response(body InternalServerErrorRequest) *Response {
+func PutTripsTripIDJSON500Response(body InternalServerErrorRequest) *Response {
 	return &Response{
 		body:        body,
 		Code:        500,
@@ -675,149 +1483,3154 @@ func GetTripsTripIDLinksJSON500Response(body InternalServerErrorRequest) *Respon
 	}
 }
 
-// PostTripsTripIDLinksJSON201Response is a constructor method for a PostTripsTripIDLinks response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDLinksJSON201Response(body CreateLinkResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        201,
-		contentType: "application/json",
-	}
+// GetTripsTripIDActivitiesJSON200Response is a constructor method for a GetTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesJSON200Response(body GetTripActivitiesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesJSON400Response is a constructor method for a GetTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesJSON400Response(body BadRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesJSON404Response is a constructor method for a GetTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesJSON404Response(body NotFoundRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesJSON500Response is a constructor method for a GetTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesJSON500Response(body InternalServerErrorRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesJSON201Response is a constructor method for a PostTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesJSON201Response(body CreateActivityResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesJSON400Response is a constructor method for a PostTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesJSON400Response(body BadRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesJSON401Response is a constructor method for a PostTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesJSON401Response(body UnauthorizedRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesJSON403Response is a constructor method for a PostTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesJSON403Response(body ForbiddenRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesJSON404Response is a constructor method for a PostTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesJSON404Response(body NotFoundRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesJSON409Response is a constructor method for a PostTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesJSON409Response(body ConflictRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesJSON500Response is a constructor method for a PostTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesJSON500Response(body InternalServerErrorRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesICSJSON400Response is a constructor method for a GetTripsTripIDActivitiesICS response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesICSJSON400Response(body BadRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesICSJSON404Response is a constructor method for a GetTripsTripIDActivitiesICS response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesICSJSON404Response(body NotFoundRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesICSJSON500Response is a constructor method for a GetTripsTripIDActivitiesICS response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesICSJSON500Response(body InternalServerErrorRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesBatchJSON201Response is a constructor method for a PostTripsTripIDActivitiesBatch response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesBatchJSON201Response(body CreateActivitiesBatchResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesBatchJSON400Response is a constructor method for a PostTripsTripIDActivitiesBatch response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesBatchJSON400Response(body BadRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesBatchJSON401Response is a constructor method for a PostTripsTripIDActivitiesBatch response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesBatchJSON401Response(body UnauthorizedRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesBatchJSON403Response is a constructor method for a PostTripsTripIDActivitiesBatch response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesBatchJSON403Response(body ForbiddenRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesBatchJSON404Response is a constructor method for a PostTripsTripIDActivitiesBatch response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesBatchJSON404Response(body NotFoundRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesBatchJSON409Response is a constructor method for a PostTripsTripIDActivitiesBatch response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesBatchJSON409Response(body ConflictRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesBatchJSON500Response is a constructor method for a PostTripsTripIDActivitiesBatch response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesBatchJSON500Response(body InternalServerErrorRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesOrderJSON204Response is a constructor method for a PutTripsTripIDActivitiesOrder response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesOrderJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesOrderJSON400Response is a constructor method for a PutTripsTripIDActivitiesOrder response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesOrderJSON400Response(body BadRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesOrderJSON401Response is a constructor method for a PutTripsTripIDActivitiesOrder response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesOrderJSON401Response(body UnauthorizedRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesOrderJSON403Response is a constructor method for a PutTripsTripIDActivitiesOrder response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesOrderJSON403Response(body ForbiddenRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesOrderJSON404Response is a constructor method for a PutTripsTripIDActivitiesOrder response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesOrderJSON404Response(body NotFoundRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesOrderJSON409Response is a constructor method for a PutTripsTripIDActivitiesOrder response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesOrderJSON409Response(body ConflictRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesOrderJSON500Response is a constructor method for a PutTripsTripIDActivitiesOrder response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesOrderJSON500Response(body InternalServerErrorRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesSearchJSON200Response is a constructor method for a GetTripsTripIDActivitiesSearch response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesSearchJSON200Response(body SearchActivitiesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesSearchJSON400Response is a constructor method for a GetTripsTripIDActivitiesSearch response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesSearchJSON400Response(body BadRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesSearchJSON404Response is a constructor method for a GetTripsTripIDActivitiesSearch response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesSearchJSON404Response(body NotFoundRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesSearchJSON500Response is a constructor method for a GetTripsTripIDActivitiesSearch response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesSearchJSON500Response(body InternalServerErrorRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesSummaryJSON200Response is a constructor method for a GetTripsTripIDActivitiesSummary response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesSummaryJSON200Response(body GetTripActivitiesSummaryResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesSummaryJSON404Response is a constructor method for a GetTripsTripIDActivitiesSummary response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesSummaryJSON404Response(body NotFoundRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesSummaryJSON500Response is a constructor method for a GetTripsTripIDActivitiesSummary response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesSummaryJSON500Response(body InternalServerErrorRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDJSON204Response is a constructor method for a DeleteTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDJSON400Response is a constructor method for a DeleteTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDJSON400Response(body BadRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDJSON401Response is a constructor method for a DeleteTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDJSON401Response(body UnauthorizedRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDJSON403Response is a constructor method for a DeleteTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDJSON403Response(body ForbiddenRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDJSON404Response is a constructor method for a DeleteTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDJSON404Response(body NotFoundRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDJSON409Response is a constructor method for a DeleteTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDJSON409Response(body ConflictRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDJSON500Response is a constructor method for a DeleteTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDJSON500Response(body InternalServerErrorRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesActivityIDJSON200Response is a constructor method for a GetTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesActivityIDJSON200Response(body GetActivityResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesActivityIDJSON400Response is a constructor method for a GetTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesActivityIDJSON400Response(body BadRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesActivityIDJSON404Response is a constructor method for a GetTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesActivityIDJSON404Response(body NotFoundRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesActivityIDJSON500Response is a constructor method for a GetTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesActivityIDJSON500Response(body InternalServerErrorRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDJSON200Response is a constructor method for a PutTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDJSON200Response(body GetActivityResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDJSON400Response is a constructor method for a PutTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDJSON400Response(body BadRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDJSON401Response is a constructor method for a PutTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDJSON401Response(body UnauthorizedRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDJSON403Response is a constructor method for a PutTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDJSON403Response(body ForbiddenRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDJSON404Response is a constructor method for a PutTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDJSON404Response(body NotFoundRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDJSON409Response is a constructor method for a PutTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDJSON409Response(body ConflictRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDJSON500Response is a constructor method for a PutTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDJSON500Response(body InternalServerErrorRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDDoneJSON200Response is a constructor method for a PatchTripsTripIDActivitiesActivityIDDone response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDDoneJSON200Response(body GetActivityResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDDoneJSON400Response is a constructor method for a PatchTripsTripIDActivitiesActivityIDDone response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDDoneJSON400Response(body BadRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDDoneJSON401Response is a constructor method for a PatchTripsTripIDActivitiesActivityIDDone response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDDoneJSON401Response(body UnauthorizedRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDDoneJSON403Response is a constructor method for a PatchTripsTripIDActivitiesActivityIDDone response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDDoneJSON403Response(body ForbiddenRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDDoneJSON404Response is a constructor method for a PatchTripsTripIDActivitiesActivityIDDone response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDDoneJSON404Response(body NotFoundRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDDoneJSON409Response is a constructor method for a PatchTripsTripIDActivitiesActivityIDDone response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDDoneJSON409Response(body ConflictRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDDoneJSON500Response is a constructor method for a PatchTripsTripIDActivitiesActivityIDDone response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDDoneJSON500Response(body InternalServerErrorRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDCancelJSON204Response is a constructor method for a PatchTripsTripIDCancel response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDCancelJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDCancelJSON400Response is a constructor method for a PatchTripsTripIDCancel response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDCancelJSON400Response(body BadRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDCancelJSON401Response is a constructor method for a PatchTripsTripIDCancel response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDCancelJSON401Response(body UnauthorizedRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDCancelJSON403Response is a constructor method for a PatchTripsTripIDCancel response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDCancelJSON403Response(body ForbiddenRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDCancelJSON404Response is a constructor method for a PatchTripsTripIDCancel response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDCancelJSON404Response(body NotFoundRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDCancelJSON409Response is a constructor method for a PatchTripsTripIDCancel response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDCancelJSON409Response(body ConflictRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDCancelJSON500Response is a constructor method for a PatchTripsTripIDCancel response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDCancelJSON500Response(body InternalServerErrorRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDCloneJSON201Response is a constructor method for a PostTripsTripIDClone response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDCloneJSON201Response(body CreateTripResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDCloneJSON400Response is a constructor method for a PostTripsTripIDClone response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDCloneJSON400Response(body BadRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDCloneJSON401Response is a constructor method for a PostTripsTripIDClone response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDCloneJSON401Response(body UnauthorizedRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDCloneJSON403Response is a constructor method for a PostTripsTripIDClone response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDCloneJSON403Response(body ForbiddenRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDCloneJSON404Response is a constructor method for a PostTripsTripIDClone response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDCloneJSON404Response(body NotFoundRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDCloneJSON500Response is a constructor method for a PostTripsTripIDClone response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDCloneJSON500Response(body InternalServerErrorRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConfirmJSON400Response is a constructor method for a GetTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConfirmJSON400Response(body BadRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConfirmJSON401Response is a constructor method for a GetTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConfirmJSON401Response(body UnauthorizedRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConfirmJSON403Response is a constructor method for a GetTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConfirmJSON403Response(body ForbiddenRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConfirmJSON404Response is a constructor method for a GetTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConfirmJSON404Response(body NotFoundRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConfirmJSON409Response is a constructor method for a GetTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConfirmJSON409Response(body ConflictRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConfirmJSON500Response is a constructor method for a GetTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConfirmJSON500Response(body InternalServerErrorRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDConfirmJSON204Response is a constructor method for a PatchTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDConfirmJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDConfirmJSON400Response is a constructor method for a PatchTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDConfirmJSON400Response(body BadRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDConfirmJSON401Response is a constructor method for a PatchTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDConfirmJSON401Response(body UnauthorizedRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDConfirmJSON403Response is a constructor method for a PatchTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDConfirmJSON403Response(body ForbiddenRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDConfirmJSON404Response is a constructor method for a PatchTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDConfirmJSON404Response(body NotFoundRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDConfirmJSON409Response is a constructor method for a PatchTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDConfirmJSON409Response(body ConflictRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDConfirmJSON500Response is a constructor method for a PatchTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDConfirmJSON500Response(body InternalServerErrorRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDFullJSON200Response is a constructor method for a GetTripsTripIDFull response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDFullJSON200Response(body GetTripFullResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDFullJSON404Response is a constructor method for a GetTripsTripIDFull response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDFullJSON404Response(body NotFoundRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDFullJSON500Response is a constructor method for a GetTripsTripIDFull response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDFullJSON500Response(body InternalServerErrorRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesJSON201Response is a constructor method for a PostTripsTripIDInvites response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesJSON201Response(body InviteParticipantResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesJSON400Response is a constructor method for a PostTripsTripIDInvites response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesJSON400Response(body BadRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesJSON401Response is a constructor method for a PostTripsTripIDInvites response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesJSON401Response(body UnauthorizedRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesJSON403Response is a constructor method for a PostTripsTripIDInvites response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesJSON403Response(body ForbiddenRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesJSON404Response is a constructor method for a PostTripsTripIDInvites response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesJSON404Response(body NotFoundRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesJSON409Response is a constructor method for a PostTripsTripIDInvites response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesJSON409Response(body ConflictRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesJSON500Response is a constructor method for a PostTripsTripIDInvites response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesJSON500Response(body InternalServerErrorRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksJSON200Response is a constructor method for a GetTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksJSON200Response(body GetLinksResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksJSON400Response is a constructor method for a GetTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksJSON400Response(body BadRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksJSON404Response is a constructor method for a GetTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksJSON404Response(body NotFoundRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksJSON500Response is a constructor method for a GetTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksJSON500Response(body InternalServerErrorRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksJSON201Response is a constructor method for a PostTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksJSON201Response(body CreateLinkResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksJSON400Response is a constructor method for a PostTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksJSON400Response(body BadRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksJSON401Response is a constructor method for a PostTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksJSON401Response(body UnauthorizedRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksJSON403Response is a constructor method for a PostTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksJSON403Response(body ForbiddenRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksJSON404Response is a constructor method for a PostTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksJSON404Response(body NotFoundRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksJSON409Response is a constructor method for a PostTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksJSON409Response(body ConflictRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksJSON500Response is a constructor method for a PostTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksJSON500Response(body InternalServerErrorRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksLinkIDJSON200Response is a constructor method for a GetTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksLinkIDJSON200Response(body GetLinkResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksLinkIDJSON400Response is a constructor method for a GetTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksLinkIDJSON400Response(body BadRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksLinkIDJSON404Response is a constructor method for a GetTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksLinkIDJSON404Response(body NotFoundRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksLinkIDJSON500Response is a constructor method for a GetTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksLinkIDJSON500Response(body InternalServerErrorRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDOwnerJSON200Response is a constructor method for a PatchTripsTripIDOwner response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDOwnerJSON200Response(body TransferTripOwnerResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDOwnerJSON400Response is a constructor method for a PatchTripsTripIDOwner response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDOwnerJSON400Response(body BadRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDOwnerJSON401Response is a constructor method for a PatchTripsTripIDOwner response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDOwnerJSON401Response(body UnauthorizedRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDOwnerJSON403Response is a constructor method for a PatchTripsTripIDOwner response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDOwnerJSON403Response(body ForbiddenRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDOwnerJSON404Response is a constructor method for a PatchTripsTripIDOwner response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDOwnerJSON404Response(body NotFoundRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDOwnerJSON409Response is a constructor method for a PatchTripsTripIDOwner response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDOwnerJSON409Response(body ConflictRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDOwnerJSON500Response is a constructor method for a PatchTripsTripIDOwner response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDOwnerJSON500Response(body InternalServerErrorRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDOwnerMagicLinkJSON202Response is a constructor method for a PostTripsTripIDOwnerMagicLink response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDOwnerMagicLinkJSON202Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        202,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDOwnerMagicLinkJSON400Response is a constructor method for a PostTripsTripIDOwnerMagicLink response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDOwnerMagicLinkJSON400Response(body BadRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDOwnerMagicLinkJSON404Response is a constructor method for a PostTripsTripIDOwnerMagicLink response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDOwnerMagicLinkJSON404Response(body NotFoundRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDOwnerMagicLinkJSON409Response is a constructor method for a PostTripsTripIDOwnerMagicLink response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDOwnerMagicLinkJSON409Response(body ConflictRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDOwnerMagicLinkJSON500Response is a constructor method for a PostTripsTripIDOwnerMagicLink response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDOwnerMagicLinkJSON500Response(body InternalServerErrorRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDOwnerTokenJSON200Response is a constructor method for a GetTripsTripIDOwnerToken response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDOwnerTokenJSON200Response(body OwnerTokenResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDOwnerTokenJSON400Response is a constructor method for a GetTripsTripIDOwnerToken response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDOwnerTokenJSON400Response(body BadRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDOwnerTokenJSON403Response is a constructor method for a GetTripsTripIDOwnerToken response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDOwnerTokenJSON403Response(body ForbiddenRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDOwnerTokenJSON404Response is a constructor method for a GetTripsTripIDOwnerToken response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDOwnerTokenJSON404Response(body NotFoundRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDOwnerTokenJSON409Response is a constructor method for a GetTripsTripIDOwnerToken response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDOwnerTokenJSON409Response(body ConflictRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDOwnerTokenJSON500Response is a constructor method for a GetTripsTripIDOwnerToken response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDOwnerTokenJSON500Response(body InternalServerErrorRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsJSON200Response is a constructor method for a GetTripsTripIDParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsJSON200Response(body GetTripParticipantsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsJSON400Response is a constructor method for a GetTripsTripIDParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsJSON400Response(body BadRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsJSON404Response is a constructor method for a GetTripsTripIDParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsJSON404Response(body NotFoundRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsJSON500Response is a constructor method for a GetTripsTripIDParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsJSON500Response(body InternalServerErrorRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsCSVJSON400Response is a constructor method for a GetTripsTripIDParticipantsCSV response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsCSVJSON400Response(body BadRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsCSVJSON404Response is a constructor method for a GetTripsTripIDParticipantsCSV response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsCSVJSON404Response(body NotFoundRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsCSVJSON500Response is a constructor method for a GetTripsTripIDParticipantsCSV response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsCSVJSON500Response(body InternalServerErrorRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDParticipantsConfirmBatchJSON200Response is a constructor method for a PatchTripsTripIDParticipantsConfirmBatch response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDParticipantsConfirmBatchJSON200Response(body ConfirmParticipantsBatchResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDParticipantsConfirmBatchJSON400Response is a constructor method for a PatchTripsTripIDParticipantsConfirmBatch response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDParticipantsConfirmBatchJSON400Response(body BadRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDParticipantsConfirmBatchJSON401Response is a constructor method for a PatchTripsTripIDParticipantsConfirmBatch response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDParticipantsConfirmBatchJSON401Response(body UnauthorizedRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDParticipantsConfirmBatchJSON403Response is a constructor method for a PatchTripsTripIDParticipantsConfirmBatch response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDParticipantsConfirmBatchJSON403Response(body ForbiddenRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDParticipantsConfirmBatchJSON404Response is a constructor method for a PatchTripsTripIDParticipantsConfirmBatch response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDParticipantsConfirmBatchJSON404Response(body NotFoundRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDParticipantsConfirmBatchJSON500Response is a constructor method for a PatchTripsTripIDParticipantsConfirmBatch response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDParticipantsConfirmBatchJSON500Response(body InternalServerErrorRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsSummaryJSON200Response is a constructor method for a GetTripsTripIDParticipantsSummary response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsSummaryJSON200Response(body GetTripParticipantsSummaryResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsSummaryJSON401Response is a constructor method for a GetTripsTripIDParticipantsSummary response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsSummaryJSON401Response(body UnauthorizedRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsSummaryJSON403Response is a constructor method for a GetTripsTripIDParticipantsSummary response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsSummaryJSON403Response(body ForbiddenRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsSummaryJSON404Response is a constructor method for a GetTripsTripIDParticipantsSummary response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsSummaryJSON404Response(body NotFoundRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsSummaryJSON500Response is a constructor method for a GetTripsTripIDParticipantsSummary response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsSummaryJSON500Response(body InternalServerErrorRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDParticipantsParticipantIDJSON204Response is a constructor method for a DeleteTripsTripIDParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDParticipantsParticipantIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDParticipantsParticipantIDJSON400Response is a constructor method for a DeleteTripsTripIDParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDParticipantsParticipantIDJSON400Response(body BadRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDParticipantsParticipantIDJSON401Response is a constructor method for a DeleteTripsTripIDParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDParticipantsParticipantIDJSON401Response(body UnauthorizedRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDParticipantsParticipantIDJSON403Response is a constructor method for a DeleteTripsTripIDParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDParticipantsParticipantIDJSON403Response(body ForbiddenRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDParticipantsParticipantIDJSON404Response is a constructor method for a DeleteTripsTripIDParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDParticipantsParticipantIDJSON404Response(body NotFoundRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDParticipantsParticipantIDJSON409Response is a constructor method for a DeleteTripsTripIDParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDParticipantsParticipantIDJSON409Response(body ConflictRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDParticipantsParticipantIDJSON500Response is a constructor method for a DeleteTripsTripIDParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDParticipantsParticipantIDJSON500Response(body InternalServerErrorRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDParticipantsParticipantIDJSON204Response is a constructor method for a PutTripsTripIDParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDParticipantsParticipantIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDParticipantsParticipantIDJSON400Response is a constructor method for a PutTripsTripIDParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDParticipantsParticipantIDJSON400Response(body BadRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDParticipantsParticipantIDJSON401Response is a constructor method for a PutTripsTripIDParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDParticipantsParticipantIDJSON401Response(body UnauthorizedRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDParticipantsParticipantIDJSON403Response is a constructor method for a PutTripsTripIDParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDParticipantsParticipantIDJSON403Response(body ForbiddenRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDParticipantsParticipantIDJSON404Response is a constructor method for a PutTripsTripIDParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDParticipantsParticipantIDJSON404Response(body NotFoundRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDParticipantsParticipantIDJSON409Response is a constructor method for a PutTripsTripIDParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDParticipantsParticipantIDJSON409Response(body ConflictRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDParticipantsParticipantIDJSON500Response is a constructor method for a PutTripsTripIDParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDParticipantsParticipantIDJSON500Response(body InternalServerErrorRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDParticipantsParticipantIDPromoteJSON204Response is a constructor method for a PostTripsTripIDParticipantsParticipantIDPromote response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDParticipantsParticipantIDPromoteJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDParticipantsParticipantIDPromoteJSON400Response is a constructor method for a PostTripsTripIDParticipantsParticipantIDPromote response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDParticipantsParticipantIDPromoteJSON400Response(body BadRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDParticipantsParticipantIDPromoteJSON401Response is a constructor method for a PostTripsTripIDParticipantsParticipantIDPromote response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDParticipantsParticipantIDPromoteJSON401Response(body UnauthorizedRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDParticipantsParticipantIDPromoteJSON403Response is a constructor method for a PostTripsTripIDParticipantsParticipantIDPromote response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDParticipantsParticipantIDPromoteJSON403Response(body ForbiddenRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDParticipantsParticipantIDPromoteJSON404Response is a constructor method for a PostTripsTripIDParticipantsParticipantIDPromote response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDParticipantsParticipantIDPromoteJSON404Response(body NotFoundRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDParticipantsParticipantIDPromoteJSON409Response is a constructor method for a PostTripsTripIDParticipantsParticipantIDPromote response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDParticipantsParticipantIDPromoteJSON409Response(body ConflictRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDParticipantsParticipantIDPromoteJSON500Response is a constructor method for a PostTripsTripIDParticipantsParticipantIDPromote response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDParticipantsParticipantIDPromoteJSON500Response(body InternalServerErrorRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDRescheduleJSON204Response is a constructor method for a PostTripsTripIDReschedule response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDRescheduleJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDRescheduleJSON400Response is a constructor method for a PostTripsTripIDReschedule response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDRescheduleJSON400Response(body BadRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDRescheduleJSON401Response is a constructor method for a PostTripsTripIDReschedule response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDRescheduleJSON401Response(body UnauthorizedRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDRescheduleJSON403Response is a constructor method for a PostTripsTripIDReschedule response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDRescheduleJSON403Response(body ForbiddenRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDRescheduleJSON404Response is a constructor method for a PostTripsTripIDReschedule response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDRescheduleJSON404Response(body NotFoundRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDRescheduleJSON409Response is a constructor method for a PostTripsTripIDReschedule response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDRescheduleJSON409Response(body ConflictRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDRescheduleJSON500Response is a constructor method for a PostTripsTripIDReschedule response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDRescheduleJSON500Response(body InternalServerErrorRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDRestoreJSON204Response is a constructor method for a PostTripsTripIDRestore response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDRestoreJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDRestoreJSON400Response is a constructor method for a PostTripsTripIDRestore response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDRestoreJSON400Response(body BadRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDRestoreJSON401Response is a constructor method for a PostTripsTripIDRestore response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDRestoreJSON401Response(body UnauthorizedRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDRestoreJSON403Response is a constructor method for a PostTripsTripIDRestore response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDRestoreJSON403Response(body ForbiddenRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDRestoreJSON404Response is a constructor method for a PostTripsTripIDRestore response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDRestoreJSON404Response(body NotFoundRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDRestoreJSON500Response is a constructor method for a PostTripsTripIDRestore response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDRestoreJSON500Response(body InternalServerErrorRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDStatusJSON204Response is a constructor method for a PatchTripsTripIDStatus response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDStatusJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDStatusJSON400Response is a constructor method for a PatchTripsTripIDStatus response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDStatusJSON400Response(body BadRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDStatusJSON401Response is a constructor method for a PatchTripsTripIDStatus response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDStatusJSON401Response(body UnauthorizedRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDStatusJSON403Response is a constructor method for a PatchTripsTripIDStatus response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDStatusJSON403Response(body ForbiddenRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDStatusJSON404Response is a constructor method for a PatchTripsTripIDStatus response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDStatusJSON404Response(body NotFoundRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDStatusJSON409Response is a constructor method for a PatchTripsTripIDStatus response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDStatusJSON409Response(body ConflictRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDStatusJSON500Response is a constructor method for a PatchTripsTripIDStatus response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDStatusJSON500Response(body InternalServerErrorRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDTimelineJSON200Response is a constructor method for a GetTripsTripIDTimeline response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDTimelineJSON200Response(body GetTripTimelineResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDTimelineJSON400Response is a constructor method for a GetTripsTripIDTimeline response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDTimelineJSON400Response(body BadRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDTimelineJSON404Response is a constructor method for a GetTripsTripIDTimeline response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDTimelineJSON404Response(body NotFoundRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDTimelineJSON500Response is a constructor method for a GetTripsTripIDTimeline response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDTimelineJSON500Response(body InternalServerErrorRequest) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// List the participants of all the trips.
+	// (GET /admin/participants)
+	GetAdminParticipants(w http.ResponseWriter, r *http.Request, params GetAdminParticipantsParams) *Response
+	// Issue a new owner token of a trip.
+	// (POST /admin/trips/{tripId}/owner-token)
+	PostAdminTripsTripIDOwnerToken(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Report the running app diagnostics.
+	// (GET /diagnostics)
+	GetDiagnostics(w http.ResponseWriter, r *http.Request) *Response
+	// Check the application readiness.
+	// (GET /healthz)
+	GetHealthz(w http.ResponseWriter, r *http.Request, params GetHealthzParams) *Response
+	// List the trips an email owns or participates in.
+	// (GET /trips)
+	GetParticipantsByEmailTrips(w http.ResponseWriter, r *http.Request, params GetParticipantsByEmailTripsParams) *Response
+	// Count the invitations of an email awaiting its confirmation.
+	// (GET /participants/pending-count)
+	GetParticipantsPendingCount(w http.ResponseWriter, r *http.Request, params GetParticipantsPendingCountParams) *Response
+	// Wraper to confirms a participant on a trip.
+	// (GET /participants/{participantId}/confirm)
+	GetParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request, participantID string, params GetParticipantsParticipantIDConfirmParams) *Response
+	// Confirms a participant on a trip.
+	// (PATCH /participants/{participantId}/confirm)
+	PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request, participantID string, params PatchParticipantsParticipantIDConfirmParams) *Response
+	// Resend a participant its invitation.
+	// (POST /participants/{participantId}/resend-invite)
+	PostParticipantsParticipantIDResendInvite(w http.ResponseWriter, r *http.Request, participantID string) *Response
+	// Create a new trip
+	// (POST /trips)
+	PostTrips(w http.ResponseWriter, r *http.Request, params PostTripsParams) *Response
+	// Delete a trip.
+	// (DELETE /trips/{tripId})
+	DeleteTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get a trip details.
+	// (GET /trips/{tripId})
+	GetTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Update a trip.
+	// (PUT /trips/{tripId})
+	PutTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get a trip activities.
+	// (GET /trips/{tripId}/activities)
+	GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDActivitiesParams) *Response
+	// Create a trip activity.
+	// (POST /trips/{tripId}/activities)
+	PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get a trip activities as an iCalendar feed.
+	// (GET /trips/{tripId}/activities.ics)
+	GetTripsTripIDActivitiesICS(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Create a trip activities at once.
+	// (POST /trips/{tripId}/activities/batch)
+	PostTripsTripIDActivitiesBatch(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Reorder the activities of a trip day.
+	// (PUT /trips/{tripId}/activities/order)
+	PutTripsTripIDActivitiesOrder(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Search a trip activities by title.
+	// (GET /trips/{tripId}/activities/search)
+	GetTripsTripIDActivitiesSearch(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDActivitiesSearchParams) *Response
+	// Count a trip activities by day.
+	// (GET /trips/{tripId}/activities/summary)
+	GetTripsTripIDActivitiesSummary(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Delete a trip activity.
+	// (DELETE /trips/{tripId}/activities/{activityId})
+	DeleteTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *Response
+	// Get a trip activity.
+	// (GET /trips/{tripId}/activities/{activityId})
+	GetTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *Response
+	// Update a trip activity.
+	// (PUT /trips/{tripId}/activities/{activityId})
+	PutTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *Response
+	// Mark a trip activity as done or not.
+	// (PATCH /trips/{tripId}/activities/{activityId}/done)
+	PatchTripsTripIDActivitiesActivityIDDone(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *Response
+	// Cancel a trip.
+	// (PATCH /trips/{tripId}/cancel)
+	PatchTripsTripIDCancel(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Clone a trip on new dates.
+	// (POST /trips/{tripId}/clone)
+	PostTripsTripIDClone(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Wrapper to confirm a trip and send e-mail invitations.
+	// (GET /trips/{tripId}/confirm)
+	GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDConfirmParams) *Response
+	// Confirm a trip and send e-mail invitations.
+	// (PATCH /trips/{tripId}/confirm)
+	PatchTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tripID string, params PatchTripsTripIDConfirmParams) *Response
+	// Get a trip with its activities, links and participants.
+	// (GET /trips/{tripId}/full)
+	GetTripsTripIDFull(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Invite someone to the trip.
+	// (POST /trips/{tripId}/invites)
+	PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get a trip links.
+	// (GET /trips/{tripId}/links)
+	GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Create a trip link.
+	// (POST /trips/{tripId}/links)
+	PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get a trip link.
+	// (GET /trips/{tripId}/links/{linkId})
+	GetTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request, tripID string, linkID string) *Response
+	// Transfer a trip to another owner.
+	// (PATCH /trips/{tripId}/owner)
+	PatchTripsTripIDOwner(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Send the trip owner a magic link to get a new owner token.
+	// (POST /trips/{tripId}/owner/magic-link)
+	PostTripsTripIDOwnerMagicLink(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Exchange the token of a magic link for an owner token.
+	// (GET /trips/{tripId}/owner/token)
+	GetTripsTripIDOwnerToken(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDOwnerTokenParams) *Response
+	// Get a trip participants.
+	// (GET /trips/{tripId}/participants)
+	GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDParticipantsParams) *Response
+	// Get a trip participants as a CSV file.
+	// (GET /trips/{tripId}/participants.csv)
+	GetTripsTripIDParticipantsCSV(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Confirm several participants of a trip at once.
+	// (PATCH /trips/{tripId}/participants/confirm/batch)
+	PatchTripsTripIDParticipantsConfirmBatch(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get a trip participants RSVP summary.
+	// (GET /trips/{tripId}/participants/summary)
+	GetTripsTripIDParticipantsSummary(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Remove a participant from a trip.
+	// (DELETE /trips/{tripId}/participants/{participantId})
+	DeleteTripsTripIDParticipantsParticipantID(w http.ResponseWriter, r *http.Request, tripID string, participantID string, params DeleteTripsTripIDParticipantsParticipantIDParams) *Response
+	// Correct the email of a trip participant.
+	// (PUT /trips/{tripId}/participants/{participantId})
+	PutTripsTripIDParticipantsParticipantID(w http.ResponseWriter, r *http.Request, tripID string, participantID string) *Response
+	// Promote a waitlisted participant of a trip.
+	// (POST /trips/{tripId}/participants/{participantId}/promote)
+	PostTripsTripIDParticipantsParticipantIDPromote(w http.ResponseWriter, r *http.Request, tripID string, participantID string) *Response
+	// Move a trip and its activities by some days.
+	// (POST /trips/{tripId}/reschedule)
+	PostTripsTripIDReschedule(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Restore a deleted trip.
+	// (POST /trips/{tripId}/restore)
+	PostTripsTripIDRestore(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Move a trip to another status.
+	// (PATCH /trips/{tripId}/status)
+	PatchTripsTripIDStatus(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get a trip itinerary as a flat feed.
+	// (GET /trips/{tripId}/timeline)
+	GetTripsTripIDTimeline(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDTimelineParams) *Response
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler          ServerInterface
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// GetAdminParticipants operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminParticipants(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminParticipantsParams
+
+	// ------------- Optional query parameter "confirmed" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "confirmed", r.URL.Query(), &params.Confirmed); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "confirmed"})
+		return
+	}
+
+	// ------------- Optional query parameter "olderThan" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "olderThan", r.URL.Query(), &params.OlderThan); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "olderThan"})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "page"})
+		return
+	}
+
+	// ------------- Optional query parameter "perPage" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "perPage", r.URL.Query(), &params.PerPage); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "perPage"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetAdminParticipants(w, r, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostAdminTripsTripIDOwnerToken operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminTripsTripIDOwnerToken(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostAdminTripsTripIDOwnerToken(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetDiagnostics operation middleware
+func (siw *ServerInterfaceWrapper) GetDiagnostics(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetDiagnostics(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetHealthz operation middleware
+func (siw *ServerInterfaceWrapper) GetHealthz(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetHealthzParams
+
+	// ------------- Optional query parameter "deep" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "deep", r.URL.Query(), &params.Deep); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "deep"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetHealthz(w, r, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetParticipantsPendingCount operation middleware
+func (siw *ServerInterfaceWrapper) GetParticipantsPendingCount(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetParticipantsPendingCountParams
+
+	// ------------- Required query parameter "email" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "email", r.URL.Query(), &params.Email); err != nil {
+		err = fmt.Errorf("invalid format for parameter email: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "email"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetParticipantsPendingCount(w, r, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetParticipantsParticipantIDConfirm operation middleware
+func (siw *ServerInterfaceWrapper) GetParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetParticipantsParticipantIDConfirmParams
+
+	// ------------- Optional query parameter "token" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "token", r.URL.Query(), &params.Token); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "token"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetParticipantsParticipantIDConfirm(w, r, participantID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchParticipantsParticipantIDConfirm operation middleware
+func (siw *ServerInterfaceWrapper) PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchParticipantsParticipantIDConfirmParams
+
+	// ------------- Optional query parameter "token" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "token", r.URL.Query(), &params.Token); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "token"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchParticipantsParticipantIDConfirm(w, r, participantID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostParticipantsParticipantIDResendInvite operation middleware
+func (siw *ServerInterfaceWrapper) PostParticipantsParticipantIDResendInvite(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostParticipantsParticipantIDResendInvite(w, r, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetParticipantsByEmailTrips operation middleware
+func (siw *ServerInterfaceWrapper) GetParticipantsByEmailTrips(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetParticipantsByEmailTripsParams
+
+	// ------------- Optional query parameter "participantEmail" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "participantEmail", r.URL.Query(), &params.ParticipantEmail); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantEmail"})
+		return
+	}
+
+	// ------------- Optional query parameter "ownerEmail" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "ownerEmail", r.URL.Query(), &params.OwnerEmail); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "ownerEmail"})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "page"})
+		return
+	}
+
+	// ------------- Optional query parameter "perPage" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "perPage", r.URL.Query(), &params.PerPage); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "perPage"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetParticipantsByEmailTrips(w, r, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTrips operation middleware
+func (siw *ServerInterfaceWrapper) PostTrips(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTripsParams
+
+	// ------------- Optional query parameter "sync" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "sync", r.URL.Query(), &params.Sync); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "sync"})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, TooManyValuesForParamError{NumValues: n, paramName: "Idempotency-Key"})
+			return
+		}
+
+		if err := runtime.BindStyledParameter("simple", false, "Idempotency-Key", valueList[0], &IdempotencyKey); err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "Idempotency-Key"})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTrips(w, r, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripID(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripID operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripID(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripID(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDActivities operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDActivitiesParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "from"})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "to"})
+		return
+	}
+
+	// ------------- Optional query parameter "q" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "q", r.URL.Query(), &params.Q); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "q"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDActivities(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDActivities operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDActivities(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDActivitiesICS operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDActivitiesICS(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDActivitiesICS(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDActivitiesBatch operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDActivitiesBatch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDActivitiesBatch(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripIDActivitiesOrder operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDActivitiesOrder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripIDActivitiesOrder(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDActivitiesSearch operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDActivitiesSearch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDActivitiesSearchParams
+
+	// ------------- Required query parameter "q" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "q", r.URL.Query(), &params.Q); err != nil {
+		err = fmt.Errorf("invalid format for parameter q: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "q"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDActivitiesSearch(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDActivitiesSummary operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDActivitiesSummary(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDActivitiesSummary(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDActivitiesActivityID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDActivitiesActivityID(w, r, tripID, activityID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDActivitiesActivityID operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDActivitiesActivityID(w, r, tripID, activityID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripIDActivitiesActivityID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripIDActivitiesActivityID(w, r, tripID, activityID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchTripsTripIDActivitiesActivityIDDone operation middleware
+func (siw *ServerInterfaceWrapper) PatchTripsTripIDActivitiesActivityIDDone(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchTripsTripIDActivitiesActivityIDDone(w, r, tripID, activityID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchTripsTripIDCancel operation middleware
+func (siw *ServerInterfaceWrapper) PatchTripsTripIDCancel(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchTripsTripIDCancel(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDClone operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDClone(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDClone(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDConfirm operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDConfirmParams
+
+	// ------------- Optional query parameter "token" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "token", r.URL.Query(), &params.Token); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "token"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDConfirm(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchTripsTripIDConfirm operation middleware
+func (siw *ServerInterfaceWrapper) PatchTripsTripIDConfirm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchTripsTripIDConfirmParams
+
+	// ------------- Optional query parameter "token" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "token", r.URL.Query(), &params.Token); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "token"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchTripsTripIDConfirm(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDFull operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDFull(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDFull(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDInvites operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDInvites(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDLinks operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDLinks(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDLinks operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDLinks(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
 }
 
-// PostTripsTripIDLinksJSON400Response is a constructor method for a PostTripsTripIDLinks response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDLinksJSON400Response(body BadRequest) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
-}
+// GetTripsTripIDLinksLinkID operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-// PostTripsTripIDLinksJSON404Response is a constructor method for a PostTripsTripIDLinks response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDLinksJSON404Response(body NotFoundRequest) *Response {
-	return &Response{
-		body:        body,
-		Code:        404,
-		contentType: "application/json",
-	}
-}
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
 
-// PostTripsTripIDLinksJSON500Response is a constructor method for a PostTripsTripIDLinks response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDLinksJSON500Response(body InternalServerErrorRequest) *Response {
-	return &Response{
-		body:        body,
-		Code:        500,
-		contentType: "application/json",
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
 	}
-}
 
-// GetTripsTripIDParticipantsJSON200Response is a constructor method for a GetTripsTripIDParticipants response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDParticipantsJSON200Response(body GetTripParticipantsResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        200,
-		contentType: "application/json",
-	}
-}
+	// ------------- Path parameter "linkId" -------------
+	var linkID string
 
-// GetTripsTripIDParticipantsJSON400Response is a constructor method for a GetTripsTripIDParticipants response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDParticipantsJSON400Response(body BadRequest) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
+	if err := runtime.BindStyledParameter("simple", false, "linkId", chi.URLParam(r, "linkId"), &linkID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "linkId"})
+		return
 	}
-}
 
-// GetTripsTripIDParticipantsJSON404Response is a constructor method for a GetTripsTripIDParticipants response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDParticipantsJSON404Response(body NotFoundRequest) *Response {
-	return &Response{
-		body:        body,
-		Code:        404,
-		contentType: "application/json",
-	}
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDLinksLinkID(w, r, tripID, linkID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDParticipantsJSON500Response is a constructor method for a GetTripsTripIDParticipants response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDParticipantsJSON500Response(body InternalServerErrorRequest) *Response {
-	return &Response{
-		body:        body,
-		Code:        500,
-		contentType: "application/json",
+// PatchTripsTripIDOwner operation middleware
+func (siw *ServerInterfaceWrapper) PatchTripsTripIDOwner(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
 	}
-}
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Wraper to confirms a participant on a trip.
-	// (GET /participants/{participantId}/confirm)
-	GetParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request, participantID string) *Response
-	// Confirms a participant on a trip.
-	// (PATCH /participants/{participantId}/confirm)
-	PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request, participantID string) *Response
-	// Create a new trip
-	// (POST /trips)
-	PostTrips(w http.ResponseWriter, r *http.Request) *Response
-	// Get a trip details.
-	// (GET /trips/{tripId})
-	GetTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Update a trip.
-	// (PUT /trips/{tripId})
-	PutTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Get a trip activities.
-	// (GET /trips/{tripId}/activities)
-	GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Create a trip activity.
-	// (POST /trips/{tripId}/activities)
-	PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Wrapper to confirm a trip and send e-mail invitations.
-	// (GET /trips/{tripId}/confirm)
-	GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Confirm a trip and send e-mail invitations.
-	// (PATCH /trips/{tripId}/confirm)
-	PatchTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Invite someone to the trip.
-	// (POST /trips/{tripId}/invites)
-	PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Get a trip links.
-	// (GET /trips/{tripId}/links)
-	GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Create a trip link.
-	// (POST /trips/{tripId}/links)
-	PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Get a trip participants.
-	// (GET /trips/{tripId}/participants)
-	GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request, tripID string) *Response
-}
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchTripsTripIDOwner(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler          ServerInterface
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	handler(w, r.WithContext(ctx))
 }
 
-// GetParticipantsParticipantIDConfirm operation middleware
-func (siw *ServerInterfaceWrapper) GetParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request) {
+// PostTripsTripIDOwnerMagicLink operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDOwnerMagicLink(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	// ------------- Path parameter "participantId" -------------
-	var participantID string
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
 
-	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
 		return
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetParticipantsParticipantIDConfirm(w, r, participantID)
+		resp := siw.Handler.PostTripsTripIDOwnerMagicLink(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -830,20 +4643,31 @@ func (siw *ServerInterfaceWrapper) GetParticipantsParticipantIDConfirm(w http.Re
 	handler(w, r.WithContext(ctx))
 }
 
-// PatchParticipantsParticipantIDConfirm operation middleware
-func (siw *ServerInterfaceWrapper) PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request) {
+// GetTripsTripIDOwnerToken operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDOwnerToken(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	// ------------- Path parameter "participantId" -------------
-	var participantID string
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
 
-	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDOwnerTokenParams
+
+	// ------------- Required query parameter "token" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "token", r.URL.Query(), &params.Token); err != nil {
+		err = fmt.Errorf("invalid format for parameter token: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "token"})
 		return
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PatchParticipantsParticipantIDConfirm(w, r, participantID)
+		resp := siw.Handler.GetTripsTripIDOwnerToken(w, r, tripID, params)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -856,12 +4680,37 @@ func (siw *ServerInterfaceWrapper) PatchParticipantsParticipantIDConfirm(w http.
 	handler(w, r.WithContext(ctx))
 }
 
-// PostTrips operation middleware
-func (siw *ServerInterfaceWrapper) PostTrips(w http.ResponseWriter, r *http.Request) {
+// GetTripsTripIDParticipants operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDParticipantsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "page"})
+		return
+	}
+
+	// ------------- Optional query parameter "perPage" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "perPage", r.URL.Query(), &params.PerPage); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "perPage"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PostTrips(w, r)
+		resp := siw.Handler.GetTripsTripIDParticipants(w, r, tripID, params)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -874,8 +4723,8 @@ func (siw *ServerInterfaceWrapper) PostTrips(w http.ResponseWriter, r *http.Requ
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripID operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripID(w http.ResponseWriter, r *http.Request) {
+// GetTripsTripIDParticipantsCSV operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDParticipantsCSV(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -887,7 +4736,7 @@ func (siw *ServerInterfaceWrapper) GetTripsTripID(w http.ResponseWriter, r *http
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripID(w, r, tripID)
+		resp := siw.Handler.GetTripsTripIDParticipantsCSV(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -900,8 +4749,8 @@ func (siw *ServerInterfaceWrapper) GetTripsTripID(w http.ResponseWriter, r *http
 	handler(w, r.WithContext(ctx))
 }
 
-// PutTripsTripID operation middleware
-func (siw *ServerInterfaceWrapper) PutTripsTripID(w http.ResponseWriter, r *http.Request) {
+// PatchTripsTripIDParticipantsConfirmBatch operation middleware
+func (siw *ServerInterfaceWrapper) PatchTripsTripIDParticipantsConfirmBatch(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -913,7 +4762,7 @@ func (siw *ServerInterfaceWrapper) PutTripsTripID(w http.ResponseWriter, r *http
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PutTripsTripID(w, r, tripID)
+		resp := siw.Handler.PatchTripsTripIDParticipantsConfirmBatch(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -926,8 +4775,8 @@ func (siw *ServerInterfaceWrapper) PutTripsTripID(w http.ResponseWriter, r *http
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDActivities operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request) {
+// GetTripsTripIDParticipantsSummary operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDParticipantsSummary(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -939,7 +4788,7 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDActivities(w http.ResponseWrite
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripIDActivities(w, r, tripID)
+		resp := siw.Handler.GetTripsTripIDParticipantsSummary(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -952,8 +4801,8 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDActivities(w http.ResponseWrite
 	handler(w, r.WithContext(ctx))
 }
 
-// PostTripsTripIDActivities operation middleware
-func (siw *ServerInterfaceWrapper) PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request) {
+// DeleteTripsTripIDParticipantsParticipantID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDParticipantsParticipantID(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -964,8 +4813,26 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDActivities(w http.ResponseWrit
 		return
 	}
 
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTripsTripIDParticipantsParticipantIDParams
+
+	// ------------- Optional query parameter "force" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "force", r.URL.Query(), &params.Force); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "force"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PostTripsTripIDActivities(w, r, tripID)
+		resp := siw.Handler.DeleteTripsTripIDParticipantsParticipantID(w, r, tripID, participantID, params)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -978,8 +4845,8 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDActivities(w http.ResponseWrit
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDConfirm operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request) {
+// PutTripsTripIDParticipantsParticipantID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDParticipantsParticipantID(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -990,8 +4857,16 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDConfirm(w http.ResponseWriter,
 		return
 	}
 
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripIDConfirm(w, r, tripID)
+		resp := siw.Handler.PutTripsTripIDParticipantsParticipantID(w, r, tripID, participantID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -1004,8 +4879,8 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDConfirm(w http.ResponseWriter,
 	handler(w, r.WithContext(ctx))
 }
 
-// PatchTripsTripIDConfirm operation middleware
-func (siw *ServerInterfaceWrapper) PatchTripsTripIDConfirm(w http.ResponseWriter, r *http.Request) {
+// PostTripsTripIDParticipantsParticipantIDPromote operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDParticipantsParticipantIDPromote(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -1016,8 +4891,16 @@ func (siw *ServerInterfaceWrapper) PatchTripsTripIDConfirm(w http.ResponseWriter
 		return
 	}
 
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PatchTripsTripIDConfirm(w, r, tripID)
+		resp := siw.Handler.PostTripsTripIDParticipantsParticipantIDPromote(w, r, tripID, participantID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -1030,8 +4913,8 @@ func (siw *ServerInterfaceWrapper) PatchTripsTripIDConfirm(w http.ResponseWriter
 	handler(w, r.WithContext(ctx))
 }
 
-// PostTripsTripIDInvites operation middleware
-func (siw *ServerInterfaceWrapper) PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request) {
+// PostTripsTripIDReschedule operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDReschedule(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -1043,7 +4926,7 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDInvites(w http.ResponseWriter,
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PostTripsTripIDInvites(w, r, tripID)
+		resp := siw.Handler.PostTripsTripIDReschedule(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -1056,8 +4939,8 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDInvites(w http.ResponseWriter,
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDLinks operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request) {
+// PostTripsTripIDRestore operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDRestore(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -1069,7 +4952,7 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDLinks(w http.ResponseWriter, r
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripIDLinks(w, r, tripID)
+		resp := siw.Handler.PostTripsTripIDRestore(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -1082,8 +4965,8 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDLinks(w http.ResponseWriter, r
 	handler(w, r.WithContext(ctx))
 }
 
-// PostTripsTripIDLinks operation middleware
-func (siw *ServerInterfaceWrapper) PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request) {
+// PatchTripsTripIDStatus operation middleware
+func (siw *ServerInterfaceWrapper) PatchTripsTripIDStatus(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -1095,7 +4978,7 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDLinks(w http.ResponseWriter, r
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PostTripsTripIDLinks(w, r, tripID)
+		resp := siw.Handler.PatchTripsTripIDStatus(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -1108,8 +4991,8 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDLinks(w http.ResponseWriter, r
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDParticipants operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request) {
+// GetTripsTripIDTimeline operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDTimeline(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -1120,8 +5003,25 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDParticipants(w http.ResponseWri
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDTimelineParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "page"})
+		return
+	}
+
+	// ------------- Optional query parameter "perPage" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "perPage", r.URL.Query(), &params.PerPage); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "perPage"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripIDParticipants(w, r, tripID)
+		resp := siw.Handler.GetTripsTripIDTimeline(w, r, tripID, params)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -1249,19 +5149,53 @@ func Handler(si ServerInterface, opts ...ServerOption) http.Handler {
 	}
 
 	r.Route(options.BaseURL, func(r chi.Router) {
+		r.Get("/admin/participants", wrapper.GetAdminParticipants)
+		r.Post("/admin/trips/{tripId}/owner-token", wrapper.PostAdminTripsTripIDOwnerToken)
+		r.Get("/diagnostics", wrapper.GetDiagnostics)
+		r.Get("/healthz", wrapper.GetHealthz)
+		r.Get("/participants/pending-count", wrapper.GetParticipantsPendingCount)
 		r.Get("/participants/{participantId}/confirm", wrapper.GetParticipantsParticipantIDConfirm)
 		r.Patch("/participants/{participantId}/confirm", wrapper.PatchParticipantsParticipantIDConfirm)
+		r.Post("/participants/{participantId}/resend-invite", wrapper.PostParticipantsParticipantIDResendInvite)
+		r.Get("/trips", wrapper.GetParticipantsByEmailTrips)
 		r.Post("/trips", wrapper.PostTrips)
+		r.Delete("/trips/{tripId}", wrapper.DeleteTripsTripID)
 		r.Get("/trips/{tripId}", wrapper.GetTripsTripID)
 		r.Put("/trips/{tripId}", wrapper.PutTripsTripID)
 		r.Get("/trips/{tripId}/activities", wrapper.GetTripsTripIDActivities)
 		r.Post("/trips/{tripId}/activities", wrapper.PostTripsTripIDActivities)
+		r.Get("/trips/{tripId}/activities.ics", wrapper.GetTripsTripIDActivitiesICS)
+		r.Post("/trips/{tripId}/activities/batch", wrapper.PostTripsTripIDActivitiesBatch)
+		r.Put("/trips/{tripId}/activities/order", wrapper.PutTripsTripIDActivitiesOrder)
+		r.Get("/trips/{tripId}/activities/search", wrapper.GetTripsTripIDActivitiesSearch)
+		r.Get("/trips/{tripId}/activities/summary", wrapper.GetTripsTripIDActivitiesSummary)
+		r.Delete("/trips/{tripId}/activities/{activityId}", wrapper.DeleteTripsTripIDActivitiesActivityID)
+		r.Get("/trips/{tripId}/activities/{activityId}", wrapper.GetTripsTripIDActivitiesActivityID)
+		r.Put("/trips/{tripId}/activities/{activityId}", wrapper.PutTripsTripIDActivitiesActivityID)
+		r.Patch("/trips/{tripId}/activities/{activityId}/done", wrapper.PatchTripsTripIDActivitiesActivityIDDone)
+		r.Patch("/trips/{tripId}/cancel", wrapper.PatchTripsTripIDCancel)
+		r.Post("/trips/{tripId}/clone", wrapper.PostTripsTripIDClone)
 		r.Get("/trips/{tripId}/confirm", wrapper.GetTripsTripIDConfirm)
 		r.Patch("/trips/{tripId}/confirm", wrapper.PatchTripsTripIDConfirm)
+		r.Get("/trips/{tripId}/full", wrapper.GetTripsTripIDFull)
 		r.Post("/trips/{tripId}/invites", wrapper.PostTripsTripIDInvites)
 		r.Get("/trips/{tripId}/links", wrapper.GetTripsTripIDLinks)
 		r.Post("/trips/{tripId}/links", wrapper.PostTripsTripIDLinks)
+		r.Get("/trips/{tripId}/links/{linkId}", wrapper.GetTripsTripIDLinksLinkID)
+		r.Patch("/trips/{tripId}/owner", wrapper.PatchTripsTripIDOwner)
+		r.Post("/trips/{tripId}/owner/magic-link", wrapper.PostTripsTripIDOwnerMagicLink)
+		r.Get("/trips/{tripId}/owner/token", wrapper.GetTripsTripIDOwnerToken)
 		r.Get("/trips/{tripId}/participants", wrapper.GetTripsTripIDParticipants)
+		r.Get("/trips/{tripId}/participants.csv", wrapper.GetTripsTripIDParticipantsCSV)
+		r.Patch("/trips/{tripId}/participants/confirm/batch", wrapper.PatchTripsTripIDParticipantsConfirmBatch)
+		r.Get("/trips/{tripId}/participants/summary", wrapper.GetTripsTripIDParticipantsSummary)
+		r.Delete("/trips/{tripId}/participants/{participantId}", wrapper.DeleteTripsTripIDParticipantsParticipantID)
+		r.Put("/trips/{tripId}/participants/{participantId}", wrapper.PutTripsTripIDParticipantsParticipantID)
+		r.Post("/trips/{tripId}/participants/{participantId}/promote", wrapper.PostTripsTripIDParticipantsParticipantIDPromote)
+		r.Post("/trips/{tripId}/reschedule", wrapper.PostTripsTripIDReschedule)
+		r.Post("/trips/{tripId}/restore", wrapper.PostTripsTripIDRestore)
+		r.Patch("/trips/{tripId}/status", wrapper.PatchTripsTripIDStatus)
+		r.Get("/trips/{tripId}/timeline", wrapper.GetTripsTripIDTimeline)
 	})
 	return r
 }
@@ -1287,33 +5221,163 @@ func WithErrorHandler(handler func(w http.ResponseWriter, r *http.Request, err e
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xbUW/bNhD+KwS3RyVOt+zFwB7apis8FG3QdehDUQSMdI7ZSKRGnpwahn/NHva0x/2C",
-	"/rGBpGRTsuzQStwkhl5aVSV5x7v7vjue6DmNZZZLAQI1Hc6pjieQMfv4giXv4a8CNJp/sSThyKVg6bmS",
-	"OSjkoOlwzFINEU1Ax4rn5v/p0EwkqpwZ0dwbPqcZaM2uwDziLAc6pBoVF1d0sYiomcQVJHT4aTnwc1QN",
-	"lJdfIEa6iOhLBQzheYx8ynEWqmRdERnHhdIXzM4bS5WZJ5owhCPkGdCooV9Evx5dySP4ioodIbuyi0xZ",
-	"ys0UOlzpbjaCHNOWPe6wRsMaK22rxUPsonMpNOxoGFZOHyU1yxQFT9aM0lTTm7tZvzdcXHfz2d3NGtFC",
-	"pfV9Kd7Z15FZbM1XTksn6TYrdPJQysV1F++U8zbr9EHxvJtnEtDIBXMEMKcZF29AXOGEDk87Gzfj4tdT",
-	"uwnIGE/1BcoLLqYcrb04QqZrNrCj1o2wfMGUYrNw8QmfQuTWtDqIZF9sIW8EqAsn6vYNBW9gpbsTIFh2",
-	"V/BoZAr3Y4ZGrPoB5ctdOaIlLGo7rdv1tqDvBERUPO8CxHJem06vAQ0z6DtQg67B40cFYzqkPwxWeX5Q",
-	"JvlBU9hzi5AmYtpoRAcp79bbbQc8xJ4bM2wgwTe35GTcwtuvAU2slOmVg75bgq32G+iodtHvCgQV5jZP",
-	"7E67GwlRidiLJ3ctxLY4f5tXV2J22r1n4IfzsueCNS9H1HFpmO2aLMssa4aFxhmg4ds7cGWgARqCzKt3",
-	"l19aWXQHfatl9lbY7FwkLKJQjHB9EUsx5iqDxIv7SylTYIJ2yMytWAlJujVVtlj/nCnkMc+ZwK4hk3tL",
-	"7AqiNvFhPFmTuuMGuxBFaN23jJYO0VGVfqJIU3ZpuBNVAUExUdZSlU63un8kEJRg6R+gpqBeKSVVty5C",
-	"tRBxKxG71H77CiNbRnqO7XYY2lsl39jK5sq2ZSN3RWCXOrc+vU3RtxJ/k4Xo2Gh6K5HY6fsNiz/z5DEf",
-	"jvd3MH1Mx711x5g1uBjL0sReXLzSOcR8zGP27Z9v/4EmCSPPz0ckZ4oRSS5ZfH0EIjGvWZ66YX9LkqdM",
-	"iGNQJJZCoyq+/ZswkhSKCQQiyds3H8nvslACZmbmexlfA2pgeLwsM4e0WoNGdApKO32eHZ8cn9haNwfB",
-	"ck6H9Gf7KqI5w4k108DPO4N5DTqLQcm5ZuAVWHeYALP2MsA0OcnPR97z6OxlOdcIUywDBKXp8NOccqOb",
-	"UaCi+WEDsb6PXMJwWTaECT6byY507P5+Ojk1f8VSIAiHoNza3uxh8EU7bKzWB1FkJjJMyjLOr6cu6/y6",
-	"089gzIoUyZLqFhE9PTnZSei2wsJrh7dI93veVvDpvQlucmSL9HUiXET0l3vc/Jas3qLO9tRtmaXIMqZm",
-	"dEg/KpaDIihJGeOaMOKFIZGCMGJqfQszSyrNIm1hcRRP1nFxbl73yOiR8QSR8bI7HhYRHZghroiTuiVj",
-	"nEttjzG6jGXQ+EIms3uzy/oXhUbSt8G6BoVne1GgivtHjo0nEpfWsIQRATc2EL04dEHnBeBg7prdi22l",
-	"i41D88foLIiLy/75/ZLw/Zl+Q7+sZ+ZDYebXgCUHk8Q5+bgFAxHNizbiLR4s3u+f5dePxkEs3xc8PazW",
-	"YeWCqaW62ZxVBvXPLGWCqavwYcI1UbJAIDc8TYkCLJQgLE0JToAYmZpcAt4ACPvGAnvZgyBMJKTsQrjB",
-	"EYGpHSq1WRInskCyUsRovi3Frb7vHFCya/kq2gPzAPNdPcwrgPofEE3e237ieFAY7Ouk07yN+CCnnbWr",
-	"fz0GD6YbUJ26fBjONoKwJVUG9JA9eO7SG9tLiuprxB4G7e3ier94CQmREA2mVjvKGE+JvRNold90OtvS",
-	"Mu6B0APhSXSHO0Z/S3pwd2hDOsYOF6Ny/NMu3jZe+fjO9dvmGxs9ZA8Fss7HRMsMpACTwqpuQ8innBVS",
-	"l5e8A8o4ex/7QPoM9YvxPS4OsL1gQ9tHQ/ljg9CmwvcP9331E/xfyT1IL6H2A7UebIfZRzDwaoNbS9Zp",
-	"3sYOSD7+xZsD6nW3Xm3vEXKA6ciP+W012mLxfwAAAP//N6l3hkg/AAA=",
+	"H4sIAAAAAAAC/+1dW3PjNpb+KyzvVs1uDX3rpHeTrsqD43ZvnHTbLttJaiqTUkEiJHGaIhWCsltJ5dfs",
+	"wz7t4/6C+WN7LgAJ3kRSlmzZzXmYtEUSODgAPpxzcC5/7EVzGYq5v/dm74uDo4OjPXfPD8fR3ps/9hI/",
+	"CST8Pg9EGB7IGB55Uo1if574UQgPztRcjvyxPxL//J9//p9Ujieck6tzZy5i4UTOUIw+7svQw5/FPODX",
+	"/jtyTHvOKApVEi/++b/wgreIRZhI+Ozi/c/O99EiDuUSv7yORh9loqRIDoCAOxkr7vyYqP3T3ZuLZKqQ",
+	"3kPhzfzwEHpP/JE/h+bo54lM8D9qMZuJeAlfvvdV4iRT6dhvOtHYEUFAvycwRIW9JWICTfyyl2vy1yIb",
+	"ruVvCz+G4eO3RIOTRB9liE1+f/nj9cXZ3wYnbz+cXwxuL384uzhwbqe+cuJoAcMNgBblAiUTPxSJ9Jxx",
+	"HM2ooSiAXhLHD+/8RLrN9MJfUThx7v1kij/6Mf3sYCPYNJDqOiqi96lN+kk5gRwnziKEqRj78QwIGInQ",
+	"GUpnHAVBdA9/L+bICVglMX1x7sGI/0smJzjOK5svOBOxmMkEpgiYBhwfTeVM0EpaznEhDaMokCLESfOR",
+	"cb8tJEyIuxfCV/BnSgP8FDNPobOxCJQssvwyDJZlltxPIydtxHWi2InMe1Eo+bnne04YwVr6062gEJaj",
+	"H07qCMQpiW+nMII1CeTJ9BycKVj/YoLzAfxOcEHgDuBpEsr5z1fTGhJ92CUT2owwAf5sMdt7c4y9j8Ui",
+	"gIV+XEM7rDDZTPYVvAWLl5YlLJcEiYdFJRLnuBM5M/GJ//3q6Mgi7vVRHXUyvmpJoMVO+Ap3DuwOIHAW",
+	"wW6B7oDOX7EZNYflLQkB4Ff8T76lt0yUc63fhL5h7SQyJLQQcwIsfPfwHwo/sMf+r7EcQxP/cjiKZvAx",
+	"fKMO+ak6rNobaR9/wv/cvS+r6PlWeA4OHvbrpkiBJq91i6bj43LHP4ZikUyj2P9dbpwCu+0iKV+USXkX",
+	"xUPf8wA6N0xH2nCeiNdVE3EO/cWhCJwbGcNx45zFMSDJhgkynXAf1IVNGlGnzzNC98M/8D/n3p+H0X0o",
+	"4306YLCTOSz7/PF2rtQCziEnlPcOvZydRoLOBPtko8Y3cqTJYmfmZHLwsJNw6I8QlAkTY3nnRws4w+YC",
+	"xozgDJhsUHEEiDjRXeP3dGwBSHLziOEBbnU/IYTnITmjWNLxOZTjKJYM+Rk5CP3+aOpMAVlD6M1Fmib+",
+	"HRCKQgoino9HKn7BY+FRwFsKpg2fZy3CuY3DG4rQK5+MV0Aabf9bZCz+3/nbS/zslias+YzUJ5C7B+OY",
+	"CZjavcXC99ITCYWdDDZ5TeRQE+Qp+bQAeC19XIHZqEvo14NQnogvy0RcRInzLlqEG2cGNEztPisg9Hwx",
+	"CWFn+aNqif5azqOYZfp4EYYkssxB9s0+szFvKkUAu2ijcrwI1T3saf4UutZ6CuMdj9X8ZrDRE4kYCiWd",
+	"mT9hBFH8+gIImmGTHv158+H2CmCIpgD/hqb8AOALMQk6n0xdEvoRlkS4hDcBC0lXejoIeJsxvt/8z1kC",
+	"4a3ye+WmO51KPDp5wRsigErh+aBstdpx1gaaw6ZV+X0BGwCW9hTmwpMSxADQB/gcxg0AbPk33Bn/7uq9",
+	"h5v+9dEX/AFuBN5mMzzEFyGQBXLFMJAHD9dTkZpmTeUEqR0hj1SZaJaJUpKe9sD+jmansFFfV+0OXCv+",
+	"SDqwqe5gOEj8FongJcgS6ko7jrZ/hAyNKKYpFA1T1TuRqHq3EHtXGWaGS21WIa3YtTqGFadFTu7fV7a0",
+	"iCKqZQQgoZWMNPT8jCk21gJuMGsKh3LgnMHrMrZb0Z/FdiNaWHXRvOEMo2TKkmxKE0iTwGs08Ci57wOT",
+	"Q+UnIAMHy0oDj62/frukLkimXUuGJSL2ak0T+XE17y09/HGez1Y7q807bQnLmLsOSS6tIYSlwvT6Cc/r",
+	"S7DvHOfsO68ebN+hJVZh2DneCcOOtSeI0F2x6+ywFOFWWChOSVnXJgrcE1XI/MBDWi3DUfNyu5Ghx3ir",
+	"7cYsxMgyvmjDQiprwNJ0xvDWIv0RoFVwW/ciJh1EN2BsE2R7STWJDyfn7wc3f7s4HVz+fHF2PTi9vHh3",
+	"fv3h5Pb88gIPG72pmpAMduV7GU5AutL70vz16vVrwxoQvzzawpo3556EiYTJHy33f5DLZjbBS3B8fMQx",
+	"kY4loXfJVxA8OmSaEmP5Bi09PFpzEQG/gzIzjLwlAp+tJ5HhBpgO21uziDUZeCmiA898hHMD6zOBo/A8",
+	"ceSnOWlpYpygDR3kxaWBBlp438JXyKmNrG9eqrjZrWVdsreUYOn48WDJpjBDI1dPOtHzPuKWy0TdFpcn",
+	"UFV7KfLUtuuvyx2f6pWx6d5Nu89KWcsbiitFZjjDjMXUkwnaEQ7Wwd7nb6QERuCWectMqN05Z7diUibq",
+	"Zyk+Onci8EFVRRl8bNmq2XrtgcxHJmUJ07V0FnN4UzZvri/qTIEfIs8f+8Z+fj7ev4Dx7H9Aid5hcrV4",
+	"byEr0o6nx1Nu2d6yWb9nSwYDfTSCHotT5/LVA91PwGLKz7l1kgoHVk1qAaQj837qBzJ3/7II9bqkBTFf",
+	"FGDhR1qfq66HtnG2cq/NZ+sTodGXm0UjGaK+9MteuAgC5Cj+lwwo3H9/MdzfyTxX5MpdnBDkWHevLqoL",
+	"6M1FZ2L6hlEbyNtFON9KEcMXJ3pRsEbBJxtBlicDQIA8ar2l31ahVo8cPXL0yPH5IgcZwMUo8e/8BA0W",
+	"eKUa+OFHlfNPge8dxhfPdqJEBxP8AL0szV2Sdo4EikHwN0aOq8ubW6fopqNfAfAqq2aHY9zJDfqZpkFZ",
+	"9LuaeKTK9itscbNhhEs/hPYVgF5AoyAsAnlRZBcpRjF0W3TsyNCbRz7+y4tcbDw1+KipmEtVngE0l1lu",
+	"q+SY6ZK9Syzd9M471wl+g3caaBH2DvY+I/X0HayTko25x5dOdpDDEWxpWe0qd4qPzIYD2EB7NOokqquL",
+	"XBG6eN0b87Yziua+fjPnj52U4Ql/os1WevpRyrm2wPpodBgrAIvUXZzucmxYI1spq36pbzd2wD5wuR3m",
+	"Zt5vMGEj6d/JWqM4IWLRt/Bgb0vWV5yfnVUQn5ORl5chstPbaRtvL0z2wuSDwJ4Ri+AeLWUFvOenBvER",
+	"yxQGKMl9dpLI4mKeTp3sHpaSaNfiprtOf4KidM4/OwfweOxoo+OS0f7A0aecx25V5u5S31WyH+Tg5uz0",
+	"+uyW/U+Sylv6XiHuMewpMay/O+ygk98WIgRR/SIPJoIGrYnra3sLPlB5A9UO/T+XWRSeAVpzVwEzwbJx",
+	"GWxAuY7JrQAV1Ayq0AowXigyAoykvv33yIsOIQkVQ/06oG4m/SJYltTrn2NgIwmthsL+KHjko6CzEp3I",
+	"T8nhNJkF+RXfy6095veY32O+jfnO5VyGdgw99eHmbstD57vbD+/JlugkMgiMT1sO/tRiNJLSo+vyKiVD",
+	"AFVBnY5BD/tbqR6Qe0DuAXmXL8YywC5dtyRR4GUgnfZwDw0z+AXoi2bs1fQsC+AWAJ7zNNtF7iIJIZys",
+	"2wcO4yThr8ga1SPRIeDoB6x9rZGnfEWHJnt4/y8J3sSlH2qktkdyCKeBB5/vj2AxJtUxbPiklBMF/ZpT",
+	"e/e98ClGAk3k9iHRPj+MFVVElABHojCfvMWtoiELA8IDL5utpUxc42o+FB4cZOSI/fe92ZJb+/ueA/1L",
+	"cw2xiQigK2Yl8WvzEUCyFGODZ8jKEJt8sM8OBYbkWNUHiLQy4uZ27R/WX61NuiofZUcbrCSBNW3TrUqo",
+	"uQW7YSEwx7HepNxLs700+xlfi9UYPnN2z+eFl9tQ7HsI7k25Pdb2WLtl6RX2DOgD+6wLV3uhXdMrBUD2",
+	"jQLdUdnMGQ4qMrLZfbDRNG/7TXOeiYmgBEilr9AuTPonucoKymfATmWWkvrY+NoLiD1o9ebO5xyv3Jy3",
+	"2XKOr/N+L2Ljk13TP1kWF+eS01PGaEFFaAY8HwViNufYz2eRwXf1GHbBMX83k/v2ktr6iHMwUnddUIfv",
+	"WE5vfnLGPmeVq8Ogkl3dSg1rryNo6xnFtpAup3m2s6pcvyHW3xCH6RbosCmub366cvRra+os5VAauknJ",
+	"BaToUBZ9v1fYlxTjp/QH5hVOmvpZBY/Z2HLDU9InY+1tHdsDDL05D4fmlm5FBIbCxDgwoFJJE+08lpCd",
+	"eIMQoq3eNibkYWMWaYOx7ykKvrVDZRMQRRW6MaCdHG01VhqzaJEAN+kSHjO6wvdv7Cok2j1ukC9MEkbJ",
+	"YEyrikywLOPmw/JywXyPClxbCOTjwedyiuLi6JRU7REhtJ7evpBHj9wvDrkLRmvC7kXJVSqO5SixkxOP",
+	"K2TAjUF2fYmnqbiTZZco8lvjRGuYJi3KXLgAVqNiAmX4MbZM5FOhsEfyM8t5MSctjOMj5gx8wtdre9tM",
+	"l2VB0g4ERbu9Wb9H+d6s35v1U9eTqkRd13IW3cnCBSf5cnT1OWk4M05yPeBZUQ5OAQinyJaYiNLyN0DV",
+	"SKa+Di8EPN0VWbMtkz5Z6Wus+sSXZpu+nuDiTbHEAltcj6M4Db1PX4/tPba/pCvbkufLHAA+qvN5ueKH",
+	"cABgfAXaa/NhKNXVCh90ONwW0Alw0OQ5MjSQbSct+pcT/XMeL2kH6PcSR3CQodein7xxVDSTGKBiTpfU",
+	"Y5HpiSnWZS5GfgInkPAVvSKxPk1y4OSuRrWWA7QtUdHw5CjwMVlVmCMKSyM6WByZ7EdoVKP20vzTwIE0",
+	"TKea0zq/Gn2qp8xzACoi9B8ciSBYvrEUJR6XKkE9nrW+8QGa0W00Gui5PX49lJ8SCt7RlQFMUMyLOW/7",
+	"A60/0PoD7VkfaDpksaawLj1MIV5bf5rOqG2YgpiS3TIFPWl+vAqGrJ8mL58aMRUDdOipPkHT+6JZn0+v",
+	"x+Qek59nGLyurGjCiXCbc8E+vCWOFTYS6cfKer6YI/q/PjL31G5V8cUzKv2S8+nXMGI0jKKPf9WJlMXO",
+	"16SwNbXU+N6c38753lgtbLVI1onuu8/VmufGg4py2RPaHzL9IdMfMs+yfMkqB8oMn+tRuzahyL0fBJrC",
+	"NKMIZTB3hjK5l9IimeIE1ECwlQsLX+K/6WWXTfVAvJJZMfkcXTtkoUGS66Jm0d7WfF/xjjKZecIUCc+V",
+	"OvAtniX+TP4Oy6t1PeFVtCVRM2XvRTfCtMDBxUlx8GvXCz0+qgvt+K1F2V607Vn0LoHAJJCUMScRPmwT",
+	"fxJGcRqTLZTckUiOk5TBfRzHc7XaWEjljxpjx+yCC5Q3wD8VAcChiJ2x5MjVh4Cw89Ppyfuzi7cn17rM",
+	"O+gSP539dHZxS9FOZoO4zjxYWEcOPPMjT1MESL6PIIConFTmZrJiSLIVfH568+wiSDTr+zCSF7kfLV/w",
+	"dpor7cqy8/eqjdji2q+iAJFRsMgXO0TfjzfsqqDIl3s0WsTo2a18T5Y2KV2mTaMA069RNU4AAvkPcgFk",
+	"e+FMKoUxmmgrzEq2+KEnP7E0tXUtHIbawsH6M1PGM6b0Xty95txrzpsG+ygGfbfSefxa0rNiSavMgxyk",
+	"nQ3h/U0U69i/fEesGS/r1Cjt1eF76k2pLFeSlbXDD+gM0ddA1Bi6FMZpWlWMH5otFKUnNSp5DSkUL2TO",
+	"uucc36Pn11amOgT29G4SPeb3mP88MV9JEbOEX9K5b+hRhYA/1Oahtoh/YkVbWs3ck7kyb2hyfqswNWkj",
+	"7TgAJCc3v+FSi+RcNRFU6ykdTljdlSqRHjgnOh8JXvIVtAei5hErkW7XnleVaXkNc542RAYi/PjUlj1e",
+	"d71h70UZElZlo+As7pU400GuNCgj6d7essFTsz770xqBkW170+jemYlwaXfK2fBcI+SpivsUTgMPR/3R",
+	"Z5WQItuRdeko+v2x9v74w0C1jt9tYQFfsTOehz92NuTdW/BV/h/94bPDgYslmwXHW7ffM+tapZdsP54H",
+	"YiT5AoiNy1yVm2qIo9FBJXjLpBOTFCzS2w053yHHrh4yelNFb6roTRWdws/f0m/bAfK9lwl8vUW2h7ke",
+	"5p6/JnjoQds1qfg+iPhjERRR/MRPdIq6F68gbltkfgtP88uqlzB76O2h9yVBL9UzbePihi/aiMofbjUm",
+	"6z100buAZZx4UCyWmb8+DqsH0x5MX1wcFtelrsLnz+KGDgGyvyp/3lLI4R/4nxY3gLWiyPPQ63iUu7mH",
+	"+i30XLcQnTY1xpJbLAAwxvgt7TiM+aU5qzR9Zm8manZbcr0hBB0qLrHnnRTvH3HbVTCkN2708ngvj+98",
+	"8p1bSgp5r1v1VS77fk0hFGgXVpaXVQ3HzP8mrw4luhRc7HacT+xJ72cJMLnzxIA6OWBweXJ6MZffM4w4",
+	"wWYgx0lanTylmxIEUTbMWN750ULp4XyU84R5kCtkfmKNmOvyUt+akyZTZ9ZWSDlAMe8ofHAfxaih1J5d",
+	"hzMx8Uf7KB1V26NupO7Amk3h0FdcJxyOtQlJiQUqH+14IwT/gATtrOnqVYuz7XMX/Hqc7YCzCEW0+0xq",
+	"eso3ZmMR1zHxdb2pUOY8kzUM4d96IISlOkoB9jIs2TQQDYPYKGuwnzheJFX4l8RJJDqWTSNsKQN7hkii",
+	"KxBYTu/4tTPzw0UiFcCY9pUWDsx0Ricjxvc/33KkhOcrvOX3XOf7yx+vL87+NoBHg5uz0+uzW523BEMI",
+	"KOd+PagRAFUqtGefRlMqEUs0myrnOUBDvBZhI5Q9tdpbmzEGh94YrWHXd7fGTjnmhktTleZpxXQCdqJ0",
+	"d+TzXijuwXodsLb2mCU5YhyvIz/NcaO6mYDqK7XALO9aTDR54PNyJuOYh5uYoUr5GBVMEFwQFgXiq6vL",
+	"UDXI2EY0pzQ1insxQVuct7gKdgEkgDneIqjJ3f+Bi7mw4wgmzMcc8rmoG8qhgcEvlWDb2VE6lyAHc5dR",
+	"hE7qs0K1Rzikj4O0sWcXJHA5z5JvkA91FntdFwUeBPqlmckMsi3f6uuUy2i52ElJt/cC7K01/cH0jCzY",
+	"sH+TKK6B7Wt+CMjNTtJeKXX9Ogi9ITuQHWMdsquFSukUVHdFlw3RHaB+oYfr8SM/ecJoyh4qe6jsb9ra",
+	"4pRKRLJQdX7JlnhpXbPxN49miGQ/XhTNbqjjXkDrUadHnV5A293rNF2z3tbv0UrgcYdyn23IabkJRW1e",
+	"ndyefucU8Vlfw5GFGBoW4UgGgb6fQk5k4qOyrqfEaISXXrqGkptLehYb97qq4wD1byxu1+S1hMU3ZCzi",
+	"pZXPZ3XK3iez6/qwOIAnnKDHnyGuHls1V49rDL5zMWlRbvUKc4vC0Yh3mS7nMKKKe4lzfOBcLujCNCbD",
+	"uE93AaNAzOZUc7U9qTPxif/96ujIIvx1XVKhuYyvWhF/FgIfYUnMqSD7BI0uOtUS9NRA/i5kMbnVi7X3",
+	"83oe0uef2JP5LmuY/mkxM9sP0RBT+aIqGWPabVN6ZxR5sgo58oMGYXGIqRtmYjSFRbKPZY/xFwc/N/ZH",
+	"iWS6jjyYgPJ7fX41uLi8Hby7/PHiLSLP2JeBp6yuRByL5V6VIZpfdcaA7Ab270Tge/rMMNZfHqLSanJI",
+	"r+hvEToBrWeqUQrB14m/BOE6w3FVEISNAL8w27L3YQcLz/ORPhFcWQyuxAp7/0C7xcW3O3P2OOwo72po",
+	"vTb1ZiN3PLFsrmVRtew6Fc5w9z7tT6J9+SmJxT6f0n/s6VWK/abcQVYZm/7Ab7EDTlomVzWU+TG7tVCq",
+	"Vj7uznnp83ln/sADbxH6wEX9C1+6ptukUXZoN2AX+v/m2IWOv8EuuUfX8++kyw0V145HPMixaPX6ge+r",
+	"lJjPbdtUKonQQUmx+twYU1ZZofWimvW5MaWkvkLjK6SMz409q6U64lVNbdpGTpGfykqA5TdKGgVptppH",
+	"XLYRtSPWQ9sfQAOdqPGbMy73KD+NgoWnfzc/RjM8BubJ0mVakLdcHrLiuGo+MXQjtWfR66PKwapstBEo",
+	"49Zo6TIllnNd3CLU9ynabwz+CHBlWWUpWXPEhObo3grDZ08ysrmyF1fqXZuV5ViHoVX8tNiZHYYwZjoE",
+	"MwazilmR+zbLdvvq9euStCSyG//iqrDK+rIRZRJLmVDyzuESb5KcH8n4DM2i10QMhy60YLwu6DpKoI/G",
+	"JJDGvaqJKw3LKYwSSp5LLIBuaeDzqU7fsRo8rqaWT2B5qFouOzs4/o8vHV56aED56+vXX371tfnfAWzo",
+	"FWOTnwSafaCz/GcPHbYEmljSWS3H1Bd4boQVy/8aqye1kN+sL1oaBSzC0hjKE0KBfHMrUeKh/VRLx3bv",
+	"6V7W3jIatg9KR0SeZ3mOuKUS56unrro6bOO0UQkeLFHYqKPso7LRTc+gdNbd0mm/Omot2Gd7OZPwXx1R",
+	"x96C63gNtCvtajucbXkrnQTfRfdkec0n6iZfXdf5XcYR+5jhXkvYRrqa/DwWM7kwEqkaoBeUlxJt7wBO",
+	"97HAl0MtFLRFUM64gjJ+auFrJxpZbToqq0nZwjHz3HmJtoUWK79QG1wxr69dmvA8DEHT5/1eGHUu1VHa",
+	"UbeRF4tGtR2/L6twbZV63QrzaspKr6lfo0xRXiz564K1eNV9sbQz7mEdGb1vCtWgbWsL19XR76V43t5a",
+	"Ub+OWnCkvobmw5ZOlyOx3PklAGu6Sx4w3206aGFkS2TLEyxDiG1wJYcdJcsSWfo2whyrn0bm+O1ws+a0",
+	"hiddhYR2JzBecamBl5e9h1EUSBGWturPU6mrh1in8L1Ab2s5+kg+3WOTVo9ykOjKy63FmlJ3Yb4vbI9z",
+	"RRM3Yol+6FRcFH8zw6Wua4/zjqf3zFeoIBgndxpYYUn5Xnr8urkjucT/jCMZ27svv2JJgTbW73Y4TEUc",
+	"ciGoAL2pEXm9TVmgtnZbLtXDWbF5oKowXsVJC+d6ZW6kLIu9LpFJy5PKYlTtx2q44tfb8Gd9yW6bolpb",
+	"icOOhG0k/Mk0m0W82na3iP3WOpqLjZW2g0ETfNiJdW0nXaeVaXMkmRjrLkmVqldDmsuGmmyzmvOZmlqN",
+	"6iHiROUYKgahuhP/WGJCm7W58gBrteSKCYBazczmFlHbPWGHGzWfChLhOU2OuAJUvizbY9cBlS9zBlDy",
+	"bdqWEairINalabJzDpJowBa3iu3Xkjm2KbzDjQLKxuhdOKi1ndcdBw2W9GLIuczdR6ShGWQoj7hmFpJQ",
+	"bVBvf5dQdfKYRcIDbX971LLHjJNGhGmWnM9PLk5ovKk0lO2fvCR0MpOxPxKHNyIaXIlFEFVWKp/E0WLO",
+	"9lotV/mJ6/x4e0q/sGkwb54vtdvBppaOcwumP5acN2n2gxMT+Joscter4WI2JBtqtoOjxZAwPLWq7n99",
+	"ZPk3fl0awXvdbMUcus44jmYOtIDjgE/tG6E0QAn/ohYO0AkxCu4w/he/K64I20BL93TCmcgI72cpJw25",
+	"4U4WcRvrbe7S7Zv3hoLCDRsOHVn4tWagea0rB4+/sllIfxV4aFpewUT4jFbDV3Vs1PPQefD6u+LYsSNa",
+	"P1/x6OHfg5q7mTr3XXuIH9BntfJ2BQaVLfksL4ZQvqdvaDVqmg/mApN2JLTlsyRFsF3CwJ/RGw8x5R9X",
+	"aJbWsW6fsrYuXjrDckdKHnY7iR5txSPtb91G9IvS7BItXC3kKJZJ1XlWiqnXOUUaguid8ySXxYlyuNBV",
+	"uXOCwfrOkHz2rFWRhs3zwuD0LjTFFYlSoGklEzw00JokJoK0a6BmMs3nOrETEJA+fS/iEDnQyJKfp3wf",
+	"qP3+GZyk7VbBhN5TOqyEGJVZgWCVSkV35/icmKiW4QhIDKOFAl7gaMeU0saK50d8M2ZtKzlN2ZKUut1b",
+	"k9zWKPJWJriIu625ljp/oW3KPzj8RyX569Jr2tyUhtRBml9D+O4sUrOdNc35VmFsZSp0rGCjB9UkJ6W+",
+	"ceYgMOIGcLO8cvDPNJolirNgloO9lXLeg22nfBZU2U27ijEdZJVcd4vwYwg7iHvsdu53Odpr+2x12m7i",
+	"hC3RoM/QGht1m6Mwt0rTJWktmLb7+90iCB4VjBqulMrWbsLmtG6xdpvO2jjY7O2cux3rVKPPTQtXmdzG",
+	"xUMPg5W8g8057FQeFLn5Mtzp6nGTxS73dp7t2nkeUUl2fsDgSlsPgLMsQDlVawck8L9kXbqCAVr0LOrV",
+	"nE5LvdEBhMmUXNy1Jk4SNKVtzbEPXgXR4C+YlLDXtp9c2z7alLZdsWgCf5yq1EeskeQaNj7SqeMzaOUf",
+	"02aCiPNqgkrHt/4zlzU+Tgv8KQ2ALmjz6zjgddbaWx4NVeXpmnWMh7lE+NoFwrmRCTmdo72DldkoS90G",
+	"7FhIs38RHKd0OdwBlP/sxoLeH7X3R63zRy0csOtQvkkvVV64242s6Xg3UhhUW2Mg61K2bNzN79SC61aR",
+	"oiWIh7nWCp2JEzVJH3WEy3OLDS1y5AFz0FY7BYEKgKAd86NFMor4VozYbZHb3pO1KTnMquHU6FxmDA9m",
+	"V0tPhvw0tVowbe1flolLCzCZzSKVcMkvMf11KKE36epiv4MxmanNqz6ldqkpw7AyYmTgW0aStpaRKkW5",
+	"ip8FzZ20ckMZ50PRy0mGdzKA7hw1JQgfLlM7M3yDYhSVdITTKQV03deVefxLkxL/a1lIKgBBSzwysVuU",
+	"D4WDc/w4i77alMXB5QQzzZY3yjBjbqHyCWb2dCs3/u9tWiKYxCHx2FjTUAllsZ+JpTMVdxh7qBRbYKNE",
+	"BK3oq7PUgPZnTbO0msUxqZZjVya9TtoYTYHrHKWJmzGTZizrvF9pwlyTzyfllxlhjqSHbJEHeFCtCKzM",
+	"J35bGVhpAzl+liW+TqLAMy4gJhZ3VUzj6k7zMY6de20rAbW6koA3Mr2uhT50bl/zZrm9xFyMSPhMY1Fl",
+	"WgJC+8+gJzdo75G5oeC3Bm3PhLcy8ClXtuab7oSG+saZg9bI93cJZgYjyIdh46JPSH7FzU+2E6YmNpd4",
+	"FiW4lwf69S660erJTp3cbYLp8CLsSMmrMenrC2peaW4q2xas+PlZLPK2doTr7NbOzul1OEhO0uW1aT3W",
+	"k9oVQ7H8B0Ex7qFMQlhKHQ+ecUrHkZN1xerwrC4IfnX4Oq9A+yykA1wE86kYSvhRBBW+9i283yo3aCtW",
+	"+A27le/hMw0iu++392rRuK9h316AZqqKPMwR33a5ldKS7YjsVKTrwXKT1PnjLJEpk5hIVMHMg3GkVEqz",
+	"qUTQRYyqpPsliVBneT6+eOmpekKbkZieNh20P/ihl2ZpAcYutU04NYONSce656PzCaLeNhR0RmOrjjgz",
+	"eqMumbfBuDPN0BZBZ9S8Wx971tpM/p0UQdLBFlInkKGFVSRiKFT1TFKhr7g5ZU8qlKStNQ7hrS8mYaTg",
+	"XOvg/gTCoqq5As5P1U/8IltR53OSzYYLP0D/Odfx5F0mkSuZGBc6fIPFR0bbQbm/OlwxHZrjCgFw5k94",
+	"kTmUQDK7CzJcOtgzd8vS67RdFnP810BJOK+9mhBRNUvmg2mkkmZunXhejPeUmvibD7dXwBbKn2T50Kd+",
+	"89q778CskIEXLwfxIux49WI1G0QTLC/kh3AcCUIq7Ki8gcx8lCYox8YSf2xmFEhuXKdWYsnG5Uk5K1vk",
+	"wxGJsehymktO6qOc728uL8g9X+ksWpmzxS9Hvx4wZCBLFkEL0P8pTbXp4AdWd2PKcmPKUignTUOsu01d",
+	"H1Zk7SrPrEisLmYLhVfVqcgWiHCysOS52tQuzEM9xrZZwPgYtcRkPFF3yk5XR9uD5U3Ocl1toKM90UWy",
+	"XEnlS5Iub5lrL1OcXD2Lj+Ks+3juueRNsc7JY+qV2h72lFbu3lcmtXe+OnIL61tzr5nxwqoZrSxve6wI",
+	"Wu75Aa6hzKEC6R3XkbpiE8ApxrS3l9fqIuYrLZCc9j+VqolbJXPPQUW6yFZh9nUFU9aWnasdvNMVhVX/",
+	"EBkyN29nEkn7ale7eBt/b9deGpFVx8B+qUNEImBNNP4m7TxrPPMwT/so3zy2vR671TXKyaMXF1p7h5Vt",
+	"h0WuDjCtvzPAJMw7GEbqSbTdDUzt9bWAr1AEHiZ5qXIFPURtlXvXoSnPBXaWnEasKc3xf51l1BZj1o+t",
+	"Kk70y4qvqpqbltFJ1VXuHydBbnHn5YvZsv4Z6WJ6j+INVFEZeqNrElfI5oL9SIyiNaBOHmxOs0v76kZh",
+	"HXKW+lCmrsv6xqFiTa5chjahjbNwLalQ8bYn43MJvnwAOFQXBu6az6pOEnyLJ1KJFVbaAV1N2UWT9b2I",
+	"tUPQPFLwGAvzwRdDMfqYPQrlROCjrl663TNdnQawKzox5XnGlZRFxe6O3jewiUbTjWdmrCqGnmDK+kmh",
+	"BHj+Pk5XeHp9dLDVPIYdExieeCAF7qrjWS1xW/Q8S5NURAHqvlYK/bZztprql+uC9jINXQ2zua7b2Wad",
+	"sXbMJcrbwDWr7XWLN2u6ZdvxCuZwxfVUhdiFb2Ja/5Fs7AJFg0HN3NGz1abJKlNanQ9Wpc+VvmSqGGlG",
+	"WwUlDSsa/vf/1HiB8O5aAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,44 @@
+package spec
+
+// Paginated is the envelope of all the paginated lists: a page of the items along with where it stands among
+// the rest of them, so the clients parse every list the same way.
+type Paginated[T any] struct {
+	// The items of the page, empty past the last one.
+	Items []T `json:"items"`
+
+	// Page listed, starting at 1.
+	Page int `json:"page"`
+
+	// Items by page, the last one may have less.
+	PageSize int `json:"pageSize"`
+
+	// Items on all the pages.
+	Total int `json:"total"`
+
+	// Pages to list all the items, 0 when there are none.
+	TotalPages int `json:"totalPages"`
+}
+
+// NewPaginated wraps a page of pageSize items out of total.
+func NewPaginated[T any](items []T, page, pageSize int, total int64) Paginated[T] {
+	// an empty page is answered as [], never as null.
+	if items == nil {
+		items = []T{}
+	}
+	return Paginated[T]{
+		Items:      items,
+		Page:       page,
+		PageSize:   pageSize,
+		Total:      int(total),
+		TotalPages: TotalPages(total, pageSize),
+	}
+}
+
+// TotalPages is the pages of pageSize items it takes to list total of them, the last one partial. No items, or
+// pages of no items, take no pages.
+func TotalPages(total int64, pageSize int) int {
+	if total <= 0 || pageSize <= 0 {
+		return 0
+	}
+	return int((total-1)/int64(pageSize) + 1)
+}
@@ -0,0 +1,43 @@
+package spec
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestTotalPages(t *testing.T) {
+	tests := []struct {
+		name     string
+		total    int64
+		pageSize int
+		pages    int
+	}{
+		{"no items", 0, 20, 0},
+		{"a partial page", 7, 20, 1},
+		{"an exact multiple", 40, 20, 2},
+		{"one past a multiple", 41, 20, 3},
+		{"pages of one", 5, 1, 5},
+		{"pages of no items", 10, 0, 0},
+		{"negative page size", 10, -5, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if pages := TotalPages(test.total, test.pageSize); pages != test.pages {
+				t.Fatalf("expected %d items by %d to take %d pages, got %d", test.total, test.pageSize, test.pages, pages)
+			}
+		})
+	}
+}
+
+func TestNewPaginatedAnswersAnEmptyPageAsAnArray(t *testing.T) {
+	body, err := json.Marshal(NewPaginated[GetLinksResponseArray](nil, 3, 20, 40))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"items":[],"page":3,"pageSize":20,"total":40,"totalPages":2}`
+	if string(body) != expected {
+		t.Fatalf("expected %s, got %s", expected, body)
+	}
+}
@@ -0,0 +1,482 @@
+package api
+
+import (
+	"context"
+	"journey/cmd/journey/config"
+	"journey/internal/api/spec"
+	"journey/internal/pgstore"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// DEFAULT_STORE_RETRIES is how many times a store call failing transiently is attempted again.
+const DEFAULT_STORE_RETRIES = 2
+
+const (
+	// storeRetryBackoff is the wait before the first new attempt, doubled on each of the next ones.
+	storeRetryBackoff = 50 * time.Millisecond
+	// maxStoreRetryBackoff bounds the wait between two attempts.
+	maxStoreRetryBackoff = time.Second
+)
+
+// GetStoreRetries reads JOURNEY_DB_RETRIES, falling back to the default when missing or invalid. 0 disables
+// the retries.
+func GetStoreRetries() int {
+	if value, err := config.GetSpecificEnvironmentVariable("JOURNEY_DB_RETRIES"); err == nil {
+		if retries, err := strconv.Atoi(value); err == nil && retries >= 0 {
+			return retries
+		}
+	}
+	return DEFAULT_STORE_RETRIES
+}
+
+// retryingStore attempts the calls of next again when they fail transiently, the reads as on a connection reset
+// and the writes only when left unapplied, so the handlers only see the failures a new attempt did not fix.
+type retryingStore struct {
+	next    store
+	retries int
+	backoff time.Duration
+}
+
+func newRetryingStore(next store, retries int, backoff time.Duration) retryingStore {
+	return retryingStore{next: next, retries: retries, backoff: backoff}
+}
+
+// retry calls the read call until it succeeds, fails for good or runs out of attempts, answering its last error.
+func (s retryingStore) retry(ctx context.Context, call func() error) error {
+	return s.retryOn(ctx, pgstore.IsTransient, call)
+}
+
+// retryWrite calls the write call as retry does, only attempting it again when the failure left it unapplied: a
+// write lost with the connection may have been committed, attempting it again would apply it twice.
+func (s retryingStore) retryWrite(ctx context.Context, call func() error) error {
+	return s.retryOn(ctx, pgstore.IsSafeToRetryWrite, call)
+}
+
+// retryOn calls call until it succeeds, fails with an error retryable refuses or runs out of attempts, answering
+// its last error. The wait between the attempts is cut short by the context, answering the last error as well.
+func (s retryingStore) retryOn(ctx context.Context, retryable func(error) bool, call func() error) error {
+	wait := s.backoff
+	for attempt := 0; ; attempt++ {
+		err := call()
+		if err == nil || attempt >= s.retries || !retryable(err) {
+			return err
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+		wait = min(2*wait, maxStoreRetryBackoff)
+	}
+}
+
+// CreateTrip is attempted again as a whole, its transaction rolled back on the failure.
+func (s retryingStore) CreateTrip(ctx context.Context, pool *pgxpool.Pool, params spec.CreateTripRequest, ownerTokenHash string) (tripID uuid.UUID, err error) {
+	err = s.retryWrite(ctx, func() error {
+		tripID, err = s.next.CreateTrip(ctx, pool, params, ownerTokenHash)
+		return err
+	})
+	return tripID, err
+}
+
+// CloneTrip is attempted again as a whole, its transaction rolled back on the failure.
+func (s retryingStore) CloneTrip(ctx context.Context, pool *pgxpool.Pool, params pgstore.CloneTripParams) (tripID uuid.UUID, err error) {
+	err = s.retryWrite(ctx, func() error {
+		tripID, err = s.next.CloneTrip(ctx, pool, params)
+		return err
+	})
+	return tripID, err
+}
+
+func (s retryingStore) GetTrip(ctx context.Context, id uuid.UUID) (trip pgstore.Trip, err error) {
+	err = s.retry(ctx, func() error {
+		trip, err = s.next.GetTrip(ctx, id)
+		return err
+	})
+	return trip, err
+}
+
+func (s retryingStore) TripExists(ctx context.Context, id uuid.UUID) (exists bool, err error) {
+	err = s.retry(ctx, func() error {
+		exists, err = s.next.TripExists(ctx, id)
+		return err
+	})
+	return exists, err
+}
+
+func (s retryingStore) UpdateTrip(ctx context.Context, arg pgstore.UpdateTripParams) error {
+	return s.retryWrite(ctx, func() error {
+		return s.next.UpdateTrip(ctx, arg)
+	})
+}
+
+func (s retryingStore) UpdateTripConfirm(ctx context.Context, arg pgstore.UpdateTripConfirmParams) (confirmed int64, err error) {
+	err = s.retryWrite(ctx, func() error {
+		confirmed, err = s.next.UpdateTripConfirm(ctx, arg)
+		return err
+	})
+	return confirmed, err
+}
+
+func (s retryingStore) UpdateTripStatus(ctx context.Context, arg pgstore.UpdateTripStatusParams) error {
+	return s.retryWrite(ctx, func() error {
+		return s.next.UpdateTripStatus(ctx, arg)
+	})
+}
+
+// RescheduleTrip is attempted again as a whole, its transaction rolled back on the failure.
+func (s retryingStore) RescheduleTrip(ctx context.Context, pool *pgxpool.Pool, params pgstore.RescheduleTripParams) error {
+	return s.retryWrite(ctx, func() error {
+		return s.next.RescheduleTrip(ctx, pool, params)
+	})
+}
+
+// TransferTripOwner is attempted again as a whole, its transaction rolled back on the failure.
+func (s retryingStore) TransferTripOwner(ctx context.Context, pool *pgxpool.Pool, params pgstore.TransferTripOwnerParams) error {
+	return s.retryWrite(ctx, func() error {
+		return s.next.TransferTripOwner(ctx, pool, params)
+	})
+}
+
+func (s retryingStore) UpdateTripOwnerTokenHash(ctx context.Context, arg pgstore.UpdateTripOwnerTokenHashParams) error {
+	return s.retryWrite(ctx, func() error {
+		return s.next.UpdateTripOwnerTokenHash(ctx, arg)
+	})
+}
+
+// DeleteTrip is attempted again as a whole, its transaction rolled back on the failure.
+func (s retryingStore) DeleteTrip(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID) error {
+	return s.retryWrite(ctx, func() error {
+		return s.next.DeleteTrip(ctx, pool, tripID)
+	})
+}
+
+func (s retryingStore) GetDeletedTrip(ctx context.Context, id uuid.UUID) (trip pgstore.Trip, err error) {
+	err = s.retry(ctx, func() error {
+		trip, err = s.next.GetDeletedTrip(ctx, id)
+		return err
+	})
+	return trip, err
+}
+
+// RestoreTrip is attempted again as a whole, its transaction rolled back on the failure.
+func (s retryingStore) RestoreTrip(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID, deletedAt pgtype.Timestamp) error {
+	return s.retryWrite(ctx, func() error {
+		return s.next.RestoreTrip(ctx, pool, tripID, deletedAt)
+	})
+}
+
+func (s retryingStore) GetTripsByEmail(ctx context.Context, arg pgstore.GetTripsByEmailParams) (trips []pgstore.GetTripsByEmailRow, err error) {
+	err = s.retry(ctx, func() error {
+		trips, err = s.next.GetTripsByEmail(ctx, arg)
+		return err
+	})
+	return trips, err
+}
+
+func (s retryingStore) CountTripsByEmail(ctx context.Context, email string) (total int64, err error) {
+	err = s.retry(ctx, func() error {
+		total, err = s.next.CountTripsByEmail(ctx, email)
+		return err
+	})
+	return total, err
+}
+
+func (s retryingStore) ListTripsByOwner(ctx context.Context, arg pgstore.ListTripsByOwnerParams) (trips []pgstore.ListTripsByOwnerRow, err error) {
+	err = s.retry(ctx, func() error {
+		trips, err = s.next.ListTripsByOwner(ctx, arg)
+		return err
+	})
+	return trips, err
+}
+
+func (s retryingStore) CountTripsByOwner(ctx context.Context, email string) (total int64, err error) {
+	err = s.retry(ctx, func() error {
+		total, err = s.next.CountTripsByOwner(ctx, email)
+		return err
+	})
+	return total, err
+}
+
+func (s retryingStore) ClaimIdempotencyKey(ctx context.Context, arg pgstore.ClaimIdempotencyKeyParams) (claimed int64, err error) {
+	err = s.retryWrite(ctx, func() error {
+		claimed, err = s.next.ClaimIdempotencyKey(ctx, arg)
+		return err
+	})
+	return claimed, err
+}
+
+func (s retryingStore) GetIdempotencyKey(ctx context.Context, key string) (idempotencyKey pgstore.IdempotencyKey, err error) {
+	err = s.retry(ctx, func() error {
+		idempotencyKey, err = s.next.GetIdempotencyKey(ctx, key)
+		return err
+	})
+	return idempotencyKey, err
+}
+
+func (s retryingStore) CompleteIdempotencyKey(ctx context.Context, arg pgstore.CompleteIdempotencyKeyParams) error {
+	return s.retryWrite(ctx, func() error {
+		return s.next.CompleteIdempotencyKey(ctx, arg)
+	})
+}
+
+func (s retryingStore) ReleaseIdempotencyKey(ctx context.Context, key string) error {
+	return s.retryWrite(ctx, func() error {
+		return s.next.ReleaseIdempotencyKey(ctx, key)
+	})
+}
+
+func (s retryingStore) ConfirmParticipant(ctx context.Context, arg pgstore.ConfirmParticipantParams) error {
+	return s.retryWrite(ctx, func() error {
+		return s.next.ConfirmParticipant(ctx, arg)
+	})
+}
+
+// ReorderActivities is attempted again as a whole, its transaction rolled back on the failure.
+func (s retryingStore) ReorderActivities(ctx context.Context, pool *pgxpool.Pool, params pgstore.ReorderActivitiesParams) error {
+	return s.retryWrite(ctx, func() error {
+		return s.next.ReorderActivities(ctx, pool, params)
+	})
+}
+
+// ConfirmParticipantsBatch is attempted again as a whole, its transaction rolled back on the failure.
+func (s retryingStore) ConfirmParticipantsBatch(ctx context.Context, pool *pgxpool.Pool, arg pgstore.ConfirmTripParticipantsParams) (participants []pgstore.Participant, err error) {
+	err = s.retryWrite(ctx, func() error {
+		participants, err = s.next.ConfirmParticipantsBatch(ctx, pool, arg)
+		return err
+	})
+	return participants, err
+}
+
+func (s retryingStore) GetParticipant(ctx context.Context, id uuid.UUID) (participant pgstore.Participant, err error) {
+	err = s.retry(ctx, func() error {
+		participant, err = s.next.GetParticipant(ctx, id)
+		return err
+	})
+	return participant, err
+}
+
+func (s retryingStore) GetParticipantForTrip(ctx context.Context, arg pgstore.GetParticipantForTripParams) (participant pgstore.Participant, err error) {
+	err = s.retry(ctx, func() error {
+		participant, err = s.next.GetParticipantForTrip(ctx, arg)
+		return err
+	})
+	return participant, err
+}
+
+func (s retryingStore) GetParticipants(ctx context.Context, tripID uuid.UUID) (participants []pgstore.Participant, err error) {
+	err = s.retry(ctx, func() error {
+		participants, err = s.next.GetParticipants(ctx, tripID)
+		return err
+	})
+	return participants, err
+}
+
+func (s retryingStore) GetParticipantsSummary(ctx context.Context, tripID uuid.UUID) (summary pgstore.GetParticipantsSummaryRow, err error) {
+	err = s.retry(ctx, func() error {
+		summary, err = s.next.GetParticipantsSummary(ctx, tripID)
+		return err
+	})
+	return summary, err
+}
+
+func (s retryingStore) GetAdminParticipants(ctx context.Context, arg pgstore.GetAdminParticipantsParams) (participants []pgstore.GetAdminParticipantsRow, err error) {
+	err = s.retry(ctx, func() error {
+		participants, err = s.next.GetAdminParticipants(ctx, arg)
+		return err
+	})
+	return participants, err
+}
+
+func (s retryingStore) CountAdminParticipants(ctx context.Context, arg pgstore.CountAdminParticipantsParams) (total int64, err error) {
+	err = s.retry(ctx, func() error {
+		total, err = s.next.CountAdminParticipants(ctx, arg)
+		return err
+	})
+	return total, err
+}
+
+func (s retryingStore) CountPendingInvitesByEmail(ctx context.Context, email string) (total int64, err error) {
+	err = s.retry(ctx, func() error {
+		total, err = s.next.CountPendingInvitesByEmail(ctx, email)
+		return err
+	})
+	return total, err
+}
+
+func (s retryingStore) GetTripWithParticipants(ctx context.Context, id uuid.UUID) (trip pgstore.Trip, participants []pgstore.Participant, err error) {
+	err = s.retry(ctx, func() error {
+		trip, participants, err = s.next.GetTripWithParticipants(ctx, id)
+		return err
+	})
+	return trip, participants, err
+}
+
+func (s retryingStore) GetTripWithParticipantsPage(ctx context.Context, arg pgstore.GetTripAndParticipantsPageParams) (trip pgstore.Trip, participants []pgstore.Participant, total int64, err error) {
+	err = s.retry(ctx, func() error {
+		trip, participants, total, err = s.next.GetTripWithParticipantsPage(ctx, arg)
+		return err
+	})
+	return trip, participants, total, err
+}
+
+// InviteParticipantsWithinCapacity is attempted again as a whole, its transaction rolled back on the failure.
+func (s retryingStore) InviteParticipantsWithinCapacity(ctx context.Context, pool *pgxpool.Pool, arg []pgstore.InviteParticipantsToTripParams) (invited int64, err error) {
+	err = s.retryWrite(ctx, func() error {
+		invited, err = s.next.InviteParticipantsWithinCapacity(ctx, pool, arg)
+		return err
+	})
+	return invited, err
+}
+
+func (s retryingStore) UpdateParticipantEmail(ctx context.Context, arg pgstore.UpdateParticipantEmailParams) error {
+	return s.retryWrite(ctx, func() error {
+		return s.next.UpdateParticipantEmail(ctx, arg)
+	})
+}
+
+func (s retryingStore) DeleteParticipant(ctx context.Context, arg pgstore.DeleteParticipantParams) (deleted int64, err error) {
+	err = s.retryWrite(ctx, func() error {
+		deleted, err = s.next.DeleteParticipant(ctx, arg)
+		return err
+	})
+	return deleted, err
+}
+
+// PromoteWaitlistedParticipant is attempted again as a whole, its transaction rolled back on the failure.
+func (s retryingStore) PromoteWaitlistedParticipant(ctx context.Context, pool *pgxpool.Pool, arg pgstore.PromoteParticipantParams) (promoted int64, err error) {
+	err = s.retryWrite(ctx, func() error {
+		promoted, err = s.next.PromoteWaitlistedParticipant(ctx, pool, arg)
+		return err
+	})
+	return promoted, err
+}
+
+func (s retryingStore) CreateActivity(ctx context.Context, arg pgstore.CreateActivityParams) (activityID uuid.UUID, err error) {
+	err = s.retryWrite(ctx, func() error {
+		activityID, err = s.next.CreateActivity(ctx, arg)
+		return err
+	})
+	return activityID, err
+}
+
+// CreateActivities is attempted again as a whole, its transaction rolled back on the failure.
+func (s retryingStore) CreateActivities(ctx context.Context, pool *pgxpool.Pool, arg []pgstore.CreateActivityParams) (activityIDs []uuid.UUID, err error) {
+	err = s.retryWrite(ctx, func() error {
+		activityIDs, err = s.next.CreateActivities(ctx, pool, arg)
+		return err
+	})
+	return activityIDs, err
+}
+
+func (s retryingStore) GetTripActivities(ctx context.Context, tripID uuid.UUID) (activities []pgstore.Activity, err error) {
+	err = s.retry(ctx, func() error {
+		activities, err = s.next.GetTripActivities(ctx, tripID)
+		return err
+	})
+	return activities, err
+}
+
+func (s retryingStore) GetTripWithActivities(ctx context.Context, arg pgstore.GetTripAndActivitiesParams) (trip pgstore.Trip, activities []pgstore.Activity, err error) {
+	err = s.retry(ctx, func() error {
+		trip, activities, err = s.next.GetTripWithActivities(ctx, arg)
+		return err
+	})
+	return trip, activities, err
+}
+
+func (s retryingStore) GetTripWithActivitiesPage(ctx context.Context, arg pgstore.GetTripAndActivitiesPageParams) (trip pgstore.Trip, activities []pgstore.Activity, total int64, err error) {
+	err = s.retry(ctx, func() error {
+		trip, activities, total, err = s.next.GetTripWithActivitiesPage(ctx, arg)
+		return err
+	})
+	return trip, activities, total, err
+}
+
+func (s retryingStore) SearchActivities(ctx context.Context, tripID uuid.UUID, text string) (activities []pgstore.Activity, err error) {
+	err = s.retry(ctx, func() error {
+		activities, err = s.next.SearchActivities(ctx, tripID, text)
+		return err
+	})
+	return activities, err
+}
+
+func (s retryingStore) GetTripWithActivityCounts(ctx context.Context, tripID uuid.UUID) (trip pgstore.Trip, counts []pgstore.ActivityDayCount, err error) {
+	err = s.retry(ctx, func() error {
+		trip, counts, err = s.next.GetTripWithActivityCounts(ctx, tripID)
+		return err
+	})
+	return trip, counts, err
+}
+
+func (s retryingStore) GetActivity(ctx context.Context, arg pgstore.GetActivityParams) (activity pgstore.Activity, err error) {
+	err = s.retry(ctx, func() error {
+		activity, err = s.next.GetActivity(ctx, arg)
+		return err
+	})
+	return activity, err
+}
+
+func (s retryingStore) UpdateActivity(ctx context.Context, arg pgstore.UpdateActivityParams) (activity pgstore.Activity, err error) {
+	err = s.retryWrite(ctx, func() error {
+		activity, err = s.next.UpdateActivity(ctx, arg)
+		return err
+	})
+	return activity, err
+}
+
+func (s retryingStore) UpdateActivityDone(ctx context.Context, arg pgstore.UpdateActivityDoneParams) (activity pgstore.Activity, err error) {
+	err = s.retryWrite(ctx, func() error {
+		activity, err = s.next.UpdateActivityDone(ctx, arg)
+		return err
+	})
+	return activity, err
+}
+
+func (s retryingStore) DeleteActivity(ctx context.Context, arg pgstore.DeleteActivityParams) (deleted int64, err error) {
+	err = s.retryWrite(ctx, func() error {
+		deleted, err = s.next.DeleteActivity(ctx, arg)
+		return err
+	})
+	return deleted, err
+}
+
+func (s retryingStore) CreateTripLink(ctx context.Context, arg pgstore.CreateTripLinkParams) (linkID uuid.UUID, err error) {
+	err = s.retryWrite(ctx, func() error {
+		linkID, err = s.next.CreateTripLink(ctx, arg)
+		return err
+	})
+	return linkID, err
+}
+
+func (s retryingStore) GetTripWithLinks(ctx context.Context, id uuid.UUID) (trip pgstore.Trip, links []pgstore.Link, err error) {
+	err = s.retry(ctx, func() error {
+		trip, links, err = s.next.GetTripWithLinks(ctx, id)
+		return err
+	})
+	return trip, links, err
+}
+
+func (s retryingStore) GetLink(ctx context.Context, arg pgstore.GetLinkParams) (link pgstore.Link, err error) {
+	err = s.retry(ctx, func() error {
+		link, err = s.next.GetLink(ctx, arg)
+		return err
+	})
+	return link, err
+}
+
+func (s retryingStore) GetSchemaVersion(ctx context.Context) (version int32, err error) {
+	err = s.retry(ctx, func() error {
+		version, err = s.next.GetSchemaVersion(ctx)
+		return err
+	})
+	return version, err
+}
@@ -0,0 +1,143 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"io"
+	"journey/internal/pgstore"
+	"net"
+	"net/http"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// flakyStore fails the GetTrip calls with the errors queued, one per call, before reaching the fakeStore.
+type flakyStore struct {
+	*fakeStore
+	failures []error
+	attempts int
+}
+
+func (s *flakyStore) GetTrip(ctx context.Context, id uuid.UUID) (pgstore.Trip, error) {
+	s.attempts++
+	if len(s.failures) > 0 {
+		err := s.failures[0]
+		s.failures = s.failures[1:]
+		return pgstore.Trip{}, err
+	}
+	return s.fakeStore.GetTrip(ctx, id)
+}
+
+func TestRetryingStoreRetriesTransientFailures(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	flaky := &flakyStore{fakeStore: store, failures: []error{&pgconn.PgError{Code: "40001"}}}
+	api := newTestAPI(newRetryingStore(flaky, 2, time.Millisecond), &fakeMailer{})
+
+	w := serve(api, newRequest(t, http.MethodGet, "/trips/"+trip.ID.String(), nil))
+
+	assertStatus(t, w, http.StatusOK)
+	if flaky.attempts != 2 {
+		t.Fatalf("expected the serialization failure attempted again once, got %d attempts", flaky.attempts)
+	}
+}
+
+func TestRetryingStoreStopsAfterTheRetries(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	flaky := &flakyStore{fakeStore: store, failures: []error{syscall.ECONNRESET, syscall.ECONNRESET, syscall.ECONNRESET}}
+
+	_, err := newRetryingStore(flaky, 2, time.Millisecond).GetTrip(context.Background(), trip.ID)
+
+	if !errors.Is(err, syscall.ECONNRESET) {
+		t.Fatalf("expected the last connection reset, got %v", err)
+	}
+	if flaky.attempts != 3 {
+		t.Fatalf("expected the call and 2 retries, got %d attempts", flaky.attempts)
+	}
+}
+
+func TestRetryingStoreDoesNotRetryPermanentFailures(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	cases := map[string]struct {
+		ctx context.Context
+		err error
+	}{
+		"constraint violation": {ctx: context.Background(), err: &pgconn.PgError{Code: "23505"}},
+		"context canceled":     {ctx: canceled, err: context.Canceled},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			store := newFakeStore()
+			trip := store.addTrip(newTestTrip(3))
+			flaky := &flakyStore{fakeStore: store, failures: []error{tc.err}}
+
+			_, err := newRetryingStore(flaky, 2, time.Millisecond).GetTrip(tc.ctx, trip.ID)
+
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("expected %v, got %v", tc.err, err)
+			}
+			if flaky.attempts != 1 {
+				t.Fatalf("expected a single attempt, got %d", flaky.attempts)
+			}
+		})
+	}
+}
+
+// flakyWriteStore fails the CreateActivity calls with the errors queued, one per call, before reaching the
+// fakeStore.
+type flakyWriteStore struct {
+	*fakeStore
+	failures []error
+	attempts int
+}
+
+func (s *flakyWriteStore) CreateActivity(ctx context.Context, arg pgstore.CreateActivityParams) (uuid.UUID, error) {
+	s.attempts++
+	if len(s.failures) > 0 {
+		err := s.failures[0]
+		s.failures = s.failures[1:]
+		return uuid.UUID{}, err
+	}
+	return s.fakeStore.CreateActivity(ctx, arg)
+}
+
+func TestRetryingStoreRetriesWritesOnlyWhenUnapplied(t *testing.T) {
+	cases := map[string]struct {
+		err      error
+		attempts int
+	}{
+		"serialization failure": {err: &pgconn.PgError{Code: "40001"}, attempts: 2},
+		"deadlock":              {err: &pgconn.PgError{Code: "40P01"}, attempts: 2},
+		"connection reset":      {err: &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}, attempts: 1},
+		"unexpected EOF":        {err: io.ErrUnexpectedEOF, attempts: 1},
+		"connection exception":  {err: &pgconn.PgError{Code: "08006"}, attempts: 1},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			store := newFakeStore()
+			trip := store.addTrip(newTestTrip(3))
+			flaky := &flakyWriteStore{fakeStore: store, failures: []error{tc.err}}
+
+			_, err := newRetryingStore(flaky, 2, time.Millisecond).CreateActivity(context.Background(), pgstore.CreateActivityParams{
+				TripID: trip.ID,
+				Title:  "Museu",
+			})
+
+			if flaky.attempts != tc.attempts {
+				t.Fatalf("expected %d attempts, got %d", tc.attempts, flaky.attempts)
+			}
+			if tc.attempts == 1 && !errors.Is(err, tc.err) {
+				t.Fatalf("expected %v, got %v", tc.err, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package api
+
+import (
+	"journey/internal/pgstore"
+	"net/http"
+)
+
+// storeFailureStatus is the status a failed store call answers. The constraint violations are caused by the
+// request: a unique one conflicts with the stored rows, a foreign key one references a row gone missing, as
+// the trip deleted meanwhile. Any other failure is the store's own.
+func storeFailureStatus(err error) int {
+	switch {
+	case pgstore.IsUniqueViolation(err):
+		return http.StatusConflict
+	case pgstore.IsForeignKeyViolation(err):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,116 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"journey/internal/api/spec"
+	"journey/internal/pgstore"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	errUniqueViolation     = fmt.Errorf("pgstore: failed to insert: %w", &pgconn.PgError{Code: "23505", ConstraintName: "participants_trip_id_email_key"})
+	errForeignKeyViolation = fmt.Errorf("pgstore: failed to insert: %w", &pgconn.PgError{Code: "23503", ConstraintName: "activities_trip_id_fkey"})
+)
+
+// violatingStore fails the writes with err, as the server refusing the rows would.
+type violatingStore struct {
+	*fakeStore
+	err error
+}
+
+func (s violatingStore) InviteParticipantsWithinCapacity(context.Context, *pgxpool.Pool, []pgstore.InviteParticipantsToTripParams) (int64, error) {
+	return 0, s.err
+}
+
+func (s violatingStore) UpdateParticipantEmail(context.Context, pgstore.UpdateParticipantEmailParams) error {
+	return s.err
+}
+
+func (s violatingStore) CreateActivity(context.Context, pgstore.CreateActivityParams) (uuid.UUID, error) {
+	return uuid.UUID{}, s.err
+}
+
+func (s violatingStore) CreateActivities(context.Context, *pgxpool.Pool, []pgstore.CreateActivityParams) ([]uuid.UUID, error) {
+	return nil, s.err
+}
+
+func (s violatingStore) CreateTripLink(context.Context, pgstore.CreateTripLinkParams) (uuid.UUID, error) {
+	return uuid.UUID{}, s.err
+}
+
+func TestStoreFailureStatus(t *testing.T) {
+	cases := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{"unique violation", errUniqueViolation, http.StatusConflict},
+		{"foreign key violation", errForeignKeyViolation, http.StatusNotFound},
+		{"other violation", &pgconn.PgError{Code: "23514"}, http.StatusInternalServerError},
+		{"not a server error", errors.New("connection lost"), http.StatusInternalServerError},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			if status := storeFailureStatus(test.err); status != test.expected {
+				t.Fatalf("expected %d, got %d", test.expected, status)
+			}
+		})
+	}
+}
+
+func TestStoreViolationsAnswered(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	participant := store.addParticipant(trip.ID, "guest@exmaple.com")
+	occursAt := trip.StartsAt.Time.Add(2 * time.Hour)
+	tripPath := "/trips/" + trip.ID.String()
+
+	cases := []struct {
+		name   string
+		err    error
+		method string
+		target string
+		body   any
+		status int
+		code   ErrorCode
+	}{
+		{"duplicate invite", errUniqueViolation, http.MethodPost, tripPath + "/invites",
+			map[string]string{"email": "guest@trip.com"}, http.StatusConflict, ErrorCodeParticipantAlreadyInvited},
+		{"invite on a deleted trip", errForeignKeyViolation, http.MethodPost, tripPath + "/invites",
+			map[string]string{"email": "guest@trip.com"}, http.StatusNotFound, ErrorCodeTripNotFound},
+		{"invite failing otherwise", errors.New("connection lost"), http.MethodPost, tripPath + "/invites",
+			map[string]string{"email": "guest@trip.com"}, http.StatusInternalServerError, ErrorCodeInternal},
+		{"duplicate participant email", errUniqueViolation, http.MethodPut, tripPath + "/participants/" + participant.ID.String(),
+			map[string]string{"email": "guest@example.com"}, http.StatusConflict, ErrorCodeParticipantAlreadyInvited},
+		{"activity on a deleted trip", errForeignKeyViolation, http.MethodPost, tripPath + "/activities",
+			map[string]any{"title": "Museum", "occurs_at": occursAt}, http.StatusNotFound, ErrorCodeTripNotFound},
+		{"activities on a deleted trip", errForeignKeyViolation, http.MethodPost, tripPath + "/activities/batch",
+			map[string]any{"activities": []map[string]any{{"title": "Museum", "occurs_at": occursAt}}}, http.StatusNotFound, ErrorCodeTripNotFound},
+		{"link on a deleted trip", errForeignKeyViolation, http.MethodPost, tripPath + "/links",
+			map[string]string{"title": "Hotel", "url": "https://hotel.com"}, http.StatusNotFound, ErrorCodeTripNotFound},
+	}
+
+	for _, test := range cases {
+		t.Run(test.name, func(t *testing.T) {
+			api := newTestAPI(violatingStore{store, test.err}, &fakeMailer{})
+
+			w := serve(api, withOwnerToken(newRequest(t, test.method, test.target, test.body), TEST_OWNER_TOKEN))
+
+			assertStatus(t, w, test.status)
+			var response spec.BadRequest
+			decodeResponse(t, w, &response)
+			if response.Code != string(test.code) {
+				t.Fatalf("expected %s, got %s", test.code, response.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"journey/internal/api/spec"
+	"journey/internal/i18n"
+	"journey/internal/pgstore"
+	"math"
+	"net/http"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// timelineEntryActivity is the type of the timeline entries of the activities, the only ones for now.
+const timelineEntryActivity = "activity"
+
+// Get a trip itinerary as a flat feed.
+// (GET /trips/{tripId}/timeline)
+func (api *API) GetTripsTripIDTimeline(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDTimelineParams) *spec.Response {
+	tripUUID := pathUUID(r, "tripId")
+
+	// Out of range, the page and its size are clamped rather than rejected.
+	page, perPage := 1, defaultTimelinePerPage
+	if params.Page != nil {
+		page = max(*params.Page, 1)
+	}
+	if params.PerPage != nil {
+		perPage = min(max(*params.PerPage, 1), maxTimelinePerPage)
+	}
+	// the offset must fit the query int, a page that far is past the last one anyway.
+	page = min(page, math.MaxInt32/perPage)
+
+	_, activities, total, err := api.store.GetTripWithActivitiesPage(r.Context(), pgstore.GetTripAndActivitiesPageParams{
+		ID:         tripUUID,
+		PageLimit:  int32(perPage),
+		PageOffset: int32((page - 1) * perPage),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return spec.GetTripsTripIDTimelineJSON404Response(api.notFound(r, i18n.TripNotFound))
+	}
+	if err != nil {
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed on route: '%v: %v'", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripUUID.String()),
+		)
+		return spec.GetTripsTripIDTimelineJSON500Response(api.internalServerError(r, i18n.UnableToGetActivities))
+	}
+
+	entries := make([]spec.GetTripTimelineResponseArray, len(activities))
+	for index, activity := range activities {
+		entries[index] = activityTimelineEntry(activity)
+	}
+
+	return spec.GetTripsTripIDTimelineJSON200Response(spec.NewPaginated(entries, page, perPage, total))
+}
+
+func activityTimelineEntry(activity pgstore.Activity) spec.GetTripTimelineResponseArray {
+	return spec.GetTripTimelineResponseArray{
+		Type:     timelineEntryActivity,
+		ID:       activity.ID.String(),
+		Title:    activity.Title,
+		OccursAt: activity.OccursAt.Time,
+		EndsAt:   activityEndsAt(activity),
+		Notes:    notesResponse(activity.Notes),
+	}
+}
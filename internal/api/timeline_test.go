@@ -0,0 +1,148 @@
+package api
+
+import (
+	"journey/internal/api/spec"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestGetTripsTripIDTimeline(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	other := store.addTrip(newTestTrip(3))
+	start := trip.StartsAt.Time
+
+	// added out of order and spread over the days, the late hours of a day before the early ones of the next.
+	dinner := store.addActivity(trip.ID, "Dinner", start.AddDate(0, 0, 1).Add(9*time.Hour))
+	museum := store.addActivity(trip.ID, "Museum", start.Add(2*time.Hour))
+	beach := store.addActivity(trip.ID, "Beach", start.AddDate(0, 0, 2))
+	breakfast := store.addActivity(trip.ID, "Breakfast", start.AddDate(0, 0, 1).Add(-4*time.Hour))
+	store.addActivity(other.ID, "Elsewhere", start.Add(time.Hour))
+	api := newTestAPI(store, &fakeMailer{})
+
+	w := serve(api, newRequest(t, http.MethodGet, "/trips/"+trip.ID.String()+"/timeline", nil))
+
+	assertStatus(t, w, http.StatusOK)
+	var response spec.GetTripTimelineResponse
+	decodeResponse(t, w, &response)
+	if response.Page != 1 || response.PageSize != defaultTimelinePerPage || response.TotalPages != 1 || response.Total != 4 {
+		t.Fatalf("expected the single page of 4 entries, got %+v", response)
+	}
+
+	expected := []string{museum.ID.String(), breakfast.ID.String(), dinner.ID.String(), beach.ID.String()}
+	if len(response.Items) != len(expected) {
+		t.Fatalf("expected %d entries, got %+v", len(expected), response.Items)
+	}
+	for index, entry := range response.Items {
+		if entry.ID != expected[index] {
+			t.Fatalf("expected entry %d to be %s, got %s (%s)", index, expected[index], entry.ID, entry.Title)
+		}
+		if entry.Type != timelineEntryActivity {
+			t.Fatalf("expected entry %s typed %q, got %q", entry.ID, timelineEntryActivity, entry.Type)
+		}
+		if index > 0 && entry.OccursAt.Before(response.Items[index-1].OccursAt) {
+			t.Fatalf("expected the entries by their start, got %s before %s", response.Items[index-1].OccursAt, entry.OccursAt)
+		}
+	}
+}
+
+func TestGetTripsTripIDTimelineEntry(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	activity := store.addActivity(trip.ID, "Museum", trip.StartsAt.Time.Add(2*time.Hour))
+	activity.DurationMinutes = 90
+	activity.Notes = pgtype.Text{String: "bring passport", Valid: true}
+	store.activities[activity.ID] = activity
+	api := newTestAPI(store, &fakeMailer{})
+
+	w := serve(api, newRequest(t, http.MethodGet, "/trips/"+trip.ID.String()+"/timeline", nil))
+
+	assertStatus(t, w, http.StatusOK)
+	var response spec.GetTripTimelineResponse
+	decodeResponse(t, w, &response)
+	if len(response.Items) != 1 {
+		t.Fatalf("expected a single entry, got %+v", response.Items)
+	}
+	entry := response.Items[0]
+	if entry.Type != "activity" || entry.Title != "Museum" || !entry.OccursAt.Equal(activity.OccursAt.Time) {
+		t.Fatalf("expected the museum activity, got %+v", entry)
+	}
+	if !entry.EndsAt.Equal(activity.OccursAt.Time.Add(90 * time.Minute)) {
+		t.Fatalf("expected the entry to end after its duration, got %s", entry.EndsAt)
+	}
+	if entry.Notes == nil || *entry.Notes != "bring passport" {
+		t.Fatalf("expected the notes of the activity, got %v", entry.Notes)
+	}
+}
+
+func TestGetTripsTripIDTimelinePagination(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	for index := 0; index < 5; index++ {
+		store.addActivity(trip.ID, "Activity", trip.StartsAt.Time.Add(time.Duration(index)*12*time.Hour))
+	}
+	api := newTestAPI(store, &fakeMailer{})
+
+	tests := []struct {
+		name       string
+		query      string
+		page       int
+		perPage    int
+		count      int
+		totalPages int
+	}{
+		{"first page", "?perPage=2", 1, 2, 2, 3},
+		{"last page", "?page=3&perPage=2", 3, 2, 1, 3},
+		{"past the last page", "?page=9&perPage=2", 9, 2, 0, 3},
+		{"size clamped to the max", "?perPage=1000", 1, maxTimelinePerPage, 5, 1},
+		{"invalid values clamped", "?page=-3&perPage=0", 1, 1, 1, 5},
+	}
+
+	var previous time.Time
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := serve(api, newRequest(t, http.MethodGet, "/trips/"+trip.ID.String()+"/timeline"+test.query, nil))
+
+			assertStatus(t, w, http.StatusOK)
+			var response spec.GetTripTimelineResponse
+			decodeResponse(t, w, &response)
+			if response.Page != test.page || response.PageSize != test.perPage || response.TotalPages != test.totalPages || response.Total != 5 {
+				t.Fatalf("expected page %d of %d out of %d pages of 5, got %+v", test.page, test.perPage, test.totalPages, response)
+			}
+			if len(response.Items) != test.count {
+				t.Fatalf("expected %d entries, got %d", test.count, len(response.Items))
+			}
+		})
+	}
+
+	// the pages follow each other by the start of the entries.
+	for page := 1; page <= 3; page++ {
+		w := serve(api, newRequest(t, http.MethodGet, "/trips/"+trip.ID.String()+"/timeline?perPage=2&page="+strconv.Itoa(page), nil))
+		var response spec.GetTripTimelineResponse
+		decodeResponse(t, w, &response)
+		for _, entry := range response.Items {
+			if !entry.OccursAt.After(previous) {
+				t.Fatalf("expected page %d to follow the previous one, got %s after %s", page, entry.OccursAt, previous)
+			}
+			previous = entry.OccursAt
+		}
+	}
+}
+
+func TestGetTripsTripIDTimelineNotFound(t *testing.T) {
+	api := newTestAPI(newFakeStore(), &fakeMailer{})
+
+	w := serve(api, newRequest(t, http.MethodGet, "/trips/"+uuid.NewString()+"/timeline", nil))
+
+	assertStatus(t, w, http.StatusNotFound)
+	var response spec.NotFoundRequest
+	decodeResponse(t, w, &response)
+	if response.Code != string(ErrorCodeTripNotFound) {
+		t.Fatalf("expected %s, got %s", ErrorCodeTripNotFound, response.Code)
+	}
+}
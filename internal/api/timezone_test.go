@@ -0,0 +1,89 @@
+package api
+
+import (
+	"journey/internal/api/spec"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestGetTripsTripIDActivitiesBucketsByTheTripTimezone(t *testing.T) {
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	store := newFakeStore()
+	trip := newTestTrip(3)
+	trip.Timezone = "America/Sao_Paulo"
+	store.addTrip(trip)
+	// 22:30 on the first day in São Paulo, already the second day in UTC.
+	lateEvening := store.addActivity(trip.ID, "Dinner", time.Date(2030, time.March, 11, 22, 30, 0, 0, saoPaulo).UTC())
+	api := newTestAPI(store, &fakeMailer{})
+
+	w := serve(api, newRequest(t, http.MethodGet, "/trips/"+trip.ID.String()+"/activities", nil))
+
+	assertStatus(t, w, http.StatusOK)
+	var response spec.GetTripActivitiesResponse
+	decodeResponse(t, w, &response)
+
+	if len(response.Activities) != 3 {
+		t.Fatalf("expected the 3 trip days listed, got %d", len(response.Activities))
+	}
+	firstDay := response.Activities[0]
+	if !firstDay.Date.Equal(time.Date(2030, time.March, 11, 0, 0, 0, 0, saoPaulo)) {
+		t.Fatalf("expected the first day at the local midnight, got %v", firstDay.Date)
+	}
+	if len(firstDay.Activities) != 1 || firstDay.Activities[0].ID != lateEvening.ID.String() {
+		t.Fatalf("expected the late-evening activity on the first local day, got %+v", response.Activities)
+	}
+	if len(response.Activities[1].Activities) != 0 {
+		t.Fatalf("expected nothing on the second day, got %+v", response.Activities[1].Activities)
+	}
+}
+
+func TestPostTripsTimezone(t *testing.T) {
+	store := newFakeStore()
+	api := newTestAPI(store, &fakeMailer{})
+	startsAt := testNow.Add(time.Hour)
+
+	create := func(timezone any) *http.Request {
+		body := newCreateTripBody("owner@example.com", startsAt, startsAt.AddDate(0, 0, 3))
+		if timezone != nil {
+			body["timezone"] = timezone
+		}
+		return newRequest(t, http.MethodPost, "/trips", body)
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		w := serve(api, create("America/Sao_Paulo"))
+
+		assertStatus(t, w, http.StatusCreated)
+		var response spec.CreateTripResponse
+		decodeResponse(t, w, &response)
+		if timezone := store.trip(uuid.MustParse(response.TripID)).Timezone; timezone != "America/Sao_Paulo" {
+			t.Fatalf("expected the timezone saved, got %q", timezone)
+		}
+	})
+
+	t.Run("defaults to UTC", func(t *testing.T) {
+		w := serve(api, create(nil))
+
+		assertStatus(t, w, http.StatusCreated)
+		var response spec.CreateTripResponse
+		decodeResponse(t, w, &response)
+		if timezone := store.trip(uuid.MustParse(response.TripID)).Timezone; timezone != "UTC" {
+			t.Fatalf("expected the UTC default, got %q", timezone)
+		}
+	})
+
+	for _, timezone := range []string{"Mars/Olympus_Mons", "Local", "-03:00"} {
+		t.Run("invalid "+timezone, func(t *testing.T) {
+			w := serve(api, create(timezone))
+
+			assertStatus(t, w, http.StatusBadRequest)
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"journey/internal/api/spec"
+	"journey/internal/i18n"
+	"journey/internal/mailer/mailpit"
+	"journey/internal/pgstore"
+	"net/http"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// maxParticipantsInt is the capacity of an update as stored, 0 lifting it.
+func maxParticipantsInt(maxParticipants int) pgtype.Int4 {
+	if maxParticipants == 0 {
+		return pgtype.Int4{}
+	}
+	return pgtype.Int4{Int32: int32(maxParticipants), Valid: true}
+}
+
+// maxParticipantsResponse is the stored capacity as answered, missing when unlimited.
+func maxParticipantsResponse(maxParticipants pgtype.Int4) *int {
+	if !maxParticipants.Valid {
+		return nil
+	}
+	parsed := int(maxParticipants.Int32)
+	return &parsed
+}
+
+// Promote a waitlisted participant of a trip.
+// (POST /trips/{tripId}/participants/{participantId}/promote)
+func (api *API) PostTripsTripIDParticipantsParticipantIDPromote(w http.ResponseWriter, r *http.Request, tripID string, participantID string) *spec.Response {
+	tripUUID := pathUUID(r, "tripId")
+	participantUUID := pathUUID(r, "participantId")
+
+	trip, err := api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		return spec.PostTripsTripIDParticipantsParticipantIDPromoteJSON404Response(api.notFound(r, i18n.TripNotFound))
+	}
+
+	if err := api.authorizeTripOwner(r, trip); err != nil {
+		if errors.Is(err, errMissingOwnerToken) {
+			return spec.PostTripsTripIDParticipantsParticipantIDPromoteJSON401Response(api.unauthorized(r, i18n.MissingOwnerToken))
+		}
+		return spec.PostTripsTripIDParticipantsParticipantIDPromoteJSON403Response(api.forbidden(r, refusedOwnerTokenKey(err)))
+	}
+
+	if isTripClosed(trip) {
+		return spec.PostTripsTripIDParticipantsParticipantIDPromoteJSON409Response(api.conflict(r, i18n.TripClosed))
+	}
+
+	participant, err := api.store.GetParticipantForTrip(r.Context(), pgstore.GetParticipantForTripParams{
+		ID:     participantUUID,
+		TripID: tripUUID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return spec.PostTripsTripIDParticipantsParticipantIDPromoteJSON404Response(api.notFound(r, i18n.ParticipantNotFound))
+	}
+	if err != nil {
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed on route: '%v: %v'", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripID),
+			zap.String("participantID", participantID),
+		)
+		return spec.PostTripsTripIDParticipantsParticipantIDPromoteJSON500Response(api.internalServerError(r, i18n.UnableToPromoteParticipant))
+	}
+
+	if !participant.IsWaitlisted {
+		return spec.PostTripsTripIDParticipantsParticipantIDPromoteJSON400Response(api.badRequest(r, i18n.ParticipantNotWaitlisted))
+	}
+
+	// Only a participant still waitlisted is promoted, one promoted meanwhile by another request is left as is.
+	promoted, err := api.store.PromoteWaitlistedParticipant(r.Context(), api.pool, pgstore.PromoteParticipantParams{
+		ID:     participant.ID,
+		TripID: tripUUID,
+	})
+	if errors.Is(err, pgstore.ErrTripFull) {
+		return spec.PostTripsTripIDParticipantsParticipantIDPromoteJSON409Response(api.conflict(r, i18n.TripFull, trip.MaxParticipants.Int32))
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return spec.PostTripsTripIDParticipantsParticipantIDPromoteJSON404Response(api.notFound(r, i18n.TripNotFound))
+	}
+	if err != nil {
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed on route: '%v: %v'", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripID),
+			zap.String("participantID", participantID),
+		)
+		return spec.PostTripsTripIDParticipantsParticipantIDPromoteJSON500Response(api.internalServerError(r, i18n.UnableToPromoteParticipant))
+	}
+	if promoted == 0 {
+		return spec.PostTripsTripIDParticipantsParticipantIDPromoteJSON400Response(api.badRequest(r, i18n.ParticipantNotWaitlisted))
+	}
+
+	// Off the waitlist, the participant is sent the invite it was held from.
+	dataToSendInvite := mailpit.SendInviteToParticipants{
+		Trip: trip,
+		Invites: []mailpit.InviteParticipantsToTrip{{
+			TripID: tripUUID,
+			Participant: mailpit.Participant{
+				ParticipantId: participant.ID,
+				Email:         participant.Email,
+				Name:          participant.Name.String,
+			},
+		}},
+		Locale: i18n.FromRequest(r),
+	}
+
+	sendEmail := func() error { return api.mailer.SendConfirmTripEmailToParticipants(dataToSendInvite) }
+	if err := api.dispatcher.Enqueue(r.Context(), "PostTripsTripIDParticipantsParticipantIDPromote", sendEmail, zap.String("tripID", tripID)); err != nil {
+		api.loggerFor(r.Context()).Error(
+			"failed to enqueue email on PostTripsTripIDParticipantsParticipantIDPromote",
+			zap.Error(err),
+			zap.String("tripID", tripID),
+			zap.String("participantID", participantID),
+		)
+	}
+
+	return spec.PostTripsTripIDParticipantsParticipantIDPromoteJSON204Response(nil)
+}
@@ -0,0 +1,281 @@
+package api
+
+import (
+	"journey/internal/api/spec"
+	"journey/internal/pgstore"
+	"net/http"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// newLimitedTrip is a test trip taking up to maxParticipants participants.
+func newLimitedTrip(maxParticipants int32) pgstore.Trip {
+	trip := newTestTrip(3)
+	trip.MaxParticipants = pgtype.Int4{Int32: maxParticipants, Valid: true}
+	return trip
+}
+
+// addWaitlisted adds a participant invited past the trip capacity.
+func (s *fakeStore) addWaitlisted(tripID uuid.UUID, email string) pgstore.Participant {
+	participant := s.addParticipant(tripID, email)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	participant.IsWaitlisted = true
+	s.participants[participant.ID] = participant
+	return participant
+}
+
+func TestTripMaxParticipants(t *testing.T) {
+	api := newTestAPI(newFakeStore(), &fakeMailer{})
+	startsAt := testNow.Add(time.Hour)
+
+	body := newCreateTripBody("owner@trip.com", startsAt, startsAt.AddDate(0, 0, 3))
+	body["max_participants"] = 4
+	created := createTrip(t, api, body)
+
+	if trip := tripDetails(t, api, created.TripID); trip.MaxParticipants == nil || *trip.MaxParticipants != 4 {
+		t.Fatalf("expected the trip limited to 4 participants, got %v", trip.MaxParticipants)
+	}
+
+	update := func(t *testing.T, body map[string]any) {
+		t.Helper()
+		body["destination"], body["starts_at"], body["ends_at"] = "Florianópolis", startsAt, startsAt.AddDate(0, 0, 3)
+		r := withOwnerToken(newRequest(t, http.MethodPut, "/trips/"+created.TripID, body), created.OwnerToken)
+		assertStatus(t, serve(api, r), http.StatusNoContent)
+	}
+
+	t.Run("kept when omitted", func(t *testing.T) {
+		update(t, map[string]any{})
+
+		if trip := tripDetails(t, api, created.TripID); trip.MaxParticipants == nil || *trip.MaxParticipants != 4 {
+			t.Fatalf("expected the limit kept, got %v", trip.MaxParticipants)
+		}
+	})
+
+	t.Run("lifted when 0", func(t *testing.T) {
+		update(t, map[string]any{"max_participants": 0})
+
+		if trip := tripDetails(t, api, created.TripID); trip.MaxParticipants != nil {
+			t.Fatalf("expected the limit lifted, got %v", *trip.MaxParticipants)
+		}
+	})
+
+	t.Run("refused when negative", func(t *testing.T) {
+		body := map[string]any{"destination": "Florianópolis", "starts_at": startsAt, "ends_at": startsAt.AddDate(0, 0, 3), "max_participants": -1}
+		r := withOwnerToken(newRequest(t, http.MethodPut, "/trips/"+created.TripID, body), created.OwnerToken)
+		assertStatus(t, serve(api, r), http.StatusBadRequest)
+	})
+}
+
+func TestPostTripsTripIDInvitesWaitlistsPastCapacity(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newLimitedTrip(2))
+	store.addParticipant(trip.ID, "seated@example.com")
+	store.addWaitlisted(trip.ID, "waiting@example.com")
+	mailer := &fakeMailer{}
+	api := newTestAPI(store, mailer)
+
+	r := newRequest(t, http.MethodPost, "/trips/"+trip.ID.String()+"/invites", map[string]any{
+		"emails": []string{"zoe@example.com", "ana@example.com", "bia@example.com"},
+	})
+	w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+	assertStatus(t, w, http.StatusCreated)
+	var created spec.InviteParticipantResponse
+	decodeResponse(t, w, &created)
+	if len(created.Participants) != 3 || created.Participants[0].IsWaitlisted || !created.Participants[1].IsWaitlisted || !created.Participants[2].IsWaitlisted {
+		t.Fatalf("expected only the first email seated, got %+v", created.Participants)
+	}
+
+	if len(mailer.invites) != 1 || len(mailer.invites[0].Invites) != 1 || mailer.invites[0].Invites[0].Participant.Email != "zoe@example.com" {
+		t.Fatalf("expected only the seated participant sent its invitation, got %+v", mailer.invites)
+	}
+
+	t.Run("all of them waitlisted", func(t *testing.T) {
+		mailer.invites = nil
+		r := newRequest(t, http.MethodPost, "/trips/"+trip.ID.String()+"/invites", map[string]any{"email": "late@example.com"})
+		w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+		assertStatus(t, w, http.StatusCreated)
+		var created spec.InviteParticipantResponse
+		decodeResponse(t, w, &created)
+		if !created.Participant.IsWaitlisted {
+			t.Fatalf("expected the participant waitlisted, got %+v", created.Participant)
+		}
+		if len(mailer.invites) != 0 {
+			t.Fatalf("expected no invitation sent, got %+v", mailer.invites)
+		}
+	})
+}
+
+func TestPostTripsTripIDParticipantsParticipantIDPromote(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newLimitedTrip(1))
+	seated := store.addParticipant(trip.ID, "seated@example.com")
+	waitlisted := store.addWaitlisted(trip.ID, "waiting@example.com")
+	mailer := &fakeMailer{}
+	api := newTestAPI(store, mailer)
+	promote := func() *http.Request {
+		r := newRequest(t, http.MethodPost, "/trips/"+trip.ID.String()+"/participants/"+waitlisted.ID.String()+"/promote", nil)
+		return withOwnerToken(r, TEST_OWNER_TOKEN)
+	}
+
+	w := serve(api, promote())
+	assertStatus(t, w, http.StatusConflict)
+	var response spec.ConflictRequest
+	decodeResponse(t, w, &response)
+	if response.Code != string(ErrorCodeTripFull) {
+		t.Fatalf("expected %s, got %s", ErrorCodeTripFull, response.Code)
+	}
+
+	// The seated participant declined, its seat is freed.
+	r := newRequest(t, http.MethodDelete, "/trips/"+trip.ID.String()+"/participants/"+seated.ID.String(), nil)
+	assertStatus(t, serve(api, withOwnerToken(r, TEST_OWNER_TOKEN)), http.StatusNoContent)
+
+	assertStatus(t, serve(api, promote()), http.StatusNoContent)
+
+	if store.participant(waitlisted.ID).IsWaitlisted {
+		t.Fatal("expected the participant off the waitlist")
+	}
+	if len(mailer.invites) != 1 || len(mailer.invites[0].Invites) != 1 || mailer.invites[0].Invites[0].Participant.ParticipantId != waitlisted.ID {
+		t.Fatalf("expected the promoted participant sent its invitation, got %+v", mailer.invites)
+	}
+}
+
+func TestPostTripsTripIDParticipantsParticipantIDPromoteRefused(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newLimitedTrip(3))
+	closed := newLimitedTrip(3)
+	closed.Status = pgstore.TripStatusCancelled
+	store.addTrip(closed)
+	seated := store.addParticipant(trip.ID, "seated@example.com")
+	waitlisted := store.addWaitlisted(trip.ID, "waiting@example.com")
+	closedWaitlisted := store.addWaitlisted(closed.ID, "waiting@example.com")
+	mailer := &fakeMailer{}
+	api := newTestAPI(store, mailer)
+
+	cases := []struct {
+		name        string
+		trip        string
+		participant string
+		token       string
+		status      int
+		code        ErrorCode
+	}{
+		{"missing trip", uuid.NewString(), waitlisted.ID.String(), TEST_OWNER_TOKEN, http.StatusNotFound, ErrorCodeTripNotFound},
+		{"missing participant", trip.ID.String(), uuid.NewString(), TEST_OWNER_TOKEN, http.StatusNotFound, ErrorCodeParticipantNotFound},
+		{"participant of another trip", trip.ID.String(), closedWaitlisted.ID.String(), TEST_OWNER_TOKEN, http.StatusNotFound, ErrorCodeParticipantNotFound},
+		{"without the owner token", trip.ID.String(), waitlisted.ID.String(), "", http.StatusUnauthorized, ErrorCodeMissingOwnerToken},
+		{"with a wrong token", trip.ID.String(), waitlisted.ID.String(), "not-the-owner", http.StatusForbidden, ErrorCodeWrongOwnerToken},
+		{"closed trip", closed.ID.String(), closedWaitlisted.ID.String(), TEST_OWNER_TOKEN, http.StatusConflict, ErrorCodeTripClosed},
+		{"participant not waitlisted", trip.ID.String(), seated.ID.String(), TEST_OWNER_TOKEN, http.StatusBadRequest, ErrorCodeParticipantNotWaitlisted},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			r := newRequest(t, http.MethodPost, "/trips/"+c.trip+"/participants/"+c.participant+"/promote", nil)
+			if c.token != "" {
+				r = withOwnerToken(r, c.token)
+			}
+			w := serve(api, r)
+
+			assertStatus(t, w, c.status)
+			var response spec.BadRequest
+			decodeResponse(t, w, &response)
+			if response.Code != string(c.code) {
+				t.Fatalf("expected %s, got %s", c.code, response.Code)
+			}
+		})
+	}
+	if calls := store.callsOf("PromoteWaitlistedParticipant"); calls != 0 {
+		t.Fatalf("expected no participant promoted, got %d calls", calls)
+	}
+	if len(mailer.invites) != 0 {
+		t.Fatalf("expected no invitation sent, got %+v", mailer.invites)
+	}
+}
+
+func TestWaitlistedParticipantIsNotInvited(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newLimitedTrip(1))
+	seated := store.addParticipant(trip.ID, "seated@example.com")
+	waitlisted := store.addWaitlisted(trip.ID, "waiting@example.com")
+	mailer := &fakeMailer{}
+	api := newTestAPI(store, mailer)
+
+	t.Run("confirming the trip", func(t *testing.T) {
+		assertStatus(t, serve(api, newRequest(t, http.MethodPatch, "/trips/"+trip.ID.String()+"/confirm", nil)), http.StatusNoContent)
+
+		if len(mailer.invites) != 1 || len(mailer.invites[0].Invites) != 1 || mailer.invites[0].Invites[0].Participant.ParticipantId != seated.ID {
+			t.Fatalf("expected only the seated participant invited, got %+v", mailer.invites)
+		}
+	})
+
+	refused := map[string]*http.Request{
+		"confirming itself": newRequest(t, http.MethodPatch, "/participants/"+waitlisted.ID.String()+"/confirm", nil),
+		"resending the invite": withOwnerToken(
+			newRequest(t, http.MethodPost, "/participants/"+waitlisted.ID.String()+"/resend-invite", nil), TEST_OWNER_TOKEN,
+		),
+	}
+	for name, r := range refused {
+		t.Run(name, func(t *testing.T) {
+			w := serve(api, r)
+
+			assertStatus(t, w, http.StatusBadRequest)
+			var response spec.BadRequest
+			decodeResponse(t, w, &response)
+			if response.Code != string(ErrorCodeParticipantWaitlisted) {
+				t.Fatalf("expected %s, got %s", ErrorCodeParticipantWaitlisted, response.Code)
+			}
+		})
+	}
+
+	t.Run("correcting its email", func(t *testing.T) {
+		mailer.invites = nil
+		r := newRequest(t, http.MethodPut, "/trips/"+trip.ID.String()+"/participants/"+waitlisted.ID.String(), map[string]any{"email": "fixed@example.com"})
+		assertStatus(t, serve(api, withOwnerToken(r, TEST_OWNER_TOKEN)), http.StatusNoContent)
+
+		if store.participant(waitlisted.ID).Email != "fixed@example.com" {
+			t.Fatal("expected the email corrected")
+		}
+		if len(mailer.invites) != 0 {
+			t.Fatalf("expected no invitation sent, got %+v", mailer.invites)
+		}
+	})
+
+	if store.participant(waitlisted.ID).IsConfirmed {
+		t.Fatal("expected the waitlisted participant not confirmed")
+	}
+}
+
+func TestConcurrentPromotionsTakeTheLastSeatOnce(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newLimitedTrip(1))
+	waitlisted := []pgstore.Participant{
+		store.addWaitlisted(trip.ID, "ana@example.com"),
+		store.addWaitlisted(trip.ID, "bia@example.com"),
+	}
+	api := newTestAPI(store, &fakeMailer{})
+
+	statuses := make([]int, len(waitlisted))
+	var wg sync.WaitGroup
+	for i, participant := range waitlisted {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r := newRequest(t, http.MethodPost, "/trips/"+trip.ID.String()+"/participants/"+participant.ID.String()+"/promote", nil)
+			statuses[i] = serve(api, withOwnerToken(r, TEST_OWNER_TOKEN)).Code
+		}()
+	}
+	wg.Wait()
+
+	slices.Sort(statuses)
+	if statuses[0] != http.StatusNoContent || statuses[1] != http.StatusConflict {
+		t.Fatalf("expected one participant promoted and the other refused, got %v", statuses)
+	}
+}
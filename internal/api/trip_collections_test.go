@@ -0,0 +1,41 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestGetTripCollectionsInSingleStoreCall(t *testing.T) {
+	for _, collection := range []string{"participants", "participants.csv", "activities", "activities.ics", "links"} {
+		t.Run(collection, func(t *testing.T) {
+			store := newFakeStore()
+			trip := store.addTrip(newTestTrip(3))
+			store.addParticipant(trip.ID, "guest@example.com")
+			store.addActivity(trip.ID, "Museum", trip.StartsAt.Time.Add(2*time.Hour))
+			store.addLink(trip.ID, "Hotel", "https://example.com/hotel")
+			api := newTestAPI(store, &fakeMailer{})
+
+			w := serve(api, newRequest(t, http.MethodGet, "/trips/"+trip.ID.String()+"/"+collection, nil))
+
+			assertStatus(t, w, http.StatusOK)
+			if calls := store.totalCalls(); calls != 1 {
+				t.Fatalf("expected a single store call, got %d", calls)
+			}
+		})
+
+		t.Run(collection+" not found", func(t *testing.T) {
+			store := newFakeStore()
+			api := newTestAPI(store, &fakeMailer{})
+
+			w := serve(api, newRequest(t, http.MethodGet, "/trips/"+uuid.NewString()+"/"+collection, nil))
+
+			assertStatus(t, w, http.StatusNotFound)
+			if calls := store.totalCalls(); calls != 1 {
+				t.Fatalf("expected a single store call, got %d", calls)
+			}
+		})
+	}
+}
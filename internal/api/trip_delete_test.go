@@ -0,0 +1,158 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestDeleteTripsTripID(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	store.addParticipant(trip.ID, "guest@example.com")
+	store.addActivity(trip.ID, "Museum", trip.StartsAt.Time.Add(time.Hour))
+	store.addLink(trip.ID, "Booking", "https://booking.com/reservation")
+	other := store.addTrip(newTestTrip(3))
+	kept := store.addParticipant(other.ID, "guest@example.com")
+	api := newTestAPI(store, &fakeMailer{})
+
+	r := newRequest(t, http.MethodDelete, "/trips/"+trip.ID.String(), nil)
+	assertStatus(t, serve(api, withOwnerToken(r, TEST_OWNER_TOKEN)), http.StatusNoContent)
+
+	if _, found := store.trips[trip.ID]; found {
+		t.Fatal("expected the trip deleted")
+	}
+	if deleted := store.deletedTrips[trip.ID]; !deleted.DeletedAt.Valid {
+		t.Fatalf("expected the trip soft deleted, got %+v", deleted)
+	}
+	if len(store.activities) != 0 || len(store.links) != 0 {
+		t.Fatalf("expected the activities and links deleted, got %d and %d", len(store.activities), len(store.links))
+	}
+	if len(store.deletedActivities) != 1 || len(store.deletedLinks) != 1 {
+		t.Fatalf("expected the activities and links soft deleted, got %d and %d", len(store.deletedActivities), len(store.deletedLinks))
+	}
+	if len(store.participants) != 2 || store.participants[kept.ID].ID != kept.ID {
+		t.Fatalf("expected the participants kept, got %+v", store.participants)
+	}
+	if calls := store.callsOf("DeleteTrip"); calls != 1 {
+		t.Fatalf("expected the trip deleted in a single transaction, got %d", calls)
+	}
+
+	t.Run("is then missing", func(t *testing.T) {
+		assertStatus(t, serve(api, newRequest(t, http.MethodGet, "/trips/"+trip.ID.String(), nil)), http.StatusNotFound)
+
+		r := newRequest(t, http.MethodDelete, "/trips/"+trip.ID.String(), nil)
+		assertStatus(t, serve(api, withOwnerToken(r, TEST_OWNER_TOKEN)), http.StatusNotFound)
+	})
+}
+
+func TestDeleteTripsTripIDRefused(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	api := newTestAPI(store, &fakeMailer{})
+
+	cases := []struct {
+		name   string
+		tripID string
+		token  string
+		status int
+	}{
+		{"missing trip", uuid.NewString(), TEST_OWNER_TOKEN, http.StatusNotFound},
+		{"without the owner token", trip.ID.String(), "", http.StatusUnauthorized},
+		{"with a wrong token", trip.ID.String(), "not-the-owner", http.StatusForbidden},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			r := newRequest(t, http.MethodDelete, "/trips/"+c.tripID, nil)
+			if c.token != "" {
+				r = withOwnerToken(r, c.token)
+			}
+
+			assertStatus(t, serve(api, r), c.status)
+		})
+	}
+	if _, found := store.trips[trip.ID]; !found {
+		t.Fatal("expected the trip kept")
+	}
+	if calls := store.callsOf("DeleteTrip"); calls != 0 {
+		t.Fatalf("expected no trip deleted, got %d deletes", calls)
+	}
+}
+
+func TestPostTripsTripIDRestore(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	guest := store.addParticipant(trip.ID, "guest@example.com")
+	activity := store.addActivity(trip.ID, "Museum", trip.StartsAt.Time.Add(time.Hour))
+	link := store.addLink(trip.ID, "Booking", "https://booking.com/reservation")
+	api := newTestAPI(store, &fakeMailer{})
+	target := "/trips/" + trip.ID.String()
+
+	r := newRequest(t, http.MethodDelete, target, nil)
+	assertStatus(t, serve(api, withOwnerToken(r, TEST_OWNER_TOKEN)), http.StatusNoContent)
+	assertStatus(t, serve(api, newRequest(t, http.MethodPatch, "/participants/"+guest.ID.String()+"/confirm", nil)), http.StatusNotFound)
+
+	r = newRequest(t, http.MethodPost, target+"/restore", nil)
+	assertStatus(t, serve(api, withOwnerToken(r, TEST_OWNER_TOKEN)), http.StatusNoContent)
+
+	assertStatus(t, serve(api, newRequest(t, http.MethodGet, target, nil)), http.StatusOK)
+	if restored := store.trip(trip.ID); restored.DeletedAt.Valid {
+		t.Fatalf("expected the trip restored, got %+v", restored)
+	}
+	if restored := store.activity(activity.ID); restored.ID != activity.ID || restored.DeletedAt.Valid {
+		t.Fatalf("expected the activity restored, got %+v", restored)
+	}
+	if _, found := store.links[link.ID]; !found || len(store.deletedLinks) != 0 {
+		t.Fatalf("expected the link restored, got %d soft deleted", len(store.deletedLinks))
+	}
+	if calls := store.callsOf("RestoreTrip"); calls != 1 {
+		t.Fatalf("expected the trip restored in a single transaction, got %d", calls)
+	}
+
+	t.Run("is then not deleted", func(t *testing.T) {
+		r := newRequest(t, http.MethodPost, target+"/restore", nil)
+		assertStatus(t, serve(api, withOwnerToken(r, TEST_OWNER_TOKEN)), http.StatusNotFound)
+	})
+}
+
+func TestPostTripsTripIDRestoreRefused(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	live := store.addTrip(newTestTrip(3))
+	api := newTestAPI(store, &fakeMailer{})
+
+	r := newRequest(t, http.MethodDelete, "/trips/"+trip.ID.String(), nil)
+	assertStatus(t, serve(api, withOwnerToken(r, TEST_OWNER_TOKEN)), http.StatusNoContent)
+
+	cases := []struct {
+		name   string
+		tripID string
+		token  string
+		status int
+	}{
+		{"missing trip", uuid.NewString(), TEST_OWNER_TOKEN, http.StatusNotFound},
+		{"trip not deleted", live.ID.String(), TEST_OWNER_TOKEN, http.StatusNotFound},
+		{"without the owner token", trip.ID.String(), "", http.StatusUnauthorized},
+		{"with a wrong token", trip.ID.String(), "not-the-owner", http.StatusForbidden},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			r := newRequest(t, http.MethodPost, "/trips/"+c.tripID+"/restore", nil)
+			if c.token != "" {
+				r = withOwnerToken(r, c.token)
+			}
+
+			assertStatus(t, serve(api, r), c.status)
+		})
+	}
+	if _, found := store.deletedTrips[trip.ID]; !found {
+		t.Fatal("expected the trip kept deleted")
+	}
+	if calls := store.callsOf("RestoreTrip"); calls != 0 {
+		t.Fatalf("expected no trip restored, got %d restores", calls)
+	}
+}
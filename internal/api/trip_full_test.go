@@ -0,0 +1,57 @@
+package api
+
+import (
+	"encoding/json"
+	"journey/internal/api/spec"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestGetTripsTripIDFull(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	activity := store.addActivity(trip.ID, "Museum", trip.StartsAt.Time.Add(2*time.Hour))
+	link := store.addLink(trip.ID, "Booking", "https://example.com/booking")
+	participant := store.addParticipant(trip.ID, "guest@example.com")
+	api := newTestAPI(store, &fakeMailer{})
+
+	w := serve(api, newRequest(t, http.MethodGet, "/trips/"+trip.ID.String()+"/full", nil))
+
+	assertStatus(t, w, http.StatusOK)
+	var response spec.GetTripFullResponse
+	decodeResponse(t, w, &response)
+	if response.Trip.ID != trip.ID.String() || response.Trip.Destination != trip.Destination {
+		t.Fatalf("expected the trip details, got %+v", response.Trip)
+	}
+	if len(response.Activities) != 3 || len(response.Activities[0].Activities) != 1 || response.Activities[0].Activities[0].ID != activity.ID.String() {
+		t.Fatalf("expected the activity on the first of the 3 trip days, got %+v", response.Activities)
+	}
+	if len(response.Links) != 1 || response.Links[0].ID != link.ID.String() {
+		t.Fatalf("expected the trip link, got %+v", response.Links)
+	}
+	if len(response.Participants) != 1 || response.Participants[0].ID != participant.ID.String() {
+		t.Fatalf("expected the trip participant, got %+v", response.Participants)
+	}
+}
+
+func TestGetTripsTripIDFullMissingTrip(t *testing.T) {
+	api := newTestAPI(newFakeStore(), &fakeMailer{})
+
+	w := serve(api, newRequest(t, http.MethodGet, "/trips/"+uuid.NewString()+"/full", nil))
+
+	assertStatus(t, w, http.StatusNotFound)
+	decoder := json.NewDecoder(w.Body)
+	var response spec.NotFoundRequest
+	if err := decoder.Decode(&response); err != nil {
+		t.Fatal(err)
+	}
+	if response.Code != string(ErrorCodeTripNotFound) {
+		t.Fatalf("expected %s, got %s", ErrorCodeTripNotFound, response.Code)
+	}
+	if decoder.More() {
+		t.Fatal("expected a single 404 answered for the missing trip")
+	}
+}
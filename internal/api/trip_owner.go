@@ -0,0 +1,127 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"journey/internal/api/spec"
+	"journey/internal/i18n"
+	"journey/internal/pgstore"
+	"net/http"
+
+	"go.uber.org/zap"
+)
+
+// Transfer a trip to another owner.
+// (PATCH /trips/{tripId}/owner)
+func (api *API) PatchTripsTripIDOwner(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID := pathUUID(r, "tripId")
+
+	trip, err := api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		return spec.PatchTripsTripIDOwnerJSON404Response(api.notFound(r, i18n.TripNotFound))
+	}
+
+	if err := api.authorizeTripOwner(r, trip); err != nil {
+		if errors.Is(err, errMissingOwnerToken) {
+			return spec.PatchTripsTripIDOwnerJSON401Response(api.unauthorized(r, i18n.MissingOwnerToken))
+		}
+		return spec.PatchTripsTripIDOwnerJSON403Response(api.forbidden(r, refusedOwnerTokenKey(err)))
+	}
+
+	if isTripClosed(trip) {
+		return spec.PatchTripsTripIDOwnerJSON409Response(api.conflict(r, i18n.TripClosed))
+	}
+
+	var body spec.PatchTripsTripIDOwnerJSONRequestBody
+	if err := decodeBody(r, &body); err != nil {
+		return spec.PatchTripsTripIDOwnerJSON400Response(api.badRequest(r, i18n.InvalidRequest, err.Error()))
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PatchTripsTripIDOwnerJSON400Response(api.invalidFieldsRequest(r, err))
+	}
+
+	if string(body.OwnerEmail) == normalizeEmail(trip.OwnerEmail) {
+		return spec.PatchTripsTripIDOwnerJSON400Response(api.badRequest(r, i18n.AlreadyTheTripOwner, body.OwnerEmail))
+	}
+
+	// the token of the previous owner is replaced, so it no longer changes the trip.
+	ownerToken, ownerTokenHash, err := generateOwnerToken()
+	if err != nil {
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed route: '%v: %v' when transferring a trip: ", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripID),
+		)
+		return spec.PatchTripsTripIDOwnerJSON500Response(api.internalServerError(r, i18n.UnableToUpdateTrip))
+	}
+
+	params := pgstore.TransferTripOwnerParams{
+		Owner: pgstore.UpdateTripOwnerParams{
+			OwnerEmail:     string(body.OwnerEmail),
+			OwnerName:      body.OwnerName,
+			OwnerTokenHash: ownerTokenHash,
+			ID:             tripUUID,
+		},
+	}
+	if body.DemotePreviousOwner != nil && *body.DemotePreviousOwner {
+		params.PreviousOwnerEmail = normalizeEmail(trip.OwnerEmail)
+	}
+
+	err = api.store.TransferTripOwner(r.Context(), api.pool, params)
+	if errors.Is(err, pgstore.ErrTripFull) {
+		return spec.PatchTripsTripIDOwnerJSON409Response(api.conflict(r, i18n.TripFull, trip.MaxParticipants.Int32))
+	}
+	if err != nil {
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed route: '%v: %v' when transferring a trip: ", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripID),
+		)
+		return spec.PatchTripsTripIDOwnerJSON500Response(api.internalServerError(r, i18n.UnableToUpdateTrip))
+	}
+
+	return spec.PatchTripsTripIDOwnerJSON200Response(spec.TransferTripOwnerResponse{
+		OwnerToken: api.issueOwnerToken(r.Context(), tripUUID, string(body.OwnerEmail), ownerToken),
+	})
+}
+
+// Issue a new owner token of a trip.
+// (POST /admin/trips/{tripId}/owner-token)
+func (api *API) PostAdminTripsTripIDOwnerToken(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	if err := api.authorizeAdmin(r); err != nil {
+		if errors.Is(err, errMissingAdminToken) {
+			return spec.PostAdminTripsTripIDOwnerTokenJSON401Response(api.unauthorized(r, i18n.MissingAdminToken))
+		}
+		return spec.PostAdminTripsTripIDOwnerTokenJSON403Response(api.forbidden(r, i18n.WrongAdminToken))
+	}
+
+	tripUUID := pathUUID(r, "tripId")
+
+	trip, err := api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		return spec.PostAdminTripsTripIDOwnerTokenJSON404Response(api.notFound(r, i18n.TripNotFound))
+	}
+
+	// the opaque token is replaced even when the JWTs are answered, so a leaked one is revoked along the way and
+	// the trips created before the owner tokens, which have none, get one.
+	ownerToken, ownerTokenHash, err := generateOwnerToken()
+	if err == nil {
+		err = api.store.UpdateTripOwnerTokenHash(r.Context(), pgstore.UpdateTripOwnerTokenHashParams{
+			OwnerTokenHash: ownerTokenHash,
+			ID:             trip.ID,
+		})
+	}
+	if err != nil {
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed route: '%v: %v' when issuing an owner token: ", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripID),
+		)
+		return spec.PostAdminTripsTripIDOwnerTokenJSON500Response(api.internalServerError(r, i18n.UnexpectedError))
+	}
+
+	return spec.PostAdminTripsTripIDOwnerTokenJSON200Response(spec.ReissueOwnerTokenResponse{
+		OwnerToken: api.issueOwnerToken(r.Context(), trip.ID, normalizeEmail(trip.OwnerEmail), ownerToken),
+	})
+}
@@ -0,0 +1,298 @@
+package api
+
+import (
+	"journey/internal/api/spec"
+	"journey/internal/jwt"
+	"journey/internal/pgstore"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// participantsWithEmail answers the participants of the trip with the email.
+func participantsWithEmail(store *fakeStore, tripID uuid.UUID, email string) []pgstore.Participant {
+	var participants []pgstore.Participant
+	for _, participant := range store.participants {
+		if participant.TripID == tripID && participant.Email == email {
+			participants = append(participants, participant)
+		}
+	}
+	return participants
+}
+
+func TestPatchTripsTripIDOwner(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	api := newTestAPI(store, &fakeMailer{})
+	target := "/trips/" + trip.ID.String() + "/owner"
+
+	r := newRequest(t, http.MethodPatch, target, map[string]any{"owner_email": " New.Owner@Example.com ", "owner_name": "New Owner"})
+	w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+	assertStatus(t, w, http.StatusOK)
+	var response spec.TransferTripOwnerResponse
+	decodeResponse(t, w, &response)
+	if response.OwnerToken == "" || response.OwnerToken == TEST_OWNER_TOKEN {
+		t.Fatalf("expected a new owner token, got %q", response.OwnerToken)
+	}
+	updated := store.trips[trip.ID]
+	if updated.OwnerEmail != "new.owner@example.com" || updated.OwnerName != "New Owner" {
+		t.Fatalf("expected the trip owned by new.owner@example.com, got %s (%s)", updated.OwnerEmail, updated.OwnerName)
+	}
+	if participants := participantsWithEmail(store, trip.ID, "new.owner@example.com"); len(participants) != 1 || !participants[0].IsConfirmed {
+		t.Fatalf("expected the new owner confirmed on the trip, got %+v", participants)
+	}
+	if participants := participantsWithEmail(store, trip.ID, "owner@example.com"); len(participants) != 0 {
+		t.Fatalf("expected the previous owner off the trip, got %+v", participants)
+	}
+
+	t.Run("the previous token no longer works", func(t *testing.T) {
+		r := newRequest(t, http.MethodPatch, "/trips/"+trip.ID.String()+"/status", map[string]string{"status": "cancelled"})
+
+		assertStatus(t, serve(api, withOwnerToken(r, TEST_OWNER_TOKEN)), http.StatusForbidden)
+	})
+
+	t.Run("the new token changes the trip", func(t *testing.T) {
+		r := newRequest(t, http.MethodPatch, "/trips/"+trip.ID.String()+"/status", map[string]string{"status": "cancelled"})
+
+		assertStatus(t, serve(api, withOwnerToken(r, response.OwnerToken)), http.StatusNoContent)
+	})
+}
+
+func TestPatchTripsTripIDOwnerDemotesThePreviousOwner(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	api := newTestAPI(store, &fakeMailer{})
+
+	r := newRequest(t, http.MethodPatch, "/trips/"+trip.ID.String()+"/owner", map[string]any{
+		"owner_email":           "new.owner@example.com",
+		"owner_name":            "New Owner",
+		"demote_previous_owner": true,
+	})
+	assertStatus(t, serve(api, withOwnerToken(r, TEST_OWNER_TOKEN)), http.StatusOK)
+
+	if owner := store.trips[trip.ID].OwnerEmail; owner != "new.owner@example.com" {
+		t.Fatalf("expected the trip owned by new.owner@example.com, got %s", owner)
+	}
+	if participants := participantsWithEmail(store, trip.ID, "owner@example.com"); len(participants) != 1 || !participants[0].IsConfirmed {
+		t.Fatalf("expected the previous owner confirmed on the trip, got %+v", participants)
+	}
+	if calls := store.callsOf("TransferTripOwner"); calls != 1 {
+		t.Fatalf("expected the transfer in a single transaction, got %d", calls)
+	}
+}
+
+func TestPatchTripsTripIDOwnerAlreadyAParticipant(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	guest := store.addParticipant(trip.ID, "guest@example.com")
+	api := newTestAPI(store, &fakeMailer{})
+
+	r := newRequest(t, http.MethodPatch, "/trips/"+trip.ID.String()+"/owner", map[string]any{"owner_email": "Guest@example.com", "owner_name": "Guest"})
+	assertStatus(t, serve(api, withOwnerToken(r, TEST_OWNER_TOKEN)), http.StatusOK)
+
+	participants := participantsWithEmail(store, trip.ID, "guest@example.com")
+	if len(participants) != 1 || participants[0].ID != guest.ID {
+		t.Fatalf("expected the invited participant kept, not a new one, got %+v", participants)
+	}
+	if !participants[0].IsConfirmed {
+		t.Fatal("expected the new owner confirmed on the trip")
+	}
+	if len(store.participants) != 1 {
+		t.Fatalf("expected no other participant added, got %d", len(store.participants))
+	}
+}
+
+func TestPatchTripsTripIDOwnerWaitlisted(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newLimitedTrip(2))
+	store.addParticipant(trip.ID, "seated@example.com")
+	waitlisted := store.addWaitlisted(trip.ID, "waiting@example.com")
+	api := newTestAPI(store, &fakeMailer{})
+	transfer := func(t *testing.T, body map[string]any) *httptest.ResponseRecorder {
+		t.Helper()
+		r := newRequest(t, http.MethodPatch, "/trips/"+trip.ID.String()+"/owner", body)
+		return serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+	}
+
+	t.Run("refused when the previous owner takes the last seat", func(t *testing.T) {
+		w := transfer(t, map[string]any{"owner_email": "waiting@example.com", "owner_name": "Waiting", "demote_previous_owner": true})
+
+		assertStatus(t, w, http.StatusConflict)
+		var response spec.ConflictRequest
+		decodeResponse(t, w, &response)
+		if response.Code != string(ErrorCodeTripFull) {
+			t.Fatalf("expected %s, got %s", ErrorCodeTripFull, response.Code)
+		}
+		if owner := store.trip(trip.ID).OwnerEmail; owner != trip.OwnerEmail {
+			t.Fatalf("expected the trip still owned by %s, got %s", trip.OwnerEmail, owner)
+		}
+		if participant := store.participant(waitlisted.ID); participant.IsConfirmed || !participant.IsWaitlisted {
+			t.Fatalf("expected the participant left waitlisted, got %+v", participant)
+		}
+	})
+
+	t.Run("seated", func(t *testing.T) {
+		assertStatus(t, transfer(t, map[string]any{"owner_email": "waiting@example.com", "owner_name": "Waiting"}), http.StatusOK)
+
+		if participant := store.participant(waitlisted.ID); !participant.IsConfirmed || participant.IsWaitlisted {
+			t.Fatalf("expected the new owner confirmed off the waitlist, got %+v", participant)
+		}
+	})
+}
+
+func TestPatchTripsTripIDOwnerInvalid(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	api := newTestAPI(store, &fakeMailer{})
+	target := "/trips/" + trip.ID.String() + "/owner"
+
+	cases := map[string]any{
+		"missing email":     map[string]any{"owner_name": "New Owner"},
+		"not an email":      map[string]any{"owner_email": "new-owner", "owner_name": "New Owner"},
+		"missing name":      map[string]any{"owner_email": "new.owner@example.com"},
+		"blank name":        map[string]any{"owner_email": "new.owner@example.com", "owner_name": "   "},
+		"the current owner": map[string]any{"owner_email": "OWNER@example.com", "owner_name": "Owner"},
+		"not json":          "{",
+	}
+
+	for name, body := range cases {
+		t.Run(name, func(t *testing.T) {
+			w := serve(api, withOwnerToken(newRequest(t, http.MethodPatch, target, body), TEST_OWNER_TOKEN))
+
+			assertStatus(t, w, http.StatusBadRequest)
+		})
+	}
+	if calls := store.callsOf("TransferTripOwner"); calls != 0 {
+		t.Fatalf("expected the trip not transferred, got %d transfers", calls)
+	}
+	if owner := store.trips[trip.ID].OwnerEmail; owner != "owner@example.com" {
+		t.Fatalf("expected the trip still owned by owner@example.com, got %s", owner)
+	}
+}
+
+func TestPatchTripsTripIDOwnerRefused(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	cancelled := newTestTrip(3)
+	cancelled.Status = pgstore.TripStatusCancelled
+	store.addTrip(cancelled)
+	api := newTestAPI(store, &fakeMailer{})
+	body := map[string]any{"owner_email": "new.owner@example.com", "owner_name": "New Owner"}
+
+	cases := []struct {
+		name   string
+		tripID string
+		token  string
+		status int
+	}{
+		{"missing trip", uuid.NewString(), TEST_OWNER_TOKEN, http.StatusNotFound},
+		{"without the owner token", trip.ID.String(), "", http.StatusUnauthorized},
+		{"with a wrong token", trip.ID.String(), "not-the-owner", http.StatusForbidden},
+		{"closed trip", cancelled.ID.String(), TEST_OWNER_TOKEN, http.StatusConflict},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			r := newRequest(t, http.MethodPatch, "/trips/"+c.tripID+"/owner", body)
+			if c.token != "" {
+				r = withOwnerToken(r, c.token)
+			}
+
+			assertStatus(t, serve(api, r), c.status)
+		})
+	}
+	if calls := store.callsOf("TransferTripOwner"); calls != 0 {
+		t.Fatalf("expected no trip transferred, got %d transfers", calls)
+	}
+}
+
+// issueAdminOwnerToken answers the owner token the admin issues for the trip, failing the test unless issued.
+func issueAdminOwnerToken(t *testing.T, api *API, tripID uuid.UUID) string {
+	t.Helper()
+
+	r := newRequest(t, http.MethodPost, "/admin/trips/"+tripID.String()+"/owner-token", nil)
+	r.Header.Set("Authorization", "Bearer "+testAdminToken)
+	w := serve(api, r)
+	assertStatus(t, w, http.StatusOK)
+	var response spec.ReissueOwnerTokenResponse
+	decodeResponse(t, w, &response)
+	return response.OwnerToken
+}
+
+func TestPostAdminTripsTripIDOwnerToken(t *testing.T) {
+	store := newFakeStore()
+	// created before the owner tokens, the trip has no hash.
+	legacy := newTestTrip(3)
+	legacy.OwnerTokenHash = ""
+	store.addTrip(legacy)
+	api := newTestAPI(store, &fakeMailer{}, WithAdminToken(testAdminToken))
+
+	if status := renameTrip(t, api, tripDetails(t, api, legacy.ID.String()), TEST_OWNER_TOKEN); status != http.StatusForbidden {
+		t.Fatalf("expected the trip without a hash refused, got %d", status)
+	}
+
+	ownerToken := issueAdminOwnerToken(t, api, legacy.ID)
+	if status := renameTrip(t, api, tripDetails(t, api, legacy.ID.String()), ownerToken); status != http.StatusNoContent {
+		t.Fatalf("expected the trip changed with the issued token, got %d", status)
+	}
+
+	t.Run("the previous token refused", func(t *testing.T) {
+		trip := store.addTrip(newTestTrip(3))
+
+		issued := issueAdminOwnerToken(t, api, trip.ID)
+
+		if status := renameTrip(t, api, tripDetails(t, api, trip.ID.String()), TEST_OWNER_TOKEN); status != http.StatusForbidden {
+			t.Fatalf("expected the previous token refused, got %d", status)
+		}
+		if status := renameTrip(t, api, tripDetails(t, api, trip.ID.String()), issued); status != http.StatusNoContent {
+			t.Fatalf("expected the trip changed with the issued token, got %d", status)
+		}
+	})
+
+	t.Run("a JWT when enabled", func(t *testing.T) {
+		api := newTestAPI(store, &fakeMailer{}, WithAdminToken(testAdminToken), WithOwnerTokens(testOwnerTokens))
+
+		issued := issueAdminOwnerToken(t, api, legacy.ID)
+
+		if !jwt.LooksLikeJWT(issued) {
+			t.Fatalf("expected a JWT, got %s", issued)
+		}
+		if status := renameTrip(t, api, tripDetails(t, api, legacy.ID.String()), issued); status != http.StatusNoContent {
+			t.Fatalf("expected the trip changed with the JWT, got %d", status)
+		}
+	})
+}
+
+func TestPostAdminTripsTripIDOwnerTokenRefused(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	api := newTestAPI(store, &fakeMailer{}, WithAdminToken(testAdminToken))
+
+	cases := []struct {
+		name   string
+		tripID string
+		token  string
+		status int
+	}{
+		{"missing token", trip.ID.String(), "", http.StatusUnauthorized},
+		{"wrong token", trip.ID.String(), TEST_OWNER_TOKEN, http.StatusForbidden},
+		{"missing trip", uuid.NewString(), testAdminToken, http.StatusNotFound},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			r := newRequest(t, http.MethodPost, "/admin/trips/"+c.tripID+"/owner-token", nil)
+			if c.token != "" {
+				r.Header.Set("Authorization", "Bearer "+c.token)
+			}
+
+			assertStatus(t, serve(api, r), c.status)
+		})
+	}
+	if calls := store.callsOf("UpdateTripOwnerTokenHash"); calls != 0 {
+		t.Fatalf("expected no owner token replaced, got %d calls", calls)
+	}
+}
@@ -0,0 +1,73 @@
+package api
+
+import (
+	"journey/internal/api/spec"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestPostTripsBoundsTheTripDuration(t *testing.T) {
+	store := newFakeStore()
+	api := newTestAPI(store, &fakeMailer{}, WithMaxTripDays(30))
+	startsAt := testNow.Add(time.Hour)
+
+	t.Run("at the limit", func(t *testing.T) {
+		w := serve(api, newRequest(t, http.MethodPost, "/trips", newCreateTripBody("owner@example.com", startsAt, startsAt.AddDate(0, 0, 29))))
+
+		assertStatus(t, w, http.StatusCreated)
+	})
+
+	t.Run("a day over the limit", func(t *testing.T) {
+		w := serve(api, newRequest(t, http.MethodPost, "/trips", newCreateTripBody("owner@example.com", startsAt, startsAt.AddDate(0, 0, 30))))
+
+		assertStatus(t, w, http.StatusBadRequest)
+		var response spec.BadRequest
+		decodeResponse(t, w, &response)
+		if response.Code != string(ErrorCodeTripPeriodInvalid) {
+			t.Fatalf("expected the code %s, got %q", ErrorCodeTripPeriodInvalid, response.Code)
+		}
+	})
+
+	t.Run("starting too far ahead", func(t *testing.T) {
+		farAhead := testNow.AddDate(maxTripStartYearsAhead, 0, 1)
+		w := serve(api, newRequest(t, http.MethodPost, "/trips", newCreateTripBody("owner@example.com", farAhead, farAhead.AddDate(0, 0, 3))))
+
+		assertStatus(t, w, http.StatusBadRequest)
+	})
+
+	if store.callsOf("CreateTrip") != 1 {
+		t.Fatal("expected only the trip at the limit persisted")
+	}
+}
+
+func TestPutTripsTripIDBoundsTheTripDuration(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	api := newTestAPI(store, &fakeMailer{}, WithMaxTripDays(30))
+	startsAt := trip.StartsAt.Time
+
+	update := func(endsAt time.Time) *http.Request {
+		r := newRequest(t, http.MethodPut, "/trips/"+trip.ID.String(), map[string]any{
+			"destination": "Florianópolis",
+			"starts_at":   startsAt,
+			"ends_at":     endsAt,
+		})
+		return withOwnerToken(r, TEST_OWNER_TOKEN)
+	}
+
+	assertStatus(t, serve(api, update(startsAt.AddDate(0, 0, 30))), http.StatusBadRequest)
+	if store.callsOf("UpdateTrip") != 0 {
+		t.Fatal("expected the trip over the limit not updated")
+	}
+
+	assertStatus(t, serve(api, update(startsAt.AddDate(0, 0, 29))), http.StatusNoContent)
+
+	w := serve(api, newRequest(t, http.MethodGet, "/trips/"+trip.ID.String()+"/activities", nil))
+	assertStatus(t, w, http.StatusOK)
+	var response spec.GetTripActivitiesResponse
+	decodeResponse(t, w, &response)
+	if len(response.Activities) != 30 {
+		t.Fatalf("expected the activities grouped in at most 30 days, got %d", len(response.Activities))
+	}
+}
@@ -0,0 +1,110 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"journey/internal/api/spec"
+	"journey/internal/i18n"
+	"journey/internal/mailer/mailpit"
+	"journey/internal/pgstore"
+	"net/http"
+	"slices"
+
+	"go.uber.org/zap"
+)
+
+var errTripClosed = errors.New("trip cancelled or completed")
+
+// tripStatusTransitions is the statuses each status moves to. The cancelled and completed trips are final.
+var tripStatusTransitions = map[string][]string{
+	pgstore.TripStatusPlanning:  {pgstore.TripStatusConfirmed, pgstore.TripStatusCancelled},
+	pgstore.TripStatusConfirmed: {pgstore.TripStatusCompleted, pgstore.TripStatusCancelled},
+}
+
+func canMoveTripStatus(from, to string) bool {
+	return slices.Contains(tripStatusTransitions[from], to)
+}
+
+// isTripClosed tells whether the trip no longer accepts invites, activities or links.
+func isTripClosed(trip pgstore.Trip) bool {
+	return trip.Status == pgstore.TripStatusCancelled || trip.Status == pgstore.TripStatusCompleted
+}
+
+// Cancel a trip.
+// (PATCH /trips/{tripId}/cancel)
+func (api *API) PatchTripsTripIDCancel(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID := pathUUID(r, "tripId")
+
+	trip, err := api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		return spec.PatchTripsTripIDCancelJSON404Response(api.notFound(r, i18n.TripNotFound))
+	}
+
+	if err := api.authorizeTripOwner(r, trip); err != nil {
+		if errors.Is(err, errMissingOwnerToken) {
+			return spec.PatchTripsTripIDCancelJSON401Response(api.unauthorized(r, i18n.MissingOwnerToken))
+		}
+		return spec.PatchTripsTripIDCancelJSON403Response(api.forbidden(r, refusedOwnerTokenKey(err)))
+	}
+
+	// cancelled already, the participants were told then.
+	if trip.Status == pgstore.TripStatusCancelled {
+		return spec.PatchTripsTripIDCancelJSON204Response(nil)
+	}
+
+	if !canMoveTripStatus(trip.Status, pgstore.TripStatusCancelled) {
+		return spec.PatchTripsTripIDCancelJSON409Response(api.conflict(r, i18n.InvalidTripStatusTransition, trip.Status, pgstore.TripStatusCancelled))
+	}
+
+	if err := api.cancelTrip(r.Context(), trip, i18n.FromRequest(r)); err != nil {
+		api.loggerFor(r.Context()).Error(
+			fmt.Sprintf("failed route: '%v: %v' when cancelling a trip: ", r.URL.RawPath, r.URL.Path),
+			zap.Error(err),
+			zap.String("tripID", tripID),
+		)
+
+		return spec.PatchTripsTripIDCancelJSON500Response(api.internalServerError(r, i18n.UnableToUpdateTrip))
+	}
+
+	return spec.PatchTripsTripIDCancelJSON204Response(nil)
+}
+
+// cancelTrip moves the trip to cancelled and enqueues the email telling its confirmed participants, written in
+// the locale. The participants who never confirmed were not expecting the trip, they are left alone.
+func (api *API) cancelTrip(ctx context.Context, trip pgstore.Trip, locale i18n.Locale) error {
+	if err := api.store.UpdateTripStatus(ctx, pgstore.UpdateTripStatusParams{Status: pgstore.TripStatusCancelled, ID: trip.ID}); err != nil {
+		return fmt.Errorf("unable to cancel trip: %w", err)
+	}
+
+	participants, err := api.store.GetParticipants(ctx, trip.ID)
+	if err != nil {
+		return fmt.Errorf("unable to get participants to tell: %w", err)
+	}
+
+	confirmed := api.filterParticipants(participants, func(participant pgstore.Participant) bool {
+		return participant.IsConfirmed
+	})
+	if len(confirmed) == 0 {
+		return nil
+	}
+
+	cancellation := mailpit.SendTripCancellation{Trip: trip, Locale: locale}
+	for _, participant := range confirmed {
+		cancellation.Participants = append(cancellation.Participants, mailpit.Participant{
+			Email:         participant.Email,
+			ParticipantId: participant.ID,
+		})
+	}
+
+	sendEmail := func() error { return api.mailer.SendTripCancellationToParticipants(cancellation) }
+	if err := api.dispatcher.Enqueue(ctx, "cancelTrip", sendEmail, zap.String("tripID", trip.ID.String())); err != nil {
+		api.loggerFor(ctx).Error(
+			"failed to enqueue email on cancelTrip",
+			zap.Error(err),
+			zap.String("tripID", trip.ID.String()),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,235 @@
+package api
+
+import (
+	"context"
+	"journey/internal/api/spec"
+	"journey/internal/pgstore"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestPatchTripsTripIDStatus(t *testing.T) {
+	t.Run("planning to confirmed", func(t *testing.T) {
+		store := newFakeStore()
+		trip := store.addTrip(newTestTrip(3))
+		store.addParticipant(trip.ID, "guest@example.com")
+		mailer := &fakeMailer{}
+		api := newTestAPI(store, mailer)
+
+		r := newRequest(t, http.MethodPatch, "/trips/"+trip.ID.String()+"/status", map[string]string{"status": "confirmed"})
+		w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+		assertStatus(t, w, http.StatusNoContent)
+		if updated := store.trips[trip.ID]; updated.Status != pgstore.TripStatusConfirmed || !updated.IsConfirmed {
+			t.Fatalf("expected the trip confirmed, got status %q is_confirmed %v", updated.Status, updated.IsConfirmed)
+		}
+		if len(mailer.invites) != 1 {
+			t.Fatalf("expected the invitations sent on the confirmation, got %d", len(mailer.invites))
+		}
+
+		w = serve(api, newRequest(t, http.MethodGet, "/trips/"+trip.ID.String(), nil))
+
+		assertStatus(t, w, http.StatusOK)
+		var response spec.GetTripDetailsResponse
+		decodeResponse(t, w, &response)
+		if response.Trip.Status != pgstore.TripStatusConfirmed {
+			t.Fatalf("expected the confirmed status on the details, got %q", response.Trip.Status)
+		}
+	})
+
+	t.Run("out of a final status", func(t *testing.T) {
+		store := newFakeStore()
+		cancelled := newTestTrip(3)
+		cancelled.Status = pgstore.TripStatusCancelled
+		trip := store.addTrip(cancelled)
+		api := newTestAPI(store, &fakeMailer{})
+
+		r := newRequest(t, http.MethodPatch, "/trips/"+trip.ID.String()+"/status", map[string]string{"status": "planning"})
+		w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+		assertStatus(t, w, http.StatusConflict)
+		var response spec.ConflictRequest
+		decodeResponse(t, w, &response)
+		if response.Code != string(ErrorCodeInvalidStatusTransition) {
+			t.Fatalf("expected %s, got %s", ErrorCodeInvalidStatusTransition, response.Code)
+		}
+		if store.trips[trip.ID].Status != pgstore.TripStatusCancelled {
+			t.Fatalf("expected the trip still cancelled, got %q", store.trips[trip.ID].Status)
+		}
+	})
+
+	t.Run("unknown status", func(t *testing.T) {
+		store := newFakeStore()
+		trip := store.addTrip(newTestTrip(3))
+		api := newTestAPI(store, &fakeMailer{})
+
+		r := newRequest(t, http.MethodPatch, "/trips/"+trip.ID.String()+"/status", map[string]string{"status": "archived"})
+		w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+		assertStatus(t, w, http.StatusBadRequest)
+	})
+
+	t.Run("without the owner token", func(t *testing.T) {
+		store := newFakeStore()
+		trip := store.addTrip(newTestTrip(3))
+		api := newTestAPI(store, &fakeMailer{})
+
+		w := serve(api, newRequest(t, http.MethodPatch, "/trips/"+trip.ID.String()+"/status", map[string]string{"status": "cancelled"}))
+
+		assertStatus(t, w, http.StatusUnauthorized)
+	})
+}
+
+func TestClosedTripsRejectWrites(t *testing.T) {
+	for _, status := range []string{pgstore.TripStatusCancelled, pgstore.TripStatusCompleted} {
+		t.Run(status, func(t *testing.T) {
+			store := newFakeStore()
+			closed := newTestTrip(3)
+			closed.Status = status
+			trip := store.addTrip(closed)
+			api := newTestAPI(store, &fakeMailer{})
+
+			requests := map[string]*http.Request{
+				"invite": newRequest(t, http.MethodPost, "/trips/"+trip.ID.String()+"/invites", map[string]string{"email": "guest@example.com"}),
+				"activity": newRequest(t, http.MethodPost, "/trips/"+trip.ID.String()+"/activities", map[string]any{
+					"title": "Museum", "occurs_at": trip.StartsAt.Time.Add(2 * time.Hour),
+				}),
+				"link": newRequest(t, http.MethodPost, "/trips/"+trip.ID.String()+"/links", map[string]string{
+					"title": "Booking", "url": "https://example.com/booking",
+				}),
+			}
+
+			for name, r := range requests {
+				w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+				assertStatus(t, w, http.StatusConflict)
+				var response spec.ConflictRequest
+				decodeResponse(t, w, &response)
+				if response.Code != string(ErrorCodeTripClosed) {
+					t.Fatalf("expected %s on the %s, got %s", ErrorCodeTripClosed, name, response.Code)
+				}
+			}
+			if calls := store.callsOf("InviteParticipantsWithinCapacity") + store.callsOf("CreateActivity") + store.callsOf("CreateTripLink"); calls != 0 {
+				t.Fatalf("expected nothing written on the %s trip, got %d writes", status, calls)
+			}
+
+			w := serve(api, newRequest(t, http.MethodPatch, "/trips/"+trip.ID.String()+"/confirm", nil))
+
+			assertStatus(t, w, http.StatusConflict)
+		})
+	}
+}
+
+func TestPatchTripsTripIDCancel(t *testing.T) {
+	store := newFakeStore()
+	planned := newTestTrip(3)
+	planned.Status = pgstore.TripStatusConfirmed
+	trip := store.addTrip(planned)
+	confirmed := store.addParticipant(trip.ID, "confirmed@example.com")
+	store.addParticipant(trip.ID, "pending@example.com")
+	if err := store.ConfirmParticipant(context.Background(), pgstore.ConfirmParticipantParams{IsConfirmed: true, ID: confirmed.ID}); err != nil {
+		t.Fatal(err)
+	}
+	mailer := &fakeMailer{}
+	api := newTestAPI(store, mailer)
+	target := "/trips/" + trip.ID.String() + "/cancel"
+
+	assertStatus(t, serve(api, withOwnerToken(newRequest(t, http.MethodPatch, target, nil), TEST_OWNER_TOKEN)), http.StatusNoContent)
+
+	if status := store.trips[trip.ID].Status; status != pgstore.TripStatusCancelled {
+		t.Fatalf("expected the trip cancelled, got %q", status)
+	}
+	if len(mailer.cancellations) != 1 {
+		t.Fatalf("expected the cancellation sent once, got %d", len(mailer.cancellations))
+	}
+	if told := mailer.cancellations[0].Participants; len(told) != 1 || told[0].Email != "confirmed@example.com" {
+		t.Fatalf("expected only the confirmed participant told, got %+v", told)
+	}
+
+	t.Run("rejects the activities and links", func(t *testing.T) {
+		requests := map[string]*http.Request{
+			"activity": newRequest(t, http.MethodPost, "/trips/"+trip.ID.String()+"/activities", map[string]any{
+				"title": "Museum", "occurs_at": trip.StartsAt.Time.Add(2 * time.Hour),
+			}),
+			"link": newRequest(t, http.MethodPost, "/trips/"+trip.ID.String()+"/links", map[string]string{
+				"title": "Booking", "url": "https://example.com/booking",
+			}),
+		}
+
+		for name, r := range requests {
+			w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+			if w.Code != http.StatusConflict {
+				t.Fatalf("expected the %s rejected with %d, got %d", name, http.StatusConflict, w.Code)
+			}
+		}
+	})
+
+	t.Run("again changes nothing", func(t *testing.T) {
+		assertStatus(t, serve(api, withOwnerToken(newRequest(t, http.MethodPatch, target, nil), TEST_OWNER_TOKEN)), http.StatusNoContent)
+
+		if len(mailer.cancellations) != 1 {
+			t.Fatalf("expected the participants told once, got %d cancellations", len(mailer.cancellations))
+		}
+	})
+}
+
+func TestPatchTripsTripIDCancelRefused(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	completed := newTestTrip(3)
+	completed.Status = pgstore.TripStatusCompleted
+	store.addTrip(completed)
+	mailer := &fakeMailer{}
+	api := newTestAPI(store, mailer)
+
+	cases := []struct {
+		name   string
+		tripID string
+		token  string
+		status int
+	}{
+		{"missing trip", uuid.NewString(), TEST_OWNER_TOKEN, http.StatusNotFound},
+		{"without the owner token", trip.ID.String(), "", http.StatusUnauthorized},
+		{"with a wrong token", trip.ID.String(), "not-the-owner", http.StatusForbidden},
+		{"completed trip", completed.ID.String(), TEST_OWNER_TOKEN, http.StatusConflict},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			r := newRequest(t, http.MethodPatch, "/trips/"+c.tripID+"/cancel", nil)
+			if c.token != "" {
+				r = withOwnerToken(r, c.token)
+			}
+
+			assertStatus(t, serve(api, r), c.status)
+		})
+	}
+	if calls := store.callsOf("UpdateTripStatus"); calls != 0 {
+		t.Fatalf("expected no trip cancelled, got %d updates", calls)
+	}
+	if len(mailer.cancellations) != 0 {
+		t.Fatalf("expected no cancellation sent, got %d", len(mailer.cancellations))
+	}
+}
+
+func TestPatchTripsTripIDStatusCancelledTellsTheParticipants(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	guest := store.addParticipant(trip.ID, "guest@example.com")
+	if err := store.ConfirmParticipant(context.Background(), pgstore.ConfirmParticipantParams{IsConfirmed: true, ID: guest.ID}); err != nil {
+		t.Fatal(err)
+	}
+	mailer := &fakeMailer{}
+	api := newTestAPI(store, mailer)
+
+	r := newRequest(t, http.MethodPatch, "/trips/"+trip.ID.String()+"/status", map[string]string{"status": "cancelled"})
+	assertStatus(t, serve(api, withOwnerToken(r, TEST_OWNER_TOKEN)), http.StatusNoContent)
+
+	if len(mailer.cancellations) != 1 || len(mailer.cancellations[0].Participants) != 1 {
+		t.Fatalf("expected the participant told of the cancellation, got %+v", mailer.cancellations)
+	}
+}
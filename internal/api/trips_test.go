@@ -0,0 +1,116 @@
+package api
+
+import (
+	"journey/internal/api/spec"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestGetParticipantsByEmailTrips(t *testing.T) {
+	store := newFakeStore()
+
+	owned := newTestTrip(3)
+	owned.OwnerEmail = "Traveler@Example.com"
+	owned.IsConfirmed = true
+	store.addTrip(owned)
+
+	invited := newTestTrip(5)
+	invited.StartsAt.Time = invited.StartsAt.Time.AddDate(0, 1, 0)
+	invited.EndsAt.Time = invited.EndsAt.Time.AddDate(0, 1, 0)
+	store.addTrip(invited)
+	store.addParticipant(invited.ID, "traveler@example.com")
+
+	unrelated := store.addTrip(newTestTrip(2))
+	store.addParticipant(unrelated.ID, "someone@example.com")
+
+	api := newTestAPI(store, &fakeMailer{})
+
+	list := func(t *testing.T, query url.Values) spec.GetParticipantTripsResponse {
+		t.Helper()
+
+		w := serve(api, newRequest(t, http.MethodGet, "/trips?"+query.Encode(), nil))
+		assertStatus(t, w, http.StatusOK)
+		var response spec.GetParticipantTripsResponse
+		decodeResponse(t, w, &response)
+		return response
+	}
+
+	t.Run("owner and participant", func(t *testing.T) {
+		response := list(t, url.Values{"participantEmail": {" TRAVELER@example.com "}})
+
+		if len(response.Items) != 2 || response.Total != 2 || response.TotalPages != 1 {
+			t.Fatalf("expected the 2 trips of the email on a single page, got %+v", response)
+		}
+		if response.Items[0].ID != owned.ID.String() || !response.Items[0].IsOwner || !response.Items[0].IsConfirmed {
+			t.Fatalf("expected the owned and confirmed trip first, got %+v", response.Items[0])
+		}
+		if response.Items[1].ID != invited.ID.String() || response.Items[1].IsOwner || response.Items[1].IsConfirmed {
+			t.Fatalf("expected the invited and unconfirmed trip second, got %+v", response.Items[1])
+		}
+	})
+
+	t.Run("paginated", func(t *testing.T) {
+		first := list(t, url.Values{"participantEmail": {"traveler@example.com"}, "perPage": {"1"}})
+		if len(first.Items) != 1 || first.Items[0].ID != owned.ID.String() || first.TotalPages != 2 || first.Page != 1 {
+			t.Fatalf("expected the first trip with more to come, got %+v", first)
+		}
+
+		second := list(t, url.Values{"participantEmail": {"traveler@example.com"}, "perPage": {"1"}, "page": {"2"}})
+		if len(second.Items) != 1 || second.Items[0].ID != invited.ID.String() || second.Page != 2 || second.Total != 2 {
+			t.Fatalf("expected the second and last trip, got %+v", second)
+		}
+	})
+
+	t.Run("owned only", func(t *testing.T) {
+		response := list(t, url.Values{"ownerEmail": {" TRAVELER@example.com "}})
+
+		if len(response.Items) != 1 || response.Total != 1 || response.TotalPages != 1 {
+			t.Fatalf("expected only the owned trip, got %+v", response)
+		}
+		if response.Items[0].ID != owned.ID.String() || !response.Items[0].IsOwner || !response.Items[0].IsConfirmed {
+			t.Fatalf("expected the owned and confirmed trip, got %+v", response.Items[0])
+		}
+	})
+
+	t.Run("owned paginated", func(t *testing.T) {
+		later := newTestTrip(4)
+		later.OwnerEmail = "traveler@example.com"
+		later.StartsAt.Time = later.StartsAt.Time.AddDate(0, 2, 0)
+		later.EndsAt.Time = later.EndsAt.Time.AddDate(0, 2, 0)
+		store.addTrip(later)
+
+		first := list(t, url.Values{"ownerEmail": {"traveler@example.com"}, "perPage": {"1"}})
+		if len(first.Items) != 1 || first.Items[0].ID != owned.ID.String() || first.Total != 2 || first.TotalPages != 2 {
+			t.Fatalf("expected the first owned trip with more to come, got %+v", first)
+		}
+
+		second := list(t, url.Values{"ownerEmail": {"traveler@example.com"}, "perPage": {"1"}, "page": {"2"}})
+		if len(second.Items) != 1 || second.Items[0].ID != later.ID.String() || !second.Items[0].IsOwner {
+			t.Fatalf("expected the later owned trip, got %+v", second)
+		}
+	})
+
+	t.Run("no trips", func(t *testing.T) {
+		response := list(t, url.Values{"participantEmail": {"nobody@example.com"}})
+
+		if response.Items == nil || len(response.Items) != 0 || response.PageSize != defaultTripsPerPage || response.TotalPages != 0 {
+			t.Fatalf("expected an empty page of the default size, got %+v", response)
+		}
+	})
+
+	for name, query := range map[string]url.Values{
+		"invalid email":   {"participantEmail": {"not-an-email"}},
+		"missing email":   {},
+		"both emails":     {"participantEmail": {"traveler@example.com"}, "ownerEmail": {"traveler@example.com"}},
+		"invalid owner":   {"ownerEmail": {"not-an-email"}},
+		"page zero":       {"participantEmail": {"traveler@example.com"}, "page": {"0"}},
+		"perPage too big": {"participantEmail": {"traveler@example.com"}, "perPage": {"101"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			w := serve(api, newRequest(t, http.MethodGet, "/trips?"+query.Encode(), nil))
+
+			assertStatus(t, w, http.StatusBadRequest)
+		})
+	}
+}
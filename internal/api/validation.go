@@ -0,0 +1,219 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"journey/internal/api/spec"
+	"journey/internal/i18n"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/discord-gophers/goapi-gen/types"
+	"github.com/go-playground/validator/v10"
+	"github.com/go-playground/validator/v10/non-standard/validators"
+)
+
+// newValidator validates the request bodies, naming the fields as their JSON names and rejecting the
+// whitespace-only strings tagged notblank.
+func newValidator() *validator.Validate {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	if err := validate.RegisterValidation("notblank", validators.NotBlank); err != nil {
+		panic(err)
+	}
+
+	validate.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
+
+	return validate
+}
+
+// decodeBody decodes the JSON body of r into the struct dest, its emails normalized rather than checked:
+// types.Email refuses a malformed email while decoding, before the validator names the field, and refuses
+// one only padded with spaces. The emails are left for the email rule of the validator to check.
+func decodeBody(r *http.Request, dest any) error {
+	var fields map[string]json.RawMessage
+	if err := json.NewDecoder(r.Body).Decode(&fields); err != nil {
+		return err
+	}
+
+	value := reflect.ValueOf(dest).Elem()
+	for index := 0; index < value.NumField(); index++ {
+		name, _, _ := strings.Cut(value.Type().Field(index).Tag.Get("json"), ",")
+		raw, found := fields[name]
+		if !found {
+			continue
+		}
+		decoded, err := decodeEmails(raw, value.Field(index))
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		if decoded {
+			delete(fields, name)
+		}
+	}
+
+	rest, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(rest, dest)
+}
+
+// decodeEmails decodes raw into field when it holds emails, an email or a list of them, optionally behind a
+// pointer, the emails read as strings and normalized. It answers whether field holds emails.
+func decodeEmails(raw json.RawMessage, field reflect.Value) (bool, error) {
+	switch dest := field.Addr().Interface().(type) {
+	case *types.Email:
+		var email string
+		if err := json.Unmarshal(raw, &email); err != nil {
+			return true, err
+		}
+		*dest = types.Email(normalizeEmail(email))
+	case **types.Email:
+		var email *string
+		if err := json.Unmarshal(raw, &email); err != nil {
+			return true, err
+		}
+		if email != nil {
+			normalized := types.Email(normalizeEmail(*email))
+			*dest = &normalized
+		}
+	case *[]types.Email:
+		var emails []string
+		if err := json.Unmarshal(raw, &emails); err != nil {
+			return true, err
+		}
+		*dest = normalizeEmails(emails)
+	case **[]types.Email:
+		var emails *[]string
+		if err := json.Unmarshal(raw, &emails); err != nil {
+			return true, err
+		}
+		if emails != nil {
+			normalized := normalizeEmails(*emails)
+			*dest = &normalized
+		}
+	default:
+		return false, nil
+	}
+	return true, nil
+}
+
+// normalizeEmails are emails normalized, nil when emails is.
+func normalizeEmails(emails []string) []types.Email {
+	if emails == nil {
+		return nil
+	}
+	normalized := make([]types.Email, len(emails))
+	for index, email := range emails {
+		normalized[index] = types.Email(normalizeEmail(email))
+	}
+	return normalized
+}
+
+// invalidFields lists the fields failing the validation with their rule, e.g. "destination (max=255)".
+func invalidFields(err error) string {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return err.Error()
+	}
+
+	fields := make([]string, len(validationErrors))
+	for index, fieldError := range validationErrors {
+		rule := fieldError.Tag()
+		if fieldError.Param() != "" {
+			rule += "=" + fieldError.Param()
+		}
+		fields[index] = fmt.Sprintf("%s (%s)", fieldError.Field(), rule)
+	}
+
+	return strings.Join(fields, ", ")
+}
+
+// invalidFieldsRequest answers a request failing the validation: the fields summed up on the message, as
+// invalidFields, and each of them on the fields.
+func (api *API) invalidFieldsRequest(r *http.Request, err error) spec.BadRequest {
+	response := api.badRequest(r, i18n.InvalidFields, invalidFields(err))
+	if fields := fieldErrors(err, i18n.FromRequest(r)); len(fields) > 0 {
+		response.Fields = &fields
+	}
+	return response
+}
+
+// fieldErrors lists the fields failing the validation by their JSON path, e.g. "activities[0].title", with
+// the rule they fail and what they must be, in the locale. It is empty unless err comes from the validator.
+func fieldErrors(err error, locale i18n.Locale) []spec.FieldError {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return nil
+	}
+
+	fields := make([]spec.FieldError, len(validationErrors))
+	for index, fieldError := range validationErrors {
+		// the namespace starts at the validated struct, named after its Go type.
+		_, path, found := strings.Cut(fieldError.Namespace(), ".")
+		if !found || path == "" {
+			path = fieldError.Field()
+		}
+
+		rule := fieldError.Tag()
+		if fieldError.Param() != "" {
+			rule += "=" + fieldError.Param()
+		}
+
+		fields[index] = spec.FieldError{
+			Field:   path,
+			Rule:    rule,
+			Message: fieldMessage(fieldError, path, locale),
+		}
+	}
+	return fields
+}
+
+// fieldMessage tells what the field at path must be to pass its rule, the lengths of the strings counted in
+// characters and the ones of the lists in items.
+func fieldMessage(fieldError validator.FieldError, path string, locale i18n.Locale) string {
+	param := fieldError.Param()
+	switch tag := fieldError.Tag(); {
+	case strings.HasPrefix(tag, "required"):
+		return i18n.Message(locale, i18n.FieldRequired, path)
+	case tag == "notblank":
+		return i18n.Message(locale, i18n.FieldBlank, path)
+	case tag == "email":
+		return i18n.Message(locale, i18n.FieldNotEmail, path)
+	case strings.HasPrefix(tag, "uuid"):
+		return i18n.Message(locale, i18n.FieldNotUUID, path)
+	case tag == "url":
+		return i18n.Message(locale, i18n.FieldNotURL, path)
+	case tag == "timezone":
+		return i18n.Message(locale, i18n.FieldNotTimezone, path)
+	case tag == "oneof":
+		return i18n.Message(locale, i18n.FieldNotOneOf, path, strings.Join(strings.Fields(param), ", "))
+	case tag == "unique":
+		return i18n.Message(locale, i18n.FieldNotUnique, path)
+	case tag == "min" || tag == "gte":
+		return i18n.Message(locale, boundKey(fieldError.Kind(), i18n.FieldTooShort, i18n.FieldTooFewItems, i18n.FieldTooSmall), path, param)
+	case tag == "max" || tag == "lte":
+		return i18n.Message(locale, boundKey(fieldError.Kind(), i18n.FieldTooLong, i18n.FieldTooManyItems, i18n.FieldTooLarge), path, param)
+	}
+	return i18n.Message(locale, i18n.FieldInvalid, path, fieldError.Tag())
+}
+
+// boundKey is the message of a bound on a field of kind: on the length of a string, the items of a list or
+// the value of a number.
+func boundKey(kind reflect.Kind, length, items, value i18n.Key) i18n.Key {
+	switch kind {
+	case reflect.String:
+		return length
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return items
+	}
+	return value
+}
@@ -0,0 +1,216 @@
+package api
+
+import (
+	"journey/internal/api/spec"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRequestBodiesRejectBlankAndOverLengthText(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	api := newTestAPI(store, &fakeMailer{})
+	startsAt := testNow.Add(time.Hour)
+
+	routes := []struct {
+		name      string
+		method    string
+		target    string
+		field     string
+		maxLength int
+		body      func(value string) map[string]any
+	}{
+		{
+			name:      "create trip",
+			method:    http.MethodPost,
+			target:    "/trips",
+			field:     "destination",
+			maxLength: 255,
+			body: func(value string) map[string]any {
+				return map[string]any{
+					"destination":      value,
+					"owner_name":       "Owner",
+					"owner_email":      "owner@example.com",
+					"emails_to_invite": []string{},
+					"starts_at":        startsAt,
+					"ends_at":          startsAt.AddDate(0, 0, 3),
+				}
+			},
+		},
+		{
+			name:      "create trip owner",
+			method:    http.MethodPost,
+			target:    "/trips",
+			field:     "owner_name",
+			maxLength: 255,
+			body: func(value string) map[string]any {
+				return map[string]any{
+					"destination":      "Florianópolis",
+					"owner_name":       value,
+					"owner_email":      "owner@example.com",
+					"emails_to_invite": []string{},
+					"starts_at":        startsAt,
+					"ends_at":          startsAt.AddDate(0, 0, 3),
+				}
+			},
+		},
+		{
+			name:      "update trip",
+			method:    http.MethodPut,
+			target:    "/trips/" + trip.ID.String(),
+			field:     "destination",
+			maxLength: 255,
+			body: func(value string) map[string]any {
+				return map[string]any{"destination": value, "starts_at": startsAt, "ends_at": startsAt.AddDate(0, 0, 3)}
+			},
+		},
+		{
+			name:      "create activity",
+			method:    http.MethodPost,
+			target:    "/trips/" + trip.ID.String() + "/activities",
+			field:     "title",
+			maxLength: 120,
+			body: func(value string) map[string]any {
+				return map[string]any{"title": value, "occurs_at": trip.StartsAt.Time.Add(time.Hour)}
+			},
+		},
+		{
+			name:      "create link",
+			method:    http.MethodPost,
+			target:    "/trips/" + trip.ID.String() + "/links",
+			field:     "title",
+			maxLength: 120,
+			body: func(value string) map[string]any {
+				return map[string]any{"title": value, "url": "https://example.com"}
+			},
+		},
+	}
+
+	for _, route := range routes {
+		values := map[string]string{
+			"empty":           "",
+			"whitespace only": "  \t\n  ",
+			"over length":     strings.Repeat("a", route.maxLength+1),
+		}
+
+		for name, value := range values {
+			t.Run(route.name+"/"+name, func(t *testing.T) {
+				r := newRequest(t, route.method, route.target, route.body(value))
+				w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+				assertStatus(t, w, http.StatusBadRequest)
+				var response spec.BadRequest
+				decodeResponse(t, w, &response)
+				if response.Code != string(ErrorCodeInvalidRequest) {
+					t.Fatalf("expected the code %s, got %q", ErrorCodeInvalidRequest, response.Code)
+				}
+				if !strings.Contains(response.Message, route.field+" (") {
+					t.Fatalf("expected the message to list %s, got %q", route.field, response.Message)
+				}
+			})
+		}
+
+		t.Run(route.name+"/max length", func(t *testing.T) {
+			r := newRequest(t, route.method, route.target, route.body(strings.Repeat("a", route.maxLength)))
+			w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+			if w.Code == http.StatusBadRequest {
+				t.Fatalf("expected %d characters to be accepted, got %s", route.maxLength, w.Body.String())
+			}
+		})
+	}
+
+	if store.callsOf("CreateTrip") != 2 || store.callsOf("UpdateTrip") != 1 || store.callsOf("CreateActivity") != 1 || store.callsOf("CreateTripLink") != 1 {
+		t.Fatal("expected only the valid bodies to be persisted")
+	}
+}
+
+func TestInvalidFieldsAreListedByTheirJSONNames(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	api := newTestAPI(store, &fakeMailer{})
+	startsAt := testNow.Add(time.Hour)
+
+	invalidFields := func(t *testing.T, r *http.Request) []spec.FieldError {
+		t.Helper()
+
+		r.Header.Set("Accept-Language", "en")
+		w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+		assertStatus(t, w, http.StatusBadRequest)
+		var response spec.BadRequest
+		decodeResponse(t, w, &response)
+		if response.Code != string(ErrorCodeInvalidRequest) || !strings.HasPrefix(response.Message, "invalid fields: ") {
+			t.Fatalf("expected the invalid fields summed up on the message, got %+v", response)
+		}
+		if response.Fields == nil {
+			t.Fatalf("expected the invalid fields listed, got none on %q", response.Message)
+		}
+		return *response.Fields
+	}
+
+	t.Run("several fields", func(t *testing.T) {
+		r := newRequest(t, http.MethodPost, "/trips", map[string]any{
+			"destination":      "Rio",
+			"owner_email":      "not-an-email",
+			"emails_to_invite": []string{"guest@example.com", "nope"},
+			"starts_at":        startsAt,
+			"ends_at":          startsAt.AddDate(0, 0, 3),
+			"latitude":         91,
+			"longitude":        0,
+		})
+
+		expected := []spec.FieldError{
+			{Field: "destination", Rule: "min=4", Message: "destination must have at least 4 characters"},
+			{Field: "emails_to_invite[1]", Rule: "email", Message: "emails_to_invite[1] must be a valid email"},
+			{Field: "latitude", Rule: "max=90", Message: "latitude must be at most 90"},
+			{Field: "owner_email", Rule: "email", Message: "owner_email must be a valid email"},
+			{Field: "owner_name", Rule: "required", Message: "owner_name is required"},
+		}
+		if fields := invalidFields(t, r); !reflect.DeepEqual(fields, expected) {
+			t.Fatalf("expected the fields %+v, got %+v", expected, fields)
+		}
+	})
+
+	t.Run("nested fields", func(t *testing.T) {
+		r := newRequest(t, http.MethodPost, "/trips/"+trip.ID.String()+"/activities/batch", map[string]any{
+			"activities": []map[string]any{
+				{"title": "Museum", "occurs_at": trip.StartsAt.Time.Add(time.Hour)},
+				{"title": "  ", "occurs_at": trip.StartsAt.Time.Add(time.Hour), "duration_minutes": -5},
+			},
+		})
+
+		expected := []spec.FieldError{
+			{Field: "activities[1].duration_minutes", Rule: "min=0", Message: "activities[1].duration_minutes must be at least 0"},
+			{Field: "activities[1].title", Rule: "notblank", Message: "activities[1].title must not be blank"},
+		}
+		if fields := invalidFields(t, r); !reflect.DeepEqual(fields, expected) {
+			t.Fatalf("expected the fields %+v, got %+v", expected, fields)
+		}
+	})
+
+	t.Run("in the language of the request", func(t *testing.T) {
+		r := newRequest(t, http.MethodPost, "/trips/"+trip.ID.String()+"/links", map[string]any{"title": "Hotel"})
+		w := serve(api, withOwnerToken(r, TEST_OWNER_TOKEN))
+
+		assertStatus(t, w, http.StatusBadRequest)
+		var response spec.BadRequest
+		decodeResponse(t, w, &response)
+		expected := []spec.FieldError{{Field: "url", Rule: "required", Message: "url é obrigatório"}}
+		if response.Fields == nil || !reflect.DeepEqual(*response.Fields, expected) {
+			t.Fatalf("expected the fields %+v, got %+v", expected, response.Fields)
+		}
+	})
+
+	t.Run("none on a malformed body", func(t *testing.T) {
+		r := newRequest(t, http.MethodPost, "/trips", map[string]any{"destination": 42})
+		w := serve(api, r)
+
+		assertStatus(t, w, http.StatusBadRequest)
+		if strings.Contains(w.Body.String(), `"fields"`) {
+			t.Fatalf("expected no fields on a body not decoded, got %s", w.Body.String())
+		}
+	})
+}
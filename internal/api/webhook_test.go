@@ -0,0 +1,54 @@
+package api
+
+import (
+	"encoding/json"
+	"io"
+	"journey/internal/webhook"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestConfirmTripNotifiesTheWebhook(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+	store.addParticipant(trip.ID, "guest@example.com")
+	store.addParticipant(trip.ID, "friend@example.com")
+	store.addWaitlisted(trip.ID, "waiting@example.com")
+
+	var received webhook.TripConfirmed
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Header.Get(webhook.SIGNATURE_HEADER) != webhook.Sign("secret", body) {
+			t.Errorf("expected the payload signed")
+		}
+		_ = json.Unmarshal(body, &received)
+	}))
+	defer server.Close()
+
+	api := newTestAPI(store, &fakeMailer{}, WithWebhook(webhook.New(server.URL, "secret")))
+	w := serve(api, newRequest(t, http.MethodPatch, "/trips/"+trip.ID.String()+"/confirm", nil))
+
+	assertStatus(t, w, http.StatusNoContent)
+	if received.TripID != trip.ID.String() || received.ParticipantCount != 2 || received.Event != webhook.EVENT_TRIP_CONFIRMED {
+		t.Fatalf("expected the confirmation of the trip with its 2 seated participants, got %+v", received)
+	}
+}
+
+func TestConfirmTripSucceedsWhenTheWebhookIsDown(t *testing.T) {
+	store := newFakeStore()
+	trip := store.addTrip(newTestTrip(3))
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL
+	server.Close()
+
+	notifier := webhook.New(url, "secret", webhook.WithRetry(2, 0))
+	api := newTestAPI(store, &fakeMailer{}, WithWebhook(notifier))
+	w := serve(api, newRequest(t, http.MethodPatch, "/trips/"+trip.ID.String()+"/confirm", nil))
+
+	assertStatus(t, w, http.StatusNoContent)
+	if !store.trip(trip.ID).IsConfirmed {
+		t.Fatal("expected the trip to be confirmed")
+	}
+}
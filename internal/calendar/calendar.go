@@ -0,0 +1,93 @@
+// Package calendar renders the trips as iCalendar (RFC 5545) documents, served as a feed and attached to the
+// invitations.
+package calendar
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"journey/internal/pgstore"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	DateTimeLayout = "20060102T150405Z"
+	DateLayout     = "20060102"
+	// LineLimit is the length, in octets, above which RFC 5545 requires the lines to be folded.
+	LineLimit = 75
+)
+
+var textEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+// WriteTrip renders the trip as a VCALENDAR: the trip period as an all-day VEVENT and one VEVENT
+// per activity, lasting its duration. The UIDs come from the trip and activities ids, so a re-import
+// updates the events.
+func WriteTrip(w io.Writer, trip pgstore.Trip, activities []pgstore.Activity, now time.Time) error {
+	cw := lineWriter{w: bufio.NewWriter(w)}
+	stamp := now.UTC().Format(DateTimeLayout)
+	location := textEscaper.Replace(trip.Destination)
+
+	cw.line("BEGIN:VCALENDAR")
+	cw.line("VERSION:2.0")
+	cw.line("PRODID:-//plann.er//journey//PT")
+	cw.line("CALSCALE:GREGORIAN")
+
+	cw.line("BEGIN:VEVENT")
+	cw.line("UID:" + trip.ID.String() + "@journey")
+	cw.line("DTSTAMP:" + stamp)
+	cw.line("DTSTART;VALUE=DATE:" + trip.StartsAt.Time.UTC().Format(DateLayout))
+	// the DTEND of an all-day event is exclusive, the trip includes its last day.
+	cw.line("DTEND;VALUE=DATE:" + trip.EndsAt.Time.UTC().AddDate(0, 0, 1).Format(DateLayout))
+	cw.line("SUMMARY:" + textEscaper.Replace("Trip to "+trip.Destination))
+	cw.line("LOCATION:" + location)
+	cw.line("END:VEVENT")
+
+	for _, activity := range activities {
+		cw.line("BEGIN:VEVENT")
+		cw.line("UID:" + activity.ID.String() + "@journey")
+		cw.line("DTSTAMP:" + stamp)
+		cw.line("DTSTART:" + activity.OccursAt.Time.UTC().Format(DateTimeLayout))
+		cw.line("DTEND:" + activity.OccursAt.Time.Add(time.Duration(activity.DurationMinutes)*time.Minute).UTC().Format(DateTimeLayout))
+		cw.line("SUMMARY:" + textEscaper.Replace(activity.Title))
+		cw.line("LOCATION:" + location)
+		cw.line("END:VEVENT")
+	}
+
+	cw.line("END:VCALENDAR")
+
+	if cw.err != nil {
+		return cw.err
+	}
+	return cw.w.Flush()
+}
+
+// lineWriter writes the content lines CRLF terminated and folded, keeping the first error.
+type lineWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (cw *lineWriter) line(content string) {
+	if cw.err != nil {
+		return
+	}
+
+	limit := LineLimit
+	for len(content) > limit {
+		cut := limit
+		// never split a multi-byte character between two lines.
+		for !utf8.RuneStart(content[cut]) {
+			cut--
+		}
+		if _, cw.err = fmt.Fprintf(cw.w, "%s\r\n ", content[:cut]); cw.err != nil {
+			return
+		}
+		content = content[cut:]
+		// the continuation lines spend one octet on the leading space.
+		limit = LineLimit - 1
+	}
+
+	_, cw.err = fmt.Fprintf(cw.w, "%s\r\n", content)
+}
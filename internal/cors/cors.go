@@ -0,0 +1,82 @@
+package cors
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	allowedMethods = "GET, POST, PUT, PATCH, DELETE, OPTIONS"
+	allowedHeaders = "Accept, Accept-Language, Authorization, Content-Type, X-Request-ID"
+	exposedHeaders = "X-Request-ID"
+	// preflightMaxAge is how long, in seconds, the browsers may cache a preflight answer.
+	preflightMaxAge = 600
+)
+
+const wildcard = "*"
+
+// ParseOrigins splits a comma-separated list of origins, as JOURNEY_CORS_ORIGINS, dropping the blank ones
+// and the trailing slashes.
+func ParseOrigins(value string) []string {
+	var origins []string
+	for _, origin := range strings.Split(value, ",") {
+		origin = strings.TrimSuffix(strings.TrimSpace(origin), "/")
+		if origin != "" {
+			origins = append(origins, origin)
+		}
+	}
+	return origins
+}
+
+// Middleware answers the CORS headers to the requests of the allowed origins and the preflights, rejecting
+// the other origins with 403. A "*" allows every origin, then without credentials: browsers refuse the
+// wildcard along with them. The requests without Origin, as the same-origin ones, pass through untouched.
+func Middleware(origins []string) func(http.Handler) http.Handler {
+	allowAny := false
+	allowed := make(map[string]bool, len(origins))
+	for _, origin := range origins {
+		if origin == wildcard {
+			allowAny = true
+		}
+		allowed[origin] = true
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			origin := r.Header.Get("Origin")
+			if origin == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			header := w.Header()
+			header.Add("Vary", "Origin")
+
+			if !allowAny && !allowed[origin] {
+				http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+				return
+			}
+
+			if allowAny {
+				header.Set("Access-Control-Allow-Origin", wildcard)
+			} else {
+				header.Set("Access-Control-Allow-Origin", origin)
+				header.Set("Access-Control-Allow-Credentials", "true")
+			}
+
+			if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+				header.Add("Vary", "Access-Control-Request-Method")
+				header.Add("Vary", "Access-Control-Request-Headers")
+				header.Set("Access-Control-Allow-Methods", allowedMethods)
+				header.Set("Access-Control-Allow-Headers", allowedHeaders)
+				header.Set("Access-Control-Max-Age", strconv.Itoa(preflightMaxAge))
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+
+			header.Set("Access-Control-Expose-Headers", exposedHeaders)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
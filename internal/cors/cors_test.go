@@ -0,0 +1,101 @@
+package cors
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func serve(origins []string, r *http.Request) (*httptest.ResponseRecorder, bool) {
+	reached := false
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reached = true
+		w.WriteHeader(http.StatusOK)
+	})
+
+	w := httptest.NewRecorder()
+	Middleware(origins)(next).ServeHTTP(w, r)
+	return w, reached
+}
+
+func TestParseOrigins(t *testing.T) {
+	got := ParseOrigins(" https://app.example.com/, ,http://localhost:3000")
+	want := []string{"https://app.example.com", "http://localhost:3000"}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	origins := []string{"https://app.example.com"}
+
+	t.Run("allowed origin", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/trips", nil)
+		r.Header.Set("Origin", "https://app.example.com")
+		w, reached := serve(origins, r)
+
+		if !reached || w.Code != http.StatusOK {
+			t.Fatalf("expected the request to be served, got %d", w.Code)
+		}
+		if got := w.Header().Get("Access-Control-Allow-Origin"); got != "https://app.example.com" {
+			t.Fatalf("expected the origin echoed, got %q", got)
+		}
+		if got := w.Header().Get("Access-Control-Allow-Credentials"); got != "true" {
+			t.Fatalf("expected the credentials allowed, got %q", got)
+		}
+	})
+
+	t.Run("disallowed origin", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/trips", nil)
+		r.Header.Set("Origin", "https://evil.example.com")
+		w, reached := serve(origins, r)
+
+		if reached || w.Code != http.StatusForbidden {
+			t.Fatalf("expected the request rejected, got %d", w.Code)
+		}
+		if got := w.Header().Get("Access-Control-Allow-Origin"); got != "" {
+			t.Fatalf("expected no allowed origin, got %q", got)
+		}
+	})
+
+	t.Run("preflight", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodOptions, "/trips", nil)
+		r.Header.Set("Origin", "https://app.example.com")
+		r.Header.Set("Access-Control-Request-Method", http.MethodPost)
+		r.Header.Set("Access-Control-Request-Headers", "Authorization, Content-Type")
+		w, reached := serve(origins, r)
+
+		if reached || w.Code != http.StatusNoContent {
+			t.Fatalf("expected the preflight answered by the middleware, got %d", w.Code)
+		}
+		if got := w.Header().Get("Access-Control-Allow-Methods"); got != allowedMethods {
+			t.Fatalf("expected the allowed methods, got %q", got)
+		}
+		if got := w.Header().Get("Access-Control-Allow-Headers"); got != allowedHeaders {
+			t.Fatalf("expected the allowed headers, got %q", got)
+		}
+	})
+
+	t.Run("wildcard without credentials", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/trips", nil)
+		r.Header.Set("Origin", "http://localhost:3000")
+		w, reached := serve([]string{"*"}, r)
+
+		if !reached || w.Header().Get("Access-Control-Allow-Origin") != "*" {
+			t.Fatalf("expected any origin allowed, got %q", w.Header().Get("Access-Control-Allow-Origin"))
+		}
+		if got := w.Header().Get("Access-Control-Allow-Credentials"); got != "" {
+			t.Fatalf("expected no credentials along the wildcard, got %q", got)
+		}
+	})
+
+	t.Run("no origin", func(t *testing.T) {
+		w, reached := serve(origins, httptest.NewRequest(http.MethodGet, "/trips", nil))
+
+		if !reached || w.Header().Get("Access-Control-Allow-Origin") != "" {
+			t.Fatal("expected a same-origin request to pass through untouched")
+		}
+	})
+}
@@ -0,0 +1,91 @@
+package httplog
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const RequestIDHeader = "X-Request-ID"
+
+// maxRequestIDLength bounds the ids accepted from the clients, longer ones are replaced.
+const maxRequestIDLength = 128
+
+type contextKey struct{}
+
+// RequestIDFromContext is the id of the request, empty when the middleware didn't run.
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(contextKey{}).(string)
+	return requestID
+}
+
+// Middleware assigns an id to each request, reusing the X-Request-ID sent by the client, echoes it on the
+// response and logs the method, path, status and duration of the request once answered.
+func Middleware(logger *zap.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+
+			requestID := r.Header.Get(RequestIDHeader)
+			if !isValidRequestID(requestID) {
+				requestID = uuid.NewString()
+			}
+
+			w.Header().Set(RequestIDHeader, requestID)
+			recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+
+			next.ServeHTTP(recorder, r.WithContext(context.WithValue(r.Context(), contextKey{}, requestID)))
+
+			logger.Info(
+				"request",
+				zap.String("method", r.Method),
+				zap.String("path", r.URL.Path),
+				zap.Int("status", recorder.status),
+				zap.Duration("duration", time.Since(start)),
+				zap.String("request_id", requestID),
+			)
+		})
+	}
+}
+
+func isValidRequestID(requestID string) bool {
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		return false
+	}
+
+	for _, char := range requestID {
+		if char < '!' || char > '~' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// statusRecorder keeps the status written, the ResponseWriter doesn't expose it.
+type statusRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func (sr *statusRecorder) WriteHeader(status int) {
+	if !sr.wroteHeader {
+		sr.status = status
+		sr.wroteHeader = true
+	}
+	sr.ResponseWriter.WriteHeader(status)
+}
+
+func (sr *statusRecorder) Write(b []byte) (int, error) {
+	sr.wroteHeader = true
+	return sr.ResponseWriter.Write(b)
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer.
+func (sr *statusRecorder) Unwrap() http.ResponseWriter {
+	return sr.ResponseWriter
+}
@@ -0,0 +1,72 @@
+package httplog
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func serve(t *testing.T, r *http.Request, status int) (*httptest.ResponseRecorder, string, *observer.ObservedLogs) {
+	t.Helper()
+
+	core, logs := observer.New(zap.InfoLevel)
+	var seen string
+	handler := Middleware(zap.New(core))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = RequestIDFromContext(r.Context())
+		w.WriteHeader(status)
+	}))
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	return w, seen, logs
+}
+
+func TestMiddlewareGeneratesTheRequestIDWhenAbsent(t *testing.T) {
+	w, seen, logs := serve(t, httptest.NewRequest(http.MethodGet, "/trips", nil), http.StatusNotFound)
+
+	requestID := w.Header().Get(RequestIDHeader)
+	if _, err := uuid.Parse(requestID); err != nil {
+		t.Fatalf("expected a generated uuid request id, got %q", requestID)
+	}
+	if seen != requestID {
+		t.Fatalf("expected the handler to see the request id %q, got %q", requestID, seen)
+	}
+
+	entries := logs.All()
+	if len(entries) != 1 {
+		t.Fatalf("expected one log entry, got %d", len(entries))
+	}
+	fields := entries[0].ContextMap()
+	if fields["request_id"] != requestID || fields["status"] != int64(http.StatusNotFound) || fields["method"] != http.MethodGet || fields["path"] != "/trips" {
+		t.Fatalf("expected the request logged, got %v", fields)
+	}
+	if _, found := fields["duration"]; !found {
+		t.Fatalf("expected the duration logged, got %v", fields)
+	}
+}
+
+func TestMiddlewareEchoesTheSuppliedRequestID(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/trips", nil)
+	r.Header.Set(RequestIDHeader, "client-request-1")
+
+	w, seen, _ := serve(t, r, http.StatusOK)
+
+	if w.Header().Get(RequestIDHeader) != "client-request-1" || seen != "client-request-1" {
+		t.Fatalf("expected the supplied request id echoed, got %q and %q", w.Header().Get(RequestIDHeader), seen)
+	}
+}
+
+func TestMiddlewareReplacesInvalidRequestIDs(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/trips", nil)
+	r.Header.Set(RequestIDHeader, "with spaces\tand tabs")
+
+	w, _, _ := serve(t, r, http.StatusOK)
+
+	if _, err := uuid.Parse(w.Header().Get(RequestIDHeader)); err != nil {
+		t.Fatalf("expected the invalid request id replaced, got %q", w.Header().Get(RequestIDHeader))
+	}
+}
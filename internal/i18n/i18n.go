@@ -0,0 +1,79 @@
+package i18n
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+type Locale string
+
+const (
+	PortugueseBR Locale = "pt-BR"
+	English      Locale = "en"
+	// DefaultLocale answers the requests not asking for a supported language.
+	DefaultLocale = PortugueseBR
+)
+
+// Key identifies a message, resolved to the text of each locale.
+type Key string
+
+// FromRequest is the locale asked by the Accept-Language header of the request.
+func FromRequest(r *http.Request) Locale {
+	return Negotiate(r.Header.Get("Accept-Language"))
+}
+
+// Negotiate picks the supported locale of highest quality in an Accept-Language value, matching by the
+// primary language ("en-US" is English), and falls back to DefaultLocale.
+func Negotiate(acceptLanguage string) Locale {
+	best := DefaultLocale
+	bestQuality := 0.0
+
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		quality := 1.0
+		if value, found := strings.CutPrefix(strings.TrimSpace(params), "q="); found {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			quality = parsed
+		}
+
+		locale, supported := match(tag)
+		if supported && quality > bestQuality {
+			best, bestQuality = locale, quality
+		}
+	}
+
+	return best
+}
+
+func match(tag string) (Locale, bool) {
+	primary, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(tag)), "-")
+	switch primary {
+	case "pt":
+		return PortugueseBR, true
+	case "en":
+		return English, true
+	}
+	return "", false
+}
+
+// Message is the text of key on the locale, formatted with args. A key missing on the locale falls back to
+// DefaultLocale, and a key missing there to the key itself.
+func Message(locale Locale, key Key, args ...any) string {
+	text, found := messages[locale][key]
+	if !found {
+		text, found = messages[DefaultLocale][key]
+	}
+	if !found {
+		text = string(key)
+	}
+
+	if len(args) == 0 {
+		return text
+	}
+	return fmt.Sprintf(text, args...)
+}
@@ -0,0 +1,48 @@
+package i18n
+
+import "testing"
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		acceptLanguage string
+		want           Locale
+	}{
+		{"", DefaultLocale},
+		{"en", English},
+		{"EN-us", English},
+		{"pt-BR,en;q=0.8", PortugueseBR},
+		{"pt-PT;q=0.4,en-GB;q=0.7", English},
+		{"fr-FR,de;q=0.9", DefaultLocale},
+		{"fr-FR,en;q=0.1", English},
+		{"en;q=invalid", DefaultLocale},
+		{"*", DefaultLocale},
+	}
+
+	for _, tt := range tests {
+		if got := Negotiate(tt.acceptLanguage); got != tt.want {
+			t.Errorf("Negotiate(%q) = %q, want %q", tt.acceptLanguage, got, tt.want)
+		}
+	}
+}
+
+func TestMessage(t *testing.T) {
+	if got := Message(English, InvalidRequest, "boom"); got != "invalid request: boom" {
+		t.Fatalf("expected the English message formatted, got %q", got)
+	}
+	if got := Message("", TripNotFound); got != "viagem não encontrada" {
+		t.Fatalf("expected an unknown locale to fall back to the default, got %q", got)
+	}
+	if got := Message(English, Key("unknown")); got != "unknown" {
+		t.Fatalf("expected an unknown key to be answered as is, got %q", got)
+	}
+}
+
+func TestEveryLocaleHasTheDefaultKeys(t *testing.T) {
+	for locale, texts := range messages {
+		for key := range messages[DefaultLocale] {
+			if _, found := texts[key]; !found {
+				t.Errorf("expected %q to translate %q", locale, key)
+			}
+		}
+	}
+}
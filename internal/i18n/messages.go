@@ -0,0 +1,473 @@
+package i18n
+
+const (
+	InvalidRequest              Key = "invalid_request"
+	InvalidFields               Key = "invalid_fields"
+	InvalidPagination           Key = "invalid_pagination"
+	InvalidOlderThan            Key = "invalid_older_than"
+	InvalidTripsEmail           Key = "invalid_trips_email"
+	InvalidUUID                 Key = "invalid_uuid"
+	MissingOwnerToken           Key = "missing_owner_token"
+	WrongOwnerToken             Key = "wrong_owner_token"
+	TripNotFound                Key = "trip_not_found"
+	TripStartsInThePast         Key = "trip_starts_in_the_past"
+	TripEndsBeforeStart         Key = "trip_ends_before_start"
+	TripTooLong                 Key = "trip_too_long"
+	TripStartsTooFarAhead       Key = "trip_starts_too_far_ahead"
+	ActivitiesOutOfTripPeriod   Key = "activities_out_of_trip_period"
+	UnableToCreateTrip          Key = "unable_to_create_trip"
+	OwnerConfirmationNotSent    Key = "owner_confirmation_not_sent"
+	InvalidIdempotencyKey       Key = "invalid_idempotency_key"
+	IdempotencyKeyConflict      Key = "idempotency_key_conflict"
+	IdempotencyKeyInProgress    Key = "idempotency_key_in_progress"
+	UnableToGetTrips            Key = "unable_to_get_trips"
+	UnableToGetTrip             Key = "unable_to_get_trip"
+	UnableToConfirmTrip         Key = "unable_to_confirm_trip"
+	UnableToUpdateTrip          Key = "unable_to_update_trip"
+	UnableToDeleteTrip          Key = "unable_to_delete_trip"
+	UnableToDeleteActivity      Key = "unable_to_delete_activity"
+	UnableToRestoreTrip         Key = "unable_to_restore_trip"
+	UnableToCheckTripActivities Key = "unable_to_check_trip_activities"
+	TripClosed                  Key = "trip_closed"
+	TripAlreadyConfirmed        Key = "trip_already_confirmed"
+	TripFull                    Key = "trip_full"
+	InvalidTripStatusTransition Key = "invalid_trip_status_transition"
+	AlreadyTheTripOwner         Key = "already_the_trip_owner"
+	ParticipantNotFound         Key = "participant_not_found"
+	ParticipantAlreadyConfirmed Key = "participant_already_confirmed"
+	ParticipantAlreadyInvited   Key = "participant_already_invited"
+	ParticipantInvitedWithoutID Key = "participant_invited_without_id"
+	ParticipantIsTheOwner       Key = "participant_is_the_owner"
+	ParticipantWaitlisted       Key = "participant_waitlisted"
+	ParticipantNotWaitlisted    Key = "participant_not_waitlisted"
+	UndeliverableEmails         Key = "undeliverable_emails"
+	RequestTooLarge             Key = "request_too_large"
+	UnexpectedError             Key = "unexpected_error"
+	MissingAdminToken           Key = "missing_admin_token"
+	WrongAdminToken             Key = "wrong_admin_token"
+	MissingConfirmToken         Key = "missing_confirm_token"
+	InvalidConfirmToken         Key = "invalid_confirm_token"
+	ConfirmTokenExpired         Key = "confirm_token_expired"
+	OwnerTokenExpired           Key = "owner_token_expired"
+	MagicLinksDisabled          Key = "magic_links_disabled"
+	InvalidMagicLink            Key = "invalid_magic_link"
+	MagicLinkExpired            Key = "magic_link_expired"
+	UnableToReadDiagnostics     Key = "unable_to_read_diagnostics"
+	UnableToConfirmParticipant  Key = "unable_to_confirm_participant"
+	UnableToGetParticipants     Key = "unable_to_get_participants"
+	UnableToCheckParticipants   Key = "unable_to_check_participants"
+	UnableToInviteParticipant   Key = "unable_to_invite_participant"
+	UnableToUpdateParticipant   Key = "unable_to_update_participant"
+	UnableToRemoveParticipant   Key = "unable_to_remove_participant"
+	UnableToResendInvite        Key = "unable_to_resend_invite"
+	UnableToPromoteParticipant  Key = "unable_to_promote_participant"
+	UnableToSendMagicLink       Key = "unable_to_send_magic_link"
+	ActivityNotFound            Key = "activity_not_found"
+	ActivityOutOfTripPeriod     Key = "activity_out_of_trip_period"
+	BatchOutOfTripPeriod        Key = "batch_out_of_trip_period"
+	InvalidActivitiesRange      Key = "invalid_activities_range"
+	InvalidActivitiesSearch     Key = "invalid_activities_search"
+	ActivitiesNotOfDay          Key = "activities_not_of_day"
+	UnableToGetActivities       Key = "unable_to_get_activities"
+	UnableToGetActivity         Key = "unable_to_get_activity"
+	UnableToCreateActivity      Key = "unable_to_create_activity"
+	UnableToUpdateActivity      Key = "unable_to_update_activity"
+	LinkNotFound                Key = "link_not_found"
+	LinkAlreadyExists           Key = "link_already_exists"
+	UnableToGetLinks            Key = "unable_to_get_links"
+	UnableToGetLink             Key = "unable_to_get_link"
+	UnableToCreateLink          Key = "unable_to_create_link"
+
+	// The invalid fields of a request, each taking the field name first.
+	FieldRequired     Key = "field_required"
+	FieldBlank        Key = "field_blank"
+	FieldNotEmail     Key = "field_not_email"
+	FieldNotUUID      Key = "field_not_uuid"
+	FieldNotURL       Key = "field_not_url"
+	FieldNotTimezone  Key = "field_not_timezone"
+	FieldNotOneOf     Key = "field_not_one_of"
+	FieldNotUnique    Key = "field_not_unique"
+	FieldTooShort     Key = "field_too_short"
+	FieldTooLong      Key = "field_too_long"
+	FieldTooFewItems  Key = "field_too_few_items"
+	FieldTooManyItems Key = "field_too_many_items"
+	FieldTooSmall     Key = "field_too_small"
+	FieldTooLarge     Key = "field_too_large"
+	FieldInvalid      Key = "field_invalid"
+
+	// The emails, their bodies are HTML and the texts their plaintext alternatives, taking the same arguments.
+	EmailConfirmTripSubject  Key = "email_confirm_trip_subject"
+	EmailConfirmTripBody     Key = "email_confirm_trip_body"
+	EmailConfirmTripText     Key = "email_confirm_trip_text"
+	EmailInviteSubject       Key = "email_invite_subject"
+	EmailInviteBody          Key = "email_invite_body"
+	EmailInviteText          Key = "email_invite_text"
+	EmailInviteGreeting      Key = "email_invite_greeting"
+	EmailInviteGreetingName  Key = "email_invite_greeting_name"
+	EmailReminderSubject     Key = "email_reminder_subject"
+	EmailReminderBody        Key = "email_reminder_body"
+	EmailReminderText        Key = "email_reminder_text"
+	EmailCancellationSubject Key = "email_cancellation_subject"
+	EmailCancellationBody    Key = "email_cancellation_body"
+	EmailCancellationText    Key = "email_cancellation_text"
+	EmailMagicLinkSubject    Key = "email_magic_link_subject"
+	EmailMagicLinkBody       Key = "email_magic_link_body"
+	EmailMagicLinkText       Key = "email_magic_link_text"
+
+	// The pages answered on the confirmation links opened from the emails.
+	PageTripConfirmedTitle        Key = "page_trip_confirmed_title"
+	PageTripConfirmedText         Key = "page_trip_confirmed_text"
+	PageParticipantConfirmedTitle Key = "page_participant_confirmed_title"
+	PageParticipantConfirmedText  Key = "page_participant_confirmed_text"
+)
+
+var messages = map[Locale]map[Key]string{
+	PortugueseBR: {
+		InvalidRequest:              "requisição inválida: %s",
+		InvalidFields:               "campos inválidos: %s",
+		InvalidPagination:           "paginação inválida, page deve ser ao menos 1 e perPage entre 1 e %d",
+		InvalidOlderThan:            "olderThan inválido, deve ser uma duração positiva, como 72h",
+		InvalidTripsEmail:           "envie participantEmail ou ownerEmail, apenas um deles",
+		InvalidUUID:                 "%s não é reconhecido como um uuid válido",
+		MissingOwnerToken:           "token do dono da viagem ausente, envie-o no cabeçalho Authorization como Bearer",
+		WrongOwnerToken:             "o token não pertence ao dono da viagem",
+		TripNotFound:                "viagem não encontrada",
+		TripStartsInThePast:         "o período da viagem é inválido, não é possível definir a data de início antes de hoje/agora",
+		TripEndsBeforeStart:         "o período da viagem é inválido, a data de término deve ser igual ou posterior à data de início",
+		TripTooLong:                 "o período da viagem é inválido, a viagem pode durar no máximo %d dias",
+		TripStartsTooFarAhead:       "o período da viagem é inválido, a data de início deve ser em até %d anos",
+		ActivitiesOutOfTripPeriod:   "alterações inválidas, há atividades fora do novo período da viagem. Atividades fora do período: %s",
+		UnableToCreateTrip:          "não foi possível criar a viagem, contate o administrador",
+		OwnerConfirmationNotSent:    "a viagem foi criada, mas não foi possível enviar o email de confirmação para %s",
+		InvalidIdempotencyKey:       "o cabeçalho Idempotency-Key deve ter entre 1 e %d caracteres",
+		IdempotencyKeyConflict:      "o Idempotency-Key já foi usado com outro corpo de requisição",
+		IdempotencyKeyInProgress:    "uma requisição com este Idempotency-Key ainda está em andamento, tente novamente",
+		UnableToGetTrips:            "não foi possível obter as viagens",
+		UnableToGetTrip:             "não foi possível obter a viagem",
+		UnableToConfirmTrip:         "não foi possível confirmar a viagem e enviar as notificações",
+		UnableToUpdateTrip:          "não foi possível atualizar a viagem",
+		UnableToDeleteTrip:          "não foi possível excluir a viagem",
+		UnableToDeleteActivity:      "não foi possível excluir a atividade",
+		UnableToRestoreTrip:         "não foi possível restaurar a viagem",
+		UnableToCheckTripActivities: "não foi possível verificar as atividades da viagem antes de atualizá-la: %s",
+		TripClosed:                  "a viagem foi cancelada ou concluída e não aceita mais alterações",
+		TripAlreadyConfirmed:        "viagem já confirmada",
+		TripFull:                    "a viagem atingiu o limite de %d participantes",
+		InvalidTripStatusTransition: "não é possível mudar a viagem de %s para %s",
+		AlreadyTheTripOwner:         "%s já é o dono da viagem",
+		ParticipantNotFound:         "participante não encontrado",
+		ParticipantAlreadyConfirmed: "participante já confirmado",
+		ParticipantAlreadyInvited:   "o participante já foi convidado",
+		ParticipantInvitedWithoutID: "participante convidado, mas não foi possível recuperar o id da operação",
+		ParticipantIsTheOwner:       "o email é o do dono da viagem, que não é convidado como participante",
+		ParticipantWaitlisted:       "o participante está na lista de espera e ainda não foi convidado",
+		ParticipantNotWaitlisted:    "o participante não está na lista de espera",
+		UndeliverableEmails:         "emails que não recebem mensagens, confira o domínio: %s",
+		RequestTooLarge:             "o corpo da requisição passa do limite de %d bytes",
+		UnexpectedError:             "ocorreu um erro inesperado, contate o administrador",
+		MissingAdminToken:           "token de administrador ausente, envie-o no cabeçalho Authorization como Bearer",
+		WrongAdminToken:             "o token não é o de administrador",
+		MissingConfirmToken:         "token de confirmação ausente, use o link recebido por e-mail",
+		InvalidConfirmToken:         "o token de confirmação é inválido",
+		ConfirmTokenExpired:         "o token de confirmação expirou, peça um novo convite",
+		OwnerTokenExpired:           "o token do dono da viagem expirou, peça um novo pelo link mágico",
+		MagicLinksDisabled:          "os links mágicos não estão disponíveis neste servidor",
+		InvalidMagicLink:            "o link mágico é inválido",
+		MagicLinkExpired:            "o link mágico expirou, peça um novo",
+		UnableToReadDiagnostics:     "não foi possível ler os diagnósticos, contate o administrador",
+		UnableToConfirmParticipant:  "não foi possível confirmar o participante",
+		UnableToGetParticipants:     "não foi possível obter os participantes da viagem",
+		UnableToCheckParticipants:   "não foi possível obter os participantes para verificar se o novo participante já existe",
+		UnableToInviteParticipant:   "não foi possível convidar o novo participante",
+		UnableToUpdateParticipant:   "não foi possível atualizar o participante",
+		UnableToRemoveParticipant:   "não foi possível remover o participante",
+		UnableToResendInvite:        "não foi possível reenviar o convite",
+		UnableToPromoteParticipant:  "não foi possível tirar o participante da lista de espera",
+		UnableToSendMagicLink:       "não foi possível enviar o link mágico",
+		ActivityNotFound:            "atividade não encontrada",
+		ActivityOutOfTripPeriod:     "atividade inválida, a data de ocorrência está fora do período da viagem ('%s' até '%s')",
+		BatchOutOfTripPeriod:        "atividades inválidas, as atividades nos índices %s estão fora do período da viagem ('%s' até '%s')",
+		InvalidActivitiesRange:      "intervalo inválido, from deve ser anterior ou igual a to",
+		InvalidActivitiesSearch:     "busca inválida, q deve ter entre 1 e %d caracteres",
+		ActivitiesNotOfDay:          "as atividades devem ser todas as do dia %s, cada uma uma única vez",
+		UnableToGetActivities:       "não foi possível obter as atividades da viagem",
+		UnableToGetActivity:         "não foi possível obter a atividade da viagem",
+		UnableToCreateActivity:      "não foi possível criar a atividade, contate o administrador",
+		UnableToUpdateActivity:      "não foi possível atualizar a atividade, contate o administrador",
+		LinkNotFound:                "link não encontrado",
+		LinkAlreadyExists:           "a viagem já tem um link para %s",
+		UnableToGetLinks:            "não foi possível obter os links da viagem",
+		UnableToGetLink:             "não foi possível obter o link da viagem",
+		UnableToCreateLink:          "não foi possível criar o link da viagem",
+
+		FieldRequired:     "%s é obrigatório",
+		FieldBlank:        "%s não pode estar em branco",
+		FieldNotEmail:     "%s deve ser um email válido",
+		FieldNotUUID:      "%s deve ser um uuid válido",
+		FieldNotURL:       "%s deve ser uma url válida",
+		FieldNotTimezone:  "%s deve ser um fuso horário IANA, como America/Sao_Paulo",
+		FieldNotOneOf:     "%s deve ser um de: %s",
+		FieldNotUnique:    "%s não pode ter itens repetidos",
+		FieldTooShort:     "%s deve ter ao menos %s caracteres",
+		FieldTooLong:      "%s deve ter no máximo %s caracteres",
+		FieldTooFewItems:  "%s deve ter ao menos %s itens",
+		FieldTooManyItems: "%s deve ter no máximo %s itens",
+		FieldTooSmall:     "%s deve ser ao menos %s",
+		FieldTooLarge:     "%s deve ser no máximo %s",
+		FieldInvalid:      "%s não atende à regra %s",
+
+		EmailConfirmTripSubject: "Confirme sua presença na viagem para %v em %v",
+		EmailConfirmTripBody: `
+        <div style="font-family: sans-serif; font-size: 16px; line-height: 1.6;">
+          <p>Você solicitou a criação de uma viagem para <strong>%v</strong> nas datas de <strong>%v</strong> até <strong>%v</strong>.</p>
+          <p></p>
+          <p>Para confirmar sua viagem, clique no link abaixo:</p>
+          <p></p>
+          <p>
+            <a href="%v">Confirmar viagem</a>
+          </p>
+          <p></p>
+          <p>Caso você não saiba do que se trata esse e-mail, apenas ignore esse e-mail.</p>
+        </div>
+		`,
+		EmailConfirmTripText: "Você solicitou a criação de uma viagem para %v nas datas de %v até %v.\n\n" +
+			"Para confirmar sua viagem, acesse o link abaixo:\n\n%v\n\n" +
+			"Caso você não saiba do que se trata esse e-mail, apenas ignore esse e-mail.\n",
+		EmailInviteSubject:      "Confirme sua viagem",
+		EmailInviteGreeting:     "Olá!",
+		EmailInviteGreetingName: "Olá, %v!",
+		EmailInviteBody: `
+		<div style="font-family: sans-serif; font-size: 16px; line-height: 1.6;">
+		  <p>%v</p>
+		  <p>Você foi convidado(a) para participar de uma viagem para <strong>%v</strong> nas datas de <strong>%v</strong> até <strong>%v</strong>.</p>
+		  <p></p>
+		  <p>Para confirmar sua presença na viagem, clique no link abaixo:</p>
+		  <p></p>
+		  <p>
+			<a href="%v">Confirmar viagem</a>
+		  </p>
+		  <p></p>
+		  <p>Caso você não saiba do que se trata esse e-mail, apenas ignore esse e-mail.</p>
+		</div>
+	`,
+		EmailInviteText: "%v\n\n" +
+			"Você foi convidado(a) para participar de uma viagem para %v nas datas de %v até %v.\n\n" +
+			"Para confirmar sua presença na viagem, acesse o link abaixo:\n\n%v\n\n" +
+			"Caso você não saiba do que se trata esse e-mail, apenas ignore esse e-mail.\n",
+		EmailReminderSubject: "Sua viagem para %v está chegando",
+		EmailReminderBody: `
+		<div style="font-family: sans-serif; font-size: 16px; line-height: 1.6;">
+		  <p>Sua viagem para <strong>%v</strong> começa em breve, nas datas de <strong>%v</strong> até <strong>%v</strong>.</p>
+		  <p></p>
+		  <p>Boa viagem!</p>
+		</div>
+	`,
+		EmailReminderText:        "Sua viagem para %v começa em breve, nas datas de %v até %v.\n\nBoa viagem!\n",
+		EmailCancellationSubject: "Sua viagem para %v foi cancelada",
+		EmailCancellationBody: `
+		<div style="font-family: sans-serif; font-size: 16px; line-height: 1.6;">
+		  <p>A viagem para <strong>%v</strong>, nas datas de <strong>%v</strong> até <strong>%v</strong>, foi cancelada pelo dono da viagem.</p>
+		  <p></p>
+		  <p>Caso você não saiba do que se trata esse e-mail, apenas ignore esse e-mail.</p>
+		</div>
+	`,
+		EmailCancellationText: "A viagem para %v, nas datas de %v até %v, foi cancelada pelo dono da viagem.\n\n" +
+			"Caso você não saiba do que se trata esse e-mail, apenas ignore esse e-mail.\n",
+		EmailMagicLinkSubject: "Acesse sua viagem para %v",
+		EmailMagicLinkBody: `
+		<div style="font-family: sans-serif; font-size: 16px; line-height: 1.6;">
+		  <p>Você pediu para acessar a viagem para <strong>%v</strong> como dono da viagem.</p>
+		  <p></p>
+		  <p>Para acessá-la, clique no link abaixo, válido por %v minutos:</p>
+		  <p></p>
+		  <p>
+		    <a href="%v">Acessar viagem</a>
+		  </p>
+		  <p></p>
+		  <p>Caso você não tenha pedido esse acesso, apenas ignore esse e-mail.</p>
+		</div>
+	`,
+		EmailMagicLinkText: "Você pediu para acessar a viagem para %v como dono da viagem.\n\n" +
+			"Para acessá-la, abra o link abaixo, válido por %v minutos:\n\n%v\n\n" +
+			"Caso você não tenha pedido esse acesso, apenas ignore esse e-mail.\n",
+
+		PageTripConfirmedTitle:        "Viagem confirmada",
+		PageTripConfirmedText:         "Sua viagem está confirmada e os convites foram enviados aos participantes. Você já pode fechar esta página.",
+		PageParticipantConfirmedTitle: "Presença confirmada",
+		PageParticipantConfirmedText:  "Sua presença na viagem está confirmada. Boa viagem! Você já pode fechar esta página.",
+	},
+	English: {
+		InvalidRequest:              "invalid request: %s",
+		InvalidFields:               "invalid fields: %s",
+		InvalidPagination:           "invalid pagination, page must be at least 1 and perPage between 1 and %d",
+		InvalidOlderThan:            "invalid olderThan, it must be a positive duration, as 72h",
+		InvalidTripsEmail:           "send either participantEmail or ownerEmail, only one of them",
+		InvalidUUID:                 "%s is not recognized as a valid uuid",
+		MissingOwnerToken:           "missing the trip owner token, send it as a Bearer Authorization header",
+		WrongOwnerToken:             "the token doesn't belong to the trip owner",
+		TripNotFound:                "trip not found",
+		TripStartsInThePast:         "the travel period is invalid, it is not possible to change the start date to before today/now",
+		TripEndsBeforeStart:         "the travel period is invalid, end date must be equal to or greater than the start date",
+		TripTooLong:                 "the travel period is invalid, a trip can last at most %d days",
+		TripStartsTooFarAhead:       "the travel period is invalid, the start date must be within %d years",
+		ActivitiesOutOfTripPeriod:   "changes invalid, there are activities occurring out of the new trip period. Activities out of range: %s",
+		UnableToCreateTrip:          "unable to create trip, contact adm",
+		OwnerConfirmationNotSent:    "the trip was created, but its confirmation email could not be sent to %s",
+		InvalidIdempotencyKey:       "the Idempotency-Key header must have between 1 and %d characters",
+		IdempotencyKeyConflict:      "the Idempotency-Key was already used with another request body",
+		IdempotencyKeyInProgress:    "a request with this Idempotency-Key is still in progress, try again",
+		UnableToGetTrips:            "unable to retrieve the trips",
+		UnableToGetTrip:             "unable to retrieve the trip",
+		UnableToConfirmTrip:         "unable to confirm trip and send notifications",
+		UnableToUpdateTrip:          "unable to update trip",
+		UnableToDeleteTrip:          "unable to delete trip",
+		UnableToDeleteActivity:      "unable to delete activity",
+		UnableToRestoreTrip:         "unable to restore trip",
+		UnableToCheckTripActivities: "unable to check the trip activities before the update: %s",
+		TripClosed:                  "the trip was cancelled or completed and no longer accepts changes",
+		TripAlreadyConfirmed:        "trip already confirmed",
+		TripFull:                    "the trip reached its limit of %d participants",
+		InvalidTripStatusTransition: "the trip cannot move from %s to %s",
+		AlreadyTheTripOwner:         "%s already owns the trip",
+		ParticipantNotFound:         "participant not found",
+		ParticipantAlreadyConfirmed: "participant already confirmed",
+		ParticipantAlreadyInvited:   "new participant already exists",
+		ParticipantInvitedWithoutID: "new participant registered, but it was not possible to recover the operation id",
+		ParticipantIsTheOwner:       "the email is the trip owner one, who is not invited as a participant",
+		ParticipantWaitlisted:       "the participant is waitlisted and was not invited yet",
+		ParticipantNotWaitlisted:    "the participant is not waitlisted",
+		UndeliverableEmails:         "emails no mail can reach, check their domain: %s",
+		RequestTooLarge:             "the request body is over the limit of %d bytes",
+		UnexpectedError:             "an unexpected error happened, contact the administrator",
+		MissingAdminToken:           "missing the admin token, send it as a Bearer Authorization header",
+		WrongAdminToken:             "the token is not the admin one",
+		MissingConfirmToken:         "missing the confirmation token, use the link received by email",
+		InvalidConfirmToken:         "the confirmation token is invalid",
+		ConfirmTokenExpired:         "the confirmation token expired, ask for a new invitation",
+		OwnerTokenExpired:           "the trip owner token expired, ask a new one through a magic link",
+		MagicLinksDisabled:          "the magic links are not available on this server",
+		InvalidMagicLink:            "the magic link is invalid",
+		MagicLinkExpired:            "the magic link expired, ask a new one",
+		UnableToReadDiagnostics:     "unable to read the diagnostics, contact the administrator",
+		UnableToConfirmParticipant:  "unable to confirm participant",
+		UnableToGetParticipants:     "unable to retrieve trip's participants",
+		UnableToCheckParticipants:   "unable to retrieve the participants to check whether the new participant already exists",
+		UnableToInviteParticipant:   "unable to insert new participant",
+		UnableToUpdateParticipant:   "unable to update the participant",
+		UnableToRemoveParticipant:   "unable to remove the participant",
+		UnableToResendInvite:        "unable to resend the invitation",
+		UnableToPromoteParticipant:  "unable to take the participant off the waitlist",
+		UnableToSendMagicLink:       "unable to send the magic link",
+		ActivityNotFound:            "activity not found",
+		ActivityOutOfTripPeriod:     "invalid activity, date of occurrence outside the travel period ('%s' to '%s')",
+		BatchOutOfTripPeriod:        "invalid activities, the activities at indexes %s occur outside the travel period ('%s' to '%s')",
+		InvalidActivitiesRange:      "invalid range, from must be before or the same as to",
+		InvalidActivitiesSearch:     "invalid search, q must have between 1 and %d characters",
+		ActivitiesNotOfDay:          "the activities must be all the ones of the day %s, each of them once",
+		UnableToGetActivities:       "unable to retrieve trip's activities",
+		UnableToGetActivity:         "unable to retrieve trip's activity",
+		UnableToCreateActivity:      "unable to create activity, contact adm",
+		UnableToUpdateActivity:      "unable to update activity, contact adm",
+		LinkNotFound:                "link not found",
+		LinkAlreadyExists:           "the trip already has a link to %s",
+		UnableToGetLinks:            "unable to retrieve trip's links",
+		UnableToGetLink:             "unable to retrieve trip's link",
+		UnableToCreateLink:          "unable to create link to trip",
+
+		FieldRequired:     "%s is required",
+		FieldBlank:        "%s must not be blank",
+		FieldNotEmail:     "%s must be a valid email",
+		FieldNotUUID:      "%s must be a valid uuid",
+		FieldNotURL:       "%s must be a valid url",
+		FieldNotTimezone:  "%s must be an IANA timezone, as America/Sao_Paulo",
+		FieldNotOneOf:     "%s must be one of: %s",
+		FieldNotUnique:    "%s must not have repeated items",
+		FieldTooShort:     "%s must have at least %s characters",
+		FieldTooLong:      "%s must have at most %s characters",
+		FieldTooFewItems:  "%s must have at least %s items",
+		FieldTooManyItems: "%s must have at most %s items",
+		FieldTooSmall:     "%s must be at least %s",
+		FieldTooLarge:     "%s must be at most %s",
+		FieldInvalid:      "%s fails the rule %s",
+
+		EmailConfirmTripSubject: "Confirm your trip to %v on %v",
+		EmailConfirmTripBody: `
+        <div style="font-family: sans-serif; font-size: 16px; line-height: 1.6;">
+          <p>You asked to create a trip to <strong>%v</strong> from <strong>%v</strong> to <strong>%v</strong>.</p>
+          <p></p>
+          <p>To confirm your trip, click the link below:</p>
+          <p></p>
+          <p>
+            <a href="%v">Confirm trip</a>
+          </p>
+          <p></p>
+          <p>If you don't know what this email is about, just ignore it.</p>
+        </div>
+		`,
+		EmailConfirmTripText: "You asked to create a trip to %v from %v to %v.\n\n" +
+			"To confirm your trip, open the link below:\n\n%v\n\n" +
+			"If you don't know what this email is about, just ignore it.\n",
+		EmailInviteSubject:      "Confirm your trip",
+		EmailInviteGreeting:     "Hi!",
+		EmailInviteGreetingName: "Hi %v!",
+		EmailInviteBody: `
+		<div style="font-family: sans-serif; font-size: 16px; line-height: 1.6;">
+		  <p>%v</p>
+		  <p>You were invited to join a trip to <strong>%v</strong> from <strong>%v</strong> to <strong>%v</strong>.</p>
+		  <p></p>
+		  <p>To confirm your presence on the trip, click the link below:</p>
+		  <p></p>
+		  <p>
+			<a href="%v">Confirm trip</a>
+		  </p>
+		  <p></p>
+		  <p>If you don't know what this email is about, just ignore it.</p>
+		</div>
+	`,
+		EmailInviteText: "%v\n\n" +
+			"You were invited to join a trip to %v from %v to %v.\n\n" +
+			"To confirm your presence on the trip, open the link below:\n\n%v\n\n" +
+			"If you don't know what this email is about, just ignore it.\n",
+		EmailReminderSubject: "Your trip to %v is coming up",
+		EmailReminderBody: `
+		<div style="font-family: sans-serif; font-size: 16px; line-height: 1.6;">
+		  <p>Your trip to <strong>%v</strong> starts soon, from <strong>%v</strong> to <strong>%v</strong>.</p>
+		  <p></p>
+		  <p>Have a good trip!</p>
+		</div>
+	`,
+		EmailReminderText:        "Your trip to %v starts soon, from %v to %v.\n\nHave a good trip!\n",
+		EmailCancellationSubject: "Your trip to %v was cancelled",
+		EmailCancellationBody: `
+		<div style="font-family: sans-serif; font-size: 16px; line-height: 1.6;">
+		  <p>The trip to <strong>%v</strong>, from <strong>%v</strong> to <strong>%v</strong>, was cancelled by its owner.</p>
+		  <p></p>
+		  <p>If you don't know what this email is about, just ignore it.</p>
+		</div>
+	`,
+		EmailCancellationText: "The trip to %v, from %v to %v, was cancelled by its owner.\n\n" +
+			"If you don't know what this email is about, just ignore it.\n",
+		EmailMagicLinkSubject: "Access your trip to %v",
+		EmailMagicLinkBody: `
+		<div style="font-family: sans-serif; font-size: 16px; line-height: 1.6;">
+		  <p>You asked to access the trip to <strong>%v</strong> as its owner.</p>
+		  <p></p>
+		  <p>To access it, click the link below, valid for %v minutes:</p>
+		  <p></p>
+		  <p>
+		    <a href="%v">Access trip</a>
+		  </p>
+		  <p></p>
+		  <p>If you didn't ask for this access, just ignore this email.</p>
+		</div>
+	`,
+		EmailMagicLinkText: "You asked to access the trip to %v as its owner.\n\n" +
+			"To access it, open the link below, valid for %v minutes:\n\n%v\n\n" +
+			"If you didn't ask for this access, just ignore this email.\n",
+
+		PageTripConfirmedTitle:        "Trip confirmed",
+		PageTripConfirmedText:         "Your trip is confirmed and the invitations were sent to the participants. You can now close this page.",
+		PageParticipantConfirmedTitle: "Attendance confirmed",
+		PageParticipantConfirmedText:  "Your attendance on the trip is confirmed. Have a nice trip! You can now close this page.",
+	},
+}
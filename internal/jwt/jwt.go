@@ -0,0 +1,164 @@
+package jwt
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"journey/cmd/journey/config"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DEFAULT_TTL is how long an owner token is accepted after it is issued.
+const DEFAULT_TTL = 30 * 24 * time.Hour
+
+// MAGIC_LINK_TTL is how long the token of a magic link is exchanged for an owner token after it is sent.
+const MAGIC_LINK_TTL = 15 * time.Minute
+
+// Scope is what a token is issued for, a token issued for a scope is refused for another one.
+type Scope string
+
+const (
+	// ScopeOwner authorizes the routes changing the trip.
+	ScopeOwner Scope = "owner"
+	// ScopeMagicLink is only exchanged for an owner token.
+	ScopeMagicLink Scope = "magic_link"
+)
+
+var (
+	// ErrMissing is returned when verifying an empty token.
+	ErrMissing = errors.New("jwt: missing")
+	// ErrInvalid is returned when the token is malformed, not signed by the issuer or not issued for the scope.
+	ErrInvalid = errors.New("jwt: invalid")
+	// ErrExpired is returned when the token was issued for the scope, but is past its expiry.
+	ErrExpired = errors.New("jwt: expired")
+)
+
+// header is the only header issued and accepted, so a token can't pick a weaker algorithm, as "none".
+var header = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+
+// Claims binds a token to a trip and to the email owning it when the token was issued, so a token issued
+// before the trip changed of owner no longer authorizes it.
+type Claims struct {
+	TripID     uuid.UUID `json:"sub"`
+	OwnerEmail string    `json:"email"`
+	Scope      Scope     `json:"scope"`
+	IssuedAt   int64     `json:"iat"`
+	ExpiresAt  int64     `json:"exp"`
+}
+
+// Expiry is when the token stops being accepted.
+func (c Claims) Expiry() time.Time {
+	return time.Unix(c.ExpiresAt, 0)
+}
+
+// Issuer issues the JWTs authorizing the trip owners, signed with HS256. An Issuer without secret is disabled:
+// it issues nothing and refuses every token, the owners left with the opaque owner tokens.
+type Issuer struct {
+	secret []byte
+	ttl    time.Duration
+}
+
+// New issues with secret owner tokens lasting ttl, DEFAULT_TTL when not positive.
+func New(secret string, ttl time.Duration) Issuer {
+	if ttl <= 0 {
+		ttl = DEFAULT_TTL
+	}
+	return Issuer{secret: []byte(secret), ttl: ttl}
+}
+
+// NewFromEnvironment reads JOURNEY_JWT_SECRET and JOURNEY_JWT_TTL (a duration as "720h"), the issuer being
+// disabled when the secret is missing and the ttl falling back to the default when missing or invalid.
+func NewFromEnvironment() Issuer {
+	secret, _ := config.GetSpecificEnvironmentVariable("JOURNEY_JWT_SECRET")
+	var ttl time.Duration
+	if value, err := config.GetSpecificEnvironmentVariable("JOURNEY_JWT_TTL"); err == nil {
+		ttl, _ = time.ParseDuration(value)
+	}
+	return New(secret, ttl)
+}
+
+// Enabled tells whether the issuer has a secret to sign with.
+func (i Issuer) Enabled() bool {
+	return len(i.secret) > 0
+}
+
+// Issue is the token of the trip owned by ownerEmail for scope, issued at now. An owner token lasts the ttl of
+// the issuer, a magic link MAGIC_LINK_TTL. It fails when the issuer is disabled.
+func (i Issuer) Issue(scope Scope, tripID uuid.UUID, ownerEmail string, now time.Time) (string, Claims, error) {
+	if !i.Enabled() {
+		return "", Claims{}, errors.New("jwt: issuer disabled, JOURNEY_JWT_SECRET is not set")
+	}
+
+	ttl := i.ttl
+	if scope == ScopeMagicLink {
+		ttl = MAGIC_LINK_TTL
+	}
+	claims := Claims{
+		TripID:     tripID,
+		OwnerEmail: ownerEmail,
+		Scope:      scope,
+		IssuedAt:   now.Unix(),
+		ExpiresAt:  now.Add(ttl).Unix(),
+	}
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", Claims{}, fmt.Errorf("jwt: failed to encode the claims: %w", err)
+	}
+	signed := header + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return signed + "." + i.signature(signed), claims, nil
+}
+
+// Verify checks token was signed by the issuer for scope and has not expired at now, answering its claims.
+// The trip and the owner the claims are bound to are left for the caller to check.
+func (i Issuer) Verify(scope Scope, token string, now time.Time) (Claims, error) {
+	if token == "" {
+		return Claims{}, ErrMissing
+	}
+	if !i.Enabled() {
+		return Claims{}, ErrInvalid
+	}
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 || parts[0] != header {
+		return Claims{}, fmt.Errorf("%w: malformed", ErrInvalid)
+	}
+	if !hmac.Equal([]byte(parts[2]), []byte(i.signature(parts[0]+"."+parts[1]))) {
+		return Claims{}, ErrInvalid
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return Claims{}, fmt.Errorf("%w: malformed payload", ErrInvalid)
+	}
+	var claims Claims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return Claims{}, fmt.Errorf("%w: malformed claims", ErrInvalid)
+	}
+
+	// Checked before the expiry, so an expired token tells it was genuine.
+	if claims.Scope != scope {
+		return Claims{}, fmt.Errorf("%w: issued for %q", ErrInvalid, claims.Scope)
+	}
+	if !now.Before(claims.Expiry()) {
+		return Claims{}, ErrExpired
+	}
+	return claims, nil
+}
+
+// LooksLikeJWT tells whether token has the three parts of a JWT, the opaque owner tokens having no dot.
+func LooksLikeJWT(token string) bool {
+	return strings.Count(token, ".") == 2
+}
+
+func (i Issuer) signature(signed string) string {
+	mac := hmac.New(sha256.New, i.secret)
+	mac.Write([]byte(signed))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,103 @@
+package jwt
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var issuedAt = time.Date(2030, time.March, 11, 12, 0, 0, 0, time.UTC)
+
+func TestVerifyAcceptsTheTokenIssued(t *testing.T) {
+	issuer := New("shared-secret", 72*time.Hour)
+	tripID := uuid.New()
+	token, issued, err := issuer.Issue(ScopeOwner, tripID, "owner@trip.com", issuedAt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	claims, err := issuer.Verify(ScopeOwner, token, issuedAt.Add(71*time.Hour))
+	if err != nil {
+		t.Fatalf("expected the token accepted before its expiry, got %v", err)
+	}
+	if claims != issued || claims.TripID != tripID || claims.OwnerEmail != "owner@trip.com" {
+		t.Fatalf("expected the claims issued, got %+v", claims)
+	}
+	if !claims.Expiry().Equal(issuedAt.Add(72 * time.Hour)) {
+		t.Fatalf("expected the token expiring after 72h, got %v", claims.Expiry())
+	}
+	if !LooksLikeJWT(token) {
+		t.Fatalf("expected %q to look like a JWT", token)
+	}
+}
+
+func TestVerifyRefuses(t *testing.T) {
+	issuer := New("shared-secret", 72*time.Hour)
+	token, _, err := issuer.Issue(ScopeOwner, uuid.New(), "owner@trip.com", issuedAt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	magicLink, _, err := issuer.Issue(ScopeMagicLink, uuid.New(), "owner@trip.com", issuedAt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parts := strings.Split(token, ".")
+	unsigned := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`)) + "." + parts[1] + "."
+
+	cases := map[string]struct {
+		issuer Issuer
+		scope  Scope
+		token  string
+		at     time.Time
+		err    error
+	}{
+		"missing token":         {issuer, ScopeOwner, "", issuedAt, ErrMissing},
+		"malformed token":       {issuer, ScopeOwner, "not-a-token", issuedAt, ErrInvalid},
+		"unsigned token":        {issuer, ScopeOwner, unsigned, issuedAt, ErrInvalid},
+		"payload swapped":       {issuer, ScopeOwner, parts[0] + "." + strings.Split(magicLink, ".")[1] + "." + parts[2], issuedAt, ErrInvalid},
+		"another secret":        {New("other-secret", 72*time.Hour), ScopeOwner, token, issuedAt, ErrInvalid},
+		"disabled issuer":       {New("", 0), ScopeOwner, token, issuedAt, ErrInvalid},
+		"issued for magic link": {issuer, ScopeOwner, magicLink, issuedAt, ErrInvalid},
+		"issued for the owner":  {issuer, ScopeMagicLink, token, issuedAt, ErrInvalid},
+		"expired":               {issuer, ScopeOwner, token, issuedAt.Add(72 * time.Hour), ErrExpired},
+		"magic link expired":    {issuer, ScopeMagicLink, magicLink, issuedAt.Add(MAGIC_LINK_TTL), ErrExpired},
+	}
+
+	for name, test := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := test.issuer.Verify(test.scope, test.token, test.at); !errors.Is(err, test.err) {
+				t.Fatalf("expected %v, got %v", test.err, err)
+			}
+		})
+	}
+}
+
+func TestDisabledIssuer(t *testing.T) {
+	issuer := New("", 0)
+
+	if issuer.Enabled() {
+		t.Fatal("expected the issuer without secret disabled")
+	}
+	if token, _, err := issuer.Issue(ScopeOwner, uuid.New(), "owner@trip.com", issuedAt); err == nil {
+		t.Fatalf("expected no token issued, got %q", token)
+	}
+}
+
+func TestNewFallsBackToTheDefaultTTL(t *testing.T) {
+	issuer := New("shared-secret", 0)
+	token, _, err := issuer.Issue(ScopeOwner, uuid.New(), "owner@trip.com", issuedAt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := issuer.Verify(ScopeOwner, token, issuedAt.Add(DEFAULT_TTL-time.Second)); err != nil {
+		t.Fatalf("expected the token lasting %v, got %v", DEFAULT_TTL, err)
+	}
+	if _, err := issuer.Verify(ScopeOwner, token, issuedAt.Add(DEFAULT_TTL)); !errors.Is(err, ErrExpired) {
+		t.Fatalf("expected the token expired after %v, got %v", DEFAULT_TTL, err)
+	}
+}
@@ -0,0 +1,108 @@
+package dispatcher
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+const (
+	DefaultWorkers   = 4
+	DefaultQueueSize = 100
+)
+
+// ErrClosed is returned when enqueueing on a dispatcher already shut down.
+var ErrClosed = errors.New("dispatcher: closed")
+
+type job struct {
+	name   string
+	send   func() error
+	fields []zap.Field
+}
+
+// Dispatcher sends the emails on a fixed number of workers, consuming the jobs from a buffered queue.
+type Dispatcher struct {
+	logger *zap.Logger
+	jobs   chan job
+	wg     sync.WaitGroup
+	mu     sync.RWMutex
+	closed bool
+}
+
+// New starts the workers of a dispatcher holding up to queueSize jobs waiting for a worker.
+func New(logger *zap.Logger, workers int, queueSize int) *Dispatcher {
+	if workers < 1 {
+		workers = 1
+	}
+	if queueSize < 0 {
+		queueSize = 0
+	}
+
+	d := &Dispatcher{
+		logger: logger,
+		jobs:   make(chan job, queueSize),
+	}
+
+	d.wg.Add(workers)
+	for index := 0; index < workers; index++ {
+		go d.work()
+	}
+
+	return d
+}
+
+// Enqueue queues the send, blocking while the queue is full until ctx is done. The failures of the send
+// are logged under name, with fields.
+func (d *Dispatcher) Enqueue(ctx context.Context, name string, send func() error, fields ...zap.Field) error {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if d.closed {
+		return ErrClosed
+	}
+
+	select {
+	case d.jobs <- job{name, send, fields}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Shutdown stops accepting jobs and waits the queued and in-flight ones to finish, or ctx to be done.
+func (d *Dispatcher) Shutdown(ctx context.Context) error {
+	d.mu.Lock()
+	if !d.closed {
+		d.closed = true
+		close(d.jobs)
+	}
+	d.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		d.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (d *Dispatcher) work() {
+	defer d.wg.Done()
+
+	for job := range d.jobs {
+		if err := job.send(); err != nil {
+			d.logger.Error(
+				"failed to send email on "+job.name,
+				append([]zap.Field{zap.Error(err)}, job.fields...)...,
+			)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package dispatcher
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+func TestDispatcherProcessesMoreJobsThanWorkers(t *testing.T) {
+	d := New(zap.NewNop(), 2, 4)
+
+	const jobs = 50
+	var processed atomic.Int64
+	for index := 0; index < jobs; index++ {
+		err := d.Enqueue(context.Background(), "test", func() error {
+			processed.Add(1)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("expected the job to be enqueued, got %v", err)
+		}
+	}
+
+	if err := d.Shutdown(context.Background()); err != nil {
+		t.Fatalf("expected shutdown to drain the jobs, got %v", err)
+	}
+	if processed.Load() != jobs {
+		t.Fatalf("expected %d jobs processed, got %d", jobs, processed.Load())
+	}
+}
+
+func TestDispatcherShutdownWaitsInFlightJobs(t *testing.T) {
+	d := New(zap.NewNop(), 1, 1)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var finished atomic.Bool
+	err := d.Enqueue(context.Background(), "test", func() error {
+		close(started)
+		<-release
+		finished.Store(true)
+		return errors.New("failed")
+	})
+	if err != nil {
+		t.Fatalf("expected the job to be enqueued, got %v", err)
+	}
+	<-started
+
+	shutdown := make(chan error)
+	go func() { shutdown <- d.Shutdown(context.Background()) }()
+
+	select {
+	case <-shutdown:
+		t.Fatal("expected shutdown to wait the in-flight job")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	if err := <-shutdown; err != nil {
+		t.Fatalf("expected shutdown to succeed, got %v", err)
+	}
+	if !finished.Load() {
+		t.Fatal("expected the in-flight job to finish before shutdown returns")
+	}
+}
+
+func TestDispatcherShutdownHonorsContext(t *testing.T) {
+	d := New(zap.NewNop(), 1, 0)
+
+	release := make(chan struct{})
+	defer close(release)
+	_ = d.Enqueue(context.Background(), "test", func() error {
+		<-release
+		return nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := d.Shutdown(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the shutdown to give up on the deadline, got %v", err)
+	}
+}
+
+func TestDispatcherRejectsJobsAfterShutdown(t *testing.T) {
+	d := New(zap.NewNop(), 1, 1)
+	if err := d.Shutdown(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	err := d.Enqueue(context.Background(), "test", func() error { return nil })
+	if !errors.Is(err, ErrClosed) {
+		t.Fatalf("expected ErrClosed, got %v", err)
+	}
+}
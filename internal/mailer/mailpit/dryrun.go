@@ -0,0 +1,65 @@
+package mailpit
+
+import (
+	"context"
+	"journey/cmd/journey/config"
+	"strconv"
+
+	"github.com/wneessen/go-mail"
+	"go.uber.org/zap"
+)
+
+// GetDryRun reads JOURNEY_MAIL_DRYRUN, the emails are then logged rather than sent. It is false when missing
+// or invalid.
+func GetDryRun() bool {
+	if value, err := config.GetSpecificEnvironmentVariable("JOURNEY_MAIL_DRYRUN"); err == nil {
+		if dryRun, err := strconv.ParseBool(value); err == nil {
+			return dryRun
+		}
+	}
+	return false
+}
+
+// dryRunClient logs the messages rendered instead of dialing the SMTP server, for the local development and
+// the tests not to depend on it.
+type dryRunClient struct {
+	logger *zap.Logger
+}
+
+func newDryRunClient(logger *zap.Logger) func() (smtpClient, error) {
+	return func() (smtpClient, error) {
+		return dryRunClient{logger: logger.Named("mail_dryrun")}, nil
+	}
+}
+
+func (c dryRunClient) DialAndSend(msgs ...*mail.Msg) error {
+	for _, msg := range msgs {
+		fields := []zap.Field{
+			zap.Strings("to", msg.GetToString()),
+			zap.Strings("subject", msg.GetGenHeader(mail.HeaderSubject)),
+		}
+		for _, part := range msg.GetParts() {
+			if part.GetContentType() != mail.TypeTextPlain {
+				continue
+			}
+			if content, err := part.GetContent(); err == nil {
+				fields = append(fields, zap.String("body", string(content)))
+			}
+		}
+		c.logger.Info("email not sent, dry run", fields...)
+	}
+	return nil
+}
+
+func (c dryRunClient) DialAndSendWithContext(_ context.Context, msgs ...*mail.Msg) error {
+	return c.DialAndSend(msgs...)
+}
+
+// DialWithContext dials nothing, a dry run is always reachable.
+func (c dryRunClient) DialWithContext(context.Context) error {
+	return nil
+}
+
+func (c dryRunClient) Close() error {
+	return nil
+}
@@ -0,0 +1,53 @@
+package mailpit
+
+import (
+	"context"
+	"journey/internal/i18n"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestDryRunLogsInsteadOfSending(t *testing.T) {
+	t.Setenv("JOURNEY_APP_PORT", "8080")
+	t.Setenv("JOURNEY_MAIL_DRYRUN", "true")
+	core, logs := observer.New(zap.InfoLevel)
+	trip := newTestTrip()
+
+	mp, err := NewMailPit(nil, zap.New(core))
+	if err != nil {
+		t.Fatal(err)
+	}
+	mp.store = &fakeStore{trip: trip}
+	mp.sleep = func(time.Duration) { t.Fatal("expected no send attempted again") }
+
+	client, err := mp.newClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, dryRun := client.(dryRunClient); !dryRun {
+		t.Fatalf("expected the dry run client, got %T", client)
+	}
+
+	if err := mp.SendConfirmTripEmailToTripOwner(trip.ID, i18n.English); err != nil {
+		t.Fatalf("expected the dry run to succeed without the SMTP server, got %v", err)
+	}
+	if err := mp.Ping(context.Background()); err != nil {
+		t.Fatalf("expected the dry run reachable, got %v", err)
+	}
+
+	entries := logs.FilterMessage("email not sent, dry run").All()
+	if len(entries) != 1 {
+		t.Fatalf("expected the email logged once, got %d entries", len(entries))
+	}
+	fields := entries[0].ContextMap()
+	if to, _ := fields["to"].([]interface{}); len(to) != 1 || !strings.Contains(to[0].(string), trip.OwnerEmail) {
+		t.Fatalf("expected the owner as the recipient, got %v", fields["to"])
+	}
+	if body, _ := fields["body"].(string); !strings.Contains(body, confirmTripURL("http://localhost:8080", trip.ID, "")) {
+		t.Fatalf("expected the rendered body with the confirm link logged, got %q", body)
+	}
+}
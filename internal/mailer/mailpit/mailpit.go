@@ -1,129 +1,491 @@
 package mailpit
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"html"
 	"journey/cmd/journey/config"
+	"journey/internal/calendar"
+	"journey/internal/i18n"
+	"journey/internal/jwt"
 	"journey/internal/pgstore"
+	"journey/internal/token"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/wneessen/go-mail"
+	"go.uber.org/zap"
 )
 
+const calendarContentType mail.ContentType = "text/calendar"
+
+// DEFAULT_ATTACH_CALENDAR tells whether the invitations carry the trip as an .ics attachment.
+const DEFAULT_ATTACH_CALENDAR = true
+
+// DEFAULT_CC_OWNER_ON_INVITES tells whether the trip owner gets a copy of each invitation.
+const DEFAULT_CC_OWNER_ON_INVITES = false
+
+// DEFAULT_INVITE_WORKERS is how many invitations of a trip are sent at once.
+const DEFAULT_INVITE_WORKERS = 4
+
 type store interface {
 	GetTrip(context.Context, uuid.UUID) (pgstore.Trip, error)
+	UpdateInviteStatus(context.Context, pgstore.UpdateInviteStatusParams) error
 }
 
 type Mailpit struct {
-	store store
+	store     store
+	newClient func() (smtpClient, error)
+	retry     retryPolicy
+	sleep     func(time.Duration)
+	now       func() time.Time
+	// attachCalendar adds the trip .ics to the invitations, so the participants can add it to their calendars.
+	attachCalendar bool
+	// ccOwnerOnInvites copies the trip owner on each invitation, so the owner keeps a record of them.
+	ccOwnerOnInvites bool
+	// inviteWorkers bounds the invitations of a trip sent at once, they are sent one by one below 1.
+	inviteWorkers int
+	// subjects are the custom subjects of the confirmations and the invitations.
+	subjects subjectTemplates
+	// tokens signs the confirmation links, left bare when disabled.
+	tokens token.Signer
+}
+
+// NewMailPit sends the emails through the mailpit SMTP server, or logs them on the logger when
+// JOURNEY_MAIL_DRYRUN is set. It fails on an invalid subject template.
+func NewMailPit(pool *pgxpool.Pool, logger *zap.Logger) (Mailpit, error) {
+	newClient := newMailpitClient
+	if GetDryRun() {
+		newClient = newDryRunClient(logger)
+	}
+
+	subjects, err := getSubjectTemplates()
+	if err != nil {
+		return Mailpit{}, err
+	}
+
+	return Mailpit{
+		store:            pgstore.New(pool),
+		newClient:        newClient,
+		retry:            getRetryPolicy(),
+		sleep:            time.Sleep,
+		now:              time.Now,
+		attachCalendar:   GetAttachCalendar(),
+		ccOwnerOnInvites: GetCCOwnerOnInvites(),
+		inviteWorkers:    GetInviteWorkers(),
+		subjects:         subjects,
+		tokens:           token.NewFromEnvironment(),
+	}, nil
+}
+
+// GetAttachCalendar reads JOURNEY_MAIL_ATTACH_CALENDAR, falling back to the default when missing or invalid.
+func GetAttachCalendar() bool {
+	if value, err := config.GetSpecificEnvironmentVariable("JOURNEY_MAIL_ATTACH_CALENDAR"); err == nil {
+		if attach, err := strconv.ParseBool(value); err == nil {
+			return attach
+		}
+	}
+	return DEFAULT_ATTACH_CALENDAR
+}
+
+// GetCCOwnerOnInvites reads JOURNEY_CC_OWNER_ON_INVITES, falling back to the default when missing or invalid.
+func GetCCOwnerOnInvites() bool {
+	if value, err := config.GetSpecificEnvironmentVariable("JOURNEY_CC_OWNER_ON_INVITES"); err == nil {
+		if cc, err := strconv.ParseBool(value); err == nil {
+			return cc
+		}
+	}
+	return DEFAULT_CC_OWNER_ON_INVITES
+}
+
+// GetInviteWorkers reads JOURNEY_MAIL_INVITE_WORKERS, falling back to the default when missing or not
+// positive.
+func GetInviteWorkers() int {
+	if value, err := config.GetSpecificEnvironmentVariable("JOURNEY_MAIL_INVITE_WORKERS"); err == nil {
+		if workers, err := strconv.Atoi(value); err == nil && workers > 0 {
+			return workers
+		}
+	}
+	return DEFAULT_INVITE_WORKERS
+}
+
+const (
+	// SMTP_HOST is the host of the mailpit SMTP server, as named on the docker compose network.
+	SMTP_HOST = "mailpit"
+	// SMTP_PORT is the SMTP port of the mailpit server.
+	SMTP_PORT = 1025
+)
+
+// SMTPAddress is the host and port of the SMTP server the emails are sent through.
+func SMTPAddress() string {
+	return net.JoinHostPort(SMTP_HOST, strconv.Itoa(SMTP_PORT))
+}
+
+func newMailpitClient() (smtpClient, error) {
+	return mail.NewClient(SMTP_HOST, mail.WithTLSPortPolicy(mail.NoTLS), mail.WithPort(SMTP_PORT))
+}
+
+// SendConfirmTripEmailToTripOwner sends the owner the link confirming the trip, written in the locale.
+func (mp Mailpit) SendConfirmTripEmailToTripOwner(tripId uuid.UUID, locale i18n.Locale) error {
+	msg, err := mp.newOwnerConfirmationMsg(context.Background(), tripId, locale)
+	if err != nil {
+		return err
+	}
+
+	if err := mp.dialAndSend(msg); err != nil {
+		return fmt.Errorf("mailpit: failed send email client SendConfirmTripEmailToTripOwner: %w", err)
+	}
+
+	return nil
 }
 
-func NewMailPit(pool *pgxpool.Pool) Mailpit {
-	return Mailpit{pgstore.New(pool)}
+// SendConfirmTripEmailToTripOwnerOnce sends the confirmation of SendConfirmTripEmailToTripOwner in a single
+// attempt, given up once ctx is done, for a request waiting on it to answer in time.
+func (mp Mailpit) SendConfirmTripEmailToTripOwnerOnce(ctx context.Context, tripId uuid.UUID, locale i18n.Locale) error {
+	msg, err := mp.newOwnerConfirmationMsg(ctx, tripId, locale)
+	if err != nil {
+		return err
+	}
+
+	if err := mp.dialAndSendOnce(ctx, msg); err != nil {
+		return fmt.Errorf("mailpit: failed send email client SendConfirmTripEmailToTripOwnerOnce: %w", err)
+	}
+
+	return nil
 }
 
-func (mp Mailpit) SendConfirmTripEmailToTripOwner(tripId uuid.UUID) error {
-	ctx := context.Background()
+func (mp Mailpit) newOwnerConfirmationMsg(ctx context.Context, tripId uuid.UUID, locale i18n.Locale) (*mail.Msg, error) {
 	trip, err := mp.store.GetTrip(ctx, tripId)
 	if err != nil {
-		return fmt.Errorf("mailpit: failed to get trip for SendConfirmTripEmailToTripOwner: %w", err)
+		return nil, fmt.Errorf("mailpit: failed to get trip for SendConfirmTripEmailToTripOwner: %w", err)
 	}
 
 	msg := mail.NewMsg()
-	if err := msg.From("oi@planner.com"); err != nil {
-		return fmt.Errorf("mailpit: failed to set 'From' in email SendConfirmTripEmailToTripOwner: %w", err)
+	if err := setFrom(msg, "oi@planner.com", trip.OwnerName); err != nil {
+		return nil, fmt.Errorf("mailpit: failed to set 'From' in email SendConfirmTripEmailToTripOwner: %w", err)
 	}
 
 	if err := msg.To(trip.OwnerEmail); err != nil {
-		return fmt.Errorf("mailpit: failed to set 'to' in email SendConfirmTripEmailToTripOwner: %w", err)
+		return nil, fmt.Errorf("mailpit: failed to set 'to' in email SendConfirmTripEmailToTripOwner: %w", err)
 	}
 
-	portApp, err := getPortApplication("SendConfirmTripEmailToTripOwner")
+	baseURL, err := getPublicBaseURL("SendConfirmTripEmailToTripOwner")
+	if err != nil {
+		return nil, err
+	}
+
+	url := confirmTripURL(baseURL, trip.ID, mp.tokens.Sign(token.PurposeTrip, trip.ID, mp.now()))
+	startsAt, endsAt := formatTripPeriod(trip)
+	subject, err := renderSubject(mp.subjects.confirmTrip, subjectData{trip.Destination, startsAt, endsAt}, locale, i18n.EmailConfirmTripSubject, trip.Destination, startsAt)
+	if err != nil {
+		return nil, fmt.Errorf("mailpit: failed to render the subject in email SendConfirmTripEmailToTripOwner: %w", err)
+	}
+	msg.Subject(subject)
+	setBody(msg, locale, i18n.EmailConfirmTripBody, i18n.EmailConfirmTripText, trip.Destination, startsAt, endsAt, url)
+
+	return msg, nil
+}
+
+// SendConfirmTripEmailToParticipants sends each participant its own invitation, copying the owner when
+// JOURNEY_CC_OWNER_ON_INVITES is set. Up to JOURNEY_MAIL_INVITE_WORKERS invitations are sent at once, the
+// error joins the failed ones, each naming its participant.
+func (mp Mailpit) SendConfirmTripEmailToParticipants(data SendInviteToParticipants) error {
+	baseURL, err := getPublicBaseURL("SendConfirmTripEmailToParticipants")
 	if err != nil {
 		return err
 	}
 
-	url := fmt.Sprintf("http://localhost:%v/trips/%v/confirm", portApp, trip.ID.String())
-	msg.Subject(fmt.Sprintf("Confirme sua presença na viagem para %v em %v", trip.Destination, trip.StartsAt.Time.Format(time.DateOnly)))
-	msg.SetBodyString(mail.TypeTextHTML, fmt.Sprintf(`
-        <div style="font-family: sans-serif; font-size: 16px; line-height: 1.6;">
-          <p>Você solicitou a criação de uma viagem para <strong>%v</strong> nas datas de <strong>%v</strong> até <strong>%v</strong>.</p>
-          <p></p>
-          <p>Para confirmar sua viagem, clique no link abaixo:</p>
-          <p></p>
-          <p>
-            <a href="%v">Confirmar viagem</a>
-          </p>
-          <p></p>
-          <p>Caso você não saiba do que se trata esse e-mail, apenas ignore esse e-mail.</p>
-        </div>
-		`,
-		trip.Destination, trip.StartsAt.Time.Format(time.DateOnly), trip.EndsAt.Time.Format(time.DateOnly), url,
-	))
-
-	client, err := mail.NewClient("mailpit", mail.WithTLSPortPolicy(mail.NoTLS), mail.WithPort(1025))
+	startsAt, endsAt := formatTripPeriod(data.Trip)
+	subject, err := renderSubject(mp.subjects.invite, subjectData{data.Trip.Destination, startsAt, endsAt}, data.Locale, i18n.EmailInviteSubject)
 	if err != nil {
-		return fmt.Errorf("mailpit: failed create email client SendConfirmTripEmailToTripOwner: %w", err)
+		return fmt.Errorf("mailpit: failed to render the subject in email SendConfirmTripEmailToParticipants: %w", err)
 	}
+	content := inviteContent{baseURL, subject, startsAt, endsAt}
 
-	if err := client.DialAndSend(msg); err != nil {
-		return fmt.Errorf("mailpit: failed send email client SendConfirmTripEmailToTripOwner: %w", err)
+	// A failed invite doesn't hold the others back, each participant keeps the status of its own.
+	errs := make([]error, len(data.Invites))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for range min(max(mp.inviteWorkers, 1), len(data.Invites)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indexes {
+				errs[index] = mp.sendInvite(data, data.Invites[index], content)
+			}
+		}()
+	}
+	for index := range data.Invites {
+		indexes <- index
 	}
+	close(indexes)
+	wg.Wait()
 
-	return nil
+	return errors.Join(errs...)
 }
 
-func (mp Mailpit) SendConfirmTripEmailToParticipants(data SendInviteToParticipants) error {
+// inviteContent is what the invitations of a trip share, rendered once for all of them.
+type inviteContent struct {
+	baseURL  string
+	subject  string
+	startsAt string
+	endsAt   string
+}
+
+// sendInvite sends the participant its invitation and records the attempt on its invite status, failed when
+// the invitation could not be built or sent.
+func (mp Mailpit) sendInvite(data SendInviteToParticipants, invite InviteParticipantsToTrip, content inviteContent) error {
+	var errs []error
+	status := pgstore.InviteStatusSent
+	msg, err := mp.newInviteMsg(data, invite, content)
+	if err == nil {
+		err = mp.dialAndSend(msg)
+	}
+	if err != nil {
+		status = pgstore.InviteStatusFailed
+		errs = append(errs, err)
+	}
 
+	if err := mp.store.UpdateInviteStatus(context.Background(), pgstore.UpdateInviteStatusParams{
+		InviteStatus:        status,
+		InviteLastAttemptAt: pgtype.Timestamp{Valid: true, Time: mp.now().UTC()},
+		ID:                  invite.Participant.ParticipantId,
+	}); err != nil {
+		errs = append(errs, fmt.Errorf("failed to update the invite status: %w", err))
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("mailpit: failed on the invite of %s in SendConfirmTripEmailToParticipants: %w", invite.Participant.Email, err)
+	}
+	return nil
+}
+
+// newInviteMsg is the invitation of the participant, a message of its own so the recipients of an invite
+// never leak into another one.
+func (mp Mailpit) newInviteMsg(data SendInviteToParticipants, invite InviteParticipantsToTrip, content inviteContent) (*mail.Msg, error) {
 	msg := mail.NewMsg()
-	if err := msg.From("mailpit@journey.com"); err != nil {
-		return fmt.Errorf("mailpit: failed to set 'From' in email SendConfirmTripEmailToParticipants: %w", err)
+	if err := setFrom(msg, "mailpit@journey.com", data.Trip.OwnerName); err != nil {
+		return nil, fmt.Errorf("failed to set 'From': %w", err)
 	}
 
-	portApp, err := getPortApplication("SendConfirmTripEmailToTripOwner")
-	if err != nil {
-		return err
+	if err := msg.To(invite.Participant.Email); err != nil {
+		return nil, fmt.Errorf("failed to set 'to': %w", err)
 	}
 
-	for _, invite := range data.Invites {
+	// the owner invited as a participant already gets the invite, a copy would be a duplicate.
+	if mp.ccOwnerOnInvites && !strings.EqualFold(data.Trip.OwnerEmail, invite.Participant.Email) {
+		if err := msg.Cc(data.Trip.OwnerEmail); err != nil {
+			return nil, fmt.Errorf("failed to set 'cc': %w", err)
+		}
+	}
 
-		if err := msg.To(invite.Participant.Email); err != nil {
-			return fmt.Errorf("mailpit: failed to set 'to' in email SendConfirmTripEmailToParticipants: %w", err)
+	if mp.attachCalendar {
+		if err := attachTripCalendar(msg, data.Trip, mp.now()); err != nil {
+			return nil, fmt.Errorf("failed to attach the calendar: %w", err)
 		}
+	}
+
+	participantID := invite.Participant.ParticipantId
+	url := confirmParticipantURL(content.baseURL, participantID, mp.tokens.Sign(token.PurposeParticipant, participantID, mp.now()))
+	msg.Subject(content.subject)
 
-		url := fmt.Sprintf("http://localhost:%v/participants/%v/confirm", portApp, invite.Participant.ParticipantId)
-		msg.Subject("Confirme sua viagem")
-		msg.SetBodyString(mail.TypeTextHTML, fmt.Sprintf(`
-		<div style="font-family: sans-serif; font-size: 16px; line-height: 1.6;">
-		  <p>Você foi convidado(a) para participar de uma viagem para <strong>%v</strong> nas datas de <strong>%v</strong> até <strong>%v</strong>.</p>
-		  <p></p>
-		  <p>Para confirmar sua presença na viagem, clique no link abaixo:</p>
-		  <p></p>
-		  <p>
-			<a href="%v">Confirmar viagem</a>
-		  </p>
-		  <p></p>
-		  <p>Caso você não saiba do que se trata esse e-mail, apenas ignore esse e-mail.</p>
-		</div>
-	`,
-			data.Trip.Destination, data.Trip.StartsAt.Time.Format(time.DateOnly), data.Trip.EndsAt.Time.Format(time.DateOnly), url,
-		))
-
-		client, err := mail.NewClient("mailpit", mail.WithTLSPortPolicy(mail.NoTLS), mail.WithPort(1025))
-		if err != nil {
-			return fmt.Errorf("mailpit: failed to set 'to' in email SendConfirmTripEmailToParticipants: %w", err)
+	greeting := inviteGreeting(data.Locale, invite.Participant.Name)
+	setBody(msg, data.Locale, i18n.EmailInviteBody, i18n.EmailInviteText, greeting, data.Trip.Destination, content.startsAt, content.endsAt, url)
+	return msg, nil
+}
+
+// inviteGreeting greets the participant by its name, or without one when unknown.
+func inviteGreeting(locale i18n.Locale, name string) string {
+	if name = strings.TrimSpace(name); name != "" {
+		return i18n.Message(locale, i18n.EmailInviteGreetingName, name)
+	}
+	return i18n.Message(locale, i18n.EmailInviteGreeting)
+}
+
+// SendTripReminderToParticipants reminds each participant the trip is about to start, a message of its own
+// for each of them. A failed one doesn't hold the others back, the error joins the failed ones.
+func (mp Mailpit) SendTripReminderToParticipants(data SendTripReminder) error {
+	startsAt, endsAt := formatTripPeriod(data.Trip)
+	subject := i18n.Message(data.Locale, i18n.EmailReminderSubject, data.Trip.Destination)
+
+	var errs []error
+	for _, participant := range data.Participants {
+		msg := mail.NewMsg()
+		if err := setFrom(msg, "mailpit@journey.com", data.Trip.OwnerName); err != nil {
+			return fmt.Errorf("mailpit: failed to set 'From' in email SendTripReminderToParticipants: %w", err)
+		}
+
+		if err := msg.To(participant.Email); err != nil {
+			errs = append(errs, fmt.Errorf("mailpit: failed to set 'to' of %s in email SendTripReminderToParticipants: %w", participant.Email, err))
+			continue
 		}
 
-		if err := client.DialAndSend(msg); err != nil {
-			return fmt.Errorf("mailpit: failed to set 'to' in email SendConfirmTripEmailToParticipants: %w", err)
+		msg.Subject(subject)
+		setBody(msg, data.Locale, i18n.EmailReminderBody, i18n.EmailReminderText, data.Trip.Destination, startsAt, endsAt)
+		if err := mp.dialAndSend(msg); err != nil {
+			errs = append(errs, fmt.Errorf("mailpit: failed send email client of %s SendTripReminderToParticipants: %w", participant.Email, err))
 		}
 	}
 
+	return errors.Join(errs...)
+}
+
+// SendTripCancellationToParticipants tells each participant the trip was cancelled, a message of its own for
+// each of them. A failed one doesn't hold the others back, the error joins the failed ones.
+func (mp Mailpit) SendTripCancellationToParticipants(data SendTripCancellation) error {
+	startsAt, endsAt := formatTripPeriod(data.Trip)
+	subject := i18n.Message(data.Locale, i18n.EmailCancellationSubject, data.Trip.Destination)
+
+	var errs []error
+	for _, participant := range data.Participants {
+		msg := mail.NewMsg()
+		if err := setFrom(msg, "mailpit@journey.com", data.Trip.OwnerName); err != nil {
+			return fmt.Errorf("mailpit: failed to set 'From' in email SendTripCancellationToParticipants: %w", err)
+		}
+
+		if err := msg.To(participant.Email); err != nil {
+			errs = append(errs, fmt.Errorf("mailpit: failed to set 'to' of %s in email SendTripCancellationToParticipants: %w", participant.Email, err))
+			continue
+		}
+
+		msg.Subject(subject)
+		setBody(msg, data.Locale, i18n.EmailCancellationBody, i18n.EmailCancellationText, data.Trip.Destination, startsAt, endsAt)
+		if err := mp.dialAndSend(msg); err != nil {
+			errs = append(errs, fmt.Errorf("mailpit: failed send email client of %s SendTripCancellationToParticipants: %w", participant.Email, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// SendOwnerMagicLink sends the trip owner the magic link exchanging its token for an owner token, so the
+// owner gets a new one without the token returned on the trip creation.
+func (mp Mailpit) SendOwnerMagicLink(data SendOwnerMagicLink) error {
+	msg := mail.NewMsg()
+	if err := setFrom(msg, "oi@planner.com", data.Trip.OwnerName); err != nil {
+		return fmt.Errorf("mailpit: failed to set 'From' in email SendOwnerMagicLink: %w", err)
+	}
+
+	if err := msg.To(data.Trip.OwnerEmail); err != nil {
+		return fmt.Errorf("mailpit: failed to set 'to' in email SendOwnerMagicLink: %w", err)
+	}
+
+	baseURL, err := getPublicBaseURL("SendOwnerMagicLink")
+	if err != nil {
+		return err
+	}
+
+	url := ownerTokenURL(baseURL, data.Trip.ID, data.Token)
+	msg.Subject(i18n.Message(data.Locale, i18n.EmailMagicLinkSubject, data.Trip.Destination))
+	setBody(msg, data.Locale, i18n.EmailMagicLinkBody, i18n.EmailMagicLinkText, data.Trip.Destination, int(jwt.MAGIC_LINK_TTL.Minutes()), url)
+
+	if err := mp.dialAndSend(msg); err != nil {
+		return fmt.Errorf("mailpit: failed send email client SendOwnerMagicLink: %w", err)
+	}
+
 	return nil
 }
 
+// setFrom sets the sender of a trip email, shown on the name of the trip owner so the recipients recognize it.
+// A trip without an owner name is sent from the bare address.
+func setFrom(msg *mail.Msg, address, ownerName string) error {
+	if name := strings.TrimSpace(ownerName); name != "" {
+		return msg.FromFormat(name, address)
+	}
+	return msg.From(address)
+}
+
+// Ping checks the SMTP server is reachable, dialing and closing a connection to it.
+func (mp Mailpit) Ping(ctx context.Context) error {
+	client, err := mp.newClient()
+	if err != nil {
+		return fmt.Errorf("mailpit: failed create email client on Ping: %w", err)
+	}
+
+	if err := client.DialWithContext(ctx); err != nil {
+		return fmt.Errorf("mailpit: failed to dial on Ping: %w", err)
+	}
+
+	return client.Close()
+}
+
+// setBody writes the plaintext and the HTML alternatives of the message from the same arguments. The HTML
+// is added last: the clients display the last alternative they support, the plaintext is for the others. The
+// strings are escaped in the HTML alternative only, they are typed by the users.
+func setBody(msg *mail.Msg, locale i18n.Locale, htmlKey, textKey i18n.Key, args ...any) {
+	msg.SetBodyString(mail.TypeTextPlain, i18n.Message(locale, textKey, args...))
+	msg.AddAlternativeString(mail.TypeTextHTML, i18n.Message(locale, htmlKey, escapeHTMLArgs(args)...))
+}
+
+// escapeHTMLArgs is args with its strings escaped for HTML, the other values as they are.
+func escapeHTMLArgs(args []any) []any {
+	escaped := make([]any, len(args))
+	for i, arg := range args {
+		if value, ok := arg.(string); ok {
+			arg = html.EscapeString(value)
+		}
+		escaped[i] = arg
+	}
+	return escaped
+}
+
+// attachTripCalendar attaches the trip period as an .ics, the same event the calendar feed of the trip has.
+func attachTripCalendar(msg *mail.Msg, trip pgstore.Trip, now time.Time) error {
+	var buf bytes.Buffer
+	if err := calendar.WriteTrip(&buf, trip, nil, now); err != nil {
+		return err
+	}
+	return msg.AttachReader("trip.ics", &buf, mail.WithFileContentType(calendarContentType))
+}
+
+func confirmTripURL(baseURL string, tripID uuid.UUID, confirmToken string) string {
+	return withToken(fmt.Sprintf("%s/trips/%v/confirm", baseURL, tripID.String()), confirmToken)
+}
+
+func confirmParticipantURL(baseURL string, participantID uuid.UUID, confirmToken string) string {
+	return withToken(fmt.Sprintf("%s/participants/%v/confirm", baseURL, participantID), confirmToken)
+}
+
+func ownerTokenURL(baseURL string, tripID uuid.UUID, magicLinkToken string) string {
+	return withToken(fmt.Sprintf("%s/trips/%v/owner/token", baseURL, tripID), magicLinkToken)
+}
+
+// withToken adds the confirmation or the magic link token to the link, unless none was signed. The token is
+// url safe, it is added unescaped.
+func withToken(link string, confirmToken string) string {
+	if confirmToken == "" {
+		return link
+	}
+	return link + "?token=" + confirmToken
+}
+
+// getPublicBaseURL is JOURNEY_PUBLIC_BASE_URL, as "https://journey.example.com", the links of the emails are
+// built on, without its trailing slash. It falls back to the app on localhost when unset.
+func getPublicBaseURL(nameFunctionCaller string) (string, error) {
+	if baseURL, err := config.GetSpecificEnvironmentVariable("JOURNEY_PUBLIC_BASE_URL"); err == nil && baseURL != "" {
+		return strings.TrimRight(baseURL, "/"), nil
+	}
+
+	port, err := getPortApplication(nameFunctionCaller)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("http://localhost:%v", port), nil
+}
+
+// formatTripPeriod is the start and end dates of the trip, as written in the emails.
+func formatTripPeriod(trip pgstore.Trip) (string, string) {
+	return trip.StartsAt.Time.Format(time.DateOnly), trip.EndsAt.Time.Format(time.DateOnly)
+}
+
 func getPortApplication(nameFunctionCaller string) (string, error) {
 	stringEmpty := ""
 
@@ -138,6 +500,8 @@ func getPortApplication(nameFunctionCaller string) (string, error) {
 type SendInviteToParticipants struct {
 	Trip    pgstore.Trip
 	Invites []InviteParticipantsToTrip
+	// Locale is the language of the invitations, the zero value is i18n.DefaultLocale.
+	Locale i18n.Locale
 }
 
 type InviteParticipantsToTrip struct {
@@ -148,4 +512,28 @@ type InviteParticipantsToTrip struct {
 type Participant struct {
 	Email         string
 	ParticipantId uuid.UUID
+	// Name is the one the invitation greets the participant by, empty when unknown.
+	Name string
+}
+
+type SendTripReminder struct {
+	Trip         pgstore.Trip
+	Participants []Participant
+	// Locale is the language of the reminders, the zero value is i18n.DefaultLocale.
+	Locale i18n.Locale
+}
+
+type SendTripCancellation struct {
+	Trip         pgstore.Trip
+	Participants []Participant
+	// Locale is the language of the cancellations, the zero value is i18n.DefaultLocale.
+	Locale i18n.Locale
+}
+
+type SendOwnerMagicLink struct {
+	Trip pgstore.Trip
+	// Token is exchanged for an owner token on the link, it expires after jwt.MAGIC_LINK_TTL.
+	Token string
+	// Locale is the language of the email, the zero value is i18n.DefaultLocale.
+	Locale i18n.Locale
 }
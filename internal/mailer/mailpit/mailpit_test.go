@@ -0,0 +1,705 @@
+package mailpit
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"journey/internal/i18n"
+	"journey/internal/pgstore"
+	"journey/internal/token"
+	"mime"
+	"mime/multipart"
+	netmail "net/mail"
+	"net/textproto"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/wneessen/go-mail"
+)
+
+// fakeStore answers the trip and keeps the invite statuses updated, by participant.
+type fakeStore struct {
+	mu       sync.Mutex
+	trip     pgstore.Trip
+	statuses map[uuid.UUID]pgstore.UpdateInviteStatusParams
+}
+
+func (s *fakeStore) GetTrip(context.Context, uuid.UUID) (pgstore.Trip, error) {
+	return s.trip, nil
+}
+
+func (s *fakeStore) UpdateInviteStatus(_ context.Context, arg pgstore.UpdateInviteStatusParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.statuses == nil {
+		s.statuses = make(map[uuid.UUID]pgstore.UpdateInviteStatusParams)
+	}
+	s.statuses[arg.ID] = arg
+	return nil
+}
+
+func newTestTrip() pgstore.Trip {
+	startsAt := time.Date(2030, time.March, 10, 12, 0, 0, 0, time.UTC)
+	return pgstore.Trip{
+		ID:          uuid.New(),
+		Destination: "Florianópolis",
+		OwnerEmail:  "owner@example.com",
+		StartsAt:    pgtype.Timestamp{Valid: true, Time: startsAt},
+		EndsAt:      pgtype.Timestamp{Valid: true, Time: startsAt.AddDate(0, 0, 3)},
+	}
+}
+
+// bodyParts writes the message and decodes its alternatives, by content type.
+func bodyParts(t *testing.T, msg *mail.Msg) map[string]string {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if _, err := msg.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := netmail.ReadMessage(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mediaType, params, err := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/alternative" {
+		t.Fatalf("expected a multipart/alternative message, got %q", parsed.Header.Get("Content-Type"))
+	}
+
+	parts := make(map[string]string)
+	var order []string
+	reader := multipart.NewReader(parsed.Body, params["boundary"])
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		contentType, _, _ := mime.ParseMediaType(part.Header.Get("Content-Type"))
+		body, err := io.ReadAll(part)
+		if err != nil {
+			t.Fatal(err)
+		}
+		parts[contentType] = string(body)
+		order = append(order, contentType)
+	}
+
+	if len(order) != 2 || order[1] != "text/html" {
+		t.Fatalf("expected the HTML as the last, preferred, alternative, got %v", order)
+	}
+	return parts
+}
+
+func assertBothPartsContain(t *testing.T, parts map[string]string, texts ...string) {
+	t.Helper()
+
+	for _, contentType := range []string{"text/plain", "text/html"} {
+		for _, text := range texts {
+			if !strings.Contains(parts[contentType], text) {
+				t.Fatalf("expected the %s part to contain %q, got:\n%s", contentType, text, parts[contentType])
+			}
+		}
+	}
+	if strings.Contains(parts["text/plain"], "<") {
+		t.Fatalf("expected no markup in the plaintext, got:\n%s", parts["text/plain"])
+	}
+}
+
+func TestSendConfirmTripEmailToTripOwnerHasBothParts(t *testing.T) {
+	t.Setenv("JOURNEY_APP_PORT", "8080")
+	trip := newTestTrip()
+	client := &fakeClient{}
+	mp := newTestMailpit(client, &[]time.Duration{})
+	mp.store = &fakeStore{trip: trip}
+
+	if err := mp.SendConfirmTripEmailToTripOwner(trip.ID, i18n.English); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(client.sent) != 1 {
+		t.Fatalf("expected one email sent, got %d", len(client.sent))
+	}
+	assertBothPartsContain(t, bodyParts(t, client.sent[0]),
+		"http://localhost:8080/trips/"+trip.ID.String()+"/confirm", "2030-03-10", "2030-03-13",
+	)
+}
+
+func TestSendConfirmTripEmailToParticipantsHasBothParts(t *testing.T) {
+	t.Setenv("JOURNEY_APP_PORT", "8080")
+	trip := newTestTrip()
+	participantID := uuid.New()
+	client := &fakeClient{}
+
+	mp := newTestMailpit(client, &[]time.Duration{})
+	mp.store = &fakeStore{trip: trip}
+
+	err := mp.SendConfirmTripEmailToParticipants(SendInviteToParticipants{
+		Trip: trip,
+		Invites: []InviteParticipantsToTrip{
+			{TripID: trip.ID, Participant: Participant{Email: "guest@example.com", ParticipantId: participantID}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(client.sent) != 1 {
+		t.Fatalf("expected one email sent, got %d", len(client.sent))
+	}
+	assertBothPartsContain(t, bodyParts(t, client.sent[0]),
+		"http://localhost:8080/participants/"+participantID.String()+"/confirm", "2030-03-10",
+	)
+}
+
+func TestConfirmLinksUseThePublicBaseURL(t *testing.T) {
+	t.Setenv("JOURNEY_APP_PORT", "8080")
+	t.Setenv("JOURNEY_PUBLIC_BASE_URL", "https://journey.example.com/")
+	trip := newTestTrip()
+	participantID := uuid.New()
+	client := &fakeClient{}
+	mp := newTestMailpit(client, &[]time.Duration{})
+	mp.store = &fakeStore{trip: trip}
+
+	if err := mp.SendConfirmTripEmailToTripOwner(trip.ID, i18n.English); err != nil {
+		t.Fatal(err)
+	}
+	err := mp.SendConfirmTripEmailToParticipants(SendInviteToParticipants{
+		Trip: trip,
+		Invites: []InviteParticipantsToTrip{
+			{TripID: trip.ID, Participant: Participant{Email: "guest@example.com", ParticipantId: participantID}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(client.sent) != 2 {
+		t.Fatalf("expected two emails sent, got %d", len(client.sent))
+	}
+	assertBothPartsContain(t, bodyParts(t, client.sent[0]), "https://journey.example.com/trips/"+trip.ID.String()+"/confirm")
+	assertBothPartsContain(t, bodyParts(t, client.sent[1]), "https://journey.example.com/participants/"+participantID.String()+"/confirm")
+}
+
+func TestConfirmLinksCarryTheSignedToken(t *testing.T) {
+	t.Setenv("JOURNEY_APP_PORT", "8080")
+	trip := newTestTrip()
+	participantID := uuid.New()
+	now := time.Date(2030, time.March, 1, 12, 0, 0, 0, time.UTC)
+	client := &fakeClient{}
+	mp := newTestMailpit(client, &[]time.Duration{})
+	mp.store = &fakeStore{trip: trip}
+	mp.now = func() time.Time { return now }
+	mp.tokens = token.New("shared-secret", time.Hour)
+
+	if err := mp.SendConfirmTripEmailToTripOwner(trip.ID, i18n.English); err != nil {
+		t.Fatal(err)
+	}
+	err := mp.SendConfirmTripEmailToParticipants(SendInviteToParticipants{
+		Trip: trip,
+		Invites: []InviteParticipantsToTrip{
+			{TripID: trip.ID, Participant: Participant{Email: "guest@example.com", ParticipantId: participantID}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(client.sent) != 2 {
+		t.Fatalf("expected two emails sent, got %d", len(client.sent))
+	}
+	assertBothPartsContain(t, bodyParts(t, client.sent[0]),
+		"http://localhost:8080/trips/"+trip.ID.String()+"/confirm?token="+mp.tokens.Sign(token.PurposeTrip, trip.ID, now),
+	)
+	assertBothPartsContain(t, bodyParts(t, client.sent[1]),
+		"http://localhost:8080/participants/"+participantID.String()+"/confirm?token="+mp.tokens.Sign(token.PurposeParticipant, participantID, now),
+	)
+}
+
+func TestSendTripReminderToParticipantsHasBothParts(t *testing.T) {
+	client := &fakeClient{}
+
+	err := newTestMailpit(client, &[]time.Duration{}).SendTripReminderToParticipants(SendTripReminder{
+		Trip:         newTestTrip(),
+		Participants: []Participant{{Email: "guest@example.com", ParticipantId: uuid.New()}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(client.sent) != 1 {
+		t.Fatalf("expected one email sent, got %d", len(client.sent))
+	}
+	assertBothPartsContain(t, bodyParts(t, client.sent[0]), "2030-03-10", "2030-03-13")
+}
+
+func TestSendTripReminderToParticipantsGoesOnPastAFailure(t *testing.T) {
+	client := &fakeClient{rejected: map[string]error{"rejected@example.com": &textproto.Error{Code: 550, Msg: "mailbox unavailable"}}}
+
+	err := newTestMailpit(client, &[]time.Duration{}).SendTripReminderToParticipants(SendTripReminder{
+		Trip: newTestTrip(),
+		Participants: []Participant{
+			{Email: "ana@example.com", ParticipantId: uuid.New()},
+			{Email: "rejected@example.com", ParticipantId: uuid.New()},
+			{Email: "bia@example.com", ParticipantId: uuid.New()},
+		},
+	})
+
+	if err == nil || !strings.Contains(err.Error(), "rejected@example.com") {
+		t.Fatalf("expected the error to name rejected@example.com, got %v", err)
+	}
+	var sent []string
+	for _, msg := range client.sent {
+		sent = append(sent, addresses(msg.GetTo())...)
+	}
+	if expected := []string{"ana@example.com", "bia@example.com"}; !reflect.DeepEqual(sent, expected) {
+		t.Fatalf("expected each participant sent a message of its own past the failure, got %v", sent)
+	}
+}
+
+func TestSendTripCancellationToParticipants(t *testing.T) {
+	client := &fakeClient{rejected: map[string]error{"rejected@example.com": &textproto.Error{Code: 550, Msg: "mailbox unavailable"}}}
+
+	err := newTestMailpit(client, &[]time.Duration{}).SendTripCancellationToParticipants(SendTripCancellation{
+		Trip: newTestTrip(),
+		Participants: []Participant{
+			{Email: "ana@example.com", ParticipantId: uuid.New()},
+			{Email: "rejected@example.com", ParticipantId: uuid.New()},
+			{Email: "bia@example.com", ParticipantId: uuid.New()},
+		},
+	})
+
+	if err == nil || !strings.Contains(err.Error(), "rejected@example.com") {
+		t.Fatalf("expected the error to name rejected@example.com, got %v", err)
+	}
+	var sent []string
+	for _, msg := range client.sent {
+		sent = append(sent, addresses(msg.GetTo())...)
+	}
+	if expected := []string{"ana@example.com", "bia@example.com"}; !reflect.DeepEqual(sent, expected) {
+		t.Fatalf("expected each participant sent a message of its own past the failure, got %v", sent)
+	}
+	assertBothPartsContain(t, bodyParts(t, client.sent[0]), "2030-03-10", "2030-03-13")
+}
+
+func TestSendOwnerMagicLink(t *testing.T) {
+	t.Setenv("JOURNEY_APP_PORT", "8080")
+	trip := newTestTrip()
+	client := &fakeClient{}
+
+	err := newTestMailpit(client, &[]time.Duration{}).SendOwnerMagicLink(SendOwnerMagicLink{Trip: trip, Token: "header.claims.signature", Locale: i18n.English})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(client.sent) != 1 {
+		t.Fatalf("expected one email sent, got %d", len(client.sent))
+	}
+	if to := addresses(client.sent[0].GetTo()); !reflect.DeepEqual(to, []string{trip.OwnerEmail}) {
+		t.Fatalf("expected the magic link sent to the owner, got %v", to)
+	}
+	assertBothPartsContain(t, bodyParts(t, client.sent[0]),
+		"http://localhost:8080/trips/"+trip.ID.String()+"/owner/token?token=header.claims.signature", "valid for 15 minutes",
+	)
+}
+
+func TestSendConfirmTripEmailToParticipantsUpdatesInviteStatus(t *testing.T) {
+	t.Setenv("JOURNEY_APP_PORT", "8080")
+	trip := newTestTrip()
+	sentID, failedID := uuid.New(), uuid.New()
+	attemptedAt := time.Date(2030, time.March, 1, 9, 30, 0, 0, time.UTC)
+	store := &fakeStore{trip: trip}
+	// the first invite is sent, the second fails for good.
+	client := &fakeClient{errs: []error{nil, &textproto.Error{Code: 550, Msg: "mailbox unavailable"}}}
+	mp := newTestMailpit(client, &[]time.Duration{})
+	mp.store = store
+	mp.now = func() time.Time { return attemptedAt }
+
+	err := mp.SendConfirmTripEmailToParticipants(SendInviteToParticipants{
+		Trip: trip,
+		Invites: []InviteParticipantsToTrip{
+			{TripID: trip.ID, Participant: Participant{Email: "sent@example.com", ParticipantId: sentID}},
+			{TripID: trip.ID, Participant: Participant{Email: "failed@example.com", ParticipantId: failedID}},
+		},
+	})
+	if err == nil {
+		t.Fatal("expected the failed invite reported")
+	}
+
+	for id, expected := range map[uuid.UUID]string{sentID: pgstore.InviteStatusSent, failedID: pgstore.InviteStatusFailed} {
+		status, found := store.statuses[id]
+		if !found || status.InviteStatus != expected {
+			t.Fatalf("expected the invite %s, got %+v", expected, status)
+		}
+		if !status.InviteLastAttemptAt.Valid || !status.InviteLastAttemptAt.Time.Equal(attemptedAt) {
+			t.Fatalf("expected the attempt at %v, got %+v", attemptedAt, status.InviteLastAttemptAt)
+		}
+	}
+}
+
+func TestSendConfirmTripEmailToParticipantsAttachesTheCalendar(t *testing.T) {
+	t.Setenv("JOURNEY_APP_PORT", "8080")
+
+	for _, attach := range []bool{true, false} {
+		trip := newTestTrip()
+		client := &fakeClient{}
+		mp := newTestMailpit(client, &[]time.Duration{})
+		mp.store = &fakeStore{trip: trip}
+		mp.attachCalendar = attach
+
+		err := mp.SendConfirmTripEmailToParticipants(SendInviteToParticipants{
+			Trip: trip,
+			Invites: []InviteParticipantsToTrip{
+				{TripID: trip.ID, Participant: Participant{Email: "guest@example.com", ParticipantId: uuid.New()}},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		attachments := client.sent[0].GetAttachments()
+		if !attach {
+			if len(attachments) != 0 {
+				t.Fatalf("expected no attachment when disabled, got %d", len(attachments))
+			}
+			continue
+		}
+
+		if len(attachments) != 1 || attachments[0].ContentType != "text/calendar" {
+			t.Fatalf("expected a single text/calendar attachment, got %+v", attachments)
+		}
+		var ics bytes.Buffer
+		if _, err := attachments[0].Writer(&ics); err != nil {
+			t.Fatal(err)
+		}
+		for _, line := range []string{"UID:" + trip.ID.String() + "@journey", "DTSTART;VALUE=DATE:20300310", "DTEND;VALUE=DATE:20300314", "LOCATION:Florianópolis"} {
+			if !strings.Contains(ics.String(), line+"\r\n") {
+				t.Fatalf("expected the calendar to contain %q, got:\n%s", line, ics.String())
+			}
+		}
+	}
+}
+
+// addresses are the bare addresses of the recipients.
+func addresses(recipients []*netmail.Address) []string {
+	bare := make([]string, len(recipients))
+	for index, recipient := range recipients {
+		bare[index] = recipient.Address
+	}
+	return bare
+}
+
+func TestSendConfirmTripEmailToParticipantsCopiesTheOwner(t *testing.T) {
+	t.Setenv("JOURNEY_APP_PORT", "8080")
+
+	for _, cc := range []bool{true, false} {
+		trip := newTestTrip()
+		client := &fakeClient{}
+		mp := newTestMailpit(client, &[]time.Duration{})
+		mp.store = &fakeStore{trip: trip}
+		mp.ccOwnerOnInvites = cc
+
+		err := mp.SendConfirmTripEmailToParticipants(SendInviteToParticipants{
+			Trip: trip,
+			Invites: []InviteParticipantsToTrip{
+				{TripID: trip.ID, Participant: Participant{Email: "first@example.com", ParticipantId: uuid.New()}},
+				{TripID: trip.ID, Participant: Participant{Email: "second@example.com", ParticipantId: uuid.New()}},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(client.sent) != 2 {
+			t.Fatalf("expected two emails sent, got %d", len(client.sent))
+		}
+		for index, email := range []string{"first@example.com", "second@example.com"} {
+			msg := client.sent[index]
+			if to := addresses(msg.GetTo()); len(to) != 1 || to[0] != email {
+				t.Fatalf("expected the invite to %s only, got %v", email, to)
+			}
+
+			copied := addresses(msg.GetCc())
+			if !cc {
+				if len(copied) != 0 {
+					t.Fatalf("expected no copy when disabled, got %v", copied)
+				}
+				continue
+			}
+			if len(copied) != 1 || copied[0] != trip.OwnerEmail {
+				t.Fatalf("expected the invite to %s copied to the owner, got %v", email, copied)
+			}
+		}
+	}
+}
+
+func TestSendConfirmTripEmailToParticipantsDoesNotCopyTheOwnerInvited(t *testing.T) {
+	t.Setenv("JOURNEY_APP_PORT", "8080")
+	trip := newTestTrip()
+	client := &fakeClient{}
+	mp := newTestMailpit(client, &[]time.Duration{})
+	mp.store = &fakeStore{trip: trip}
+	mp.ccOwnerOnInvites = true
+
+	err := mp.SendConfirmTripEmailToParticipants(SendInviteToParticipants{
+		Trip: trip,
+		Invites: []InviteParticipantsToTrip{
+			{TripID: trip.ID, Participant: Participant{Email: "Owner@Example.com", ParticipantId: uuid.New()}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if copied := addresses(client.sent[0].GetCc()); len(copied) != 0 {
+		t.Fatalf("expected the owner invited not copied on its own invite, got %v", copied)
+	}
+}
+
+func TestTripEmailsAreSentOnTheOwnerName(t *testing.T) {
+	t.Setenv("JOURNEY_APP_PORT", "8080")
+
+	for _, name := range []string{"Maria Silva", "", "  "} {
+		trip := newTestTrip()
+		trip.OwnerName = name
+		client := &fakeClient{}
+		mp := newTestMailpit(client, &[]time.Duration{})
+		mp.store = &fakeStore{trip: trip}
+
+		if err := mp.SendConfirmTripEmailToTripOwner(trip.ID, i18n.English); err != nil {
+			t.Fatal(err)
+		}
+		err := mp.SendConfirmTripEmailToParticipants(SendInviteToParticipants{
+			Trip:    trip,
+			Invites: []InviteParticipantsToTrip{{TripID: trip.ID, Participant: Participant{Email: "guest@example.com", ParticipantId: uuid.New()}}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expected := strings.TrimSpace(name)
+		for index, address := range []string{"oi@planner.com", "mailpit@journey.com"} {
+			from := client.sent[index].GetFrom()
+			if len(from) != 1 || from[0].Address != address || from[0].Name != expected {
+				t.Fatalf("expected the email %d from %q <%s>, got %v", index, expected, address, from)
+			}
+		}
+	}
+}
+
+func TestInviteGreetsTheParticipantByName(t *testing.T) {
+	t.Setenv("JOURNEY_APP_PORT", "8080")
+
+	greetings := map[string]map[string]string{
+		"Ana & Bia": {"text/plain": "Hi Ana & Bia!", "text/html": "Hi Ana &amp; Bia!"},
+		"":          {"text/plain": "Hi!", "text/html": "Hi!"},
+	}
+	for name, expected := range greetings {
+		trip := newTestTrip()
+		client := &fakeClient{}
+		mp := newTestMailpit(client, &[]time.Duration{})
+		mp.store = &fakeStore{trip: trip}
+
+		err := mp.SendConfirmTripEmailToParticipants(SendInviteToParticipants{
+			Trip:    trip,
+			Invites: []InviteParticipantsToTrip{{TripID: trip.ID, Participant: Participant{Email: "guest@example.com", ParticipantId: uuid.New(), Name: name}}},
+			Locale:  i18n.English,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		parts := bodyParts(t, client.sent[0])
+		for contentType, greeting := range expected {
+			if !strings.Contains(parts[contentType], greeting) {
+				t.Fatalf("expected the %s part to greet %q, got:\n%s", contentType, greeting, parts[contentType])
+			}
+		}
+	}
+}
+
+func TestTripEmailsEscapeTheDestinationInHTML(t *testing.T) {
+	t.Setenv("JOURNEY_APP_PORT", "8080")
+	trip := newTestTrip()
+	trip.Destination = `<a href="https://evil.example">Rio</a> & Co`
+	escaped := "&lt;a href=&#34;https://evil.example&#34;&gt;Rio&lt;/a&gt; &amp; Co"
+
+	sends := map[string]func(mp Mailpit) error{
+		"owner confirmation": func(mp Mailpit) error { return mp.SendConfirmTripEmailToTripOwner(trip.ID, i18n.English) },
+		"invite": func(mp Mailpit) error {
+			return mp.SendConfirmTripEmailToParticipants(SendInviteToParticipants{Trip: trip, Invites: newInvites(trip, "guest@example.com")})
+		},
+		"reminder": func(mp Mailpit) error {
+			return mp.SendTripReminderToParticipants(SendTripReminder{Trip: trip, Participants: []Participant{{Email: "guest@example.com", ParticipantId: uuid.New()}}})
+		},
+	}
+
+	for name, send := range sends {
+		t.Run(name, func(t *testing.T) {
+			client := &fakeClient{}
+			mp := newTestMailpit(client, &[]time.Duration{})
+			mp.store = &fakeStore{trip: trip}
+
+			if err := send(mp); err != nil {
+				t.Fatal(err)
+			}
+
+			parts := bodyParts(t, client.sent[0])
+			if !strings.Contains(parts["text/html"], escaped) || strings.Contains(parts["text/html"], trip.Destination) {
+				t.Fatalf("expected the destination escaped in the HTML part, got:\n%s", parts["text/html"])
+			}
+			if !strings.Contains(parts["text/plain"], trip.Destination) {
+				t.Fatalf("expected the destination as is in the plaintext part, got:\n%s", parts["text/plain"])
+			}
+		})
+	}
+}
+
+// newInvites invites each email to the trip, as a participant of its own.
+func newInvites(trip pgstore.Trip, emails ...string) []InviteParticipantsToTrip {
+	invites := make([]InviteParticipantsToTrip, len(emails))
+	for index, email := range emails {
+		invites[index] = InviteParticipantsToTrip{TripID: trip.ID, Participant: Participant{Email: email, ParticipantId: uuid.New()}}
+	}
+	return invites
+}
+
+func TestSendConfirmTripEmailToParticipantsGoesOnPastAFailure(t *testing.T) {
+	t.Setenv("JOURNEY_APP_PORT", "8080")
+
+	cases := map[string]struct {
+		failing  string
+		rejected map[string]error
+	}{
+		"rejected recipient": {"rejected@example.com", map[string]error{"rejected@example.com": &textproto.Error{Code: 550, Msg: "mailbox unavailable"}}},
+		"invalid address":    {"not an address", nil},
+	}
+
+	for name, c := range cases {
+		for _, workers := range []int{1, 3} {
+			t.Run(fmt.Sprintf("%s on %d workers", name, workers), func(t *testing.T) {
+				trip := newTestTrip()
+				store := &fakeStore{trip: trip}
+				client := &fakeClient{rejected: c.rejected}
+				mp := newTestMailpit(client, &[]time.Duration{})
+				mp.store = store
+				mp.inviteWorkers = workers
+				invites := newInvites(trip, "ana@example.com", "bia@example.com", c.failing, "caio@example.com", "davi@example.com")
+
+				err := mp.SendConfirmTripEmailToParticipants(SendInviteToParticipants{Trip: trip, Invites: invites})
+
+				if err == nil || !strings.Contains(err.Error(), c.failing) {
+					t.Fatalf("expected the error to name %q, got %v", c.failing, err)
+				}
+				var sent []string
+				for _, msg := range client.sent {
+					sent = append(sent, addresses(msg.GetTo())...)
+				}
+				sort.Strings(sent)
+				expected := []string{"ana@example.com", "bia@example.com", "caio@example.com", "davi@example.com"}
+				if !reflect.DeepEqual(sent, expected) {
+					t.Fatalf("expected the invites after the failure sent too, got %v", sent)
+				}
+				for _, invite := range invites {
+					if strings.Contains(err.Error(), invite.Participant.Email) != (invite.Participant.Email == c.failing) {
+						t.Fatalf("expected the error to only name %q, got %v", c.failing, err)
+					}
+					expected := pgstore.InviteStatusSent
+					if invite.Participant.Email == c.failing {
+						expected = pgstore.InviteStatusFailed
+					}
+					if status := store.statuses[invite.Participant.ParticipantId]; status.InviteStatus != expected {
+						t.Fatalf("expected the invite of %s %s, got %q", invite.Participant.Email, expected, status.InviteStatus)
+					}
+				}
+			})
+		}
+	}
+}
+
+// peakClient sends after a pause, keeping the most sends it had in flight at once.
+type peakClient struct {
+	mu       sync.Mutex
+	inFlight int
+	peak     int
+	sends    int
+}
+
+func (c *peakClient) DialAndSend(...*mail.Msg) error {
+	c.mu.Lock()
+	c.inFlight++
+	c.sends++
+	c.peak = max(c.peak, c.inFlight)
+	c.mu.Unlock()
+
+	time.Sleep(5 * time.Millisecond)
+
+	c.mu.Lock()
+	c.inFlight--
+	c.mu.Unlock()
+	return nil
+}
+
+func (c *peakClient) DialAndSendWithContext(_ context.Context, msgs ...*mail.Msg) error {
+	return c.DialAndSend(msgs...)
+}
+
+func (c *peakClient) DialWithContext(context.Context) error {
+	return nil
+}
+
+func (c *peakClient) Close() error {
+	return nil
+}
+
+func TestSendConfirmTripEmailToParticipantsBoundsTheWorkers(t *testing.T) {
+	t.Setenv("JOURNEY_APP_PORT", "8080")
+	trip := newTestTrip()
+	client := &peakClient{}
+	mp := newTestMailpit(&fakeClient{}, &[]time.Duration{})
+	mp.newClient = func() (smtpClient, error) { return client, nil }
+	mp.store = &fakeStore{trip: trip}
+	mp.inviteWorkers = 3
+
+	var emails []string
+	for index := range 12 {
+		emails = append(emails, fmt.Sprintf("guest%d@example.com", index))
+	}
+	if err := mp.SendConfirmTripEmailToParticipants(SendInviteToParticipants{Trip: trip, Invites: newInvites(trip, emails...)}); err != nil {
+		t.Fatal(err)
+	}
+
+	if client.sends != 12 {
+		t.Fatalf("expected the 12 invites sent, got %d", client.sends)
+	}
+	if client.peak > 3 {
+		t.Fatalf("expected at most 3 invites sent at once, got %d", client.peak)
+	}
+}
+
+func TestGetInviteWorkers(t *testing.T) {
+	cases := map[string]int{
+		"":       DEFAULT_INVITE_WORKERS,
+		"8":      8,
+		"0":      DEFAULT_INVITE_WORKERS,
+		"-2":     DEFAULT_INVITE_WORKERS,
+		"plenty": DEFAULT_INVITE_WORKERS,
+	}
+
+	for value, expected := range cases {
+		t.Setenv("JOURNEY_MAIL_INVITE_WORKERS", value)
+
+		if workers := GetInviteWorkers(); workers != expected {
+			t.Fatalf("expected %q to give %d workers, got %d", value, expected, workers)
+		}
+	}
+}
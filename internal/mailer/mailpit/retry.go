@@ -0,0 +1,116 @@
+package mailpit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"journey/cmd/journey/config"
+	"journey/internal/metrics"
+	"net"
+	"net/textproto"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/wneessen/go-mail"
+)
+
+const DEFAULT_SEND_ATTEMPTS = 3
+const DEFAULT_SEND_RETRY_BASE_DELAY = 500 * time.Millisecond
+
+type smtpClient interface {
+	DialAndSend(...*mail.Msg) error
+	DialAndSendWithContext(context.Context, ...*mail.Msg) error
+	DialWithContext(context.Context) error
+	Close() error
+}
+
+// retryPolicy is how many times a send is attempted, waiting baseDelay doubled at each new attempt.
+type retryPolicy struct {
+	attempts  int
+	baseDelay time.Duration
+}
+
+// getRetryPolicy reads JOURNEY_MAIL_SEND_ATTEMPTS and JOURNEY_MAIL_SEND_RETRY_BASE_DELAY (a duration as
+// "500ms"), falling back to the defaults when missing or invalid.
+func getRetryPolicy() retryPolicy {
+	policy := retryPolicy{
+		attempts:  DEFAULT_SEND_ATTEMPTS,
+		baseDelay: DEFAULT_SEND_RETRY_BASE_DELAY,
+	}
+
+	if value, err := config.GetSpecificEnvironmentVariable("JOURNEY_MAIL_SEND_ATTEMPTS"); err == nil {
+		if attempts, err := strconv.Atoi(value); err == nil && attempts > 0 {
+			policy.attempts = attempts
+		}
+	}
+
+	if value, err := config.GetSpecificEnvironmentVariable("JOURNEY_MAIL_SEND_RETRY_BASE_DELAY"); err == nil {
+		if baseDelay, err := time.ParseDuration(value); err == nil && baseDelay >= 0 {
+			policy.baseDelay = baseDelay
+		}
+	}
+
+	return policy
+}
+
+// dialAndSend sends the message, retrying with exponential backoff while the failure is transient.
+func (mp Mailpit) dialAndSend(msg *mail.Msg) error {
+	client, err := mp.newClient()
+	if err != nil {
+		metrics.EmailsFailed.Inc()
+		return fmt.Errorf("failed create email client: %w", err)
+	}
+
+	attempts := max(mp.retry.attempts, 1)
+	for attempt := 1; ; attempt++ {
+		err = client.DialAndSend(msg)
+		if err == nil {
+			metrics.EmailsSent.Inc()
+			return nil
+		}
+
+		if attempt >= attempts || !isTransientSendError(err) {
+			metrics.EmailsFailed.Inc()
+			return fmt.Errorf("failed after %d attempt(s): %w", attempt, err)
+		}
+
+		mp.sleep(mp.retry.baseDelay << (attempt - 1))
+	}
+}
+
+// dialAndSendOnce sends the message in a single attempt, given up once ctx is done.
+func (mp Mailpit) dialAndSendOnce(ctx context.Context, msg *mail.Msg) error {
+	client, err := mp.newClient()
+	if err != nil {
+		metrics.EmailsFailed.Inc()
+		return fmt.Errorf("failed create email client: %w", err)
+	}
+
+	if err := client.DialAndSendWithContext(ctx, msg); err != nil {
+		metrics.EmailsFailed.Inc()
+		return err
+	}
+	metrics.EmailsSent.Inc()
+	return nil
+}
+
+// isTransientSendError tells whether retrying the send may succeed: the SMTP server unreachable or
+// answering a temporary (4xx) reply. Anything else, as a rejected recipient, is permanent.
+func isTransientSendError(err error) bool {
+	var replyErr *textproto.Error
+	if errors.As(err, &replyErr) {
+		return replyErr.Code >= 400 && replyErr.Code < 500
+	}
+
+	if errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.ECONNRESET) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	return false
+}
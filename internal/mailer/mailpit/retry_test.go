@@ -0,0 +1,149 @@
+package mailpit
+
+import (
+	"context"
+	"errors"
+	"journey/internal/metrics"
+	"net"
+	"net/textproto"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/wneessen/go-mail"
+)
+
+// fakeClient answers the sends with errs, in order, succeeding once they run out, and keeps the messages
+// sent. The sends to the rejected recipients always fail.
+type fakeClient struct {
+	mu       sync.Mutex
+	errs     []error
+	rejected map[string]error
+	sends    int
+	sent     []*mail.Msg
+}
+
+func (c *fakeClient) DialAndSend(msgs ...*mail.Msg) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sends++
+	for _, msg := range msgs {
+		for _, recipient := range msg.GetTo() {
+			if err, found := c.rejected[recipient.Address]; found {
+				return err
+			}
+		}
+	}
+	if len(c.errs) == 0 {
+		c.sent = append(c.sent, msgs...)
+		return nil
+	}
+	err := c.errs[0]
+	c.errs = c.errs[1:]
+	return err
+}
+
+func (c *fakeClient) DialAndSendWithContext(ctx context.Context, msgs ...*mail.Msg) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return c.DialAndSend(msgs...)
+}
+
+func (c *fakeClient) DialWithContext(context.Context) error {
+	return nil
+}
+
+func (c *fakeClient) Close() error {
+	return nil
+}
+
+func newTestMailpit(client *fakeClient, delays *[]time.Duration) Mailpit {
+	return Mailpit{
+		newClient: func() (smtpClient, error) { return client, nil },
+		retry:     retryPolicy{attempts: DEFAULT_SEND_ATTEMPTS, baseDelay: 100 * time.Millisecond},
+		sleep:     func(delay time.Duration) { *delays = append(*delays, delay) },
+		now:       time.Now,
+	}
+}
+
+func TestDialAndSendRetriesTransientFailures(t *testing.T) {
+	refused := &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}
+	client := &fakeClient{errs: []error{refused, refused}}
+	var delays []time.Duration
+
+	if err := newTestMailpit(client, &delays).dialAndSend(mail.NewMsg()); err != nil {
+		t.Fatalf("expected the send to succeed on the third attempt, got %v", err)
+	}
+	if client.sends != 3 {
+		t.Fatalf("expected 3 sends, got %d", client.sends)
+	}
+	if len(delays) != 2 || delays[0] != 100*time.Millisecond || delays[1] != 200*time.Millisecond {
+		t.Fatalf("expected exponential backoff of 100ms and 200ms, got %v", delays)
+	}
+}
+
+func TestDialAndSendGivesUpAfterTheAttempts(t *testing.T) {
+	temporary := &textproto.Error{Code: 421, Msg: "service not available"}
+	client := &fakeClient{errs: []error{temporary, temporary, temporary, temporary}}
+	var delays []time.Duration
+
+	err := newTestMailpit(client, &delays).dialAndSend(mail.NewMsg())
+	if !errors.Is(err, temporary) {
+		t.Fatalf("expected the last error to be returned, got %v", err)
+	}
+	if client.sends != DEFAULT_SEND_ATTEMPTS {
+		t.Fatalf("expected %d sends, got %d", DEFAULT_SEND_ATTEMPTS, client.sends)
+	}
+}
+
+func TestDialAndSendDoesNotRetryPermanentFailures(t *testing.T) {
+	badRecipient := &textproto.Error{Code: 550, Msg: "no such user"}
+	client := &fakeClient{errs: []error{badRecipient}}
+	var delays []time.Duration
+
+	err := newTestMailpit(client, &delays).dialAndSend(mail.NewMsg())
+	if !errors.Is(err, badRecipient) {
+		t.Fatalf("expected the permanent error to be returned, got %v", err)
+	}
+	if client.sends != 1 || len(delays) != 0 {
+		t.Fatalf("expected a single send without waiting, got %d sends and delays %v", client.sends, delays)
+	}
+}
+
+func TestDialAndSendOnceDoesNotRetry(t *testing.T) {
+	refused := &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}
+	client := &fakeClient{errs: []error{refused}}
+	var delays []time.Duration
+	mp := newTestMailpit(client, &delays)
+
+	if err := mp.dialAndSendOnce(context.Background(), mail.NewMsg()); !errors.Is(err, refused) {
+		t.Fatalf("expected the transient error returned, got %v", err)
+	}
+	if client.sends != 1 || len(delays) != 0 {
+		t.Fatalf("expected a single send without backoff, got %d sends and %v", client.sends, delays)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := mp.dialAndSendOnce(ctx, mail.NewMsg()); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the send given up with its context, got %v", err)
+	}
+}
+
+func TestDialAndSendCountsTheSendsAndFailures(t *testing.T) {
+	var delays []time.Duration
+	sent, failed := testutil.ToFloat64(metrics.EmailsSent), testutil.ToFloat64(metrics.EmailsFailed)
+
+	_ = newTestMailpit(&fakeClient{}, &delays).dialAndSend(mail.NewMsg())
+	_ = newTestMailpit(&fakeClient{errs: []error{&textproto.Error{Code: 550}}}, &delays).dialAndSend(mail.NewMsg())
+
+	if got := testutil.ToFloat64(metrics.EmailsSent) - sent; got != 1 {
+		t.Fatalf("expected 1 email counted as sent, got %v", got)
+	}
+	if got := testutil.ToFloat64(metrics.EmailsFailed) - failed; got != 1 {
+		t.Fatalf("expected 1 email counted as failed, got %v", got)
+	}
+}
@@ -0,0 +1,69 @@
+package mailpit
+
+import (
+	"fmt"
+	"journey/cmd/journey/config"
+	"journey/internal/i18n"
+	"strings"
+	"text/template"
+)
+
+// subjectData are the variables of the subject templates, the dates as written in the emails:
+// {{.Destination}}, {{.StartsAt}} and {{.EndsAt}}.
+type subjectData struct {
+	Destination string
+	StartsAt    string
+	EndsAt      string
+}
+
+// subjectTemplates replace the localized subjects of the emails, a nil one keeps the localized subject. A
+// custom subject is the same on every locale.
+type subjectTemplates struct {
+	confirmTrip *template.Template
+	invite      *template.Template
+}
+
+// getSubjectTemplates reads JOURNEY_MAIL_CONFIRM_TRIP_SUBJECT and JOURNEY_MAIL_INVITE_SUBJECT, failing on the
+// templates that don't parse or render, so a broken subject stops the app on startup rather than the emails.
+func getSubjectTemplates() (subjectTemplates, error) {
+	confirmTrip, err := parseSubjectTemplate("JOURNEY_MAIL_CONFIRM_TRIP_SUBJECT")
+	if err != nil {
+		return subjectTemplates{}, err
+	}
+	invite, err := parseSubjectTemplate("JOURNEY_MAIL_INVITE_SUBJECT")
+	if err != nil {
+		return subjectTemplates{}, err
+	}
+	return subjectTemplates{confirmTrip: confirmTrip, invite: invite}, nil
+}
+
+// parseSubjectTemplate is the template of the variable key, nil when missing or empty.
+func parseSubjectTemplate(key string) (*template.Template, error) {
+	value, err := config.GetSpecificEnvironmentVariable(key)
+	if err != nil || strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+
+	tmpl, err := template.New(key).Option("missingkey=error").Parse(value)
+	if err != nil {
+		return nil, fmt.Errorf("mailpit: %s is invalid: %w", key, err)
+	}
+	// the variables are only checked on execution, as a misspelled {{.Destiny}}.
+	if err := tmpl.Execute(&strings.Builder{}, subjectData{}); err != nil {
+		return nil, fmt.Errorf("mailpit: %s is invalid: %w", key, err)
+	}
+	return tmpl, nil
+}
+
+// renderSubject renders tmpl with data, or the localized subject of key with args when there is no template.
+func renderSubject(tmpl *template.Template, data subjectData, locale i18n.Locale, key i18n.Key, args ...any) (string, error) {
+	if tmpl == nil {
+		return i18n.Message(locale, key, args...), nil
+	}
+
+	var rendered strings.Builder
+	if err := tmpl.Execute(&rendered, data); err != nil {
+		return "", err
+	}
+	return rendered.String(), nil
+}
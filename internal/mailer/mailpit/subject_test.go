@@ -0,0 +1,105 @@
+package mailpit
+
+import (
+	"journey/internal/i18n"
+	"mime"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/wneessen/go-mail"
+)
+
+// subjectOf is the decoded subject of msg.
+func subjectOf(t *testing.T, msg *mail.Msg) string {
+	t.Helper()
+
+	subject := msg.GetGenHeader(mail.HeaderSubject)
+	if len(subject) != 1 {
+		t.Fatalf("expected one subject, got %v", subject)
+	}
+
+	decoded, err := new(mime.WordDecoder).DecodeHeader(subject[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return decoded
+}
+
+func TestCustomSubjectsRenderTheTripVariables(t *testing.T) {
+	t.Setenv("JOURNEY_APP_PORT", "8080")
+	t.Setenv("JOURNEY_MAIL_CONFIRM_TRIP_SUBJECT", "Your trip to {{.Destination}} ({{.StartsAt}} - {{.EndsAt}})")
+	t.Setenv("JOURNEY_MAIL_INVITE_SUBJECT", "Join us in {{.Destination}} on {{.StartsAt}}")
+	trip := newTestTrip()
+	client := &fakeClient{}
+
+	subjects, err := getSubjectTemplates()
+	if err != nil {
+		t.Fatal(err)
+	}
+	mp := newTestMailpit(client, &[]time.Duration{})
+	mp.store = &fakeStore{trip: trip}
+	mp.subjects = subjects
+
+	if err := mp.SendConfirmTripEmailToTripOwner(trip.ID, i18n.English); err != nil {
+		t.Fatal(err)
+	}
+	err = mp.SendConfirmTripEmailToParticipants(SendInviteToParticipants{
+		Trip: trip,
+		Invites: []InviteParticipantsToTrip{
+			{TripID: trip.ID, Participant: Participant{Email: "guest@example.com", ParticipantId: uuid.New()}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(client.sent) != 2 {
+		t.Fatalf("expected two emails sent, got %d", len(client.sent))
+	}
+	if got, want := subjectOf(t, client.sent[0]), "Your trip to Florianópolis (2030-03-10 - 2030-03-13)"; got != want {
+		t.Errorf("expected the confirmation subject %q, got %q", want, got)
+	}
+	if got, want := subjectOf(t, client.sent[1]), "Join us in Florianópolis on 2030-03-10"; got != want {
+		t.Errorf("expected the invite subject %q, got %q", want, got)
+	}
+}
+
+func TestSubjectsAreLocalizedWithoutATemplate(t *testing.T) {
+	t.Setenv("JOURNEY_APP_PORT", "8080")
+	t.Setenv("JOURNEY_MAIL_CONFIRM_TRIP_SUBJECT", "")
+	t.Setenv("JOURNEY_MAIL_INVITE_SUBJECT", "")
+	trip := newTestTrip()
+	client := &fakeClient{}
+
+	subjects, err := getSubjectTemplates()
+	if err != nil {
+		t.Fatal(err)
+	}
+	mp := newTestMailpit(client, &[]time.Duration{})
+	mp.store = &fakeStore{trip: trip}
+	mp.subjects = subjects
+
+	if err := mp.SendConfirmTripEmailToTripOwner(trip.ID, i18n.English); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := subjectOf(t, client.sent[0]), "Confirm your trip to Florianópolis on 2030-03-10"; got != want {
+		t.Errorf("expected the localized subject %q, got %q", want, got)
+	}
+}
+
+func TestInvalidSubjectTemplatesFail(t *testing.T) {
+	for name, value := range map[string]string{
+		"syntax":           "Your trip to {{.Destination",
+		"unknown variable": "Your trip to {{.Destiny}}",
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("JOURNEY_MAIL_CONFIRM_TRIP_SUBJECT", value)
+
+			if _, err := getSubjectTemplates(); err == nil {
+				t.Fatalf("expected %q to fail", value)
+			}
+		})
+	}
+}
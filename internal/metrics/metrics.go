@@ -0,0 +1,99 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// unmatchedRoute labels the requests no route answered, so the 404s of arbitrary paths don't grow the series.
+const unmatchedRoute = "unmatched"
+
+var (
+	requestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "journey_http_requests_total",
+		Help: "HTTP requests answered, by route and status.",
+	}, []string{"route", "status"})
+
+	requestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "journey_http_request_duration_seconds",
+		Help:    "Latency of the HTTP requests, by route and status.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"route", "status"})
+
+	// EmailsSent counts the emails the SMTP server accepted.
+	EmailsSent = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "journey_emails_sent_total",
+		Help: "Emails accepted by the SMTP server.",
+	})
+
+	// EmailsFailed counts the emails given up on, after the retries.
+	EmailsFailed = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "journey_emails_failed_total",
+		Help: "Emails that failed to send after the retries.",
+	})
+)
+
+// Handler exposes the metrics of the default registry in the Prometheus text format.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// Middleware counts the requests and observes their latency, labeled by the templated chi route (as
+// /trips/{tripId}) rather than the path, and the status answered.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+
+		next.ServeHTTP(recorder, r)
+
+		route, status := routeOf(r), strconv.Itoa(recorder.status)
+		requestsTotal.WithLabelValues(route, status).Inc()
+		requestDuration.WithLabelValues(route, status).Observe(time.Since(start).Seconds())
+	})
+}
+
+// routeOf is the pattern chi matched, only known once the request went through the router.
+func routeOf(r *http.Request) string {
+	routeContext := chi.RouteContext(r.Context())
+	if routeContext == nil {
+		return unmatchedRoute
+	}
+
+	route := routeContext.RoutePattern()
+	if route == "" || route == "/*" {
+		return unmatchedRoute
+	}
+	return route
+}
+
+// statusRecorder keeps the status written, the ResponseWriter doesn't expose it.
+type statusRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func (sr *statusRecorder) WriteHeader(status int) {
+	if !sr.wroteHeader {
+		sr.status = status
+		sr.wroteHeader = true
+	}
+	sr.ResponseWriter.WriteHeader(status)
+}
+
+func (sr *statusRecorder) Write(b []byte) (int, error) {
+	sr.wroteHeader = true
+	return sr.ResponseWriter.Write(b)
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer.
+func (sr *statusRecorder) Unwrap() http.ResponseWriter {
+	return sr.ResponseWriter
+}
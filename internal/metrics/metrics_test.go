@@ -0,0 +1,69 @@
+package metrics
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func newTestRouter() http.Handler {
+	r := chi.NewMux()
+	r.Use(Middleware)
+	r.Get("/trips/{tripId}", func(w http.ResponseWriter, r *http.Request) {
+		if chi.URLParam(r, "tripId") == "missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = io.WriteString(w, "{}")
+	})
+	r.Handle("/metrics", Handler())
+	return r
+}
+
+func scrape(t *testing.T, router http.Handler) string {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected the metrics scraped, got %d", w.Code)
+	}
+	return w.Body.String()
+}
+
+func TestMiddlewareLabelsByRouteAndStatus(t *testing.T) {
+	router := newTestRouter()
+	for _, path := range []string{"/trips/1", "/trips/2", "/trips/missing", "/nowhere"} {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	body := scrape(t, router)
+
+	for _, line := range []string{
+		`journey_http_requests_total{route="/trips/{tripId}",status="200"} 2`,
+		`journey_http_requests_total{route="/trips/{tripId}",status="404"} 1`,
+		`journey_http_requests_total{route="unmatched",status="404"} 1`,
+		`journey_http_request_duration_seconds_count{route="/trips/{tripId}",status="200"} 2`,
+	} {
+		if !strings.Contains(body, line) {
+			t.Fatalf("expected %q in the scrape, got:\n%s", line, body)
+		}
+	}
+	if strings.Contains(body, `route="/trips/1"`) {
+		t.Fatal("expected the route templated, not the path")
+	}
+}
+
+func TestHandlerExposesTheEmailCounters(t *testing.T) {
+	body := scrape(t, newTestRouter())
+
+	for _, name := range []string{"journey_emails_sent_total", "journey_emails_failed_total"} {
+		if !strings.Contains(body, "# TYPE "+name+" counter") {
+			t.Fatalf("expected the %s counter exposed, got:\n%s", name, body)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package pgstore
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	uniqueViolationCode     = "23505"
+	foreignKeyViolationCode = "23503"
+)
+
+// IsUniqueViolation tells whether err is the server refusing a row duplicating a unique one.
+func IsUniqueViolation(err error) bool {
+	return hasCode(err, uniqueViolationCode)
+}
+
+// IsForeignKeyViolation tells whether err is the server refusing a row referencing a missing one, as an
+// activity of a trip deleted meanwhile.
+func IsForeignKeyViolation(err error) bool {
+	return hasCode(err, foreignKeyViolationCode)
+}
+
+func hasCode(err error, code string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == code
+}
@@ -31,6 +31,9 @@ func (r iteratorForInviteParticipantsToTrip) Values() ([]interface{}, error) {
 	return []interface{}{
 		r.rows[0].TripID,
 		r.rows[0].Email,
+		r.rows[0].IsWaitlisted,
+		r.rows[0].Name,
+		r.rows[0].Phone,
 	}, nil
 }
 
@@ -39,5 +42,5 @@ func (r iteratorForInviteParticipantsToTrip) Err() error {
 }
 
 func (q *Queries) InviteParticipantsToTrip(ctx context.Context, arg []InviteParticipantsToTripParams) (int64, error) {
-	return q.db.CopyFrom(ctx, []string{"participants"}, []string{"trip_id", "email"}, &iteratorForInviteParticipantsToTrip{rows: arg})
+	return q.db.CopyFrom(ctx, []string{"participants"}, []string{"trip_id", "email", "is_waitlisted", "name", "phone"}, &iteratorForInviteParticipantsToTrip{rows: arg})
 }
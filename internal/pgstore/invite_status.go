@@ -0,0 +1,8 @@
+package pgstore
+
+// The invite_status of a participant, pending until its invite is first attempted, as the column default.
+const (
+	InviteStatusPending = "pending"
+	InviteStatusSent    = "sent"
+	InviteStatusFailed  = "failed"
+)
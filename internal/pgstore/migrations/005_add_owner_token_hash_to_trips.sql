@@ -0,0 +1,10 @@
+-- the trips created before have no hash, no opaque token changes them: their owner asks a JWT through the magic
+-- link of the trip when JOURNEY_JWT_SECRET is set, or is given a new owner token by the admin through
+-- POST /admin/trips/{tripId}/owner-token, which stores its hash.
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "owner_token_hash" VARCHAR(64) NOT NULL DEFAULT '';
+
+---- create above / drop below ----
+
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "owner_token_hash";
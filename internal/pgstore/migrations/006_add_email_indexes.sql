@@ -0,0 +1,10 @@
+CREATE INDEX IF NOT EXISTS trips_owner_email_lower_idx
+    ON trips (lower("owner_email"));
+
+CREATE INDEX IF NOT EXISTS participants_email_lower_idx
+    ON participants (lower("email"));
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS participants_email_lower_idx;
+DROP INDEX IF EXISTS trips_owner_email_lower_idx;
@@ -0,0 +1,7 @@
+ALTER TABLE activities
+    ADD COLUMN IF NOT EXISTS "duration_minutes" INTEGER NOT NULL DEFAULT 0 CHECK ("duration_minutes" >= 0);
+
+---- create above / drop below ----
+
+ALTER TABLE activities
+    DROP COLUMN IF EXISTS "duration_minutes";
@@ -0,0 +1,7 @@
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "reminder_sent_at" TIMESTAMP NULL;
+
+---- create above / drop below ----
+
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "reminder_sent_at";
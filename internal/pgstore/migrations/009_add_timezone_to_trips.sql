@@ -0,0 +1,7 @@
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "timezone" VARCHAR(64) NOT NULL DEFAULT 'UTC';
+
+---- create above / drop below ----
+
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "timezone";
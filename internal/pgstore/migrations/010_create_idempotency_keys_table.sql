@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    "key"           VARCHAR(255)    PRIMARY KEY NOT NULL,
+    "request_hash"  VARCHAR(64)                 NOT NULL,
+    "trip_id"       uuid                        NULL        REFERENCES trips ("id") ON DELETE CASCADE,
+    "owner_token"   VARCHAR(64)                 NULL,
+    "created_at"    TIMESTAMP                   NOT NULL
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS idempotency_keys;
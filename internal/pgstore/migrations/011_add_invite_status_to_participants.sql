@@ -0,0 +1,9 @@
+ALTER TABLE participants
+    ADD COLUMN IF NOT EXISTS "invite_status" VARCHAR(16) NOT NULL DEFAULT 'pending',
+    ADD COLUMN IF NOT EXISTS "invite_last_attempt_at" TIMESTAMP;
+
+---- create above / drop below ----
+
+ALTER TABLE participants
+    DROP COLUMN IF EXISTS "invite_last_attempt_at",
+    DROP COLUMN IF EXISTS "invite_status";
@@ -0,0 +1,14 @@
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "status" VARCHAR(16) NOT NULL DEFAULT 'planning'
+        CHECK ("status" IN ('planning', 'confirmed', 'cancelled', 'completed'));
+
+UPDATE trips
+SET
+    "status" = 'confirmed'
+WHERE
+    "is_confirmed" = TRUE;
+
+---- create above / drop below ----
+
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "status";
@@ -0,0 +1,13 @@
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "notes" TEXT;
+
+ALTER TABLE activities
+    ADD COLUMN IF NOT EXISTS "notes" TEXT;
+
+---- create above / drop below ----
+
+ALTER TABLE activities
+    DROP COLUMN IF EXISTS "notes";
+
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "notes";
@@ -0,0 +1,7 @@
+ALTER TABLE activities
+    ADD COLUMN IF NOT EXISTS "is_done" BOOLEAN NOT NULL DEFAULT FALSE;
+
+---- create above / drop below ----
+
+ALTER TABLE activities
+    DROP COLUMN IF EXISTS "is_done";
@@ -0,0 +1,9 @@
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "latitude" DOUBLE PRECISION CHECK ("latitude" BETWEEN -90 AND 90),
+    ADD COLUMN IF NOT EXISTS "longitude" DOUBLE PRECISION CHECK ("longitude" BETWEEN -180 AND 180);
+
+---- create above / drop below ----
+
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "longitude",
+    DROP COLUMN IF EXISTS "latitude";
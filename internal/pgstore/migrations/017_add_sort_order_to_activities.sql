@@ -0,0 +1,7 @@
+ALTER TABLE activities
+    ADD COLUMN IF NOT EXISTS "sort_order" INTEGER NOT NULL DEFAULT 0;
+
+---- create above / drop below ----
+
+ALTER TABLE activities
+    DROP COLUMN IF EXISTS "sort_order";
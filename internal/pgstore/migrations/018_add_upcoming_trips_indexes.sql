@@ -0,0 +1,11 @@
+CREATE INDEX IF NOT EXISTS trips_confirmed_starts_at_idx
+    ON trips ("starts_at")
+    WHERE "is_confirmed" = TRUE;
+
+CREATE INDEX IF NOT EXISTS participants_trip_id_idx
+    ON participants ("trip_id");
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS participants_trip_id_idx;
+DROP INDEX IF EXISTS trips_confirmed_starts_at_idx;
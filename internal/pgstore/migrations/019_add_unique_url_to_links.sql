@@ -0,0 +1,15 @@
+-- the duplicates left by the double submits are dropped first, keeping one of each, the index can't be built on
+-- them.
+DELETE FROM links AS duplicate
+USING links AS kept
+WHERE
+    duplicate."trip_id" = kept."trip_id"
+    AND duplicate."url" = kept."url"
+    AND duplicate."id" > kept."id";
+
+CREATE UNIQUE INDEX IF NOT EXISTS links_trip_id_url_key
+    ON links ("trip_id", "url");
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS links_trip_id_url_key;
@@ -0,0 +1,19 @@
+ALTER TABLE participants
+    ADD COLUMN IF NOT EXISTS "invited_at" TIMESTAMP NOT NULL DEFAULT (now() AT TIME ZONE 'UTC');
+
+-- the participants invited before have no invite time, their first attempt is the closest one they have.
+UPDATE participants
+SET
+    "invited_at" = "invite_last_attempt_at"
+WHERE
+    "invite_last_attempt_at" IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS participants_invited_at_idx
+    ON participants ("invited_at", "id");
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS participants_invited_at_idx;
+
+ALTER TABLE participants
+    DROP COLUMN IF EXISTS "invited_at";
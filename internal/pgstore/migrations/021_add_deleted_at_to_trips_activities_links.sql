@@ -0,0 +1,32 @@
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "deleted_at" TIMESTAMP;
+
+ALTER TABLE activities
+    ADD COLUMN IF NOT EXISTS "deleted_at" TIMESTAMP;
+
+ALTER TABLE links
+    ADD COLUMN IF NOT EXISTS "deleted_at" TIMESTAMP;
+
+---- create above / drop below ----
+
+-- the rows deleted until now go for good, nothing would hide them anymore.
+DELETE FROM trips
+WHERE
+    "deleted_at" IS NOT NULL;
+
+DELETE FROM activities
+WHERE
+    "deleted_at" IS NOT NULL;
+
+DELETE FROM links
+WHERE
+    "deleted_at" IS NOT NULL;
+
+ALTER TABLE links
+    DROP COLUMN IF EXISTS "deleted_at";
+
+ALTER TABLE activities
+    DROP COLUMN IF EXISTS "deleted_at";
+
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "deleted_at";
@@ -0,0 +1,13 @@
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "max_participants" INTEGER CHECK ("max_participants" > 0);
+
+ALTER TABLE participants
+    ADD COLUMN IF NOT EXISTS "is_waitlisted" BOOLEAN NOT NULL DEFAULT FALSE;
+
+---- create above / drop below ----
+
+ALTER TABLE participants
+    DROP COLUMN IF EXISTS "is_waitlisted";
+
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "max_participants";
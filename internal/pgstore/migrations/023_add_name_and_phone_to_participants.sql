@@ -0,0 +1,9 @@
+ALTER TABLE participants
+    ADD COLUMN IF NOT EXISTS "name" VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS "phone" VARCHAR(32);
+
+---- create above / drop below ----
+
+ALTER TABLE participants
+    DROP COLUMN IF EXISTS "phone",
+    DROP COLUMN IF EXISTS "name";
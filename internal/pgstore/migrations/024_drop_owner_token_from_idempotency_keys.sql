@@ -0,0 +1,7 @@
+ALTER TABLE idempotency_keys
+    DROP COLUMN IF EXISTS "owner_token";
+
+---- create above / drop below ----
+
+ALTER TABLE idempotency_keys
+    ADD COLUMN IF NOT EXISTS "owner_token" VARCHAR(64) NULL;
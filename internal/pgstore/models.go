@@ -10,32 +10,61 @@ import (
 )
 
 type Activity struct {
-	ID       uuid.UUID        `db:"id" json:"id"`
-	TripID   uuid.UUID        `db:"trip_id" json:"trip_id"`
-	Title    string           `db:"title" json:"title"`
-	OccursAt pgtype.Timestamp `db:"occurs_at" json:"occurs_at"`
+	ID              uuid.UUID        `db:"id" json:"id"`
+	TripID          uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Title           string           `db:"title" json:"title"`
+	OccursAt        pgtype.Timestamp `db:"occurs_at" json:"occurs_at"`
+	DurationMinutes int32            `db:"duration_minutes" json:"duration_minutes"`
+	Notes           pgtype.Text      `db:"notes" json:"notes"`
+	IsDone          bool             `db:"is_done" json:"is_done"`
+	SortOrder       int32            `db:"sort_order" json:"sort_order"`
+	DeletedAt       pgtype.Timestamp `db:"deleted_at" json:"deleted_at"`
+}
+
+type IdempotencyKey struct {
+	Key         string           `db:"key" json:"key"`
+	RequestHash string           `db:"request_hash" json:"request_hash"`
+	TripID      pgtype.UUID      `db:"trip_id" json:"trip_id"`
+	CreatedAt   pgtype.Timestamp `db:"created_at" json:"created_at"`
 }
 
 type Link struct {
-	ID     uuid.UUID `db:"id" json:"id"`
-	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
-	Title  string    `db:"title" json:"title"`
-	Url    string    `db:"url" json:"url"`
+	ID        uuid.UUID        `db:"id" json:"id"`
+	TripID    uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Title     string           `db:"title" json:"title"`
+	Url       string           `db:"url" json:"url"`
+	DeletedAt pgtype.Timestamp `db:"deleted_at" json:"deleted_at"`
 }
 
 type Participant struct {
-	ID          uuid.UUID `db:"id" json:"id"`
-	TripID      uuid.UUID `db:"trip_id" json:"trip_id"`
-	Email       string    `db:"email" json:"email"`
-	IsConfirmed bool      `db:"is_confirmed" json:"is_confirmed"`
+	ID                  uuid.UUID        `db:"id" json:"id"`
+	TripID              uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Email               string           `db:"email" json:"email"`
+	IsConfirmed         bool             `db:"is_confirmed" json:"is_confirmed"`
+	InviteStatus        string           `db:"invite_status" json:"invite_status"`
+	InviteLastAttemptAt pgtype.Timestamp `db:"invite_last_attempt_at" json:"invite_last_attempt_at"`
+	InvitedAt           pgtype.Timestamp `db:"invited_at" json:"invited_at"`
+	IsWaitlisted        bool             `db:"is_waitlisted" json:"is_waitlisted"`
+	Name                pgtype.Text      `db:"name" json:"name"`
+	Phone               pgtype.Text      `db:"phone" json:"phone"`
 }
 
 type Trip struct {
-	ID          uuid.UUID        `db:"id" json:"id"`
-	Destination string           `db:"destination" json:"destination"`
-	OwnerEmail  string           `db:"owner_email" json:"owner_email"`
-	OwnerName   string           `db:"owner_name" json:"owner_name"`
-	IsConfirmed bool             `db:"is_confirmed" json:"is_confirmed"`
-	StartsAt    pgtype.Timestamp `db:"starts_at" json:"starts_at"`
-	EndsAt      pgtype.Timestamp `db:"ends_at" json:"ends_at"`
+	ID              uuid.UUID        `db:"id" json:"id"`
+	Destination     string           `db:"destination" json:"destination"`
+	OwnerEmail      string           `db:"owner_email" json:"owner_email"`
+	OwnerName       string           `db:"owner_name" json:"owner_name"`
+	IsConfirmed     bool             `db:"is_confirmed" json:"is_confirmed"`
+	StartsAt        pgtype.Timestamp `db:"starts_at" json:"starts_at"`
+	EndsAt          pgtype.Timestamp `db:"ends_at" json:"ends_at"`
+	OwnerTokenHash  string           `db:"owner_token_hash" json:"owner_token_hash"`
+	ReminderSentAt  pgtype.Timestamp `db:"reminder_sent_at" json:"reminder_sent_at"`
+	Timezone        string           `db:"timezone" json:"timezone"`
+	Status          string           `db:"status" json:"status"`
+	UpdatedAt       pgtype.Timestamp `db:"updated_at" json:"updated_at"`
+	Notes           pgtype.Text      `db:"notes" json:"notes"`
+	Latitude        pgtype.Float8    `db:"latitude" json:"latitude"`
+	Longitude       pgtype.Float8    `db:"longitude" json:"longitude"`
+	DeletedAt       pgtype.Timestamp `db:"deleted_at" json:"deleted_at"`
+	MaxParticipants pgtype.Int4      `db:"max_participants" json:"max_participants"`
 }
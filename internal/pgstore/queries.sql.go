@@ -12,6 +12,58 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const claimIdempotencyKey = `-- name: ClaimIdempotencyKey :execrows
+INSERT
+INTO idempotency_keys
+    ( "key", "request_hash", "created_at" ) VALUES
+    ( $1, $2, $3 )
+ON CONFLICT ("key") DO UPDATE
+SET
+    "request_hash" = EXCLUDED."request_hash",
+    "created_at" = EXCLUDED."created_at",
+    "trip_id" = NULL
+WHERE
+    idempotency_keys."created_at" < $4::timestamp
+`
+
+type ClaimIdempotencyKeyParams struct {
+	Key           string           `db:"key" json:"key"`
+	RequestHash   string           `db:"request_hash" json:"request_hash"`
+	CreatedAt     pgtype.Timestamp `db:"created_at" json:"created_at"`
+	ExpiredBefore pgtype.Timestamp `db:"expired_before" json:"expired_before"`
+}
+
+func (q *Queries) ClaimIdempotencyKey(ctx context.Context, arg ClaimIdempotencyKeyParams) (int64, error) {
+	result, err := q.db.Exec(ctx, claimIdempotencyKey,
+		arg.Key,
+		arg.RequestHash,
+		arg.CreatedAt,
+		arg.ExpiredBefore,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const completeIdempotencyKey = `-- name: CompleteIdempotencyKey :exec
+UPDATE idempotency_keys
+SET
+    "trip_id" = $1
+WHERE
+    "key" = $2
+`
+
+type CompleteIdempotencyKeyParams struct {
+	TripID pgtype.UUID `db:"trip_id" json:"trip_id"`
+	Key    string      `db:"key" json:"key"`
+}
+
+func (q *Queries) CompleteIdempotencyKey(ctx context.Context, arg CompleteIdempotencyKeyParams) error {
+	_, err := q.db.Exec(ctx, completeIdempotencyKey, arg.TripID, arg.Key)
+	return err
+}
+
 const confirmParticipant = `-- name: ConfirmParticipant :exec
 UPDATE participants
 SET
@@ -30,21 +82,157 @@ func (q *Queries) ConfirmParticipant(ctx context.Context, arg ConfirmParticipant
 	return err
 }
 
+const confirmTripParticipants = `-- name: ConfirmTripParticipants :exec
+UPDATE participants
+SET
+    "is_confirmed" = true
+WHERE
+    trip_id = $1
+    AND id = ANY($2::uuid[])
+`
+
+type ConfirmTripParticipantsParams struct {
+	TripID uuid.UUID   `db:"trip_id" json:"trip_id"`
+	Ids    []uuid.UUID `db:"ids" json:"ids"`
+}
+
+func (q *Queries) ConfirmTripParticipants(ctx context.Context, arg ConfirmTripParticipantsParams) error {
+	_, err := q.db.Exec(ctx, confirmTripParticipants, arg.TripID, arg.Ids)
+	return err
+}
+
+const confirmTripParticipantByEmail = `-- name: ConfirmTripParticipantByEmail :execrows
+UPDATE participants
+SET
+    "is_confirmed" = true
+WHERE
+    trip_id = $1
+    AND lower("email") = $2::text
+    AND NOT "is_waitlisted"
+`
+
+type ConfirmTripParticipantByEmailParams struct {
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+	Email  string    `db:"email" json:"email"`
+}
+
+func (q *Queries) ConfirmTripParticipantByEmail(ctx context.Context, arg ConfirmTripParticipantByEmailParams) (int64, error) {
+	result, err := q.db.Exec(ctx, confirmTripParticipantByEmail, arg.TripID, arg.Email)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const countAdminParticipants = `-- name: CountAdminParticipants :one
+SELECT count(*)
+FROM participants p
+JOIN trips t ON t."id" = p."trip_id"
+WHERE
+    t."deleted_at" IS NULL
+    AND ($1::boolean IS NULL OR p."is_confirmed" = $1::boolean)
+    AND ($2::timestamp IS NULL OR p."invited_at" < $2::timestamp)
+`
+
+type CountAdminParticipantsParams struct {
+	IsConfirmed   pgtype.Bool      `db:"is_confirmed" json:"is_confirmed"`
+	InvitedBefore pgtype.Timestamp `db:"invited_before" json:"invited_before"`
+}
+
+func (q *Queries) CountAdminParticipants(ctx context.Context, arg CountAdminParticipantsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countAdminParticipants, arg.IsConfirmed, arg.InvitedBefore)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countPendingInvitesByEmail = `-- name: CountPendingInvitesByEmail :one
+SELECT count(*)
+FROM participants
+WHERE
+    lower("email") = $1::text
+    AND NOT "is_confirmed"
+    AND "trip_id" IN (SELECT "id" FROM trips WHERE "deleted_at" IS NULL)
+`
+
+func (q *Queries) CountPendingInvitesByEmail(ctx context.Context, email string) (int64, error) {
+	row := q.db.QueryRow(ctx, countPendingInvitesByEmail, email)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countSeatedParticipants = `-- name: CountSeatedParticipants :one
+SELECT count(*)
+FROM participants
+WHERE
+    trip_id = $1
+    AND NOT "is_waitlisted"
+`
+
+func (q *Queries) CountSeatedParticipants(ctx context.Context, tripID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countSeatedParticipants, tripID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countTripsByEmail = `-- name: CountTripsByEmail :one
+SELECT count(DISTINCT t."id")
+FROM trips t
+LEFT JOIN participants p
+    ON p."trip_id" = t."id"
+    AND lower(p."email") = $1::text
+WHERE
+    t."deleted_at" IS NULL
+    AND (lower(t."owner_email") = $1::text OR p."id" IS NOT NULL)
+`
+
+func (q *Queries) CountTripsByEmail(ctx context.Context, email string) (int64, error) {
+	row := q.db.QueryRow(ctx, countTripsByEmail, email)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countTripsByOwner = `-- name: CountTripsByOwner :one
+SELECT count(*)
+FROM trips
+WHERE
+    lower("owner_email") = $1::text
+    AND "deleted_at" IS NULL
+`
+
+func (q *Queries) CountTripsByOwner(ctx context.Context, email string) (int64, error) {
+	row := q.db.QueryRow(ctx, countTripsByOwner, email)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createActivity = `-- name: CreateActivity :one
 INSERT INTO activities
-    ( "trip_id", "title", "occurs_at" ) VALUES
-    ( $1, $2, $3 )
+    ( "trip_id", "title", "occurs_at", "duration_minutes", "notes" ) VALUES
+    ( $1, $2, $3, $4, $5 )
 RETURNING "id"
 `
 
 type CreateActivityParams struct {
-	TripID   uuid.UUID        `db:"trip_id" json:"trip_id"`
-	Title    string           `db:"title" json:"title"`
-	OccursAt pgtype.Timestamp `db:"occurs_at" json:"occurs_at"`
+	TripID          uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Title           string           `db:"title" json:"title"`
+	OccursAt        pgtype.Timestamp `db:"occurs_at" json:"occurs_at"`
+	DurationMinutes int32            `db:"duration_minutes" json:"duration_minutes"`
+	Notes           pgtype.Text      `db:"notes" json:"notes"`
 }
 
 func (q *Queries) CreateActivity(ctx context.Context, arg CreateActivityParams) (uuid.UUID, error) {
-	row := q.db.QueryRow(ctx, createActivity, arg.TripID, arg.Title, arg.OccursAt)
+	row := q.db.QueryRow(ctx, createActivity,
+		arg.TripID,
+		arg.Title,
+		arg.OccursAt,
+		arg.DurationMinutes,
+		arg.Notes,
+	)
 	var id uuid.UUID
 	err := row.Scan(&id)
 	return id, err
@@ -70,12 +258,302 @@ func (q *Queries) CreateTripLink(ctx context.Context, arg CreateTripLinkParams)
 	return id, err
 }
 
+const deleteActivity = `-- name: DeleteActivity :execrows
+UPDATE activities
+SET
+    "deleted_at" = (now() AT TIME ZONE 'UTC')
+WHERE
+    id = $1
+    AND trip_id = $2
+    AND deleted_at IS NULL
+`
+
+type DeleteActivityParams struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) DeleteActivity(ctx context.Context, arg DeleteActivityParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteActivity, arg.ID, arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteParticipant = `-- name: DeleteParticipant :execrows
+DELETE FROM participants
+WHERE
+    id = $1
+    AND trip_id = $2
+`
+
+type DeleteParticipantParams struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) DeleteParticipant(ctx context.Context, arg DeleteParticipantParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteParticipant, arg.ID, arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getActivity = `-- name: GetActivity :one
+SELECT
+    "id", "trip_id", "title", "occurs_at", "duration_minutes", "notes", "is_done", "sort_order", "deleted_at"
+FROM activities
+WHERE
+    id = $1
+    AND trip_id = $2
+    AND deleted_at IS NULL
+`
+
+type GetActivityParams struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) GetActivity(ctx context.Context, arg GetActivityParams) (Activity, error) {
+	row := q.db.QueryRow(ctx, getActivity, arg.ID, arg.TripID)
+	var i Activity
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.Title,
+		&i.OccursAt,
+		&i.DurationMinutes,
+		&i.Notes,
+		&i.IsDone,
+		&i.SortOrder,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getAdminParticipants = `-- name: GetAdminParticipants :many
+SELECT
+    p."id", p."trip_id", p."email", p."is_confirmed", p."invite_status", p."invited_at",
+    t."destination" AS "trip_destination"
+FROM participants p
+JOIN trips t ON t."id" = p."trip_id"
+WHERE
+    t."deleted_at" IS NULL
+    AND ($1::boolean IS NULL OR p."is_confirmed" = $1::boolean)
+    AND ($2::timestamp IS NULL OR p."invited_at" < $2::timestamp)
+ORDER BY p."invited_at", p."id"
+LIMIT $3::int
+OFFSET $4::int
+`
+
+type GetAdminParticipantsParams struct {
+	IsConfirmed   pgtype.Bool      `db:"is_confirmed" json:"is_confirmed"`
+	InvitedBefore pgtype.Timestamp `db:"invited_before" json:"invited_before"`
+	PageLimit     int32            `db:"page_limit" json:"page_limit"`
+	PageOffset    int32            `db:"page_offset" json:"page_offset"`
+}
+
+type GetAdminParticipantsRow struct {
+	ID              uuid.UUID        `db:"id" json:"id"`
+	TripID          uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Email           string           `db:"email" json:"email"`
+	IsConfirmed     bool             `db:"is_confirmed" json:"is_confirmed"`
+	InviteStatus    string           `db:"invite_status" json:"invite_status"`
+	InvitedAt       pgtype.Timestamp `db:"invited_at" json:"invited_at"`
+	TripDestination string           `db:"trip_destination" json:"trip_destination"`
+}
+
+func (q *Queries) GetAdminParticipants(ctx context.Context, arg GetAdminParticipantsParams) ([]GetAdminParticipantsRow, error) {
+	rows, err := q.db.Query(ctx, getAdminParticipants,
+		arg.IsConfirmed,
+		arg.InvitedBefore,
+		arg.PageLimit,
+		arg.PageOffset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetAdminParticipantsRow
+	for rows.Next() {
+		var i GetAdminParticipantsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Email,
+			&i.IsConfirmed,
+			&i.InviteStatus,
+			&i.InvitedAt,
+			&i.TripDestination,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getConfirmedTripsStartingBetween = `-- name: GetConfirmedTripsStartingBetween :many
+SELECT
+    t.id, t.destination, t.owner_email, t.owner_name, t.is_confirmed, t.starts_at, t.ends_at, t.owner_token_hash, t.reminder_sent_at, t.timezone, t.status, t.updated_at, t.notes, t.latitude, t.longitude, t.deleted_at, t.max_participants,
+    c."count" AS "confirmed_participants"
+FROM trips AS t
+CROSS JOIN LATERAL (
+    SELECT count(*) AS "count"
+    FROM participants
+    WHERE participants."trip_id" = t."id" AND participants."is_confirmed" = TRUE
+) AS c
+WHERE
+    t."is_confirmed" = TRUE
+    AND t."starts_at" >= $1::timestamp
+    AND t."starts_at" < $2::timestamp
+    AND t."deleted_at" IS NULL
+ORDER BY t."starts_at", t."id"
+`
+
+type GetConfirmedTripsStartingBetweenParams struct {
+	StartsFrom   pgtype.Timestamp `db:"starts_from" json:"starts_from"`
+	StartsBefore pgtype.Timestamp `db:"starts_before" json:"starts_before"`
+}
+
+type GetConfirmedTripsStartingBetweenRow struct {
+	Trip                  Trip  `db:"trip" json:"trip"`
+	ConfirmedParticipants int64 `db:"confirmed_participants" json:"confirmed_participants"`
+}
+
+func (q *Queries) GetConfirmedTripsStartingBetween(ctx context.Context, arg GetConfirmedTripsStartingBetweenParams) ([]GetConfirmedTripsStartingBetweenRow, error) {
+	rows, err := q.db.Query(ctx, getConfirmedTripsStartingBetween, arg.StartsFrom, arg.StartsBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetConfirmedTripsStartingBetweenRow
+	for rows.Next() {
+		var i GetConfirmedTripsStartingBetweenRow
+		if err := rows.Scan(
+			&i.Trip.ID,
+			&i.Trip.Destination,
+			&i.Trip.OwnerEmail,
+			&i.Trip.OwnerName,
+			&i.Trip.IsConfirmed,
+			&i.Trip.StartsAt,
+			&i.Trip.EndsAt,
+			&i.Trip.OwnerTokenHash,
+			&i.Trip.ReminderSentAt,
+			&i.Trip.Timezone,
+			&i.Trip.Status,
+			&i.Trip.UpdatedAt,
+			&i.Trip.Notes,
+			&i.Trip.Latitude,
+			&i.Trip.Longitude,
+			&i.Trip.DeletedAt,
+			&i.Trip.MaxParticipants,
+			&i.ConfirmedParticipants,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDeletedTrip = `-- name: GetDeletedTrip :one
+SELECT
+    "id", "destination", "owner_email", "owner_name", "is_confirmed", "starts_at", "ends_at", "owner_token_hash", "reminder_sent_at", "timezone", "status", "updated_at", "notes", "latitude", "longitude", "deleted_at", "max_participants"
+FROM trips
+WHERE
+    id = $1
+    AND deleted_at IS NOT NULL
+`
+
+func (q *Queries) GetDeletedTrip(ctx context.Context, id uuid.UUID) (Trip, error) {
+	row := q.db.QueryRow(ctx, getDeletedTrip, id)
+	var i Trip
+	err := row.Scan(
+		&i.ID,
+		&i.Destination,
+		&i.OwnerEmail,
+		&i.OwnerName,
+		&i.IsConfirmed,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.OwnerTokenHash,
+		&i.ReminderSentAt,
+		&i.Timezone,
+		&i.Status,
+		&i.UpdatedAt,
+		&i.Notes,
+		&i.Latitude,
+		&i.Longitude,
+		&i.DeletedAt,
+		&i.MaxParticipants,
+	)
+	return i, err
+}
+
+const getIdempotencyKey = `-- name: GetIdempotencyKey :one
+SELECT
+    "key", "request_hash", "trip_id", "created_at"
+FROM idempotency_keys
+WHERE
+    "key" = $1
+`
+
+func (q *Queries) GetIdempotencyKey(ctx context.Context, key string) (IdempotencyKey, error) {
+	row := q.db.QueryRow(ctx, getIdempotencyKey, key)
+	var i IdempotencyKey
+	err := row.Scan(
+		&i.Key,
+		&i.RequestHash,
+		&i.TripID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getLink = `-- name: GetLink :one
+SELECT
+    "id", "trip_id", "title", "url", "deleted_at"
+FROM links
+WHERE
+    id = $1
+    AND trip_id = $2
+    AND deleted_at IS NULL
+`
+
+type GetLinkParams struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) GetLink(ctx context.Context, arg GetLinkParams) (Link, error) {
+	row := q.db.QueryRow(ctx, getLink, arg.ID, arg.TripID)
+	var i Link
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.Title,
+		&i.Url,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
 const getParticipant = `-- name: GetParticipant :one
 SELECT
-    "id", "trip_id", "email", "is_confirmed"
+    "id", "trip_id", "email", "is_confirmed", "invite_status", "invite_last_attempt_at", "invited_at", "is_waitlisted", "name", "phone"
 FROM participants
 WHERE
     id = $1
+    AND trip_id IN (SELECT "id" FROM trips WHERE "deleted_at" IS NULL)
 `
 
 func (q *Queries) GetParticipant(ctx context.Context, id uuid.UUID) (Participant, error) {
@@ -86,13 +564,51 @@ func (q *Queries) GetParticipant(ctx context.Context, id uuid.UUID) (Participant
 		&i.TripID,
 		&i.Email,
 		&i.IsConfirmed,
+		&i.InviteStatus,
+		&i.InviteLastAttemptAt,
+		&i.InvitedAt,
+		&i.IsWaitlisted,
+		&i.Name,
+		&i.Phone,
+	)
+	return i, err
+}
+
+const getParticipantForTrip = `-- name: GetParticipantForTrip :one
+SELECT
+    "id", "trip_id", "email", "is_confirmed", "invite_status", "invite_last_attempt_at", "invited_at", "is_waitlisted", "name", "phone"
+FROM participants
+WHERE
+    id = $1
+    AND trip_id = $2
+`
+
+type GetParticipantForTripParams struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) GetParticipantForTrip(ctx context.Context, arg GetParticipantForTripParams) (Participant, error) {
+	row := q.db.QueryRow(ctx, getParticipantForTrip, arg.ID, arg.TripID)
+	var i Participant
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.Email,
+		&i.IsConfirmed,
+		&i.InviteStatus,
+		&i.InviteLastAttemptAt,
+		&i.InvitedAt,
+		&i.IsWaitlisted,
+		&i.Name,
+		&i.Phone,
 	)
 	return i, err
 }
 
 const getParticipants = `-- name: GetParticipants :many
 SELECT
-    "id", "trip_id", "email", "is_confirmed"
+    "id", "trip_id", "email", "is_confirmed", "invite_status", "invite_last_attempt_at", "invited_at", "is_waitlisted", "name", "phone"
 FROM participants
 WHERE
     trip_id = $1
@@ -112,6 +628,12 @@ func (q *Queries) GetParticipants(ctx context.Context, tripID uuid.UUID) ([]Part
 			&i.TripID,
 			&i.Email,
 			&i.IsConfirmed,
+			&i.InviteStatus,
+			&i.InviteLastAttemptAt,
+			&i.InvitedAt,
+			&i.IsWaitlisted,
+			&i.Name,
+			&i.Phone,
 		); err != nil {
 			return nil, err
 		}
@@ -123,12 +645,46 @@ func (q *Queries) GetParticipants(ctx context.Context, tripID uuid.UUID) ([]Part
 	return items, nil
 }
 
+const getParticipantsSummary = `-- name: GetParticipantsSummary :one
+SELECT
+    COUNT(*) AS total,
+    COUNT(*) FILTER (WHERE "is_confirmed") AS confirmed,
+    COUNT(*) FILTER (WHERE NOT "is_confirmed" AND NOT "is_waitlisted") AS pending,
+    COUNT(*) FILTER (WHERE "is_waitlisted") AS waitlisted,
+    COALESCE(ARRAY_AGG("email" ORDER BY "email") FILTER (WHERE NOT "is_confirmed" AND NOT "is_waitlisted"), '{}')::TEXT[] AS pending_emails
+FROM participants
+WHERE
+    trip_id = $1
+`
+
+type GetParticipantsSummaryRow struct {
+	Total         int64    `db:"total" json:"total"`
+	Confirmed     int64    `db:"confirmed" json:"confirmed"`
+	Pending       int64    `db:"pending" json:"pending"`
+	Waitlisted    int64    `db:"waitlisted" json:"waitlisted"`
+	PendingEmails []string `db:"pending_emails" json:"pending_emails"`
+}
+
+func (q *Queries) GetParticipantsSummary(ctx context.Context, tripID uuid.UUID) (GetParticipantsSummaryRow, error) {
+	row := q.db.QueryRow(ctx, getParticipantsSummary, tripID)
+	var i GetParticipantsSummaryRow
+	err := row.Scan(
+		&i.Total,
+		&i.Confirmed,
+		&i.Pending,
+		&i.Waitlisted,
+		&i.PendingEmails,
+	)
+	return i, err
+}
+
 const getTrip = `-- name: GetTrip :one
 SELECT
-    "id", "destination", "owner_email", "owner_name", "is_confirmed", "starts_at", "ends_at"
+    "id", "destination", "owner_email", "owner_name", "is_confirmed", "starts_at", "ends_at", "owner_token_hash", "reminder_sent_at", "timezone", "status", "updated_at", "notes", "latitude", "longitude", "deleted_at", "max_participants"
 FROM trips
 WHERE
     id = $1
+    AND deleted_at IS NULL
 `
 
 func (q *Queries) GetTrip(ctx context.Context, id uuid.UUID) (Trip, error) {
@@ -142,16 +698,27 @@ func (q *Queries) GetTrip(ctx context.Context, id uuid.UUID) (Trip, error) {
 		&i.IsConfirmed,
 		&i.StartsAt,
 		&i.EndsAt,
+		&i.OwnerTokenHash,
+		&i.ReminderSentAt,
+		&i.Timezone,
+		&i.Status,
+		&i.UpdatedAt,
+		&i.Notes,
+		&i.Latitude,
+		&i.Longitude,
+		&i.DeletedAt,
+		&i.MaxParticipants,
 	)
 	return i, err
 }
 
 const getTripActivities = `-- name: GetTripActivities :many
 SELECT
-    "id", "trip_id", "title", "occurs_at"
+    "id", "trip_id", "title", "occurs_at", "duration_minutes", "notes", "is_done", "sort_order", "deleted_at"
 FROM activities
 WHERE
     trip_id = $1
+    AND deleted_at IS NULL
 `
 
 func (q *Queries) GetTripActivities(ctx context.Context, tripID uuid.UUID) ([]Activity, error) {
@@ -168,6 +735,11 @@ func (q *Queries) GetTripActivities(ctx context.Context, tripID uuid.UUID) ([]Ac
 			&i.TripID,
 			&i.Title,
 			&i.OccursAt,
+			&i.DurationMinutes,
+			&i.Notes,
+			&i.IsDone,
+			&i.SortOrder,
+			&i.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -179,28 +751,1002 @@ func (q *Queries) GetTripActivities(ctx context.Context, tripID uuid.UUID) ([]Ac
 	return items, nil
 }
 
-const getTripLinks = `-- name: GetTripLinks :many
+const getTripAndActivities = `-- name: GetTripAndActivities :many
 SELECT
-    "id", "trip_id", "title", "url"
+    t.id, t.destination, t.owner_email, t.owner_name, t.is_confirmed, t.starts_at, t.ends_at, t.owner_token_hash, t.reminder_sent_at, t.timezone, t.status, t.updated_at, t.notes, t.latitude, t.longitude, t.deleted_at, t.max_participants,
+    a."id" AS "activity_id", a."title" AS "activity_title", a."occurs_at" AS "activity_occurs_at", a."duration_minutes" AS "activity_duration_minutes", a."notes" AS "activity_notes", a."is_done" AS "activity_is_done", a."sort_order" AS "activity_sort_order"
+FROM trips AS t
+LEFT JOIN activities AS a ON a."trip_id" = t."id"
+    AND a."deleted_at" IS NULL
+    AND ($1::date IS NULL OR a."occurs_at" >= ($1::date::timestamp AT TIME ZONE t."timezone") AT TIME ZONE 'UTC')
+    AND ($2::date IS NULL OR a."occurs_at" < (($2::date + 1)::timestamp AT TIME ZONE t."timezone") AT TIME ZONE 'UTC')
+    AND ($3::text IS NULL OR strpos(lower(a."title"), lower($3::text)) > 0)
+WHERE
+    t."id" = $4
+    AND t."deleted_at" IS NULL
+ORDER BY a."occurs_at", a."sort_order", a."id"
+`
+
+type GetTripAndActivitiesParams struct {
+	FromDate pgtype.Date `db:"from_date" json:"from_date"`
+	ToDate   pgtype.Date `db:"to_date" json:"to_date"`
+	Title    pgtype.Text `db:"title" json:"title"`
+	ID       uuid.UUID   `db:"id" json:"id"`
+}
+
+type GetTripAndActivitiesRow struct {
+	Trip                    Trip             `db:"trip" json:"trip"`
+	ActivityID              pgtype.UUID      `db:"activity_id" json:"activity_id"`
+	ActivityTitle           pgtype.Text      `db:"activity_title" json:"activity_title"`
+	ActivityOccursAt        pgtype.Timestamp `db:"activity_occurs_at" json:"activity_occurs_at"`
+	ActivityDurationMinutes pgtype.Int4      `db:"activity_duration_minutes" json:"activity_duration_minutes"`
+	ActivityNotes           pgtype.Text      `db:"activity_notes" json:"activity_notes"`
+	ActivityIsDone          pgtype.Bool      `db:"activity_is_done" json:"activity_is_done"`
+	ActivitySortOrder       pgtype.Int4      `db:"activity_sort_order" json:"activity_sort_order"`
+}
+
+func (q *Queries) GetTripAndActivities(ctx context.Context, arg GetTripAndActivitiesParams) ([]GetTripAndActivitiesRow, error) {
+	rows, err := q.db.Query(ctx, getTripAndActivities,
+		arg.FromDate,
+		arg.ToDate,
+		arg.Title,
+		arg.ID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTripAndActivitiesRow
+	for rows.Next() {
+		var i GetTripAndActivitiesRow
+		if err := rows.Scan(
+			&i.Trip.ID,
+			&i.Trip.Destination,
+			&i.Trip.OwnerEmail,
+			&i.Trip.OwnerName,
+			&i.Trip.IsConfirmed,
+			&i.Trip.StartsAt,
+			&i.Trip.EndsAt,
+			&i.Trip.OwnerTokenHash,
+			&i.Trip.ReminderSentAt,
+			&i.Trip.Timezone,
+			&i.Trip.Status,
+			&i.Trip.UpdatedAt,
+			&i.Trip.Notes,
+			&i.Trip.Latitude,
+			&i.Trip.Longitude,
+			&i.Trip.DeletedAt,
+			&i.Trip.MaxParticipants,
+			&i.ActivityID,
+			&i.ActivityTitle,
+			&i.ActivityOccursAt,
+			&i.ActivityDurationMinutes,
+			&i.ActivityNotes,
+			&i.ActivityIsDone,
+			&i.ActivitySortOrder,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripAndActivitiesPage = `-- name: GetTripAndActivitiesPage :many
+SELECT
+    t.id, t.destination, t.owner_email, t.owner_name, t.is_confirmed, t.starts_at, t.ends_at, t.owner_token_hash, t.reminder_sent_at, t.timezone, t.status, t.updated_at, t.notes, t.latitude, t.longitude, t.deleted_at, t.max_participants,
+    a."id" AS "activity_id", a."title" AS "activity_title", a."occurs_at" AS "activity_occurs_at", a."duration_minutes" AS "activity_duration_minutes", a."notes" AS "activity_notes", a."is_done" AS "activity_is_done", a."sort_order" AS "activity_sort_order",
+    (SELECT count(*) FROM activities WHERE activities."trip_id" = t."id" AND activities."deleted_at" IS NULL) AS "total"
+FROM trips AS t
+LEFT JOIN LATERAL (
+    SELECT "id", "title", "occurs_at", "duration_minutes", "notes", "is_done", "sort_order"
+    FROM activities
+    WHERE activities."trip_id" = t."id"
+        AND activities."deleted_at" IS NULL
+    ORDER BY "occurs_at", "sort_order", "id"
+    LIMIT $2::int
+    OFFSET $3::int
+) AS a ON TRUE
+WHERE
+    t."id" = $1
+    AND t."deleted_at" IS NULL
+`
+
+type GetTripAndActivitiesPageParams struct {
+	ID         uuid.UUID `db:"id" json:"id"`
+	PageLimit  int32     `db:"page_limit" json:"page_limit"`
+	PageOffset int32     `db:"page_offset" json:"page_offset"`
+}
+
+type GetTripAndActivitiesPageRow struct {
+	Trip                    Trip             `db:"trip" json:"trip"`
+	ActivityID              pgtype.UUID      `db:"activity_id" json:"activity_id"`
+	ActivityTitle           pgtype.Text      `db:"activity_title" json:"activity_title"`
+	ActivityOccursAt        pgtype.Timestamp `db:"activity_occurs_at" json:"activity_occurs_at"`
+	ActivityDurationMinutes pgtype.Int4      `db:"activity_duration_minutes" json:"activity_duration_minutes"`
+	ActivityNotes           pgtype.Text      `db:"activity_notes" json:"activity_notes"`
+	ActivityIsDone          pgtype.Bool      `db:"activity_is_done" json:"activity_is_done"`
+	ActivitySortOrder       pgtype.Int4      `db:"activity_sort_order" json:"activity_sort_order"`
+	Total                   int64            `db:"total" json:"total"`
+}
+
+func (q *Queries) GetTripAndActivitiesPage(ctx context.Context, arg GetTripAndActivitiesPageParams) ([]GetTripAndActivitiesPageRow, error) {
+	rows, err := q.db.Query(ctx, getTripAndActivitiesPage, arg.ID, arg.PageLimit, arg.PageOffset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTripAndActivitiesPageRow
+	for rows.Next() {
+		var i GetTripAndActivitiesPageRow
+		if err := rows.Scan(
+			&i.Trip.ID,
+			&i.Trip.Destination,
+			&i.Trip.OwnerEmail,
+			&i.Trip.OwnerName,
+			&i.Trip.IsConfirmed,
+			&i.Trip.StartsAt,
+			&i.Trip.EndsAt,
+			&i.Trip.OwnerTokenHash,
+			&i.Trip.ReminderSentAt,
+			&i.Trip.Timezone,
+			&i.Trip.Status,
+			&i.Trip.UpdatedAt,
+			&i.Trip.Notes,
+			&i.Trip.Latitude,
+			&i.Trip.Longitude,
+			&i.Trip.DeletedAt,
+			&i.Trip.MaxParticipants,
+			&i.ActivityID,
+			&i.ActivityTitle,
+			&i.ActivityOccursAt,
+			&i.ActivityDurationMinutes,
+			&i.ActivityNotes,
+			&i.ActivityIsDone,
+			&i.ActivitySortOrder,
+			&i.Total,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripAndActivityCounts = `-- name: GetTripAndActivityCounts :many
+SELECT
+    t.id, t.destination, t.owner_email, t.owner_name, t.is_confirmed, t.starts_at, t.ends_at, t.owner_token_hash, t.reminder_sent_at, t.timezone, t.status, t.updated_at, t.notes, t.latitude, t.longitude, t.deleted_at, t.max_participants,
+    c."day" AS "activity_day", c."count" AS "activity_count"
+FROM trips AS t
+LEFT JOIN LATERAL (
+    SELECT
+        date_trunc('day', ("occurs_at" AT TIME ZONE 'UTC') AT TIME ZONE t."timezone")::date AS "day",
+        count(*) AS "count"
+    FROM activities
+    WHERE activities."trip_id" = t."id"
+        AND activities."deleted_at" IS NULL
+    GROUP BY 1
+) AS c ON TRUE
+WHERE
+    t."id" = $1
+    AND t."deleted_at" IS NULL
+ORDER BY c."day"
+`
+
+type GetTripAndActivityCountsRow struct {
+	Trip          Trip        `db:"trip" json:"trip"`
+	ActivityDay   pgtype.Date `db:"activity_day" json:"activity_day"`
+	ActivityCount pgtype.Int8 `db:"activity_count" json:"activity_count"`
+}
+
+func (q *Queries) GetTripAndActivityCounts(ctx context.Context, id uuid.UUID) ([]GetTripAndActivityCountsRow, error) {
+	rows, err := q.db.Query(ctx, getTripAndActivityCounts, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTripAndActivityCountsRow
+	for rows.Next() {
+		var i GetTripAndActivityCountsRow
+		if err := rows.Scan(
+			&i.Trip.ID,
+			&i.Trip.Destination,
+			&i.Trip.OwnerEmail,
+			&i.Trip.OwnerName,
+			&i.Trip.IsConfirmed,
+			&i.Trip.StartsAt,
+			&i.Trip.EndsAt,
+			&i.Trip.OwnerTokenHash,
+			&i.Trip.ReminderSentAt,
+			&i.Trip.Timezone,
+			&i.Trip.Status,
+			&i.Trip.UpdatedAt,
+			&i.Trip.Notes,
+			&i.Trip.Latitude,
+			&i.Trip.Longitude,
+			&i.Trip.DeletedAt,
+			&i.Trip.MaxParticipants,
+			&i.ActivityDay,
+			&i.ActivityCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripAndLinks = `-- name: GetTripAndLinks :many
+SELECT
+    t.id, t.destination, t.owner_email, t.owner_name, t.is_confirmed, t.starts_at, t.ends_at, t.owner_token_hash, t.reminder_sent_at, t.timezone, t.status, t.updated_at, t.notes, t.latitude, t.longitude, t.deleted_at, t.max_participants,
+    l."id" AS "link_id", l."title" AS "link_title", l."url" AS "link_url"
+FROM trips AS t
+LEFT JOIN links AS l ON l."trip_id" = t."id"
+    AND l."deleted_at" IS NULL
+WHERE
+    t."id" = $1
+    AND t."deleted_at" IS NULL
+`
+
+type GetTripAndLinksRow struct {
+	Trip      Trip        `db:"trip" json:"trip"`
+	LinkID    pgtype.UUID `db:"link_id" json:"link_id"`
+	LinkTitle pgtype.Text `db:"link_title" json:"link_title"`
+	LinkUrl   pgtype.Text `db:"link_url" json:"link_url"`
+}
+
+func (q *Queries) GetTripAndLinks(ctx context.Context, id uuid.UUID) ([]GetTripAndLinksRow, error) {
+	rows, err := q.db.Query(ctx, getTripAndLinks, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTripAndLinksRow
+	for rows.Next() {
+		var i GetTripAndLinksRow
+		if err := rows.Scan(
+			&i.Trip.ID,
+			&i.Trip.Destination,
+			&i.Trip.OwnerEmail,
+			&i.Trip.OwnerName,
+			&i.Trip.IsConfirmed,
+			&i.Trip.StartsAt,
+			&i.Trip.EndsAt,
+			&i.Trip.OwnerTokenHash,
+			&i.Trip.ReminderSentAt,
+			&i.Trip.Timezone,
+			&i.Trip.Status,
+			&i.Trip.UpdatedAt,
+			&i.Trip.Notes,
+			&i.Trip.Latitude,
+			&i.Trip.Longitude,
+			&i.Trip.DeletedAt,
+			&i.Trip.MaxParticipants,
+			&i.LinkID,
+			&i.LinkTitle,
+			&i.LinkUrl,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripAndParticipants = `-- name: GetTripAndParticipants :many
+SELECT
+    t.id, t.destination, t.owner_email, t.owner_name, t.is_confirmed, t.starts_at, t.ends_at, t.owner_token_hash, t.reminder_sent_at, t.timezone, t.status, t.updated_at, t.notes, t.latitude, t.longitude, t.deleted_at, t.max_participants,
+    p."id" AS "participant_id", p."email" AS "participant_email", p."is_confirmed" AS "participant_is_confirmed",
+    p."invite_status" AS "participant_invite_status", p."invite_last_attempt_at" AS "participant_invite_last_attempt_at",
+    p."invited_at" AS "participant_invited_at", p."is_waitlisted" AS "participant_is_waitlisted",
+    p."name" AS "participant_name", p."phone" AS "participant_phone"
+FROM trips AS t
+LEFT JOIN participants AS p ON p."trip_id" = t."id"
+WHERE
+    t."id" = $1
+    AND t."deleted_at" IS NULL
+`
+
+type GetTripAndParticipantsRow struct {
+	Trip                           Trip             `db:"trip" json:"trip"`
+	ParticipantID                  pgtype.UUID      `db:"participant_id" json:"participant_id"`
+	ParticipantEmail               pgtype.Text      `db:"participant_email" json:"participant_email"`
+	ParticipantIsConfirmed         pgtype.Bool      `db:"participant_is_confirmed" json:"participant_is_confirmed"`
+	ParticipantInviteStatus        pgtype.Text      `db:"participant_invite_status" json:"participant_invite_status"`
+	ParticipantInviteLastAttemptAt pgtype.Timestamp `db:"participant_invite_last_attempt_at" json:"participant_invite_last_attempt_at"`
+	ParticipantInvitedAt           pgtype.Timestamp `db:"participant_invited_at" json:"participant_invited_at"`
+	ParticipantIsWaitlisted        pgtype.Bool      `db:"participant_is_waitlisted" json:"participant_is_waitlisted"`
+	ParticipantName                pgtype.Text      `db:"participant_name" json:"participant_name"`
+	ParticipantPhone               pgtype.Text      `db:"participant_phone" json:"participant_phone"`
+}
+
+func (q *Queries) GetTripAndParticipants(ctx context.Context, id uuid.UUID) ([]GetTripAndParticipantsRow, error) {
+	rows, err := q.db.Query(ctx, getTripAndParticipants, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTripAndParticipantsRow
+	for rows.Next() {
+		var i GetTripAndParticipantsRow
+		if err := rows.Scan(
+			&i.Trip.ID,
+			&i.Trip.Destination,
+			&i.Trip.OwnerEmail,
+			&i.Trip.OwnerName,
+			&i.Trip.IsConfirmed,
+			&i.Trip.StartsAt,
+			&i.Trip.EndsAt,
+			&i.Trip.OwnerTokenHash,
+			&i.Trip.ReminderSentAt,
+			&i.Trip.Timezone,
+			&i.Trip.Status,
+			&i.Trip.UpdatedAt,
+			&i.Trip.Notes,
+			&i.Trip.Latitude,
+			&i.Trip.Longitude,
+			&i.Trip.DeletedAt,
+			&i.Trip.MaxParticipants,
+			&i.ParticipantID,
+			&i.ParticipantEmail,
+			&i.ParticipantIsConfirmed,
+			&i.ParticipantInviteStatus,
+			&i.ParticipantInviteLastAttemptAt,
+			&i.ParticipantInvitedAt,
+			&i.ParticipantIsWaitlisted,
+			&i.ParticipantName,
+			&i.ParticipantPhone,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripAndParticipantsPage = `-- name: GetTripAndParticipantsPage :many
+SELECT
+    t.id, t.destination, t.owner_email, t.owner_name, t.is_confirmed, t.starts_at, t.ends_at, t.owner_token_hash, t.reminder_sent_at, t.timezone, t.status, t.updated_at, t.notes, t.latitude, t.longitude, t.deleted_at, t.max_participants,
+    p."id" AS "participant_id", p."email" AS "participant_email", p."is_confirmed" AS "participant_is_confirmed",
+    p."invite_status" AS "participant_invite_status", p."invite_last_attempt_at" AS "participant_invite_last_attempt_at",
+    p."invited_at" AS "participant_invited_at", p."is_waitlisted" AS "participant_is_waitlisted",
+    p."name" AS "participant_name", p."phone" AS "participant_phone",
+    (SELECT count(*) FROM participants WHERE participants."trip_id" = t."id") AS "total"
+FROM trips AS t
+LEFT JOIN LATERAL (
+    SELECT "id", "email", "is_confirmed", "invite_status", "invite_last_attempt_at", "invited_at", "is_waitlisted", "name", "phone"
+    FROM participants
+    WHERE participants."trip_id" = t."id"
+    ORDER BY "email", "id"
+    LIMIT $2::int
+    OFFSET $3::int
+) AS p ON TRUE
+WHERE
+    t."id" = $1
+    AND t."deleted_at" IS NULL
+`
+
+type GetTripAndParticipantsPageParams struct {
+	ID         uuid.UUID `db:"id" json:"id"`
+	PageLimit  int32     `db:"page_limit" json:"page_limit"`
+	PageOffset int32     `db:"page_offset" json:"page_offset"`
+}
+
+type GetTripAndParticipantsPageRow struct {
+	Trip                           Trip             `db:"trip" json:"trip"`
+	ParticipantID                  pgtype.UUID      `db:"participant_id" json:"participant_id"`
+	ParticipantEmail               pgtype.Text      `db:"participant_email" json:"participant_email"`
+	ParticipantIsConfirmed         pgtype.Bool      `db:"participant_is_confirmed" json:"participant_is_confirmed"`
+	ParticipantInviteStatus        pgtype.Text      `db:"participant_invite_status" json:"participant_invite_status"`
+	ParticipantInviteLastAttemptAt pgtype.Timestamp `db:"participant_invite_last_attempt_at" json:"participant_invite_last_attempt_at"`
+	ParticipantInvitedAt           pgtype.Timestamp `db:"participant_invited_at" json:"participant_invited_at"`
+	ParticipantIsWaitlisted        pgtype.Bool      `db:"participant_is_waitlisted" json:"participant_is_waitlisted"`
+	ParticipantName                pgtype.Text      `db:"participant_name" json:"participant_name"`
+	ParticipantPhone               pgtype.Text      `db:"participant_phone" json:"participant_phone"`
+	Total                          int64            `db:"total" json:"total"`
+}
+
+func (q *Queries) GetTripAndParticipantsPage(ctx context.Context, arg GetTripAndParticipantsPageParams) ([]GetTripAndParticipantsPageRow, error) {
+	rows, err := q.db.Query(ctx, getTripAndParticipantsPage, arg.ID, arg.PageLimit, arg.PageOffset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTripAndParticipantsPageRow
+	for rows.Next() {
+		var i GetTripAndParticipantsPageRow
+		if err := rows.Scan(
+			&i.Trip.ID,
+			&i.Trip.Destination,
+			&i.Trip.OwnerEmail,
+			&i.Trip.OwnerName,
+			&i.Trip.IsConfirmed,
+			&i.Trip.StartsAt,
+			&i.Trip.EndsAt,
+			&i.Trip.OwnerTokenHash,
+			&i.Trip.ReminderSentAt,
+			&i.Trip.Timezone,
+			&i.Trip.Status,
+			&i.Trip.UpdatedAt,
+			&i.Trip.Notes,
+			&i.Trip.Latitude,
+			&i.Trip.Longitude,
+			&i.Trip.DeletedAt,
+			&i.Trip.MaxParticipants,
+			&i.ParticipantID,
+			&i.ParticipantEmail,
+			&i.ParticipantIsConfirmed,
+			&i.ParticipantInviteStatus,
+			&i.ParticipantInviteLastAttemptAt,
+			&i.ParticipantInvitedAt,
+			&i.ParticipantIsWaitlisted,
+			&i.ParticipantName,
+			&i.ParticipantPhone,
+			&i.Total,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripLinks = `-- name: GetTripLinks :many
+SELECT
+    "id", "trip_id", "title", "url", "deleted_at"
 FROM links
 WHERE
     trip_id = $1
+    AND deleted_at IS NULL
+`
+
+func (q *Queries) GetTripLinks(ctx context.Context, tripID uuid.UUID) ([]Link, error) {
+	rows, err := q.db.Query(ctx, getTripLinks, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Link
+	for rows.Next() {
+		var i Link
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Title,
+			&i.Url,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripsByEmail = `-- name: GetTripsByEmail :many
+SELECT DISTINCT ON (t."starts_at", t."id")
+    t."id", t."destination", t."starts_at", t."ends_at",
+    (lower(t."owner_email") = $1::text)::boolean AS "is_owner",
+    CASE
+        WHEN lower(t."owner_email") = $1::text THEN t."is_confirmed"
+        ELSE COALESCE(p."is_confirmed", false)
+    END::boolean AS "is_confirmed"
+FROM trips t
+LEFT JOIN participants p
+    ON p."trip_id" = t."id"
+    AND lower(p."email") = $1::text
+WHERE
+    t."deleted_at" IS NULL
+    AND (lower(t."owner_email") = $1::text OR p."id" IS NOT NULL)
+ORDER BY t."starts_at", t."id", p."is_confirmed" DESC NULLS LAST
+LIMIT $2::int
+OFFSET $3::int
+`
+
+type GetTripsByEmailParams struct {
+	Email      string `db:"email" json:"email"`
+	PageLimit  int32  `db:"page_limit" json:"page_limit"`
+	PageOffset int32  `db:"page_offset" json:"page_offset"`
+}
+
+type GetTripsByEmailRow struct {
+	ID          uuid.UUID        `db:"id" json:"id"`
+	Destination string           `db:"destination" json:"destination"`
+	StartsAt    pgtype.Timestamp `db:"starts_at" json:"starts_at"`
+	EndsAt      pgtype.Timestamp `db:"ends_at" json:"ends_at"`
+	IsOwner     bool             `db:"is_owner" json:"is_owner"`
+	IsConfirmed bool             `db:"is_confirmed" json:"is_confirmed"`
+}
+
+func (q *Queries) GetTripsByEmail(ctx context.Context, arg GetTripsByEmailParams) ([]GetTripsByEmailRow, error) {
+	rows, err := q.db.Query(ctx, getTripsByEmail, arg.Email, arg.PageLimit, arg.PageOffset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTripsByEmailRow
+	for rows.Next() {
+		var i GetTripsByEmailRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Destination,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.IsOwner,
+			&i.IsConfirmed,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripsDueForReminder = `-- name: GetTripsDueForReminder :many
+SELECT
+    "id", "destination", "owner_email", "owner_name", "is_confirmed", "starts_at", "ends_at", "owner_token_hash", "reminder_sent_at", "timezone", "status", "updated_at", "notes", "latitude", "longitude", "deleted_at", "max_participants"
+FROM trips
+WHERE
+    is_confirmed = TRUE
+    AND reminder_sent_at IS NULL
+    AND starts_at > $1::timestamp
+    AND starts_at <= $2::timestamp
+    AND deleted_at IS NULL
+ORDER BY starts_at
+`
+
+type GetTripsDueForReminderParams struct {
+	Now      pgtype.Timestamp `db:"now" json:"now"`
+	DueUntil pgtype.Timestamp `db:"due_until" json:"due_until"`
+}
+
+func (q *Queries) GetTripsDueForReminder(ctx context.Context, arg GetTripsDueForReminderParams) ([]Trip, error) {
+	rows, err := q.db.Query(ctx, getTripsDueForReminder, arg.Now, arg.DueUntil)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Trip
+	for rows.Next() {
+		var i Trip
+		if err := rows.Scan(
+			&i.ID,
+			&i.Destination,
+			&i.OwnerEmail,
+			&i.OwnerName,
+			&i.IsConfirmed,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.OwnerTokenHash,
+			&i.ReminderSentAt,
+			&i.Timezone,
+			&i.Status,
+			&i.UpdatedAt,
+			&i.Notes,
+			&i.Latitude,
+			&i.Longitude,
+			&i.DeletedAt,
+			&i.MaxParticipants,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertConfirmedParticipant = `-- name: InsertConfirmedParticipant :exec
+INSERT INTO participants
+    ( "trip_id", "email", "is_confirmed" ) VALUES
+    ( $1, $2, true )
+`
+
+type InsertConfirmedParticipantParams struct {
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+	Email  string    `db:"email" json:"email"`
+}
+
+func (q *Queries) InsertConfirmedParticipant(ctx context.Context, arg InsertConfirmedParticipantParams) error {
+	_, err := q.db.Exec(ctx, insertConfirmedParticipant, arg.TripID, arg.Email)
+	return err
+}
+
+const insertTrip = `-- name: InsertTrip :one
+INSERT
+INTO trips
+    ( "destination", "owner_email", "owner_name", "starts_at", "ends_at", "owner_token_hash", "timezone", "notes", "latitude", "longitude", "max_participants") VALUES
+    ( $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11 )
+RETURNING "id"
+`
+
+type InsertTripParams struct {
+	Destination     string           `db:"destination" json:"destination"`
+	OwnerEmail      string           `db:"owner_email" json:"owner_email"`
+	OwnerName       string           `db:"owner_name" json:"owner_name"`
+	StartsAt        pgtype.Timestamp `db:"starts_at" json:"starts_at"`
+	EndsAt          pgtype.Timestamp `db:"ends_at" json:"ends_at"`
+	OwnerTokenHash  string           `db:"owner_token_hash" json:"owner_token_hash"`
+	Timezone        string           `db:"timezone" json:"timezone"`
+	Notes           pgtype.Text      `db:"notes" json:"notes"`
+	Latitude        pgtype.Float8    `db:"latitude" json:"latitude"`
+	Longitude       pgtype.Float8    `db:"longitude" json:"longitude"`
+	MaxParticipants pgtype.Int4      `db:"max_participants" json:"max_participants"`
+}
+
+func (q *Queries) InsertTrip(ctx context.Context, arg InsertTripParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, insertTrip,
+		arg.Destination,
+		arg.OwnerEmail,
+		arg.OwnerName,
+		arg.StartsAt,
+		arg.EndsAt,
+		arg.OwnerTokenHash,
+		arg.Timezone,
+		arg.Notes,
+		arg.Latitude,
+		arg.Longitude,
+		arg.MaxParticipants,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+type InviteParticipantsToTripParams struct {
+	TripID       uuid.UUID   `db:"trip_id" json:"trip_id"`
+	Email        string      `db:"email" json:"email"`
+	IsWaitlisted bool        `db:"is_waitlisted" json:"is_waitlisted"`
+	Name         pgtype.Text `db:"name" json:"name"`
+	Phone        pgtype.Text `db:"phone" json:"phone"`
+}
+
+const listTripsByOwner = `-- name: ListTripsByOwner :many
+SELECT
+    "id", "destination", "starts_at", "ends_at", "is_confirmed"
+FROM trips
+WHERE
+    lower("owner_email") = $1::text
+    AND "deleted_at" IS NULL
+ORDER BY "starts_at", "id"
+LIMIT $2::int
+OFFSET $3::int
+`
+
+type ListTripsByOwnerParams struct {
+	Email      string `db:"email" json:"email"`
+	PageLimit  int32  `db:"page_limit" json:"page_limit"`
+	PageOffset int32  `db:"page_offset" json:"page_offset"`
+}
+
+type ListTripsByOwnerRow struct {
+	ID          uuid.UUID        `db:"id" json:"id"`
+	Destination string           `db:"destination" json:"destination"`
+	StartsAt    pgtype.Timestamp `db:"starts_at" json:"starts_at"`
+	EndsAt      pgtype.Timestamp `db:"ends_at" json:"ends_at"`
+	IsConfirmed bool             `db:"is_confirmed" json:"is_confirmed"`
+}
+
+func (q *Queries) ListTripsByOwner(ctx context.Context, arg ListTripsByOwnerParams) ([]ListTripsByOwnerRow, error) {
+	rows, err := q.db.Query(ctx, listTripsByOwner, arg.Email, arg.PageLimit, arg.PageOffset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTripsByOwnerRow
+	for rows.Next() {
+		var i ListTripsByOwnerRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Destination,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.IsConfirmed,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockTripCapacity = `-- name: LockTripCapacity :one
+SELECT "max_participants"
+FROM trips
+WHERE
+    id = $1
+    AND "deleted_at" IS NULL
+FOR UPDATE
+`
+
+func (q *Queries) LockTripCapacity(ctx context.Context, id uuid.UUID) (pgtype.Int4, error) {
+	row := q.db.QueryRow(ctx, lockTripCapacity, id)
+	var max_participants pgtype.Int4
+	err := row.Scan(&max_participants)
+	return max_participants, err
+}
+
+const lockTripDayActivities = `-- name: LockTripDayActivities :many
+SELECT
+    a."id", a."trip_id", a."title", a."occurs_at", a."duration_minutes", a."notes", a."is_done", a."sort_order", a."deleted_at"
+FROM activities AS a
+JOIN trips AS t ON t."id" = a."trip_id"
+WHERE
+    a."trip_id" = $1
+    AND a."occurs_at" >= ($2::date::timestamp AT TIME ZONE t."timezone") AT TIME ZONE 'UTC'
+    AND a."occurs_at" < (($2::date + 1)::timestamp AT TIME ZONE t."timezone") AT TIME ZONE 'UTC'
+    AND a."deleted_at" IS NULL
+ORDER BY a."occurs_at", a."sort_order", a."id"
+FOR UPDATE OF a
+`
+
+type LockTripDayActivitiesParams struct {
+	TripID uuid.UUID   `db:"trip_id" json:"trip_id"`
+	Day    pgtype.Date `db:"day" json:"day"`
+}
+
+func (q *Queries) LockTripDayActivities(ctx context.Context, arg LockTripDayActivitiesParams) ([]Activity, error) {
+	rows, err := q.db.Query(ctx, lockTripDayActivities, arg.TripID, arg.Day)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Activity
+	for rows.Next() {
+		var i Activity
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Title,
+			&i.OccursAt,
+			&i.DurationMinutes,
+			&i.Notes,
+			&i.IsDone,
+			&i.SortOrder,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockTripParticipants = `-- name: LockTripParticipants :many
+SELECT
+    "id", "trip_id", "email", "is_confirmed", "invite_status", "invite_last_attempt_at", "invited_at", "is_waitlisted", "name", "phone"
+FROM participants
+WHERE
+    trip_id = $1
+    AND id = ANY($2::uuid[])
+FOR UPDATE
+`
+
+type LockTripParticipantsParams struct {
+	TripID uuid.UUID   `db:"trip_id" json:"trip_id"`
+	Ids    []uuid.UUID `db:"ids" json:"ids"`
+}
+
+func (q *Queries) LockTripParticipants(ctx context.Context, arg LockTripParticipantsParams) ([]Participant, error) {
+	rows, err := q.db.Query(ctx, lockTripParticipants, arg.TripID, arg.Ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Participant
+	for rows.Next() {
+		var i Participant
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Email,
+			&i.IsConfirmed,
+			&i.InviteStatus,
+			&i.InviteLastAttemptAt,
+			&i.InvitedAt,
+			&i.IsWaitlisted,
+			&i.Name,
+			&i.Phone,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markTripReminderSent = `-- name: MarkTripReminderSent :exec
+UPDATE trips
+SET
+    "reminder_sent_at" = $1
+WHERE
+    id = $2
+    AND deleted_at IS NULL
+`
+
+type MarkTripReminderSentParams struct {
+	ReminderSentAt pgtype.Timestamp `db:"reminder_sent_at" json:"reminder_sent_at"`
+	ID             uuid.UUID        `db:"id" json:"id"`
+}
+
+func (q *Queries) MarkTripReminderSent(ctx context.Context, arg MarkTripReminderSentParams) error {
+	_, err := q.db.Exec(ctx, markTripReminderSent, arg.ReminderSentAt, arg.ID)
+	return err
+}
+
+const promoteParticipant = `-- name: PromoteParticipant :execrows
+UPDATE participants
+SET
+    "is_waitlisted" = false,
+    "invite_status" = 'pending',
+    "invite_last_attempt_at" = NULL
+WHERE
+    id = $1
+    AND trip_id = $2
+    AND "is_waitlisted"
+`
+
+type PromoteParticipantParams struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) PromoteParticipant(ctx context.Context, arg PromoteParticipantParams) (int64, error) {
+	result, err := q.db.Exec(ctx, promoteParticipant, arg.ID, arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const releaseIdempotencyKey = `-- name: ReleaseIdempotencyKey :exec
+DELETE
+FROM idempotency_keys
+WHERE
+    "key" = $1
+    AND "trip_id" IS NULL
+`
+
+func (q *Queries) ReleaseIdempotencyKey(ctx context.Context, key string) error {
+	_, err := q.db.Exec(ctx, releaseIdempotencyKey, key)
+	return err
+}
+
+const removeTrip = `-- name: RemoveTrip :execrows
+UPDATE trips
+SET
+    "deleted_at" = (now() AT TIME ZONE 'UTC')
+WHERE
+    id = $1
+    AND deleted_at IS NULL
+`
+
+func (q *Queries) RemoveTrip(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, removeTrip, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const removeTripActivities = `-- name: RemoveTripActivities :exec
+UPDATE activities
+SET
+    "deleted_at" = (now() AT TIME ZONE 'UTC')
+WHERE
+    trip_id = $1
+    AND deleted_at IS NULL
 `
 
-func (q *Queries) GetTripLinks(ctx context.Context, tripID uuid.UUID) ([]Link, error) {
-	rows, err := q.db.Query(ctx, getTripLinks, tripID)
+func (q *Queries) RemoveTripActivities(ctx context.Context, tripID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, removeTripActivities, tripID)
+	return err
+}
+
+const removeTripLinks = `-- name: RemoveTripLinks :exec
+UPDATE links
+SET
+    "deleted_at" = (now() AT TIME ZONE 'UTC')
+WHERE
+    trip_id = $1
+    AND deleted_at IS NULL
+`
+
+func (q *Queries) RemoveTripLinks(ctx context.Context, tripID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, removeTripLinks, tripID)
+	return err
+}
+
+const searchTripActivities = `-- name: SearchTripActivities :many
+SELECT
+    t."id" AS "trip_id",
+    a."id" AS "activity_id", a."title" AS "activity_title", a."occurs_at" AS "activity_occurs_at", a."duration_minutes" AS "activity_duration_minutes", a."notes" AS "activity_notes", a."is_done" AS "activity_is_done", a."sort_order" AS "activity_sort_order"
+FROM trips AS t
+LEFT JOIN LATERAL (
+    SELECT "id", "title", "occurs_at", "duration_minutes", "notes", "is_done", "sort_order"
+    FROM activities
+    WHERE activities."trip_id" = t."id"
+        AND activities."deleted_at" IS NULL
+        AND activities."title" ILIKE $1::text
+    ORDER BY "occurs_at", "sort_order", "id"
+    LIMIT $2::int
+) AS a ON TRUE
+WHERE
+    t."id" = $3
+    AND t."deleted_at" IS NULL
+ORDER BY a."occurs_at", a."sort_order", a."id"
+`
+
+type SearchTripActivitiesParams struct {
+	Pattern     string    `db:"pattern" json:"pattern"`
+	ResultLimit int32     `db:"result_limit" json:"result_limit"`
+	ID          uuid.UUID `db:"id" json:"id"`
+}
+
+type SearchTripActivitiesRow struct {
+	TripID                  uuid.UUID        `db:"trip_id" json:"trip_id"`
+	ActivityID              pgtype.UUID      `db:"activity_id" json:"activity_id"`
+	ActivityTitle           pgtype.Text      `db:"activity_title" json:"activity_title"`
+	ActivityOccursAt        pgtype.Timestamp `db:"activity_occurs_at" json:"activity_occurs_at"`
+	ActivityDurationMinutes pgtype.Int4      `db:"activity_duration_minutes" json:"activity_duration_minutes"`
+	ActivityNotes           pgtype.Text      `db:"activity_notes" json:"activity_notes"`
+	ActivityIsDone          pgtype.Bool      `db:"activity_is_done" json:"activity_is_done"`
+	ActivitySortOrder       pgtype.Int4      `db:"activity_sort_order" json:"activity_sort_order"`
+}
+
+func (q *Queries) SearchTripActivities(ctx context.Context, arg SearchTripActivitiesParams) ([]SearchTripActivitiesRow, error) {
+	rows, err := q.db.Query(ctx, searchTripActivities, arg.Pattern, arg.ResultLimit, arg.ID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Link
+	var items []SearchTripActivitiesRow
 	for rows.Next() {
-		var i Link
+		var i SearchTripActivitiesRow
 		if err := rows.Scan(
-			&i.ID,
 			&i.TripID,
-			&i.Title,
-			&i.Url,
+			&i.ActivityID,
+			&i.ActivityTitle,
+			&i.ActivityOccursAt,
+			&i.ActivityDurationMinutes,
+			&i.ActivityNotes,
+			&i.ActivityIsDone,
+			&i.ActivitySortOrder,
 		); err != nil {
 			return nil, err
 		}
@@ -212,38 +1758,265 @@ func (q *Queries) GetTripLinks(ctx context.Context, tripID uuid.UUID) ([]Link, e
 	return items, nil
 }
 
-const insertTrip = `-- name: InsertTrip :one
-INSERT
-INTO trips
-    ( "destination", "owner_email", "owner_name", "starts_at", "ends_at") VALUES
-    ( $1, $2, $3, $4, $5 )
-RETURNING "id"
+const seatTripParticipantByEmail = `-- name: SeatTripParticipantByEmail :execrows
+UPDATE participants
+SET
+    "is_confirmed" = true,
+    "is_waitlisted" = false
+WHERE
+    trip_id = $1
+    AND lower("email") = $2::text
+    AND "is_waitlisted"
 `
 
-type InsertTripParams struct {
-	Destination string           `db:"destination" json:"destination"`
-	OwnerEmail  string           `db:"owner_email" json:"owner_email"`
-	OwnerName   string           `db:"owner_name" json:"owner_name"`
-	StartsAt    pgtype.Timestamp `db:"starts_at" json:"starts_at"`
-	EndsAt      pgtype.Timestamp `db:"ends_at" json:"ends_at"`
+type SeatTripParticipantByEmailParams struct {
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+	Email  string    `db:"email" json:"email"`
 }
 
-func (q *Queries) InsertTrip(ctx context.Context, arg InsertTripParams) (uuid.UUID, error) {
-	row := q.db.QueryRow(ctx, insertTrip,
-		arg.Destination,
-		arg.OwnerEmail,
-		arg.OwnerName,
-		arg.StartsAt,
-		arg.EndsAt,
+func (q *Queries) SeatTripParticipantByEmail(ctx context.Context, arg SeatTripParticipantByEmailParams) (int64, error) {
+	result, err := q.db.Exec(ctx, seatTripParticipantByEmail, arg.TripID, arg.Email)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const tripExists = `-- name: TripExists :one
+SELECT EXISTS (
+    SELECT 1 FROM trips WHERE id = $1 AND deleted_at IS NULL
+)
+`
+
+func (q *Queries) TripExists(ctx context.Context, id uuid.UUID) (bool, error) {
+	row := q.db.QueryRow(ctx, tripExists, id)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const undeleteTrip = `-- name: UndeleteTrip :execrows
+UPDATE trips
+SET
+    "deleted_at" = NULL,
+    "updated_at" = now()
+WHERE
+    id = $1
+    AND deleted_at = $2
+`
+
+type UndeleteTripParams struct {
+	ID        uuid.UUID        `db:"id" json:"id"`
+	DeletedAt pgtype.Timestamp `db:"deleted_at" json:"deleted_at"`
+}
+
+func (q *Queries) UndeleteTrip(ctx context.Context, arg UndeleteTripParams) (int64, error) {
+	result, err := q.db.Exec(ctx, undeleteTrip, arg.ID, arg.DeletedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const undeleteTripActivities = `-- name: UndeleteTripActivities :exec
+UPDATE activities
+SET
+    "deleted_at" = NULL
+WHERE
+    trip_id = $1
+    AND deleted_at = $2
+`
+
+type UndeleteTripActivitiesParams struct {
+	TripID    uuid.UUID        `db:"trip_id" json:"trip_id"`
+	DeletedAt pgtype.Timestamp `db:"deleted_at" json:"deleted_at"`
+}
+
+func (q *Queries) UndeleteTripActivities(ctx context.Context, arg UndeleteTripActivitiesParams) error {
+	_, err := q.db.Exec(ctx, undeleteTripActivities, arg.TripID, arg.DeletedAt)
+	return err
+}
+
+const undeleteTripLinks = `-- name: UndeleteTripLinks :exec
+UPDATE links
+SET
+    "deleted_at" = NULL
+WHERE
+    trip_id = $1
+    AND deleted_at = $2
+`
+
+type UndeleteTripLinksParams struct {
+	TripID    uuid.UUID        `db:"trip_id" json:"trip_id"`
+	DeletedAt pgtype.Timestamp `db:"deleted_at" json:"deleted_at"`
+}
+
+func (q *Queries) UndeleteTripLinks(ctx context.Context, arg UndeleteTripLinksParams) error {
+	_, err := q.db.Exec(ctx, undeleteTripLinks, arg.TripID, arg.DeletedAt)
+	return err
+}
+
+const updateActivity = `-- name: UpdateActivity :one
+UPDATE activities
+SET
+    "title" = $1,
+    "occurs_at" = $2,
+    "duration_minutes" = $3,
+    "notes" = $4
+WHERE
+    id = $5
+    AND trip_id = $6
+    AND deleted_at IS NULL
+RETURNING "id", "trip_id", "title", "occurs_at", "duration_minutes", "notes", "is_done", "sort_order", "deleted_at"
+`
+
+type UpdateActivityParams struct {
+	Title           string           `db:"title" json:"title"`
+	OccursAt        pgtype.Timestamp `db:"occurs_at" json:"occurs_at"`
+	DurationMinutes int32            `db:"duration_minutes" json:"duration_minutes"`
+	Notes           pgtype.Text      `db:"notes" json:"notes"`
+	ID              uuid.UUID        `db:"id" json:"id"`
+	TripID          uuid.UUID        `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) UpdateActivity(ctx context.Context, arg UpdateActivityParams) (Activity, error) {
+	row := q.db.QueryRow(ctx, updateActivity,
+		arg.Title,
+		arg.OccursAt,
+		arg.DurationMinutes,
+		arg.Notes,
+		arg.ID,
+		arg.TripID,
 	)
-	var id uuid.UUID
-	err := row.Scan(&id)
-	return id, err
+	var i Activity
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.Title,
+		&i.OccursAt,
+		&i.DurationMinutes,
+		&i.Notes,
+		&i.IsDone,
+		&i.SortOrder,
+		&i.DeletedAt,
+	)
+	return i, err
 }
 
-type InviteParticipantsToTripParams struct {
+const updateActivityDone = `-- name: UpdateActivityDone :one
+UPDATE activities
+SET
+    "is_done" = $1
+WHERE
+    id = $2
+    AND trip_id = $3
+    AND deleted_at IS NULL
+RETURNING "id", "trip_id", "title", "occurs_at", "duration_minutes", "notes", "is_done", "sort_order", "deleted_at"
+`
+
+type UpdateActivityDoneParams struct {
+	IsDone bool      `db:"is_done" json:"is_done"`
+	ID     uuid.UUID `db:"id" json:"id"`
 	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
-	Email  string    `db:"email" json:"email"`
+}
+
+func (q *Queries) UpdateActivityDone(ctx context.Context, arg UpdateActivityDoneParams) (Activity, error) {
+	row := q.db.QueryRow(ctx, updateActivityDone, arg.IsDone, arg.ID, arg.TripID)
+	var i Activity
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.Title,
+		&i.OccursAt,
+		&i.DurationMinutes,
+		&i.Notes,
+		&i.IsDone,
+		&i.SortOrder,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const updateActivityOccursAt = `-- name: UpdateActivityOccursAt :exec
+UPDATE activities
+SET
+    "occurs_at" = $1
+WHERE
+    id = $2
+    AND trip_id = $3
+    AND deleted_at IS NULL
+`
+
+type UpdateActivityOccursAtParams struct {
+	OccursAt pgtype.Timestamp `db:"occurs_at" json:"occurs_at"`
+	ID       uuid.UUID        `db:"id" json:"id"`
+	TripID   uuid.UUID        `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) UpdateActivityOccursAt(ctx context.Context, arg UpdateActivityOccursAtParams) error {
+	_, err := q.db.Exec(ctx, updateActivityOccursAt, arg.OccursAt, arg.ID, arg.TripID)
+	return err
+}
+
+const updateActivitySortOrder = `-- name: UpdateActivitySortOrder :exec
+UPDATE activities
+SET
+    "sort_order" = $1
+WHERE
+    id = $2
+    AND trip_id = $3
+    AND deleted_at IS NULL
+`
+
+type UpdateActivitySortOrderParams struct {
+	SortOrder int32     `db:"sort_order" json:"sort_order"`
+	ID        uuid.UUID `db:"id" json:"id"`
+	TripID    uuid.UUID `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) UpdateActivitySortOrder(ctx context.Context, arg UpdateActivitySortOrderParams) error {
+	_, err := q.db.Exec(ctx, updateActivitySortOrder, arg.SortOrder, arg.ID, arg.TripID)
+	return err
+}
+
+const updateInviteStatus = `-- name: UpdateInviteStatus :exec
+UPDATE participants
+SET
+    "invite_status" = $1,
+    "invite_last_attempt_at" = $2
+WHERE
+    id = $3
+`
+
+type UpdateInviteStatusParams struct {
+	InviteStatus        string           `db:"invite_status" json:"invite_status"`
+	InviteLastAttemptAt pgtype.Timestamp `db:"invite_last_attempt_at" json:"invite_last_attempt_at"`
+	ID                  uuid.UUID        `db:"id" json:"id"`
+}
+
+func (q *Queries) UpdateInviteStatus(ctx context.Context, arg UpdateInviteStatusParams) error {
+	_, err := q.db.Exec(ctx, updateInviteStatus, arg.InviteStatus, arg.InviteLastAttemptAt, arg.ID)
+	return err
+}
+
+const updateParticipantEmail = `-- name: UpdateParticipantEmail :exec
+UPDATE participants
+SET
+    "email" = $1,
+    "invite_status" = 'pending',
+    "invite_last_attempt_at" = NULL
+WHERE
+    id = $2
+`
+
+type UpdateParticipantEmailParams struct {
+	Email string    `db:"email" json:"email"`
+	ID    uuid.UUID `db:"id" json:"id"`
+}
+
+func (q *Queries) UpdateParticipantEmail(ctx context.Context, arg UpdateParticipantEmailParams) error {
+	_, err := q.db.Exec(ctx, updateParticipantEmail, arg.Email, arg.ID)
+	return err
 }
 
 const updateTrip = `-- name: UpdateTrip :exec
@@ -252,17 +2025,27 @@ SET
     "destination" = $1,
     "ends_at" = $2,
     "starts_at" = $3,
-    "is_confirmed" = $4
+    "is_confirmed" = $4,
+    "notes" = $5,
+    "latitude" = $6,
+    "longitude" = $7,
+    "max_participants" = $8,
+    "updated_at" = now()
 WHERE
-    id = $5
+    id = $9
+    AND deleted_at IS NULL
 `
 
 type UpdateTripParams struct {
-	Destination string           `db:"destination" json:"destination"`
-	EndsAt      pgtype.Timestamp `db:"ends_at" json:"ends_at"`
-	StartsAt    pgtype.Timestamp `db:"starts_at" json:"starts_at"`
-	IsConfirmed bool             `db:"is_confirmed" json:"is_confirmed"`
-	ID          uuid.UUID        `db:"id" json:"id"`
+	Destination     string           `db:"destination" json:"destination"`
+	EndsAt          pgtype.Timestamp `db:"ends_at" json:"ends_at"`
+	StartsAt        pgtype.Timestamp `db:"starts_at" json:"starts_at"`
+	IsConfirmed     bool             `db:"is_confirmed" json:"is_confirmed"`
+	Notes           pgtype.Text      `db:"notes" json:"notes"`
+	Latitude        pgtype.Float8    `db:"latitude" json:"latitude"`
+	Longitude       pgtype.Float8    `db:"longitude" json:"longitude"`
+	MaxParticipants pgtype.Int4      `db:"max_participants" json:"max_participants"`
+	ID              uuid.UUID        `db:"id" json:"id"`
 }
 
 func (q *Queries) UpdateTrip(ctx context.Context, arg UpdateTripParams) error {
@@ -271,17 +2054,25 @@ func (q *Queries) UpdateTrip(ctx context.Context, arg UpdateTripParams) error {
 		arg.EndsAt,
 		arg.StartsAt,
 		arg.IsConfirmed,
+		arg.Notes,
+		arg.Latitude,
+		arg.Longitude,
+		arg.MaxParticipants,
 		arg.ID,
 	)
 	return err
 }
 
-const updateTripConfirm = `-- name: UpdateTripConfirm :exec
+const updateTripConfirm = `-- name: UpdateTripConfirm :execrows
 UPDATE trips
 SET 
-    "is_confirmed" = $1
+    "is_confirmed" = $1,
+    "status" = CASE WHEN $1 THEN 'confirmed' ELSE 'planning' END,
+    "updated_at" = now()
 WHERE
     id = $2
+    AND deleted_at IS NULL
+    AND "is_confirmed" <> $1
 `
 
 type UpdateTripConfirmParams struct {
@@ -289,7 +2080,101 @@ type UpdateTripConfirmParams struct {
 	ID          uuid.UUID `db:"id" json:"id"`
 }
 
-func (q *Queries) UpdateTripConfirm(ctx context.Context, arg UpdateTripConfirmParams) error {
-	_, err := q.db.Exec(ctx, updateTripConfirm, arg.IsConfirmed, arg.ID)
+func (q *Queries) UpdateTripConfirm(ctx context.Context, arg UpdateTripConfirmParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateTripConfirm, arg.IsConfirmed, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateTripOwner = `-- name: UpdateTripOwner :exec
+UPDATE trips
+SET
+    "owner_email" = $1,
+    "owner_name" = $2,
+    "owner_token_hash" = $3,
+    "updated_at" = now()
+WHERE
+    id = $4
+    AND deleted_at IS NULL
+`
+
+type UpdateTripOwnerParams struct {
+	OwnerEmail     string    `db:"owner_email" json:"owner_email"`
+	OwnerName      string    `db:"owner_name" json:"owner_name"`
+	OwnerTokenHash string    `db:"owner_token_hash" json:"owner_token_hash"`
+	ID             uuid.UUID `db:"id" json:"id"`
+}
+
+func (q *Queries) UpdateTripOwner(ctx context.Context, arg UpdateTripOwnerParams) error {
+	_, err := q.db.Exec(ctx, updateTripOwner,
+		arg.OwnerEmail,
+		arg.OwnerName,
+		arg.OwnerTokenHash,
+		arg.ID,
+	)
+	return err
+}
+
+const updateTripOwnerTokenHash = `-- name: UpdateTripOwnerTokenHash :exec
+UPDATE trips
+SET
+    "owner_token_hash" = $1
+WHERE
+    id = $2
+    AND deleted_at IS NULL
+`
+
+type UpdateTripOwnerTokenHashParams struct {
+	OwnerTokenHash string    `db:"owner_token_hash" json:"owner_token_hash"`
+	ID             uuid.UUID `db:"id" json:"id"`
+}
+
+func (q *Queries) UpdateTripOwnerTokenHash(ctx context.Context, arg UpdateTripOwnerTokenHashParams) error {
+	_, err := q.db.Exec(ctx, updateTripOwnerTokenHash, arg.OwnerTokenHash, arg.ID)
+	return err
+}
+
+const updateTripPeriod = `-- name: UpdateTripPeriod :exec
+UPDATE trips
+SET
+    "starts_at" = $1,
+    "ends_at" = $2,
+    "updated_at" = now()
+WHERE
+    id = $3
+    AND deleted_at IS NULL
+`
+
+type UpdateTripPeriodParams struct {
+	StartsAt pgtype.Timestamp `db:"starts_at" json:"starts_at"`
+	EndsAt   pgtype.Timestamp `db:"ends_at" json:"ends_at"`
+	ID       uuid.UUID        `db:"id" json:"id"`
+}
+
+func (q *Queries) UpdateTripPeriod(ctx context.Context, arg UpdateTripPeriodParams) error {
+	_, err := q.db.Exec(ctx, updateTripPeriod, arg.StartsAt, arg.EndsAt, arg.ID)
+	return err
+}
+
+const updateTripStatus = `-- name: UpdateTripStatus :exec
+UPDATE trips
+SET
+    "status" = $1,
+    "is_confirmed" = $1 IN ('confirmed', 'completed'),
+    "updated_at" = now()
+WHERE
+    id = $2
+    AND deleted_at IS NULL
+`
+
+type UpdateTripStatusParams struct {
+	Status string    `db:"status" json:"status"`
+	ID     uuid.UUID `db:"id" json:"id"`
+}
+
+func (q *Queries) UpdateTripStatus(ctx context.Context, arg UpdateTripStatusParams) error {
+	_, err := q.db.Exec(ctx, updateTripStatus, arg.Status, arg.ID)
 	return err
 }
@@ -1,16 +1,185 @@
 -- name: InsertTrip :one
 INSERT
 INTO trips
-    ( "destination", "owner_email", "owner_name", "starts_at", "ends_at") VALUES
-    ( $1, $2, $3, $4, $5 )
+    ( "destination", "owner_email", "owner_name", "starts_at", "ends_at", "owner_token_hash", "timezone", "notes", "latitude", "longitude", "max_participants") VALUES
+    ( $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11 )
 RETURNING "id";
 
 -- name: GetTrip :one
 SELECT
-    "id", "destination", "owner_email", "owner_name", "is_confirmed", "starts_at", "ends_at"
+    "id", "destination", "owner_email", "owner_name", "is_confirmed", "starts_at", "ends_at", "owner_token_hash", "reminder_sent_at", "timezone", "status", "updated_at", "notes", "latitude", "longitude", "deleted_at", "max_participants"
+FROM trips
+WHERE
+    id = $1
+    AND deleted_at IS NULL;
+
+-- name: TripExists :one
+SELECT EXISTS (
+    SELECT 1 FROM trips WHERE id = $1 AND deleted_at IS NULL
+);
+
+-- name: GetTripAndActivities :many
+SELECT
+    sqlc.embed(t),
+    a."id" AS "activity_id", a."title" AS "activity_title", a."occurs_at" AS "activity_occurs_at", a."duration_minutes" AS "activity_duration_minutes", a."notes" AS "activity_notes", a."is_done" AS "activity_is_done", a."sort_order" AS "activity_sort_order"
+FROM trips AS t
+LEFT JOIN activities AS a ON a."trip_id" = t."id"
+    AND a."deleted_at" IS NULL
+    AND (sqlc.narg(from_date)::date IS NULL OR a."occurs_at" >= (sqlc.narg(from_date)::date::timestamp AT TIME ZONE t."timezone") AT TIME ZONE 'UTC')
+    AND (sqlc.narg(to_date)::date IS NULL OR a."occurs_at" < ((sqlc.narg(to_date)::date + 1)::timestamp AT TIME ZONE t."timezone") AT TIME ZONE 'UTC')
+    AND (sqlc.narg(title)::text IS NULL OR strpos(lower(a."title"), lower(sqlc.narg(title)::text)) > 0)
+WHERE
+    t."id" = sqlc.arg(id)
+    AND t."deleted_at" IS NULL
+ORDER BY a."occurs_at", a."sort_order", a."id";
+
+-- name: GetTripAndActivitiesPage :many
+SELECT
+    sqlc.embed(t),
+    a."id" AS "activity_id", a."title" AS "activity_title", a."occurs_at" AS "activity_occurs_at", a."duration_minutes" AS "activity_duration_minutes", a."notes" AS "activity_notes", a."is_done" AS "activity_is_done", a."sort_order" AS "activity_sort_order",
+    (SELECT count(*) FROM activities WHERE activities."trip_id" = t."id" AND activities."deleted_at" IS NULL) AS "total"
+FROM trips AS t
+LEFT JOIN LATERAL (
+    SELECT "id", "title", "occurs_at", "duration_minutes", "notes", "is_done", "sort_order"
+    FROM activities
+    WHERE activities."trip_id" = t."id"
+        AND activities."deleted_at" IS NULL
+    ORDER BY "occurs_at", "sort_order", "id"
+    LIMIT sqlc.arg(page_limit)::int
+    OFFSET sqlc.arg(page_offset)::int
+) AS a ON TRUE
+WHERE
+    t."id" = sqlc.arg(id)
+    AND t."deleted_at" IS NULL;
+
+-- name: GetTripAndLinks :many
+SELECT
+    sqlc.embed(t),
+    l."id" AS "link_id", l."title" AS "link_title", l."url" AS "link_url"
+FROM trips AS t
+LEFT JOIN links AS l ON l."trip_id" = t."id"
+    AND l."deleted_at" IS NULL
+WHERE
+    t."id" = $1
+    AND t."deleted_at" IS NULL;
+
+-- name: GetTripAndParticipants :many
+SELECT
+    sqlc.embed(t),
+    p."id" AS "participant_id", p."email" AS "participant_email", p."is_confirmed" AS "participant_is_confirmed",
+    p."invite_status" AS "participant_invite_status", p."invite_last_attempt_at" AS "participant_invite_last_attempt_at",
+    p."invited_at" AS "participant_invited_at", p."is_waitlisted" AS "participant_is_waitlisted",
+    p."name" AS "participant_name", p."phone" AS "participant_phone"
+FROM trips AS t
+LEFT JOIN participants AS p ON p."trip_id" = t."id"
+WHERE
+    t."id" = $1
+    AND t."deleted_at" IS NULL;
+
+-- name: GetTripAndParticipantsPage :many
+SELECT
+    sqlc.embed(t),
+    p."id" AS "participant_id", p."email" AS "participant_email", p."is_confirmed" AS "participant_is_confirmed",
+    p."invite_status" AS "participant_invite_status", p."invite_last_attempt_at" AS "participant_invite_last_attempt_at",
+    p."invited_at" AS "participant_invited_at", p."is_waitlisted" AS "participant_is_waitlisted",
+    p."name" AS "participant_name", p."phone" AS "participant_phone",
+    (SELECT count(*) FROM participants WHERE participants."trip_id" = t."id") AS "total"
+FROM trips AS t
+LEFT JOIN LATERAL (
+    SELECT "id", "email", "is_confirmed", "invite_status", "invite_last_attempt_at", "invited_at", "is_waitlisted", "name", "phone"
+    FROM participants
+    WHERE participants."trip_id" = t."id"
+    ORDER BY "email", "id"
+    LIMIT sqlc.arg(page_limit)::int
+    OFFSET sqlc.arg(page_offset)::int
+) AS p ON TRUE
+WHERE
+    t."id" = sqlc.arg(id)
+    AND t."deleted_at" IS NULL;
+
+-- name: GetTripsDueForReminder :many
+SELECT
+    "id", "destination", "owner_email", "owner_name", "is_confirmed", "starts_at", "ends_at", "owner_token_hash", "reminder_sent_at", "timezone", "status", "updated_at", "notes", "latitude", "longitude", "deleted_at", "max_participants"
 FROM trips
 WHERE
-    id = $1;
+    is_confirmed = TRUE
+    AND reminder_sent_at IS NULL
+    AND starts_at > sqlc.arg(now)::timestamp
+    AND starts_at <= sqlc.arg(due_until)::timestamp
+    AND deleted_at IS NULL
+ORDER BY starts_at;
+
+-- name: GetConfirmedTripsStartingBetween :many
+SELECT
+    sqlc.embed(t),
+    c."count" AS "confirmed_participants"
+FROM trips AS t
+CROSS JOIN LATERAL (
+    SELECT count(*) AS "count"
+    FROM participants
+    WHERE participants."trip_id" = t."id" AND participants."is_confirmed" = TRUE
+) AS c
+WHERE
+    t."is_confirmed" = TRUE
+    AND t."starts_at" >= sqlc.arg(starts_from)::timestamp
+    AND t."starts_at" < sqlc.arg(starts_before)::timestamp
+    AND t."deleted_at" IS NULL
+ORDER BY t."starts_at", t."id";
+
+-- name: MarkTripReminderSent :exec
+UPDATE trips
+SET
+    "reminder_sent_at" = $1
+WHERE
+    id = $2
+    AND deleted_at IS NULL;
+
+-- name: CountTripsByEmail :one
+SELECT count(DISTINCT t."id")
+FROM trips t
+LEFT JOIN participants p
+    ON p."trip_id" = t."id"
+    AND lower(p."email") = sqlc.arg(email)::text
+WHERE
+    t."deleted_at" IS NULL
+    AND (lower(t."owner_email") = sqlc.arg(email)::text OR p."id" IS NOT NULL);
+
+-- name: GetTripsByEmail :many
+SELECT DISTINCT ON (t."starts_at", t."id")
+    t."id", t."destination", t."starts_at", t."ends_at",
+    (lower(t."owner_email") = sqlc.arg(email)::text)::boolean AS "is_owner",
+    CASE
+        WHEN lower(t."owner_email") = sqlc.arg(email)::text THEN t."is_confirmed"
+        ELSE COALESCE(p."is_confirmed", false)
+    END::boolean AS "is_confirmed"
+FROM trips t
+LEFT JOIN participants p
+    ON p."trip_id" = t."id"
+    AND lower(p."email") = sqlc.arg(email)::text
+WHERE
+    t."deleted_at" IS NULL
+    AND (lower(t."owner_email") = sqlc.arg(email)::text OR p."id" IS NOT NULL)
+ORDER BY t."starts_at", t."id", p."is_confirmed" DESC NULLS LAST
+LIMIT sqlc.arg(page_limit)::int
+OFFSET sqlc.arg(page_offset)::int;
+
+-- name: CountTripsByOwner :one
+SELECT count(*)
+FROM trips
+WHERE
+    lower("owner_email") = sqlc.arg(email)::text
+    AND "deleted_at" IS NULL;
+
+-- name: ListTripsByOwner :many
+SELECT
+    "id", "destination", "starts_at", "ends_at", "is_confirmed"
+FROM trips
+WHERE
+    lower("owner_email") = sqlc.arg(email)::text
+    AND "deleted_at" IS NULL
+ORDER BY "starts_at", "id"
+LIMIT sqlc.arg(page_limit)::int
+OFFSET sqlc.arg(page_offset)::int;
 
 -- name: UpdateTrip :exec
 UPDATE trips
@@ -18,23 +187,106 @@ SET
     "destination" = $1,
     "ends_at" = $2,
     "starts_at" = $3,
-    "is_confirmed" = $4
+    "is_confirmed" = $4,
+    "notes" = $5,
+    "latitude" = $6,
+    "longitude" = $7,
+    "max_participants" = $8,
+    "updated_at" = now()
 WHERE
-    id = $5;
+    id = $9
+    AND deleted_at IS NULL;
 
--- name: UpdateTripConfirm :exec
+-- name: UpdateTripConfirm :execrows
 UPDATE trips
 SET 
-    "is_confirmed" = $1
+    "is_confirmed" = $1,
+    "status" = CASE WHEN $1 THEN 'confirmed' ELSE 'planning' END,
+    "updated_at" = now()
 WHERE
-    id = $2;
+    id = $2
+    AND deleted_at IS NULL
+    AND "is_confirmed" <> $1;
+
+-- name: UpdateTripPeriod :exec
+UPDATE trips
+SET
+    "starts_at" = $1,
+    "ends_at" = $2,
+    "updated_at" = now()
+WHERE
+    id = $3
+    AND deleted_at IS NULL;
+
+-- name: UpdateTripStatus :exec
+UPDATE trips
+SET
+    "status" = sqlc.arg(status),
+    "is_confirmed" = sqlc.arg(status) IN ('confirmed', 'completed'),
+    "updated_at" = now()
+WHERE
+    id = sqlc.arg(id)
+    AND deleted_at IS NULL;
+
+-- name: UpdateTripOwner :exec
+UPDATE trips
+SET
+    "owner_email" = $1,
+    "owner_name" = $2,
+    "owner_token_hash" = $3,
+    "updated_at" = now()
+WHERE
+    id = $4
+    AND deleted_at IS NULL;
+
+-- name: UpdateTripOwnerTokenHash :exec
+UPDATE trips
+SET
+    "owner_token_hash" = $1
+WHERE
+    id = $2
+    AND deleted_at IS NULL;
+
+-- name: RemoveTrip :execrows
+UPDATE trips
+SET
+    "deleted_at" = (now() AT TIME ZONE 'UTC')
+WHERE
+    id = $1
+    AND deleted_at IS NULL;
+
+-- name: GetDeletedTrip :one
+SELECT
+    "id", "destination", "owner_email", "owner_name", "is_confirmed", "starts_at", "ends_at", "owner_token_hash", "reminder_sent_at", "timezone", "status", "updated_at", "notes", "latitude", "longitude", "deleted_at", "max_participants"
+FROM trips
+WHERE
+    id = $1
+    AND deleted_at IS NOT NULL;
+
+-- name: UndeleteTrip :execrows
+UPDATE trips
+SET
+    "deleted_at" = NULL,
+    "updated_at" = now()
+WHERE
+    id = $1
+    AND deleted_at = $2;
 
 -- name: GetParticipant :one
 SELECT
-    "id", "trip_id", "email", "is_confirmed"
+    "id", "trip_id", "email", "is_confirmed", "invite_status", "invite_last_attempt_at", "invited_at", "is_waitlisted", "name", "phone"
 FROM participants
 WHERE
-    id = $1;
+    id = $1
+    AND trip_id IN (SELECT "id" FROM trips WHERE "deleted_at" IS NULL);
+
+-- name: GetParticipantForTrip :one
+SELECT
+    "id", "trip_id", "email", "is_confirmed", "invite_status", "invite_last_attempt_at", "invited_at", "is_waitlisted", "name", "phone"
+FROM participants
+WHERE
+    id = $1
+    AND trip_id = $2;
 
 -- name: ConfirmParticipant :exec
 UPDATE participants
@@ -43,30 +295,289 @@ SET
 WHERE
     id = $2;
 
+-- name: LockTripParticipants :many
+SELECT
+    "id", "trip_id", "email", "is_confirmed", "invite_status", "invite_last_attempt_at", "invited_at", "is_waitlisted", "name", "phone"
+FROM participants
+WHERE
+    trip_id = sqlc.arg(trip_id)
+    AND id = ANY(sqlc.arg(ids)::uuid[])
+FOR UPDATE;
+
+-- name: ConfirmTripParticipants :exec
+UPDATE participants
+SET
+    "is_confirmed" = true
+WHERE
+    trip_id = sqlc.arg(trip_id)
+    AND id = ANY(sqlc.arg(ids)::uuid[]);
+
+-- name: ConfirmTripParticipantByEmail :execrows
+UPDATE participants
+SET
+    "is_confirmed" = true
+WHERE
+    trip_id = sqlc.arg(trip_id)
+    AND lower("email") = sqlc.arg(email)::text
+    AND NOT "is_waitlisted";
+
+-- name: SeatTripParticipantByEmail :execrows
+UPDATE participants
+SET
+    "is_confirmed" = true,
+    "is_waitlisted" = false
+WHERE
+    trip_id = sqlc.arg(trip_id)
+    AND lower("email") = sqlc.arg(email)::text
+    AND "is_waitlisted";
+
 -- name: GetParticipants :many
 SELECT
-    "id", "trip_id", "email", "is_confirmed"
+    "id", "trip_id", "email", "is_confirmed", "invite_status", "invite_last_attempt_at", "invited_at", "is_waitlisted", "name", "phone"
 FROM participants
 WHERE
     trip_id = $1;
 
+-- name: GetAdminParticipants :many
+SELECT
+    p."id", p."trip_id", p."email", p."is_confirmed", p."invite_status", p."invited_at",
+    t."destination" AS "trip_destination"
+FROM participants p
+JOIN trips t ON t."id" = p."trip_id"
+WHERE
+    t."deleted_at" IS NULL
+    AND (sqlc.narg(is_confirmed)::boolean IS NULL OR p."is_confirmed" = sqlc.narg(is_confirmed)::boolean)
+    AND (sqlc.narg(invited_before)::timestamp IS NULL OR p."invited_at" < sqlc.narg(invited_before)::timestamp)
+ORDER BY p."invited_at", p."id"
+LIMIT sqlc.arg(page_limit)::int
+OFFSET sqlc.arg(page_offset)::int;
+
+-- name: CountAdminParticipants :one
+SELECT count(*)
+FROM participants p
+JOIN trips t ON t."id" = p."trip_id"
+WHERE
+    t."deleted_at" IS NULL
+    AND (sqlc.narg(is_confirmed)::boolean IS NULL OR p."is_confirmed" = sqlc.narg(is_confirmed)::boolean)
+    AND (sqlc.narg(invited_before)::timestamp IS NULL OR p."invited_at" < sqlc.narg(invited_before)::timestamp);
+
+-- name: GetParticipantsSummary :one
+SELECT
+    COUNT(*) AS total,
+    COUNT(*) FILTER (WHERE "is_confirmed") AS confirmed,
+    COUNT(*) FILTER (WHERE NOT "is_confirmed" AND NOT "is_waitlisted") AS pending,
+    COUNT(*) FILTER (WHERE "is_waitlisted") AS waitlisted,
+    COALESCE(ARRAY_AGG("email" ORDER BY "email") FILTER (WHERE NOT "is_confirmed" AND NOT "is_waitlisted"), '{}')::TEXT[] AS pending_emails
+FROM participants
+WHERE
+    trip_id = $1;
+
+-- name: CountPendingInvitesByEmail :one
+SELECT count(*)
+FROM participants
+WHERE
+    lower("email") = sqlc.arg(email)::text
+    AND NOT "is_confirmed"
+    AND "trip_id" IN (SELECT "id" FROM trips WHERE "deleted_at" IS NULL);
+
+-- name: UpdateInviteStatus :exec
+UPDATE participants
+SET
+    "invite_status" = $1,
+    "invite_last_attempt_at" = $2
+WHERE
+    id = $3;
+
+-- name: UpdateParticipantEmail :exec
+UPDATE participants
+SET
+    "email" = $1,
+    "invite_status" = 'pending',
+    "invite_last_attempt_at" = NULL
+WHERE
+    id = $2;
+
 -- name: InviteParticipantsToTrip :copyfrom
 INSERT INTO participants
-    ( "trip_id", "email" ) VALUES
-    ( $1, $2 );
+    ( "trip_id", "email", "is_waitlisted", "name", "phone" ) VALUES
+    ( $1, $2, $3, $4, $5 );
+
+-- name: InsertConfirmedParticipant :exec
+INSERT INTO participants
+    ( "trip_id", "email", "is_confirmed" ) VALUES
+    ( $1, $2, true );
+
+-- name: DeleteParticipant :execrows
+DELETE FROM participants
+WHERE
+    id = $1
+    AND trip_id = $2;
+
+-- name: PromoteParticipant :execrows
+UPDATE participants
+SET
+    "is_waitlisted" = false,
+    "invite_status" = 'pending',
+    "invite_last_attempt_at" = NULL
+WHERE
+    id = $1
+    AND trip_id = $2
+    AND "is_waitlisted";
+
+-- name: LockTripCapacity :one
+SELECT "max_participants"
+FROM trips
+WHERE
+    id = $1
+    AND "deleted_at" IS NULL
+FOR UPDATE;
+
+-- name: CountSeatedParticipants :one
+SELECT count(*)
+FROM participants
+WHERE
+    trip_id = $1
+    AND NOT "is_waitlisted";
 
 -- name: CreateActivity :one
 INSERT INTO activities
-    ( "trip_id", "title", "occurs_at" ) VALUES
-    ( $1, $2, $3 )
+    ( "trip_id", "title", "occurs_at", "duration_minutes", "notes" ) VALUES
+    ( $1, $2, $3, $4, $5 )
 RETURNING "id";
 
 -- name: GetTripActivities :many
 SELECT
-    "id", "trip_id", "title", "occurs_at"
+    "id", "trip_id", "title", "occurs_at", "duration_minutes", "notes", "is_done", "sort_order", "deleted_at"
 FROM activities
 WHERE
-    trip_id = $1;
+    trip_id = $1
+    AND deleted_at IS NULL;
+
+-- name: GetActivity :one
+SELECT
+    "id", "trip_id", "title", "occurs_at", "duration_minutes", "notes", "is_done", "sort_order", "deleted_at"
+FROM activities
+WHERE
+    id = $1
+    AND trip_id = $2
+    AND deleted_at IS NULL;
+
+-- name: UpdateActivity :one
+UPDATE activities
+SET
+    "title" = $1,
+    "occurs_at" = $2,
+    "duration_minutes" = $3,
+    "notes" = $4
+WHERE
+    id = $5
+    AND trip_id = $6
+    AND deleted_at IS NULL
+RETURNING "id", "trip_id", "title", "occurs_at", "duration_minutes", "notes", "is_done", "sort_order", "deleted_at";
+
+-- name: UpdateActivityDone :one
+UPDATE activities
+SET
+    "is_done" = $1
+WHERE
+    id = $2
+    AND trip_id = $3
+    AND deleted_at IS NULL
+RETURNING "id", "trip_id", "title", "occurs_at", "duration_minutes", "notes", "is_done", "sort_order", "deleted_at";
+
+-- name: UpdateActivityOccursAt :exec
+UPDATE activities
+SET
+    "occurs_at" = $1
+WHERE
+    id = $2
+    AND trip_id = $3
+    AND deleted_at IS NULL;
+
+-- name: UpdateActivitySortOrder :exec
+UPDATE activities
+SET
+    "sort_order" = $1
+WHERE
+    id = $2
+    AND trip_id = $3
+    AND deleted_at IS NULL;
+
+-- name: LockTripDayActivities :many
+SELECT
+    a."id", a."trip_id", a."title", a."occurs_at", a."duration_minutes", a."notes", a."is_done", a."sort_order", a."deleted_at"
+FROM activities AS a
+JOIN trips AS t ON t."id" = a."trip_id"
+WHERE
+    a."trip_id" = sqlc.arg(trip_id)
+    AND a."occurs_at" >= (sqlc.arg(day)::date::timestamp AT TIME ZONE t."timezone") AT TIME ZONE 'UTC'
+    AND a."occurs_at" < ((sqlc.arg(day)::date + 1)::timestamp AT TIME ZONE t."timezone") AT TIME ZONE 'UTC'
+    AND a."deleted_at" IS NULL
+ORDER BY a."occurs_at", a."sort_order", a."id"
+FOR UPDATE OF a;
+
+-- name: GetTripAndActivityCounts :many
+SELECT
+    sqlc.embed(t),
+    c."day" AS "activity_day", c."count" AS "activity_count"
+FROM trips AS t
+LEFT JOIN LATERAL (
+    SELECT
+        date_trunc('day', ("occurs_at" AT TIME ZONE 'UTC') AT TIME ZONE t."timezone")::date AS "day",
+        count(*) AS "count"
+    FROM activities
+    WHERE activities."trip_id" = t."id"
+        AND activities."deleted_at" IS NULL
+    GROUP BY 1
+) AS c ON TRUE
+WHERE
+    t."id" = sqlc.arg(id)
+    AND t."deleted_at" IS NULL
+ORDER BY c."day";
+
+-- name: SearchTripActivities :many
+SELECT
+    t."id" AS "trip_id",
+    a."id" AS "activity_id", a."title" AS "activity_title", a."occurs_at" AS "activity_occurs_at", a."duration_minutes" AS "activity_duration_minutes", a."notes" AS "activity_notes", a."is_done" AS "activity_is_done", a."sort_order" AS "activity_sort_order"
+FROM trips AS t
+LEFT JOIN LATERAL (
+    SELECT "id", "title", "occurs_at", "duration_minutes", "notes", "is_done", "sort_order"
+    FROM activities
+    WHERE activities."trip_id" = t."id"
+        AND activities."deleted_at" IS NULL
+        AND activities."title" ILIKE sqlc.arg(pattern)::text
+    ORDER BY "occurs_at", "sort_order", "id"
+    LIMIT sqlc.arg(result_limit)::int
+) AS a ON TRUE
+WHERE
+    t."id" = sqlc.arg(id)
+    AND t."deleted_at" IS NULL
+ORDER BY a."occurs_at", a."sort_order", a."id";
+
+-- name: DeleteActivity :execrows
+UPDATE activities
+SET
+    "deleted_at" = (now() AT TIME ZONE 'UTC')
+WHERE
+    id = $1
+    AND trip_id = $2
+    AND deleted_at IS NULL;
+
+-- name: RemoveTripActivities :exec
+UPDATE activities
+SET
+    "deleted_at" = (now() AT TIME ZONE 'UTC')
+WHERE
+    trip_id = $1
+    AND deleted_at IS NULL;
+
+-- name: UndeleteTripActivities :exec
+UPDATE activities
+SET
+    "deleted_at" = NULL
+WHERE
+    trip_id = $1
+    AND deleted_at = $2;
 
 -- name: CreateTripLink :one
 INSERT INTO links
@@ -76,7 +587,67 @@ RETURNING "id";
 
 -- name: GetTripLinks :many
 SELECT
-    "id", "trip_id", "title", "url"
+    "id", "trip_id", "title", "url", "deleted_at"
 FROM links
 WHERE
-    trip_id = $1;
+    trip_id = $1
+    AND deleted_at IS NULL;
+
+-- name: GetLink :one
+SELECT
+    "id", "trip_id", "title", "url", "deleted_at"
+FROM links
+WHERE
+    id = $1
+    AND trip_id = $2
+    AND deleted_at IS NULL;
+
+-- name: RemoveTripLinks :exec
+UPDATE links
+SET
+    "deleted_at" = (now() AT TIME ZONE 'UTC')
+WHERE
+    trip_id = $1
+    AND deleted_at IS NULL;
+
+-- name: UndeleteTripLinks :exec
+UPDATE links
+SET
+    "deleted_at" = NULL
+WHERE
+    trip_id = $1
+    AND deleted_at = $2;
+
+-- name: ClaimIdempotencyKey :execrows
+INSERT
+INTO idempotency_keys
+    ( "key", "request_hash", "created_at" ) VALUES
+    ( $1, $2, $3 )
+ON CONFLICT ("key") DO UPDATE
+SET
+    "request_hash" = EXCLUDED."request_hash",
+    "created_at" = EXCLUDED."created_at",
+    "trip_id" = NULL
+WHERE
+    idempotency_keys."created_at" < sqlc.arg(expired_before)::timestamp;
+
+-- name: GetIdempotencyKey :one
+SELECT
+    "key", "request_hash", "trip_id", "created_at"
+FROM idempotency_keys
+WHERE
+    "key" = $1;
+
+-- name: CompleteIdempotencyKey :exec
+UPDATE idempotency_keys
+SET
+    "trip_id" = $1
+WHERE
+    "key" = $2;
+
+-- name: ReleaseIdempotencyKey :exec
+DELETE
+FROM idempotency_keys
+WHERE
+    "key" = $1
+    AND "trip_id" IS NULL;
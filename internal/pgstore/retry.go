@@ -0,0 +1,60 @@
+package pgstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"syscall"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	serializationFailureCode = "40001"
+	deadlockDetectedCode     = "40P01"
+	// connectionExceptionClass is the class of the codes the server answers on a broken connection.
+	connectionExceptionClass = "08"
+)
+
+// IsTransient tells whether err is a failure a new attempt would likely not hit: a serialization failure,
+// a deadlock or a broken connection. Constraint violations and the cancellations of the context never are.
+func IsTransient(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == serializationFailureCode ||
+			pgErr.Code == deadlockDetectedCode ||
+			strings.HasPrefix(pgErr.Code, connectionExceptionClass)
+	}
+
+	if pgconn.SafeToRetry(err) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// IsSafeToRetryWrite tells whether a write failing with err is safe to attempt again: a serialization failure or
+// a deadlock, rolled back by the server, or a failure pgconn knows happened before anything was sent. A broken
+// connection otherwise leaves the write maybe applied, so it never is, unlike on IsTransient.
+func IsSafeToRetryWrite(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == serializationFailureCode || pgErr.Code == deadlockDetectedCode
+	}
+
+	return pgconn.SafeToRetry(err)
+}
@@ -0,0 +1,15 @@
+package pgstore
+
+import "context"
+
+// The schema_version table is the one tern records the migrations applied on, out of the sqlc schema as no
+// migration creates it.
+const getSchemaVersion = `SELECT version FROM schema_version`
+
+// GetSchemaVersion reads the version of the last migration applied to the database.
+func (q *Queries) GetSchemaVersion(ctx context.Context) (int32, error) {
+	row := q.db.QueryRow(ctx, getSchemaVersion)
+	var version int32
+	err := row.Scan(&version)
+	return version, err
+}
@@ -2,38 +2,73 @@ package pgstore
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"journey/internal/api/spec"
+	"strings"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
-func (q *Queries) CreateTrip(ctx context.Context, pool *pgxpool.Pool, params spec.CreateTripRequest) (uuid.UUID, error) {
+// DefaultTripTimezone is the timezone of the trips created without one, as the column default.
+const DefaultTripTimezone = "UTC"
+
+func (q *Queries) CreateTrip(ctx context.Context, pool *pgxpool.Pool, params spec.CreateTripRequest, ownerTokenHash string) (uuid.UUID, error) {
 	tx, err := pool.Begin(ctx)
 	if err != nil {
 		return uuid.UUID{}, fmt.Errorf("pgstore: failed to begin tx for CreateTrip: %w", err)
 	}
 	defer func() { _ = tx.Rollback(ctx) }()
 
+	timezone := DefaultTripTimezone
+	if params.Timezone != nil && *params.Timezone != "" {
+		timezone = *params.Timezone
+	}
+
+	var notes pgtype.Text
+	if params.Notes != nil && *params.Notes != "" {
+		notes = pgtype.Text{String: *params.Notes, Valid: true}
+	}
+
+	var latitude, longitude pgtype.Float8
+	if params.Latitude != nil && params.Longitude != nil {
+		latitude = pgtype.Float8{Float64: *params.Latitude, Valid: true}
+		longitude = pgtype.Float8{Float64: *params.Longitude, Valid: true}
+	}
+
+	var maxParticipants pgtype.Int4
+	if params.MaxParticipants != nil {
+		maxParticipants = pgtype.Int4{Int32: int32(*params.MaxParticipants), Valid: true}
+	}
+
 	qtx := q.WithTx(tx)
 	tripID, err := qtx.InsertTrip(ctx, InsertTripParams{
-		Destination: params.Destination,
-		OwnerEmail:  string(params.OwnerEmail),
-		OwnerName:   params.OwnerName,
-		StartsAt:    pgtype.Timestamp{Valid: true, Time: params.StartsAt},
-		EndsAt:      pgtype.Timestamp{Valid: true, Time: params.EndsAt},
+		Destination:     params.Destination,
+		OwnerEmail:      string(params.OwnerEmail),
+		OwnerName:       params.OwnerName,
+		StartsAt:        pgtype.Timestamp{Valid: true, Time: params.StartsAt},
+		EndsAt:          pgtype.Timestamp{Valid: true, Time: params.EndsAt},
+		OwnerTokenHash:  ownerTokenHash,
+		Timezone:        timezone,
+		Notes:           notes,
+		Latitude:        latitude,
+		Longitude:       longitude,
+		MaxParticipants: maxParticipants,
 	})
 	if err != nil {
 		return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert trip for CreateTrip: %w", err)
 	}
 
+	// The emails past the trip capacity are waitlisted, in the order they were sent.
 	participants := make([]InviteParticipantsToTripParams, len(params.EmailsToInvite))
 	for i, eti := range params.EmailsToInvite {
 		participants[i] = InviteParticipantsToTripParams{
-			TripID: tripID,
-			Email:  string(eti),
+			TripID:       tripID,
+			Email:        string(eti),
+			IsWaitlisted: maxParticipants.Valid && i >= int(maxParticipants.Int32),
 		}
 	}
 
@@ -47,3 +82,430 @@ func (q *Queries) CreateTrip(ctx context.Context, pool *pgxpool.Pool, params spe
 
 	return tripID, nil
 }
+
+// CloneTripParams is the trip a clone inserts with its activities and links, their TripID set to the new trip.
+type CloneTripParams struct {
+	Trip       InsertTripParams
+	Activities []CreateActivityParams
+	Links      []CreateTripLinkParams
+}
+
+// CloneTrip inserts the trip along with its activities and links within a transaction, all of them created or
+// none.
+func (q *Queries) CloneTrip(ctx context.Context, pool *pgxpool.Pool, params CloneTripParams) (uuid.UUID, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to begin tx for CloneTrip: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+	tripID, err := qtx.InsertTrip(ctx, params.Trip)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert trip for CloneTrip: %w", err)
+	}
+
+	for i, activity := range params.Activities {
+		activity.TripID = tripID
+		if _, err := qtx.CreateActivity(ctx, activity); err != nil {
+			return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert activity %d for CloneTrip: %w", i, err)
+		}
+	}
+
+	for i, link := range params.Links {
+		link.TripID = tripID
+		if _, err := qtx.CreateTripLink(ctx, link); err != nil {
+			return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert link %d for CloneTrip: %w", i, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to commit tx for CloneTrip: %w", err)
+	}
+
+	return tripID, nil
+}
+
+// CreateActivities inserts the activities in a single batch within a transaction, all of them created or none.
+// The ids are answered in the order of the activities.
+func (q *Queries) CreateActivities(ctx context.Context, pool *pgxpool.Pool, params []CreateActivityParams) ([]uuid.UUID, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("pgstore: failed to begin tx for CreateActivities: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	batch := &pgx.Batch{}
+	for _, activity := range params {
+		batch.Queue(createActivity, activity.TripID, activity.Title, activity.OccursAt, activity.DurationMinutes, activity.Notes)
+	}
+
+	results := tx.SendBatch(ctx, batch)
+	ids := make([]uuid.UUID, len(params))
+	for i := range params {
+		if err := results.QueryRow().Scan(&ids[i]); err != nil {
+			_ = results.Close()
+			return nil, fmt.Errorf("pgstore: failed to insert activity %d for CreateActivities: %w", i, err)
+		}
+	}
+	if err := results.Close(); err != nil {
+		return nil, fmt.Errorf("pgstore: failed to close the batch for CreateActivities: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("pgstore: failed to commit tx for CreateActivities: %w", err)
+	}
+
+	return ids, nil
+}
+
+// ConfirmParticipantsBatch confirms the pending participants of the trip among the ids within a transaction, all
+// of them confirmed or none. The participants of the trip are answered as they were before, the ids of other
+// trips or of no participant are missing from them.
+func (q *Queries) ConfirmParticipantsBatch(ctx context.Context, pool *pgxpool.Pool, params ConfirmTripParticipantsParams) ([]Participant, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("pgstore: failed to begin tx for ConfirmParticipantsBatch: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+	participants, err := qtx.LockTripParticipants(ctx, LockTripParticipantsParams(params))
+	if err != nil {
+		return nil, fmt.Errorf("pgstore: failed to lock the participants for ConfirmParticipantsBatch: %w", err)
+	}
+
+	var pending []uuid.UUID
+	for _, participant := range participants {
+		if !participant.IsConfirmed {
+			pending = append(pending, participant.ID)
+		}
+	}
+
+	if len(pending) > 0 {
+		if err := qtx.ConfirmTripParticipants(ctx, ConfirmTripParticipantsParams{TripID: params.TripID, Ids: pending}); err != nil {
+			return nil, fmt.Errorf("pgstore: failed to confirm the participants for ConfirmParticipantsBatch: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("pgstore: failed to commit tx for ConfirmParticipantsBatch: %w", err)
+	}
+
+	return participants, nil
+}
+
+// ErrTripFull is PromoteWaitlistedParticipant or TransferTripOwner refusing to seat a participant of a trip with
+// no seat left.
+var ErrTripFull = errors.New("pgstore: the trip has no seat left")
+
+// tripSeatsLeft locks the trip row within the transaction, so the seats counted stay free until it ends, and tells
+// how many more participants the trip takes out of the waitlist and whether its capacity is limited at all.
+func (q *Queries) tripSeatsLeft(ctx context.Context, tripID uuid.UUID) (int, bool, error) {
+	maxParticipants, err := q.LockTripCapacity(ctx, tripID)
+	if err != nil {
+		return 0, false, err
+	}
+	if !maxParticipants.Valid {
+		return 0, false, nil
+	}
+
+	seated, err := q.CountSeatedParticipants(ctx, tripID)
+	if err != nil {
+		return 0, false, err
+	}
+	return max(int(maxParticipants.Int32)-int(seated), 0), true, nil
+}
+
+// InviteParticipantsWithinCapacity invites the participants of a trip within a transaction holding the trip row,
+// so a concurrent invite or promotion doesn't take the same seats. Past the trip capacity the invites are
+// waitlisted, in their order. pgx.ErrNoRows is answered when there is no such trip.
+func (q *Queries) InviteParticipantsWithinCapacity(ctx context.Context, pool *pgxpool.Pool, params []InviteParticipantsToTripParams) (int64, error) {
+	if len(params) == 0 {
+		return 0, nil
+	}
+
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("pgstore: failed to begin tx for InviteParticipantsWithinCapacity: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+	seats, limited, err := qtx.tripSeatsLeft(ctx, params[0].TripID)
+	if err != nil {
+		return 0, fmt.Errorf("pgstore: failed to count the seats for InviteParticipantsWithinCapacity: %w", err)
+	}
+
+	invites := make([]InviteParticipantsToTripParams, len(params))
+	for i, invite := range params {
+		invite.IsWaitlisted = limited && i >= seats
+		invites[i] = invite
+	}
+
+	invited, err := qtx.InviteParticipantsToTrip(ctx, invites)
+	if err != nil {
+		return 0, fmt.Errorf("pgstore: failed to insert participants for InviteParticipantsWithinCapacity: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("pgstore: failed to commit tx for InviteParticipantsWithinCapacity: %w", err)
+	}
+
+	return invited, nil
+}
+
+// PromoteWaitlistedParticipant takes the participant off the waitlist within a transaction holding the trip row,
+// so a concurrent invite or promotion doesn't take the same seat. ErrTripFull is answered when the trip has no
+// seat left, pgx.ErrNoRows when there is no such trip, and 0 rows when the participant isn't waitlisted anymore.
+func (q *Queries) PromoteWaitlistedParticipant(ctx context.Context, pool *pgxpool.Pool, params PromoteParticipantParams) (int64, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("pgstore: failed to begin tx for PromoteWaitlistedParticipant: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+	seats, limited, err := qtx.tripSeatsLeft(ctx, params.TripID)
+	if err != nil {
+		return 0, fmt.Errorf("pgstore: failed to count the seats for PromoteWaitlistedParticipant: %w", err)
+	}
+	if limited && seats == 0 {
+		return 0, ErrTripFull
+	}
+
+	promoted, err := qtx.PromoteParticipant(ctx, params)
+	if err != nil {
+		return 0, fmt.Errorf("pgstore: failed to promote the participant for PromoteWaitlistedParticipant: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("pgstore: failed to commit tx for PromoteWaitlistedParticipant: %w", err)
+	}
+
+	return promoted, nil
+}
+
+// DeleteTrip soft deletes the trip along with its activities and links within a transaction, all of them
+// stamped with the same deletion time so RestoreTrip brings them back together. The participants are kept
+// as they are, hidden along with their trip. pgx.ErrNoRows is answered when there is no such trip.
+func (q *Queries) DeleteTrip(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for DeleteTrip: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+	removed, err := qtx.RemoveTrip(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to remove the trip for DeleteTrip: %w", err)
+	}
+	if removed == 0 {
+		return pgx.ErrNoRows
+	}
+
+	if err := qtx.RemoveTripActivities(ctx, tripID); err != nil {
+		return fmt.Errorf("pgstore: failed to remove the activities for DeleteTrip: %w", err)
+	}
+	if err := qtx.RemoveTripLinks(ctx, tripID); err != nil {
+		return fmt.Errorf("pgstore: failed to remove the links for DeleteTrip: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit tx for DeleteTrip: %w", err)
+	}
+
+	return nil
+}
+
+// RestoreTrip undoes the DeleteTrip of the trip deleted at deletedAt within a transaction, bringing back the
+// activities and links deleted along with it. pgx.ErrNoRows is answered when the trip isn't deleted at
+// deletedAt anymore.
+func (q *Queries) RestoreTrip(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID, deletedAt pgtype.Timestamp) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for RestoreTrip: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+	restored, err := qtx.UndeleteTrip(ctx, UndeleteTripParams{ID: tripID, DeletedAt: deletedAt})
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to restore the trip for RestoreTrip: %w", err)
+	}
+	if restored == 0 {
+		return pgx.ErrNoRows
+	}
+
+	if err := qtx.UndeleteTripActivities(ctx, UndeleteTripActivitiesParams{TripID: tripID, DeletedAt: deletedAt}); err != nil {
+		return fmt.Errorf("pgstore: failed to restore the activities for RestoreTrip: %w", err)
+	}
+	if err := qtx.UndeleteTripLinks(ctx, UndeleteTripLinksParams{TripID: tripID, DeletedAt: deletedAt}); err != nil {
+		return fmt.Errorf("pgstore: failed to restore the links for RestoreTrip: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit tx for RestoreTrip: %w", err)
+	}
+
+	return nil
+}
+
+// RescheduleTripParams is the new period of a trip and the new start of each of its activities.
+type RescheduleTripParams struct {
+	Trip       UpdateTripPeriodParams
+	Activities []UpdateActivityOccursAtParams
+}
+
+// RescheduleTrip moves the trip period and its activities within a transaction, all of them moved or none.
+func (q *Queries) RescheduleTrip(ctx context.Context, pool *pgxpool.Pool, params RescheduleTripParams) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for RescheduleTrip: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+	if err := qtx.UpdateTripPeriod(ctx, params.Trip); err != nil {
+		return fmt.Errorf("pgstore: failed to update the trip period for RescheduleTrip: %w", err)
+	}
+
+	for i, activity := range params.Activities {
+		if err := qtx.UpdateActivityOccursAt(ctx, activity); err != nil {
+			return fmt.Errorf("pgstore: failed to move activity %d for RescheduleTrip: %w", i, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit tx for RescheduleTrip: %w", err)
+	}
+
+	return nil
+}
+
+// ErrActivitiesNotOfDay is ReorderActivities refusing ids that are not exactly the activities of the day.
+var ErrActivitiesNotOfDay = errors.New("pgstore: the ids are not the activities of the day")
+
+// ReorderActivitiesParams is the day of a trip, in its timezone, and the ids of all its activities in their new
+// order.
+type ReorderActivitiesParams struct {
+	TripID uuid.UUID
+	Day    pgtype.Date
+	Ids    []uuid.UUID
+}
+
+// ReorderActivities sorts the activities of the day as the ids within a transaction, the activities locked so a
+// concurrent one doesn't interleave. The ids must be all the activities of the day, each once, otherwise
+// ErrActivitiesNotOfDay is answered and none of them is moved.
+func (q *Queries) ReorderActivities(ctx context.Context, pool *pgxpool.Pool, params ReorderActivitiesParams) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for ReorderActivities: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+	activities, err := qtx.LockTripDayActivities(ctx, LockTripDayActivitiesParams{TripID: params.TripID, Day: params.Day})
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to lock the activities for ReorderActivities: %w", err)
+	}
+
+	ofDay := make(map[uuid.UUID]bool, len(activities))
+	for _, activity := range activities {
+		ofDay[activity.ID] = true
+	}
+	if len(params.Ids) != len(activities) {
+		return ErrActivitiesNotOfDay
+	}
+	for _, id := range params.Ids {
+		if !ofDay[id] {
+			return ErrActivitiesNotOfDay
+		}
+		// a repeated id leaves another activity of the day out.
+		delete(ofDay, id)
+	}
+
+	for i, id := range params.Ids {
+		if err := qtx.UpdateActivitySortOrder(ctx, UpdateActivitySortOrderParams{SortOrder: int32(i), ID: id, TripID: params.TripID}); err != nil {
+			return fmt.Errorf("pgstore: failed to sort activity %d for ReorderActivities: %w", i, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit tx for ReorderActivities: %w", err)
+	}
+
+	return nil
+}
+
+// TransferTripOwnerParams is the new owner of a trip and, when set, the email of the previous owner staying on the
+// trip as a participant. The emails are compared lowercased.
+type TransferTripOwnerParams struct {
+	Owner              UpdateTripOwnerParams
+	PreviousOwnerEmail string
+}
+
+// TransferTripOwner moves the trip to the new owner within a transaction, the new owner and the previous one, when
+// set, confirmed as participants of the trip: the ones already invited are confirmed, the waitlisted ones seated
+// and the others added confirmed. The trip row is held so the seats they take are counted as the other seating
+// paths do, ErrTripFull is answered and nothing is moved when the trip has no seat left for them.
+func (q *Queries) TransferTripOwner(ctx context.Context, pool *pgxpool.Pool, params TransferTripOwnerParams) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for TransferTripOwner: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+	seats, limited, err := qtx.tripSeatsLeft(ctx, params.Owner.ID)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to count the seats for TransferTripOwner: %w", err)
+	}
+
+	if err := qtx.UpdateTripOwner(ctx, params.Owner); err != nil {
+		return fmt.Errorf("pgstore: failed to update the trip owner for TransferTripOwner: %w", err)
+	}
+
+	emails := []string{params.Owner.OwnerEmail}
+	if params.PreviousOwnerEmail != "" {
+		emails = append(emails, params.PreviousOwnerEmail)
+	}
+	for _, email := range emails {
+		confirmed, err := qtx.ConfirmTripParticipantByEmail(ctx, ConfirmTripParticipantByEmailParams{
+			TripID: params.Owner.ID,
+			Email:  strings.ToLower(email),
+		})
+		if err != nil {
+			return fmt.Errorf("pgstore: failed to confirm the participant %s for TransferTripOwner: %w", email, err)
+		}
+		if confirmed > 0 {
+			continue
+		}
+
+		if limited && seats == 0 {
+			return ErrTripFull
+		}
+		seats--
+
+		seated, err := qtx.SeatTripParticipantByEmail(ctx, SeatTripParticipantByEmailParams{
+			TripID: params.Owner.ID,
+			Email:  strings.ToLower(email),
+		})
+		if err != nil {
+			return fmt.Errorf("pgstore: failed to seat the participant %s for TransferTripOwner: %w", email, err)
+		}
+		if seated > 0 {
+			continue
+		}
+		if err := qtx.InsertConfirmedParticipant(ctx, InsertConfirmedParticipantParams{TripID: params.Owner.ID, Email: email}); err != nil {
+			return fmt.Errorf("pgstore: failed to insert the participant %s for TransferTripOwner: %w", email, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit tx for TransferTripOwner: %w", err)
+	}
+
+	return nil
+}
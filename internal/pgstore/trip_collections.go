@@ -0,0 +1,228 @@
+package pgstore
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// The trip and one of its collections are read in a single round trip, joining the collection on the trip.
+// The trip comes on every row, a trip without the collection comes on a single row with the collection
+// columns NULL, and a missing trip on no row at all, answered as pgx.ErrNoRows as GetTrip does.
+
+// GetTripWithActivities reads the trip activities, only the ones of the days between FromDate and ToDate in
+// the trip timezone and with Title in their titles, when given.
+func (q *Queries) GetTripWithActivities(ctx context.Context, arg GetTripAndActivitiesParams) (Trip, []Activity, error) {
+	rows, err := q.GetTripAndActivities(ctx, arg)
+	if err != nil {
+		return Trip{}, nil, fmt.Errorf("pgstore: failed to get trip activities for GetTripWithActivities: %w", err)
+	}
+	if len(rows) == 0 {
+		return Trip{}, nil, pgx.ErrNoRows
+	}
+
+	activities := make([]Activity, 0, len(rows))
+	for _, row := range rows {
+		if !row.ActivityID.Valid {
+			continue
+		}
+		activities = append(activities, Activity{
+			ID:              row.ActivityID.Bytes,
+			TripID:          row.Trip.ID,
+			Title:           row.ActivityTitle.String,
+			OccursAt:        row.ActivityOccursAt,
+			DurationMinutes: row.ActivityDurationMinutes.Int32,
+			Notes:           row.ActivityNotes,
+			IsDone:          row.ActivityIsDone.Bool,
+			SortOrder:       row.ActivitySortOrder.Int32,
+		})
+	}
+	return rows[0].Trip, activities, nil
+}
+
+// ActivityDayCount is how many activities a trip has on a day of its timezone.
+type ActivityDayCount struct {
+	Day   time.Time
+	Count int64
+}
+
+// GetTripWithActivityCounts counts the trip activities by day in the trip timezone, the days without activities
+// missing from the counts.
+func (q *Queries) GetTripWithActivityCounts(ctx context.Context, id uuid.UUID) (Trip, []ActivityDayCount, error) {
+	rows, err := q.GetTripAndActivityCounts(ctx, id)
+	if err != nil {
+		return Trip{}, nil, fmt.Errorf("pgstore: failed to count trip activities for GetTripWithActivityCounts: %w", err)
+	}
+	if len(rows) == 0 {
+		return Trip{}, nil, pgx.ErrNoRows
+	}
+
+	counts := make([]ActivityDayCount, 0, len(rows))
+	for _, row := range rows {
+		if !row.ActivityDay.Valid {
+			continue
+		}
+		counts = append(counts, ActivityDayCount{Day: row.ActivityDay.Time, Count: row.ActivityCount.Int64})
+	}
+	return rows[0].Trip, counts, nil
+}
+
+func (q *Queries) GetTripWithLinks(ctx context.Context, id uuid.UUID) (Trip, []Link, error) {
+	rows, err := q.GetTripAndLinks(ctx, id)
+	if err != nil {
+		return Trip{}, nil, fmt.Errorf("pgstore: failed to get trip links for GetTripWithLinks: %w", err)
+	}
+	if len(rows) == 0 {
+		return Trip{}, nil, pgx.ErrNoRows
+	}
+
+	links := make([]Link, 0, len(rows))
+	for _, row := range rows {
+		if !row.LinkID.Valid {
+			continue
+		}
+		links = append(links, Link{
+			ID:     row.LinkID.Bytes,
+			TripID: row.Trip.ID,
+			Title:  row.LinkTitle.String,
+			Url:    row.LinkUrl.String,
+		})
+	}
+	return rows[0].Trip, links, nil
+}
+
+func (q *Queries) GetTripWithParticipants(ctx context.Context, id uuid.UUID) (Trip, []Participant, error) {
+	rows, err := q.GetTripAndParticipants(ctx, id)
+	if err != nil {
+		return Trip{}, nil, fmt.Errorf("pgstore: failed to get trip participants for GetTripWithParticipants: %w", err)
+	}
+	if len(rows) == 0 {
+		return Trip{}, nil, pgx.ErrNoRows
+	}
+
+	participants := make([]Participant, 0, len(rows))
+	for _, row := range rows {
+		if !row.ParticipantID.Valid {
+			continue
+		}
+		participants = append(participants, Participant{
+			ID:                  row.ParticipantID.Bytes,
+			TripID:              row.Trip.ID,
+			Email:               row.ParticipantEmail.String,
+			IsConfirmed:         row.ParticipantIsConfirmed.Bool,
+			InviteStatus:        row.ParticipantInviteStatus.String,
+			InviteLastAttemptAt: row.ParticipantInviteLastAttemptAt,
+			InvitedAt:           row.ParticipantInvitedAt,
+			IsWaitlisted:        row.ParticipantIsWaitlisted.Bool,
+			Name:                row.ParticipantName,
+			Phone:               row.ParticipantPhone,
+		})
+	}
+	return rows[0].Trip, participants, nil
+}
+
+// GetTripWithParticipantsPage reads a page of the trip participants, by email, along the total of them. A page
+// past the last one comes empty, as long as the trip exists.
+func (q *Queries) GetTripWithParticipantsPage(ctx context.Context, arg GetTripAndParticipantsPageParams) (Trip, []Participant, int64, error) {
+	rows, err := q.GetTripAndParticipantsPage(ctx, arg)
+	if err != nil {
+		return Trip{}, nil, 0, fmt.Errorf("pgstore: failed to get trip participants for GetTripWithParticipantsPage: %w", err)
+	}
+	if len(rows) == 0 {
+		return Trip{}, nil, 0, pgx.ErrNoRows
+	}
+
+	participants := make([]Participant, 0, len(rows))
+	for _, row := range rows {
+		if !row.ParticipantID.Valid {
+			continue
+		}
+		participants = append(participants, Participant{
+			ID:                  row.ParticipantID.Bytes,
+			TripID:              row.Trip.ID,
+			Email:               row.ParticipantEmail.String,
+			IsConfirmed:         row.ParticipantIsConfirmed.Bool,
+			InviteStatus:        row.ParticipantInviteStatus.String,
+			InviteLastAttemptAt: row.ParticipantInviteLastAttemptAt,
+			InvitedAt:           row.ParticipantInvitedAt,
+			IsWaitlisted:        row.ParticipantIsWaitlisted.Bool,
+			Name:                row.ParticipantName,
+			Phone:               row.ParticipantPhone,
+		})
+	}
+	return rows[0].Trip, participants, rows[0].Total, nil
+}
+
+// GetTripWithActivitiesPage reads a page of the trip activities, by their start, along the total of them. A
+// page past the last one comes empty, as long as the trip exists.
+func (q *Queries) GetTripWithActivitiesPage(ctx context.Context, arg GetTripAndActivitiesPageParams) (Trip, []Activity, int64, error) {
+	rows, err := q.GetTripAndActivitiesPage(ctx, arg)
+	if err != nil {
+		return Trip{}, nil, 0, fmt.Errorf("pgstore: failed to get trip activities for GetTripWithActivitiesPage: %w", err)
+	}
+	if len(rows) == 0 {
+		return Trip{}, nil, 0, pgx.ErrNoRows
+	}
+
+	activities := make([]Activity, 0, len(rows))
+	for _, row := range rows {
+		if !row.ActivityID.Valid {
+			continue
+		}
+		activities = append(activities, Activity{
+			ID:              row.ActivityID.Bytes,
+			TripID:          row.Trip.ID,
+			Title:           row.ActivityTitle.String,
+			OccursAt:        row.ActivityOccursAt,
+			DurationMinutes: row.ActivityDurationMinutes.Int32,
+			Notes:           row.ActivityNotes,
+			IsDone:          row.ActivityIsDone.Bool,
+			SortOrder:       row.ActivitySortOrder.Int32,
+		})
+	}
+	return rows[0].Trip, activities, rows[0].Total, nil
+}
+
+// SearchActivitiesLimit is the most activities SearchActivities answers.
+const SearchActivitiesLimit = 50
+
+// likeEscaper escapes the LIKE wildcards, so the searched text matches as is.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// SearchActivities reads the trip activities with text in their titles, ignoring the case, by their start and at
+// most SearchActivitiesLimit of them. A missing trip answers pgx.ErrNoRows, a trip without matches none.
+func (q *Queries) SearchActivities(ctx context.Context, tripID uuid.UUID, text string) ([]Activity, error) {
+	rows, err := q.SearchTripActivities(ctx, SearchTripActivitiesParams{
+		Pattern:     "%" + likeEscaper.Replace(text) + "%",
+		ResultLimit: SearchActivitiesLimit,
+		ID:          tripID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("pgstore: failed to search trip activities for SearchActivities: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, pgx.ErrNoRows
+	}
+
+	activities := make([]Activity, 0, len(rows))
+	for _, row := range rows {
+		if !row.ActivityID.Valid {
+			continue
+		}
+		activities = append(activities, Activity{
+			ID:              row.ActivityID.Bytes,
+			TripID:          row.TripID,
+			Title:           row.ActivityTitle.String,
+			OccursAt:        row.ActivityOccursAt,
+			DurationMinutes: row.ActivityDurationMinutes.Int32,
+			Notes:           row.ActivityNotes,
+			IsDone:          row.ActivityIsDone.Bool,
+			SortOrder:       row.ActivitySortOrder.Int32,
+		})
+	}
+	return activities, nil
+}
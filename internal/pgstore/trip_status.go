@@ -0,0 +1,10 @@
+package pgstore
+
+// The status of a trip, planning until it is confirmed, as the column default. is_confirmed follows it, true
+// on the confirmed and completed trips.
+const (
+	TripStatusPlanning  = "planning"
+	TripStatusConfirmed = "confirmed"
+	TripStatusCancelled = "cancelled"
+	TripStatusCompleted = "completed"
+)
@@ -0,0 +1,34 @@
+package pgstore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// UpcomingTrip is a confirmed trip along with how many of its participants confirmed, as the reminders and the
+// listings of the trips to come need them.
+type UpcomingTrip struct {
+	Trip                  Trip
+	ConfirmedParticipants int64
+}
+
+// GetTripsStartingBetween reads the confirmed trips starting from from, included, until to, excluded, by their
+// start. The window is half-open so that consecutive windows never read a trip twice.
+func (q *Queries) GetTripsStartingBetween(ctx context.Context, from, to time.Time) ([]UpcomingTrip, error) {
+	rows, err := q.GetConfirmedTripsStartingBetween(ctx, GetConfirmedTripsStartingBetweenParams{
+		StartsFrom:   pgtype.Timestamp{Valid: true, Time: from.UTC()},
+		StartsBefore: pgtype.Timestamp{Valid: true, Time: to.UTC()},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("pgstore: failed to get trips for GetTripsStartingBetween: %w", err)
+	}
+
+	trips := make([]UpcomingTrip, 0, len(rows))
+	for _, row := range rows {
+		trips = append(trips, UpcomingTrip{Trip: row.Trip, ConfirmedParticipants: row.ConfirmedParticipants})
+	}
+	return trips, nil
+}
@@ -0,0 +1,147 @@
+package pgstore
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// newTestQueries connects to JOURNEY_TEST_DATABASE_URL, skipping the test without it, and migrates a schema of
+// its own, dropped once the test is done.
+func newTestQueries(t *testing.T) *Queries {
+	t.Helper()
+
+	url := os.Getenv("JOURNEY_TEST_DATABASE_URL")
+	if url == "" {
+		t.Skip("JOURNEY_TEST_DATABASE_URL is not set")
+	}
+
+	ctx := context.Background()
+	config, err := pgx.ParseConfig(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	schema := fmt.Sprintf("test_%d", time.Now().UnixNano())
+	config.RuntimeParams["search_path"] = schema
+
+	conn, err := pgx.ConnectConfig(ctx, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_, _ = conn.Exec(context.Background(), "DROP SCHEMA "+schema+" CASCADE")
+		_ = conn.Close(context.Background())
+	})
+
+	if _, err := conn.Exec(ctx, "CREATE SCHEMA "+schema); err != nil {
+		t.Fatal(err)
+	}
+	migrations, err := filepath.Glob(filepath.Join("migrations", "*.sql"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(migrations)
+	for _, migration := range migrations {
+		sql, err := os.ReadFile(migration)
+		if err != nil {
+			t.Fatal(err)
+		}
+		create, _, _ := strings.Cut(string(sql), "---- create above / drop below ----")
+		if _, err := conn.Exec(ctx, create); err != nil {
+			t.Fatalf("failed to apply %s: %v", migration, err)
+		}
+	}
+
+	return New(conn)
+}
+
+func TestGetTripsStartingBetween(t *testing.T) {
+	q := newTestQueries(t)
+	ctx := context.Background()
+	from := time.Date(2030, time.March, 10, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+
+	insert := func(t *testing.T, startsAt time.Time, confirmed bool, invited ...bool) uuid.UUID {
+		t.Helper()
+		id, err := q.InsertTrip(ctx, InsertTripParams{
+			Destination: "Florianópolis",
+			OwnerEmail:  "owner@example.com",
+			OwnerName:   "Owner",
+			StartsAt:    pgtype.Timestamp{Valid: true, Time: startsAt},
+			EndsAt:      pgtype.Timestamp{Valid: true, Time: startsAt.AddDate(0, 0, 2)},
+			Timezone:    "UTC",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := q.UpdateTripConfirm(ctx, UpdateTripConfirmParams{IsConfirmed: confirmed, ID: id}); err != nil {
+			t.Fatal(err)
+		}
+		for index, isConfirmed := range invited {
+			email := fmt.Sprintf("guest%d@example.com", index)
+			if _, err := q.InviteParticipantsToTrip(ctx, []InviteParticipantsToTripParams{{TripID: id, Email: email}}); err != nil {
+				t.Fatal(err)
+			}
+			if !isConfirmed {
+				continue
+			}
+			participants, err := q.GetParticipants(ctx, id)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, participant := range participants {
+				if participant.Email == email {
+					if err := q.ConfirmParticipant(ctx, ConfirmParticipantParams{IsConfirmed: true, ID: participant.ID}); err != nil {
+						t.Fatal(err)
+					}
+				}
+			}
+		}
+		return id
+	}
+
+	atStart := insert(t, from, true, true, false, true)
+	inside := insert(t, from.AddDate(0, 0, 3), true)
+	insert(t, from.AddDate(0, 0, 4), false, true)
+	insert(t, from.Add(-time.Second), true)
+	insert(t, to, true)
+	beforeEnd := insert(t, to.Add(-time.Second), true, true)
+
+	trips, err := q.GetTripsStartingBetween(ctx, from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []UpcomingTrip{
+		{Trip: Trip{ID: atStart}, ConfirmedParticipants: 2},
+		{Trip: Trip{ID: inside}, ConfirmedParticipants: 0},
+		{Trip: Trip{ID: beforeEnd}, ConfirmedParticipants: 1},
+	}
+	if len(trips) != len(expected) {
+		t.Fatalf("expected %d trips, got %+v", len(expected), trips)
+	}
+	for index, trip := range trips {
+		if trip.Trip.ID != expected[index].Trip.ID || trip.ConfirmedParticipants != expected[index].ConfirmedParticipants {
+			t.Errorf("expected trip %d to be %s with %d confirmed, got %s with %d", index, expected[index].Trip.ID, expected[index].ConfirmedParticipants, trip.Trip.ID, trip.ConfirmedParticipants)
+		}
+	}
+
+	t.Run("empty window", func(t *testing.T) {
+		trips, err := q.GetTripsStartingBetween(ctx, from, from)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(trips) != 0 {
+			t.Fatalf("expected no trips, got %+v", trips)
+		}
+	})
+}
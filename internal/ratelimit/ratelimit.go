@@ -0,0 +1,240 @@
+package ratelimit
+
+import (
+	"context"
+	"journey/cmd/journey/config"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const DEFAULT_RATE_LIMIT_PER_MINUTE = 10
+const DEFAULT_RATE_LIMIT_BURST = 5
+const DEFAULT_RATE_LIMIT_ROUTES = "POST /trips,POST /trips/{tripId}/invites"
+const DEFAULT_CLEANUP_INTERVAL = time.Minute
+
+// Clock tells the now the buckets are refilled at.
+type Clock interface {
+	Now() time.Time
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+// Route is a method and a chi-like pattern, a {param} segment matching any non-empty segment.
+type Route struct {
+	Method  string
+	Pattern string
+}
+
+// ParseRoutes splits a comma-separated list of "METHOD /pattern", as JOURNEY_RATE_LIMIT_ROUTES, dropping
+// the malformed entries.
+func ParseRoutes(value string) []Route {
+	var routes []Route
+	for _, entry := range strings.Split(value, ",") {
+		method, pattern, found := strings.Cut(strings.TrimSpace(entry), " ")
+		pattern = strings.TrimSpace(pattern)
+		if !found || method == "" || !strings.HasPrefix(pattern, "/") {
+			continue
+		}
+		routes = append(routes, Route{Method: strings.ToUpper(method), Pattern: pattern})
+	}
+	return routes
+}
+
+func (rt Route) matches(r *http.Request) bool {
+	if r.Method != rt.Method {
+		return false
+	}
+
+	patternSegments := strings.Split(rt.Pattern, "/")
+	pathSegments := strings.Split(r.URL.Path, "/")
+	if len(patternSegments) != len(pathSegments) {
+		return false
+	}
+
+	for i, segment := range patternSegments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			if pathSegments[i] == "" {
+				return false
+			}
+			continue
+		}
+		if segment != pathSegments[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// bucket holds the tokens left to a client on a route, as of updatedAt.
+type bucket struct {
+	tokens    float64
+	updatedAt time.Time
+}
+
+// Limiter is an in-memory token bucket per client IP and route: each bucket holds up to burst tokens,
+// refilled at perMinute tokens a minute, a request spending one.
+type Limiter struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	rate    float64
+	burst   float64
+	routes  []Route
+	clock   Clock
+}
+
+type Option func(*Limiter)
+
+// WithClock overrides the clock the buckets are refilled at.
+func WithClock(clock Clock) Option {
+	return func(l *Limiter) {
+		l.clock = clock
+	}
+}
+
+// New builds a limiter allowing, on each of the routes, a burst of requests per client then perMinute a
+// minute. The other routes are never limited.
+func New(perMinute, burst int, routes []Route, opts ...Option) *Limiter {
+	l := &Limiter{
+		buckets: make(map[string]*bucket),
+		rate:    float64(max(perMinute, 1)) / time.Minute.Seconds(),
+		burst:   float64(max(burst, 1)),
+		routes:  routes,
+		clock:   realClock{},
+	}
+
+	for _, opt := range opts {
+		opt(l)
+	}
+
+	return l
+}
+
+// NewFromEnvironment builds a limiter from JOURNEY_RATE_LIMIT_PER_MINUTE, JOURNEY_RATE_LIMIT_BURST and
+// JOURNEY_RATE_LIMIT_ROUTES, falling back to the defaults when missing or invalid.
+func NewFromEnvironment(opts ...Option) *Limiter {
+	perMinute := getPositiveInt("JOURNEY_RATE_LIMIT_PER_MINUTE", DEFAULT_RATE_LIMIT_PER_MINUTE)
+	burst := getPositiveInt("JOURNEY_RATE_LIMIT_BURST", DEFAULT_RATE_LIMIT_BURST)
+
+	routes := ParseRoutes(DEFAULT_RATE_LIMIT_ROUTES)
+	if value, err := config.GetSpecificEnvironmentVariable("JOURNEY_RATE_LIMIT_ROUTES"); err == nil && value != "" {
+		routes = ParseRoutes(value)
+	}
+
+	return New(perMinute, burst, routes, opts...)
+}
+
+func getPositiveInt(key string, fallback int) int {
+	if value, err := config.GetSpecificEnvironmentVariable(key); err == nil {
+		if parsed, err := strconv.Atoi(value); err == nil && parsed > 0 {
+			return parsed
+		}
+	}
+	return fallback
+}
+
+// Middleware answers 429 with a Retry-After, in seconds, to the clients out of tokens on a limited route.
+// The client is the IP of the connection: X-Forwarded-For isn't trusted, any client could forge it.
+func (l *Limiter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route, limited := l.routeOf(r)
+		if !limited {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		allowed, retryAfter := l.allow(clientIP(r) + " " + route.Method + " " + route.Pattern)
+		if !allowed {
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+			http.Error(w, http.StatusText(http.StatusTooManyRequests), http.StatusTooManyRequests)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (l *Limiter) routeOf(r *http.Request) (Route, bool) {
+	for _, route := range l.routes {
+		if route.matches(r) {
+			return route, true
+		}
+	}
+	return Route{}, false
+}
+
+// allow spends a token of the bucket, telling otherwise how long until one is refilled.
+func (l *Limiter) allow(key string) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.clock.Now()
+	b, found := l.buckets[key]
+	if !found {
+		b = &bucket{tokens: l.burst, updatedAt: now}
+		l.buckets[key] = b
+	}
+	l.refill(b, now)
+
+	if b.tokens < 1 {
+		missing := (1 - b.tokens) / l.rate
+		return false, time.Duration(missing * float64(time.Second))
+	}
+
+	b.tokens--
+	return true, 0
+}
+
+func (l *Limiter) refill(b *bucket, now time.Time) {
+	if elapsed := now.Sub(b.updatedAt).Seconds(); elapsed > 0 {
+		b.tokens = min(l.burst, b.tokens+elapsed*l.rate)
+	}
+	b.updatedAt = now
+}
+
+// Cleanup drops the buckets refilled to the full burst since: a new bucket starts as full, so forgetting
+// them loses nothing.
+func (l *Limiter) Cleanup() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.clock.Now()
+	for key, b := range l.buckets {
+		l.refill(b, now)
+		if b.tokens >= l.burst {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// Run cleans up the idle buckets at each interval, until ctx is done.
+func (l *Limiter) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			l.Cleanup()
+		}
+	}
+}
+
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
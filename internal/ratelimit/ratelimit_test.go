@@ -0,0 +1,111 @@
+package ratelimit
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func newTestLimiter(clock *fakeClock) http.Handler {
+	limiter := New(6, 2, ParseRoutes(DEFAULT_RATE_LIMIT_ROUTES), WithClock(clock))
+	return limiter.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	}))
+}
+
+func send(handler http.Handler, method, path, remoteAddr string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, path, nil)
+	r.RemoteAddr = remoteAddr
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	return w
+}
+
+func TestMiddlewareLimitsPastTheBurstThenRecovers(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2030, time.March, 10, 12, 0, 0, 0, time.UTC)}
+	handler := newTestLimiter(clock)
+
+	for i := 0; i < 2; i++ {
+		if w := send(handler, http.MethodPost, "/trips", "10.0.0.1:1234"); w.Code != http.StatusCreated {
+			t.Fatalf("expected request %d within the burst allowed, got %d", i+1, w.Code)
+		}
+	}
+
+	w := send(handler, http.MethodPost, "/trips", "10.0.0.1:5678")
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected 429 past the burst, got %d", w.Code)
+	}
+	if retryAfter := w.Header().Get("Retry-After"); retryAfter != "10" {
+		t.Fatalf("expected to retry after 10 seconds, got %q", retryAfter)
+	}
+
+	if w := send(handler, http.MethodPost, "/trips", "10.0.0.2:1234"); w.Code != http.StatusCreated {
+		t.Fatalf("expected another client not limited, got %d", w.Code)
+	}
+
+	clock.Advance(10 * time.Second)
+	if w := send(handler, http.MethodPost, "/trips", "10.0.0.1:1234"); w.Code != http.StatusCreated {
+		t.Fatalf("expected a token refilled after the window, got %d", w.Code)
+	}
+	if w := send(handler, http.MethodPost, "/trips", "10.0.0.1:1234"); w.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected only one token refilled, got %d", w.Code)
+	}
+}
+
+func TestMiddlewareOnlyLimitsTheConfiguredRoutes(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2030, time.March, 10, 12, 0, 0, 0, time.UTC)}
+	handler := newTestLimiter(clock)
+
+	for i := 0; i < 5; i++ {
+		if w := send(handler, http.MethodGet, "/trips/5b1d0a4e-4d5c-4b8e-9d1a-0c6f4f7e2a11", "10.0.0.1:1234"); w.Code != http.StatusCreated {
+			t.Fatalf("expected the reads not limited, got %d", w.Code)
+		}
+	}
+
+	invites := "/trips/5b1d0a4e-4d5c-4b8e-9d1a-0c6f4f7e2a11/invites"
+	for i := 0; i < 2; i++ {
+		send(handler, http.MethodPost, invites, "10.0.0.1:1234")
+	}
+	if w := send(handler, http.MethodPost, invites, "10.0.0.1:1234"); w.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected the invites limited past the burst, got %d", w.Code)
+	}
+}
+
+func TestCleanupDropsTheIdleBuckets(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2030, time.March, 10, 12, 0, 0, 0, time.UTC)}
+	limiter := New(6, 2, ParseRoutes(DEFAULT_RATE_LIMIT_ROUTES), WithClock(clock))
+	limiter.allow("10.0.0.1 POST /trips")
+	limiter.allow("10.0.0.2 POST /trips")
+	limiter.allow("10.0.0.2 POST /trips")
+
+	clock.Advance(10 * time.Second)
+	limiter.Cleanup()
+
+	if _, found := limiter.buckets["10.0.0.1 POST /trips"]; found {
+		t.Fatal("expected the refilled bucket dropped")
+	}
+	if _, found := limiter.buckets["10.0.0.2 POST /trips"]; !found {
+		t.Fatal("expected the bucket still spent kept")
+	}
+}
+
+func TestParseRoutes(t *testing.T) {
+	routes := ParseRoutes(" post /trips , GET, /nomethod,PUT /trips/{tripId}")
+
+	if len(routes) != 2 || routes[0] != (Route{Method: "POST", Pattern: "/trips"}) || routes[1] != (Route{Method: "PUT", Pattern: "/trips/{tripId}"}) {
+		t.Fatalf("expected the malformed entries dropped, got %v", routes)
+	}
+}
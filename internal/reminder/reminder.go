@@ -0,0 +1,175 @@
+package reminder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"journey/cmd/journey/config"
+	"journey/internal/mailer/mailpit"
+	"journey/internal/pgstore"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+const DEFAULT_REMINDER_LEAD_HOURS = 24
+const DEFAULT_REMINDER_INTERVAL = 5 * time.Minute
+
+type store interface {
+	GetTripsDueForReminder(context.Context, pgstore.GetTripsDueForReminderParams) ([]pgstore.Trip, error)
+	GetParticipants(context.Context, uuid.UUID) ([]pgstore.Participant, error)
+	MarkTripReminderSent(context.Context, pgstore.MarkTripReminderSentParams) error
+}
+
+type mailer interface {
+	SendTripReminderToParticipants(mailpit.SendTripReminder) error
+}
+
+// Clock tells the now the due trips are searched from.
+type Clock interface {
+	Now() time.Time
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+// Scheduler reminds the confirmed participants of the confirmed trips starting within the lead. Each trip
+// is reminded once, its reminder_sent_at set after the emails are attempted.
+type Scheduler struct {
+	store    store
+	mailer   mailer
+	logger   *zap.Logger
+	clock    Clock
+	lead     time.Duration
+	interval time.Duration
+}
+
+type Option func(*Scheduler)
+
+// WithClock overrides the clock the due trips are searched from.
+func WithClock(clock Clock) Option {
+	return func(s *Scheduler) {
+		s.clock = clock
+	}
+}
+
+// WithInterval overrides how often the due trips are searched.
+func WithInterval(interval time.Duration) Option {
+	return func(s *Scheduler) {
+		s.interval = interval
+	}
+}
+
+// WithLead overrides how long before the trip start the reminders are sent.
+func WithLead(lead time.Duration) Option {
+	return func(s *Scheduler) {
+		s.lead = lead
+	}
+}
+
+// New builds a scheduler reminding JOURNEY_REMINDER_LEAD_HOURS before the trips start and searching them
+// every DEFAULT_REMINDER_INTERVAL.
+func New(store store, mailer mailer, logger *zap.Logger, opts ...Option) *Scheduler {
+	s := &Scheduler{
+		store:    store,
+		mailer:   mailer,
+		logger:   logger.Named("reminder"),
+		clock:    realClock{},
+		lead:     time.Duration(GetLeadHours()) * time.Hour,
+		interval: DEFAULT_REMINDER_INTERVAL,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// GetLeadHours reads JOURNEY_REMINDER_LEAD_HOURS, falling back to the default when missing or invalid.
+func GetLeadHours() int {
+	if value, err := config.GetSpecificEnvironmentVariable("JOURNEY_REMINDER_LEAD_HOURS"); err == nil {
+		if hours, err := strconv.Atoi(value); err == nil && hours > 0 {
+			return hours
+		}
+	}
+	return DEFAULT_REMINDER_LEAD_HOURS
+}
+
+// Run reminds the due trips at each interval, until ctx is done.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.Tick(ctx); err != nil {
+			s.logger.Error("failed to remind the due trips", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Tick reminds the trips due now. A trip failing is logged and retried on the next tick, the others
+// still reminded.
+func (s *Scheduler) Tick(ctx context.Context) error {
+	now := s.clock.Now().UTC()
+	trips, err := s.store.GetTripsDueForReminder(ctx, pgstore.GetTripsDueForReminderParams{
+		Now:      pgtype.Timestamp{Valid: true, Time: now},
+		DueUntil: pgtype.Timestamp{Valid: true, Time: now.Add(s.lead)},
+	})
+	if err != nil {
+		return fmt.Errorf("reminder: failed to get the due trips: %w", err)
+	}
+
+	for _, trip := range trips {
+		if err := s.remind(ctx, trip, now); err != nil {
+			s.logger.Error("failed to remind a trip", zap.Error(err), zap.String("trip_id", trip.ID.String()))
+		}
+	}
+
+	return nil
+}
+
+func (s *Scheduler) remind(ctx context.Context, trip pgstore.Trip, now time.Time) error {
+	participants, err := s.store.GetParticipants(ctx, trip.ID)
+	if err != nil {
+		return fmt.Errorf("reminder: failed to get the participants: %w", err)
+	}
+
+	var confirmed []mailpit.Participant
+	for _, participant := range participants {
+		if participant.IsConfirmed {
+			confirmed = append(confirmed, mailpit.Participant{Email: participant.Email, ParticipantId: participant.ID})
+		}
+	}
+
+	// Marked once attempted, even when some failed: the next tick would remind again the participants reached.
+	var sendErr error
+	if len(confirmed) > 0 {
+		reminder := mailpit.SendTripReminder{Trip: trip, Participants: confirmed}
+		if err := s.mailer.SendTripReminderToParticipants(reminder); err != nil {
+			sendErr = fmt.Errorf("reminder: failed to send some of the reminders: %w", err)
+		}
+	}
+
+	err = s.store.MarkTripReminderSent(ctx, pgstore.MarkTripReminderSentParams{
+		ReminderSentAt: pgtype.Timestamp{Valid: true, Time: now},
+		ID:             trip.ID,
+	})
+	if err != nil {
+		return errors.Join(sendErr, fmt.Errorf("reminder: failed to mark the reminder sent: %w", err))
+	}
+
+	return sendErr
+}
@@ -0,0 +1,193 @@
+package reminder
+
+import (
+	"context"
+	"fmt"
+	"journey/internal/mailer/mailpit"
+	"journey/internal/pgstore"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+var testNow = time.Date(2030, time.March, 10, 12, 0, 0, 0, time.UTC)
+
+type fixedClock struct {
+	now time.Time
+}
+
+func (c fixedClock) Now() time.Time {
+	return c.now
+}
+
+// fakeStore mirrors the queries on the trips and participants kept in memory.
+type fakeStore struct {
+	mu           sync.Mutex
+	trips        map[uuid.UUID]pgstore.Trip
+	participants []pgstore.Participant
+}
+
+func (s *fakeStore) GetTripsDueForReminder(_ context.Context, arg pgstore.GetTripsDueForReminderParams) ([]pgstore.Trip, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var due []pgstore.Trip
+	for _, trip := range s.trips {
+		startsAt := trip.StartsAt.Time
+		if trip.IsConfirmed && !trip.ReminderSentAt.Valid && startsAt.After(arg.Now.Time) && !startsAt.After(arg.DueUntil.Time) {
+			due = append(due, trip)
+		}
+	}
+	return due, nil
+}
+
+func (s *fakeStore) GetParticipants(_ context.Context, tripID uuid.UUID) ([]pgstore.Participant, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var participants []pgstore.Participant
+	for _, participant := range s.participants {
+		if participant.TripID == tripID {
+			participants = append(participants, participant)
+		}
+	}
+	return participants, nil
+}
+
+func (s *fakeStore) MarkTripReminderSent(_ context.Context, arg pgstore.MarkTripReminderSentParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	trip := s.trips[arg.ID]
+	trip.ReminderSentAt = arg.ReminderSentAt
+	s.trips[arg.ID] = trip
+	return nil
+}
+
+type fakeMailer struct {
+	mu        sync.Mutex
+	reminders []mailpit.SendTripReminder
+	// failing is the email failing to be reminded, the others sent all the same.
+	failing string
+}
+
+func (m *fakeMailer) SendTripReminderToParticipants(reminder mailpit.SendTripReminder) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.reminders = append(m.reminders, reminder)
+	for _, participant := range reminder.Participants {
+		if participant.Email == m.failing {
+			return fmt.Errorf("mailbox of %s unavailable", participant.Email)
+		}
+	}
+	return nil
+}
+
+func newTrip(startsAt time.Time) pgstore.Trip {
+	return pgstore.Trip{
+		ID:          uuid.New(),
+		Destination: "Florianópolis",
+		IsConfirmed: true,
+		StartsAt:    pgtype.Timestamp{Valid: true, Time: startsAt},
+		EndsAt:      pgtype.Timestamp{Valid: true, Time: startsAt.AddDate(0, 0, 3)},
+	}
+}
+
+func TestTickRemindsOnlyTheTripsWithinTheLead(t *testing.T) {
+	soon := newTrip(testNow.Add(20 * time.Hour))
+	later := newTrip(testNow.Add(30 * time.Hour))
+	unconfirmed := newTrip(testNow.Add(10 * time.Hour))
+	unconfirmed.IsConfirmed = false
+
+	store := &fakeStore{
+		trips: map[uuid.UUID]pgstore.Trip{soon.ID: soon, later.ID: later, unconfirmed.ID: unconfirmed},
+		participants: []pgstore.Participant{
+			{ID: uuid.New(), TripID: soon.ID, Email: "confirmed@example.com", IsConfirmed: true},
+			{ID: uuid.New(), TripID: soon.ID, Email: "pending@example.com"},
+			{ID: uuid.New(), TripID: later.ID, Email: "later@example.com", IsConfirmed: true},
+			{ID: uuid.New(), TripID: unconfirmed.ID, Email: "unconfirmed@example.com", IsConfirmed: true},
+		},
+	}
+	mailer := &fakeMailer{}
+	scheduler := New(store, mailer, zap.NewNop(), WithClock(fixedClock{testNow}), WithLead(24*time.Hour))
+
+	// a second tick, as after a restart, finds the reminder already sent.
+	for range 2 {
+		if err := scheduler.Tick(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if len(mailer.reminders) != 1 {
+		t.Fatalf("expected exactly one reminder, got %d", len(mailer.reminders))
+	}
+	reminder := mailer.reminders[0]
+	if reminder.Trip.ID != soon.ID {
+		t.Fatalf("expected the trip within the lead reminded, got %s", reminder.Trip.ID)
+	}
+	if len(reminder.Participants) != 1 || reminder.Participants[0].Email != "confirmed@example.com" {
+		t.Fatalf("expected only the confirmed participant reminded, got %+v", reminder.Participants)
+	}
+
+	if !store.trips[soon.ID].ReminderSentAt.Valid {
+		t.Fatal("expected the reminded trip to be marked")
+	}
+	if store.trips[later.ID].ReminderSentAt.Valid || store.trips[unconfirmed.ID].ReminderSentAt.Valid {
+		t.Fatal("expected the trips not reminded to stay unmarked")
+	}
+}
+
+func TestTickRemindsOnceTheLeadIsReached(t *testing.T) {
+	trip := newTrip(testNow.Add(30 * time.Hour))
+	store := &fakeStore{
+		trips:        map[uuid.UUID]pgstore.Trip{trip.ID: trip},
+		participants: []pgstore.Participant{{ID: uuid.New(), TripID: trip.ID, Email: "guest@example.com", IsConfirmed: true}},
+	}
+	mailer := &fakeMailer{}
+
+	early := New(store, mailer, zap.NewNop(), WithClock(fixedClock{testNow}), WithLead(24*time.Hour))
+	if err := early.Tick(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(mailer.reminders) != 0 {
+		t.Fatalf("expected no reminder outside the lead, got %d", len(mailer.reminders))
+	}
+
+	onTime := New(store, mailer, zap.NewNop(), WithClock(fixedClock{testNow.Add(7 * time.Hour)}), WithLead(24*time.Hour))
+	if err := onTime.Tick(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(mailer.reminders) != 1 {
+		t.Fatalf("expected one reminder within the lead, got %d", len(mailer.reminders))
+	}
+}
+
+func TestTickMarksTheTripRemindedWhenARecipientFails(t *testing.T) {
+	trip := newTrip(testNow.Add(20 * time.Hour))
+	store := &fakeStore{
+		trips: map[uuid.UUID]pgstore.Trip{trip.ID: trip},
+		participants: []pgstore.Participant{
+			{ID: uuid.New(), TripID: trip.ID, Email: "bounced@example.com", IsConfirmed: true},
+			{ID: uuid.New(), TripID: trip.ID, Email: "guest@example.com", IsConfirmed: true},
+		},
+	}
+	mailer := &fakeMailer{failing: "bounced@example.com"}
+	scheduler := New(store, mailer, zap.NewNop(), WithClock(fixedClock{testNow}), WithLead(24*time.Hour))
+
+	for range 2 {
+		if err := scheduler.Tick(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if !store.trips[trip.ID].ReminderSentAt.Valid {
+		t.Fatal("expected the trip marked reminded once the reminders were attempted")
+	}
+	if len(mailer.reminders) != 1 {
+		t.Fatalf("expected the participants reminded once, got %d", len(mailer.reminders))
+	}
+}
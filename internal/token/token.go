@@ -0,0 +1,116 @@
+package token
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"journey/cmd/journey/config"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DEFAULT_TTL is how long a confirmation token is accepted after it is signed.
+const DEFAULT_TTL = 7 * 24 * time.Hour
+
+// Purpose is what a token confirms, a token signed for a purpose is refused for another one.
+type Purpose string
+
+const (
+	PurposeTrip        Purpose = "trip"
+	PurposeParticipant Purpose = "participant"
+)
+
+var (
+	// ErrMissing is returned when verifying an empty token.
+	ErrMissing = errors.New("token: missing")
+	// ErrInvalid is returned when the token is malformed or not signed for the purpose and the id.
+	ErrInvalid = errors.New("token: invalid")
+	// ErrExpired is returned when the token was signed for the purpose and the id, but is past its expiry.
+	ErrExpired = errors.New("token: expired")
+)
+
+// Signer signs the confirmation links sent by email, so a trip or a participant is only confirmed by who
+// received its link, rather than by anyone who knows or guesses its id. A Signer without secret is disabled:
+// it signs nothing and accepts every token, the links left as bare as before.
+//
+// A token is "<expiry unix seconds>.<signature>", the signature being the base64url HMAC-SHA256 of the
+// purpose, the id and the expiry keyed by the secret.
+type Signer struct {
+	secret []byte
+	ttl    time.Duration
+}
+
+// New signs with secret tokens lasting ttl, DEFAULT_TTL when not positive.
+func New(secret string, ttl time.Duration) Signer {
+	if ttl <= 0 {
+		ttl = DEFAULT_TTL
+	}
+	return Signer{secret: []byte(secret), ttl: ttl}
+}
+
+// NewFromEnvironment reads JOURNEY_CONFIRM_TOKEN_SECRET and JOURNEY_CONFIRM_TOKEN_TTL (a duration as "72h"),
+// the signer being disabled when the secret is missing and the ttl falling back to the default when missing
+// or invalid.
+func NewFromEnvironment() Signer {
+	secret, _ := config.GetSpecificEnvironmentVariable("JOURNEY_CONFIRM_TOKEN_SECRET")
+	var ttl time.Duration
+	if value, err := config.GetSpecificEnvironmentVariable("JOURNEY_CONFIRM_TOKEN_TTL"); err == nil {
+		ttl, _ = time.ParseDuration(value)
+	}
+	return New(secret, ttl)
+}
+
+// Enabled tells whether the signer has a secret to sign with.
+func (s Signer) Enabled() bool {
+	return len(s.secret) > 0
+}
+
+// Sign is the token of the id for purpose, expiring ttl after now. It is empty when the signer is disabled.
+func (s Signer) Sign(purpose Purpose, id uuid.UUID, now time.Time) string {
+	if !s.Enabled() {
+		return ""
+	}
+
+	expiresAt := strconv.FormatInt(now.Add(s.ttl).Unix(), 10)
+	return expiresAt + "." + s.signature(purpose, id, expiresAt)
+}
+
+// Verify checks token was signed for the purpose and the id and has not expired at now. Every token is
+// accepted when the signer is disabled.
+func (s Signer) Verify(purpose Purpose, id uuid.UUID, token string, now time.Time) error {
+	if !s.Enabled() {
+		return nil
+	}
+	if token == "" {
+		return ErrMissing
+	}
+
+	expiresAt, signature, found := strings.Cut(token, ".")
+	if !found {
+		return fmt.Errorf("%w: malformed", ErrInvalid)
+	}
+	expiry, err := strconv.ParseInt(expiresAt, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: malformed expiry", ErrInvalid)
+	}
+
+	// Compared before the expiry, so an expired token tells the link was genuine.
+	if !hmac.Equal([]byte(signature), []byte(s.signature(purpose, id, expiresAt))) {
+		return ErrInvalid
+	}
+	if !now.Before(time.Unix(expiry, 0)) {
+		return ErrExpired
+	}
+	return nil
+}
+
+func (s Signer) signature(purpose Purpose, id uuid.UUID, expiresAt string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(string(purpose) + ":" + id.String() + ":" + expiresAt))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,85 @@
+package token
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var signedAt = time.Date(2030, time.March, 11, 12, 0, 0, 0, time.UTC)
+
+func TestVerifyAcceptsTheTokenSigned(t *testing.T) {
+	signer := New("shared-secret", 72*time.Hour)
+	id := uuid.New()
+	token := signer.Sign(PurposeParticipant, id, signedAt)
+
+	if err := signer.Verify(PurposeParticipant, id, token, signedAt.Add(71*time.Hour)); err != nil {
+		t.Fatalf("expected the token accepted before its expiry, got %v", err)
+	}
+}
+
+func TestVerifyRefuses(t *testing.T) {
+	signer := New("shared-secret", 72*time.Hour)
+	id := uuid.New()
+	token := signer.Sign(PurposeParticipant, id, signedAt)
+
+	cases := map[string]struct {
+		signer  Signer
+		purpose Purpose
+		id      uuid.UUID
+		token   string
+		at      time.Time
+		err     error
+	}{
+		"missing token":        {signer, PurposeParticipant, id, "", signedAt, ErrMissing},
+		"malformed token":      {signer, PurposeParticipant, id, "not-a-token", signedAt, ErrInvalid},
+		"malformed expiry":     {signer, PurposeParticipant, id, "soon." + token, signedAt, ErrInvalid},
+		"another id":           {signer, PurposeParticipant, uuid.New(), token, signedAt, ErrInvalid},
+		"another purpose":      {signer, PurposeTrip, id, token, signedAt, ErrInvalid},
+		"another secret":       {New("other-secret", 72*time.Hour), PurposeParticipant, id, token, signedAt, ErrInvalid},
+		"expiry pushed back":   {signer, PurposeParticipant, id, "9999999999" + token[len("1234567890"):], signedAt, ErrInvalid},
+		"expired":              {signer, PurposeParticipant, id, token, signedAt.Add(72 * time.Hour), ErrExpired},
+		"expired long ago":     {signer, PurposeParticipant, id, token, signedAt.AddDate(1, 0, 0), ErrExpired},
+		"signed for a trip id": {signer, PurposeParticipant, id, signer.Sign(PurposeTrip, id, signedAt), signedAt, ErrInvalid},
+	}
+
+	for name, test := range cases {
+		t.Run(name, func(t *testing.T) {
+			if err := test.signer.Verify(test.purpose, test.id, test.token, test.at); !errors.Is(err, test.err) {
+				t.Fatalf("expected %v, got %v", test.err, err)
+			}
+		})
+	}
+}
+
+func TestDisabledSigner(t *testing.T) {
+	signer := New("", 0)
+	id := uuid.New()
+
+	if signer.Enabled() {
+		t.Fatal("expected the signer without secret disabled")
+	}
+	if token := signer.Sign(PurposeTrip, id, signedAt); token != "" {
+		t.Fatalf("expected no token signed, got %q", token)
+	}
+	for _, token := range []string{"", "anything"} {
+		if err := signer.Verify(PurposeTrip, id, token, signedAt); err != nil {
+			t.Fatalf("expected %q accepted, got %v", token, err)
+		}
+	}
+}
+
+func TestNewFallsBackToTheDefaultTTL(t *testing.T) {
+	signer := New("shared-secret", 0)
+	id := uuid.New()
+	token := signer.Sign(PurposeTrip, id, signedAt)
+
+	if err := signer.Verify(PurposeTrip, id, token, signedAt.Add(DEFAULT_TTL-time.Second)); err != nil {
+		t.Fatalf("expected the token lasting %v, got %v", DEFAULT_TTL, err)
+	}
+	if err := signer.Verify(PurposeTrip, id, token, signedAt.Add(DEFAULT_TTL)); !errors.Is(err, ErrExpired) {
+		t.Fatalf("expected the token expired after %v, got %v", DEFAULT_TTL, err)
+	}
+}
@@ -0,0 +1,148 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"journey/cmd/journey/config"
+	"net/http"
+	"time"
+)
+
+const SIGNATURE_HEADER = "X-Journey-Signature"
+const DEFAULT_DELIVERY_ATTEMPTS = 3
+const DEFAULT_DELIVERY_RETRY_DELAY = time.Second
+const DEFAULT_DELIVERY_TIMEOUT = 5 * time.Second
+
+const EVENT_TRIP_CONFIRMED = "trip.confirmed"
+
+// TripConfirmed is the payload delivered when a trip is confirmed.
+type TripConfirmed struct {
+	Event            string    `json:"event"`
+	TripID           string    `json:"trip_id"`
+	Destination      string    `json:"destination"`
+	StartsAt         time.Time `json:"starts_at"`
+	EndsAt           time.Time `json:"ends_at"`
+	ParticipantCount int       `json:"participant_count"`
+}
+
+// Notifier POSTs the events as JSON to url, signed with secret. A Notifier without url delivers nothing.
+type Notifier struct {
+	url        string
+	secret     string
+	client     *http.Client
+	attempts   int
+	retryDelay time.Duration
+	sleep      func(time.Duration)
+}
+
+type Option func(*Notifier)
+
+// WithClient overrides the client the events are delivered with.
+func WithClient(client *http.Client) Option {
+	return func(n *Notifier) {
+		n.client = client
+	}
+}
+
+// WithRetry overrides how many times a delivery is attempted, waiting delay between the attempts.
+func WithRetry(attempts int, delay time.Duration) Option {
+	return func(n *Notifier) {
+		n.attempts = attempts
+		n.retryDelay = delay
+	}
+}
+
+func New(url string, secret string, opts ...Option) *Notifier {
+	n := &Notifier{
+		url:        url,
+		secret:     secret,
+		client:     &http.Client{Timeout: DEFAULT_DELIVERY_TIMEOUT},
+		attempts:   DEFAULT_DELIVERY_ATTEMPTS,
+		retryDelay: DEFAULT_DELIVERY_RETRY_DELAY,
+		sleep:      time.Sleep,
+	}
+
+	for _, opt := range opts {
+		opt(n)
+	}
+
+	return n
+}
+
+// NewFromEnvironment reads JOURNEY_WEBHOOK_URL and JOURNEY_WEBHOOK_SECRET, the notifier delivering nothing
+// when the url is missing.
+func NewFromEnvironment() *Notifier {
+	url, _ := config.GetSpecificEnvironmentVariable("JOURNEY_WEBHOOK_URL")
+	secret, _ := config.GetSpecificEnvironmentVariable("JOURNEY_WEBHOOK_SECRET")
+	return New(url, secret)
+}
+
+// Enabled tells whether the notifier has an url to deliver to.
+func (n *Notifier) Enabled() bool {
+	return n.url != ""
+}
+
+// Sign is the signature of body sent in SIGNATURE_HEADER: "sha256=" and the hex HMAC-SHA256 of the body
+// keyed by the secret.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// NotifyTripConfirmed delivers the trip confirmation.
+func (n *Notifier) NotifyTripConfirmed(ctx context.Context, event TripConfirmed) error {
+	event.Event = EVENT_TRIP_CONFIRMED
+	return n.deliver(ctx, event)
+}
+
+// deliver POSTs the payload, retrying while the endpoint is unreachable or answers a 5xx or 429.
+func (n *Notifier) deliver(ctx context.Context, payload any) error {
+	if !n.Enabled() {
+		return nil
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("webhook: failed to encode the payload: %w", err)
+	}
+	signature := Sign(n.secret, body)
+
+	for attempt := 1; ; attempt++ {
+		retryable, err := n.post(ctx, body, signature)
+		if err == nil {
+			return nil
+		}
+		if !retryable || attempt >= n.attempts {
+			return fmt.Errorf("webhook: failed to deliver after %d attempt(s): %w", attempt, err)
+		}
+		n.sleep(n.retryDelay)
+	}
+}
+
+func (n *Notifier) post(ctx context.Context, body []byte, signature string) (retryable bool, err error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(SIGNATURE_HEADER, signature)
+
+	response, err := n.client.Do(request)
+	if err != nil {
+		return ctx.Err() == nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode >= 200 && response.StatusCode < 300 {
+		return false, nil
+	}
+
+	retryable = response.StatusCode >= 500 || response.StatusCode == http.StatusTooManyRequests
+	return retryable, fmt.Errorf("unexpected status %d", response.StatusCode)
+}
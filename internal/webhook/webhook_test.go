@@ -0,0 +1,106 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNotifyTripConfirmedSignsThePayload(t *testing.T) {
+	const secret = "shared-secret"
+	startsAt := time.Date(2030, time.March, 11, 12, 0, 0, 0, time.UTC)
+
+	var received map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if signature := r.Header.Get(SIGNATURE_HEADER); signature != Sign(secret, body) {
+			t.Errorf("expected the body signed, got %q", signature)
+		}
+		if contentType := r.Header.Get("Content-Type"); contentType != "application/json" {
+			t.Errorf("expected a JSON payload, got %q", contentType)
+		}
+		if err := json.Unmarshal(body, &received); err != nil {
+			t.Errorf("failed to decode the payload: %v", err)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	err := New(server.URL, secret).NotifyTripConfirmed(context.Background(), TripConfirmed{
+		TripID:           "6b3c9d5e-3d4f-4a8e-9a43-1b1f0c3c2f10",
+		Destination:      "Florianópolis",
+		StartsAt:         startsAt,
+		EndsAt:           startsAt.AddDate(0, 0, 3),
+		ParticipantCount: 2,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]any{
+		"event":             EVENT_TRIP_CONFIRMED,
+		"trip_id":           "6b3c9d5e-3d4f-4a8e-9a43-1b1f0c3c2f10",
+		"destination":       "Florianópolis",
+		"starts_at":         "2030-03-11T12:00:00Z",
+		"ends_at":           "2030-03-14T12:00:00Z",
+		"participant_count": float64(2),
+	}
+	if len(received) != len(expected) {
+		t.Fatalf("expected the payload %v, got %v", expected, received)
+	}
+	for key, value := range expected {
+		if received[key] != value {
+			t.Fatalf("expected %s to be %v, got %v", key, value, received[key])
+		}
+	}
+}
+
+func TestNotifyTripConfirmedRetries(t *testing.T) {
+	tests := []struct {
+		name         string
+		statuses     []int
+		wantAttempts int32
+		wantErr      bool
+	}{
+		{"recovers from a 5xx", []int{http.StatusBadGateway, http.StatusOK}, 2, false},
+		{"gives up after the attempts", []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable}, 3, true},
+		{"does not retry a 4xx", []int{http.StatusBadRequest}, 1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempt := attempts.Add(1)
+				w.WriteHeader(tt.statuses[min(int(attempt), len(tt.statuses))-1])
+			}))
+			defer server.Close()
+
+			notifier := New(server.URL, "secret", WithRetry(3, 0))
+			err := notifier.NotifyTripConfirmed(context.Background(), TripConfirmed{})
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if attempts.Load() != tt.wantAttempts {
+				t.Fatalf("expected %d attempts, got %d", tt.wantAttempts, attempts.Load())
+			}
+		})
+	}
+}
+
+func TestNotifyWithoutURLIsSkipped(t *testing.T) {
+	notifier := New("", "secret")
+
+	if notifier.Enabled() {
+		t.Fatal("expected a notifier without url to be disabled")
+	}
+	if err := notifier.NotifyTripConfirmed(context.Background(), TripConfirmed{}); err != nil {
+		t.Fatalf("expected the delivery skipped, got %v", err)
+	}
+}